@@ -2,6 +2,7 @@ package client
 
 import (
 	"fmt"
+	"io"
 	"net/http"
 	"net/url"
 	"path/filepath"
@@ -10,24 +11,55 @@ import (
 
 type HttpCallback func(*http.Response, error) error
 
-func DoGetRequest(reqUrl string, params, headers map[string]interface{}, cb HttpCallback) error {
-	client := http.Client{
-		Timeout: 30 * time.Second,
-	}
+// 拼接请求参数并标准化url
+func buildRequestUrl(reqUrl string, params map[string]interface{}) (string, error) {
 	p := url.Values{}
 	for k, v := range params {
 		p.Add(k, fmt.Sprintf("%v", v))
 	}
 	rawUrl := fmt.Sprintf("%s?%s", reqUrl, p.Encode())
-	// 标准化url
 	parsedUrl, err := url.Parse(rawUrl)
 	if err != nil {
-		return err
+		return "", err
 	}
 	parsedUrl.Path = filepath.Clean(parsedUrl.Path)
-	req, err := http.NewRequest("GET", parsedUrl.String(), nil)
+	return parsedUrl.String(), nil
+}
+
+func DoGetRequest(reqUrl string, params, headers map[string]interface{}, cb HttpCallback) error {
+	client := http.Client{
+		Timeout: 30 * time.Second,
+	}
+	// 标准化url
+	u, err := buildRequestUrl(reqUrl, params)
 	if err != nil {
 		return err
 	}
+	req, err := http.NewRequest("GET", u, nil)
+	if err != nil {
+		return err
+	}
+	for k, v := range headers {
+		req.Header.Set(k, fmt.Sprintf("%v", v))
+	}
+	return cb(client.Do(req))
+}
+
+// DoPostRequest 参数通过query传递, body为请求数据
+func DoPostRequest(reqUrl string, params, headers map[string]interface{}, body io.Reader, cb HttpCallback) error {
+	client := http.Client{
+		Timeout: 60 * time.Second,
+	}
+	u, err := buildRequestUrl(reqUrl, params)
+	if err != nil {
+		return err
+	}
+	req, err := http.NewRequest("POST", u, body)
+	if err != nil {
+		return err
+	}
+	for k, v := range headers {
+		req.Header.Set(k, fmt.Sprintf("%v", v))
+	}
 	return cb(client.Do(req))
 }
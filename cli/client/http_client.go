@@ -1,6 +1,7 @@
 package client
 
 import (
+	"bytes"
 	"encoding/json"
 	"fmt"
 	"io"
@@ -180,24 +181,37 @@ func userOp(host, token, target string, opType common.UserOpType, params map[str
 	return result, err
 }
 
-func AddUser(host, token, target, userName, password, tags string, expire, ttl int) (string, error) {
+func AddUser(host, token, target, userName, password, tags string, expire, ttl int, quota, plan string, resetPeriod, anchorDay int, speedTier string) (string, error) {
 	params := map[string]interface{}{
-		"user":   userName,
-		"pwd":    password,
-		"expire": expire,
-		"ttl":    ttl,
-		"tags":   tags,
+		"user":         userName,
+		"pwd":          password,
+		"expire":       expire,
+		"ttl":          ttl,
+		"tags":         tags,
+		"quota":        quota,
+		"plan":         plan,
+		"reset_period": resetPeriod,
+		"anchor_day":   anchorDay,
+		"speed_tier":   speedTier,
 	}
 	result, err := userOp(host, token, target, common.AddUser, params)
 	return string(result), err
 }
 
-func UpdateUser(host, token, target, userName, password string, expire, ttl int) (string, error) {
+func UpdateUser(host, token, target, userName, password string, expire, ttl int, quota, fields, extend, addTags, removeTags string, resetPeriod, anchorDay int, speedTier string) (string, error) {
 	params := map[string]interface{}{
-		"user":   userName,
-		"pwd":    password,
-		"expire": expire,
-		"ttl":    ttl,
+		"user":         userName,
+		"pwd":          password,
+		"expire":       expire,
+		"ttl":          ttl,
+		"quota":        quota,
+		"fields":       fields,
+		"extend":       extend,
+		"add_tags":     addTags,
+		"remove_tags":  removeTags,
+		"reset_period": resetPeriod,
+		"anchor_day":   anchorDay,
+		"speed_tier":   speedTier,
 	}
 	result, err := userOp(host, token, target, common.UpdateUser, params)
 	return string(result), err
@@ -220,6 +234,22 @@ func ResetUser(host, token, target, userName string) (string, error) {
 	return string(result), err
 }
 
+func SuspendUser(host, token, target, userName string) (string, error) {
+	params := map[string]interface{}{
+		"user": userName,
+	}
+	result, err := userOp(host, token, target, common.SuspendUser, params)
+	return string(result), err
+}
+
+func ResumeUser(host, token, target, userName string) (string, error) {
+	params := map[string]interface{}{
+		"user": userName,
+	}
+	result, err := userOp(host, token, target, common.ResumeUser, params)
+	return string(result), err
+}
+
 func ListUser(host, token, target string) (map[string][]*proto.User, error) {
 	data, err := userOp(host, token, target, common.ListUser, nil)
 	if err != nil {
@@ -300,3 +330,272 @@ func DeleteInBound(host, token, target, srcTag string) (string, error) {
 	err := DoGetRequest(reqUrl, headers, nil, getCallBackFunc(cb))
 	return result, err
 }
+
+func planOp(host, token, target, opType string, params map[string]interface{}) ([]byte, error) {
+	result := []byte{}
+	headers := map[string]interface{}{
+		"token":  token,
+		"target": target,
+		"type":   opType,
+	}
+	for k, v := range params {
+		headers[k] = v
+	}
+
+	cb := func(resp *http.Response) error {
+		defer resp.Body.Close()
+		d, err := io.ReadAll(resp.Body)
+		if err != nil {
+			return err
+		}
+		result = d
+		return nil
+	}
+
+	reqUrl := fmt.Sprintf("%s/%s", host, common.Plan)
+	err := DoGetRequest(reqUrl, headers, nil, getCallBackFunc(cb))
+	return result, err
+}
+
+func ListPlan(host, token, target string) (map[string][]*proto.Plan, error) {
+	data, err := planOp(host, token, target, "list", nil)
+	if err != nil {
+		return nil, err
+	}
+	plans := map[string][]*proto.Plan{}
+	if err := json.Unmarshal(data, &plans); err != nil {
+		return nil, err
+	}
+	return plans, nil
+}
+
+func SetPlan(host, token, target, plan, tags, duration, quota string, level, resetPeriod, anchorDay int, nodeSelector string, reapply bool) (string, error) {
+	params := map[string]interface{}{
+		"name":          plan,
+		"tags":          tags,
+		"duration":      duration,
+		"quota":         quota,
+		"level":         level,
+		"reset_period":  resetPeriod,
+		"anchor_day":    anchorDay,
+		"node_selector": nodeSelector,
+	}
+	if reapply {
+		params["reapply"] = "1"
+	}
+	result, err := planOp(host, token, target, "set", params)
+	return string(result), err
+}
+
+func DeletePlan(host, token, target, plan string) (string, error) {
+	params := map[string]interface{}{
+		"name": plan,
+	}
+	result, err := planOp(host, token, target, "del", params)
+	return string(result), err
+}
+
+type TrafficReport struct {
+	Nodes  map[string][]*proto.TrafficSeries `json:"nodes"`
+	Merged []*proto.TrafficSeries            `json:"merged"`
+}
+
+func GetTrafficReport(host, token, target, statType, name, granularity, month string) (*TrafficReport, error) {
+	result := []byte{}
+	headers := map[string]interface{}{
+		"token":       token,
+		"target":      target,
+		"type":        statType,
+		"name":        name,
+		"granularity": granularity,
+		"month":       month,
+	}
+
+	cb := func(resp *http.Response) error {
+		defer resp.Body.Close()
+		d, err := io.ReadAll(resp.Body)
+		if err != nil {
+			return err
+		}
+		result = d
+		return nil
+	}
+
+	reqUrl := fmt.Sprintf("%s/%s", host, common.Report)
+	if err := DoGetRequest(reqUrl, headers, nil, getCallBackFunc(cb)); err != nil {
+		return nil, err
+	}
+	report := &TrafficReport{}
+	if err := json.Unmarshal(result, report); err != nil {
+		return nil, fmt.Errorf("%s", string(result))
+	}
+	return report, nil
+}
+
+// 导入导出数据的加密口令通过请求头传递
+func transferKeyHeaders(key string) map[string]interface{} {
+	if key == "" {
+		return nil
+	}
+	return map[string]interface{}{"X-Transfer-Key": key}
+}
+
+// ExportUsers 导出用户, 返回原始数据, 指定key时为加密后的base64数据
+func ExportUsers(host, token, target, format, key string) ([]byte, error) {
+	result := []byte{}
+	headers := map[string]interface{}{
+		"token":  token,
+		"target": target,
+		"format": format,
+	}
+
+	cb := func(resp *http.Response) error {
+		defer resp.Body.Close()
+		d, err := io.ReadAll(resp.Body)
+		if err != nil {
+			return err
+		}
+		result = d
+		return nil
+	}
+
+	reqUrl := fmt.Sprintf("%s/%s", host, common.ExportUsers)
+	err := DoGetRequest(reqUrl, headers, transferKeyHeaders(key), getCallBackFunc(cb))
+	return result, err
+}
+
+// ImportUsers 导入用户, 返回各节点的导入结果
+func ImportUsers(host, token, target, format, policy string, dryRun bool, key string, data []byte) (map[string]interface{}, error) {
+	result := []byte{}
+	headers := map[string]interface{}{
+		"token":   token,
+		"target":  target,
+		"format":  format,
+		"policy":  policy,
+		"dry_run": dryRun,
+	}
+
+	cb := func(resp *http.Response) error {
+		defer resp.Body.Close()
+		d, err := io.ReadAll(resp.Body)
+		if err != nil {
+			return err
+		}
+		result = d
+		return nil
+	}
+
+	reqUrl := fmt.Sprintf("%s/%s", host, common.ImportUsers)
+	if err := DoPostRequest(reqUrl, headers, transferKeyHeaders(key), bytes.NewReader(data), getCallBackFunc(cb)); err != nil {
+		return nil, err
+	}
+	report := map[string]interface{}{}
+	if err := json.Unmarshal(result, &report); err != nil {
+		return nil, fmt.Errorf("%s", string(result))
+	}
+	return report, nil
+}
+
+// Reconcile 对比各节点的用户, data不为空时使用data作为期望状态, 否则使用source节点上的用户
+func Reconcile(host, token, source, target string, repair, prune bool, format, key string, data []byte) (map[string]interface{}, error) {
+	result := []byte{}
+	headers := map[string]interface{}{
+		"token":  token,
+		"source": source,
+		"target": target,
+		"repair": repair,
+		"prune":  prune,
+		"format": format,
+	}
+
+	cb := func(resp *http.Response) error {
+		defer resp.Body.Close()
+		d, err := io.ReadAll(resp.Body)
+		if err != nil {
+			return err
+		}
+		result = d
+		return nil
+	}
+
+	reqUrl := fmt.Sprintf("%s/%s", host, common.Reconcile)
+	var err error = nil
+	if len(data) > 0 {
+		err = DoPostRequest(reqUrl, headers, transferKeyHeaders(key), bytes.NewReader(data), getCallBackFunc(cb))
+	} else {
+		err = DoGetRequest(reqUrl, headers, nil, getCallBackFunc(cb))
+	}
+	if err != nil {
+		return nil, err
+	}
+	report := map[string]interface{}{}
+	if err := json.Unmarshal(result, &report); err != nil {
+		return nil, fmt.Errorf("%s", string(result))
+	}
+	return report, nil
+}
+
+type AuditReport struct {
+	Entries []*proto.AuditEntry `json:"entries"`
+	Failed  map[string]string   `json:"failed"`
+}
+
+func GetAuditLog(host, token, target, caller, operation, last, limit string) (*AuditReport, error) {
+	result := []byte{}
+	headers := map[string]interface{}{
+		"token":     token,
+		"target":    target,
+		"caller":    caller,
+		"operation": operation,
+		"last":      last,
+		"limit":     limit,
+	}
+
+	cb := func(resp *http.Response) error {
+		defer resp.Body.Close()
+		d, err := io.ReadAll(resp.Body)
+		if err != nil {
+			return err
+		}
+		result = d
+		return nil
+	}
+
+	reqUrl := fmt.Sprintf("%s/%s", host, common.Audit)
+	if err := DoGetRequest(reqUrl, headers, nil, getCallBackFunc(cb)); err != nil {
+		return nil, err
+	}
+	report := &AuditReport{}
+	if err := json.Unmarshal(result, report); err != nil {
+		return nil, fmt.Errorf("%s", string(result))
+	}
+	return report, nil
+}
+
+func CreateTrial(host, token, target string) (map[string]interface{}, error) {
+	result := []byte{}
+	headers := map[string]interface{}{
+		"token":  token,
+		"target": target,
+	}
+
+	cb := func(resp *http.Response) error {
+		defer resp.Body.Close()
+		d, err := io.ReadAll(resp.Body)
+		if err != nil {
+			return err
+		}
+		result = d
+		return nil
+	}
+
+	reqUrl := fmt.Sprintf("%s/%s", host, common.Trial)
+	if err := DoGetRequest(reqUrl, headers, nil, getCallBackFunc(cb)); err != nil {
+		return nil, err
+	}
+	trialUser := map[string]interface{}{}
+	if err := json.Unmarshal(result, &trialUser); err != nil {
+		return nil, fmt.Errorf("%s", string(result))
+	}
+	return trialUser, nil
+}
@@ -1,8 +1,11 @@
 package main
 
 import (
+	"encoding/json"
 	"fmt"
+	"os"
 	"reflect"
+	"time"
 
 	"github.com/lureiny/go-prompt"
 	"github.com/lureiny/v2raymg/cli/client"
@@ -78,6 +81,11 @@ func initPromptAndRegister() *prompt.Prompt {
 			tagsSuggest,
 			expireSuggest,
 			ttlSuggest,
+			quotaSuggest,
+			planSuggest,
+			resetPeriodSuggest,
+			anchorDaySuggest,
+			speedTierSuggest,
 		}),
 		prompt.WithGetSuggestMethod(GetSuggest),
 	)
@@ -89,6 +97,14 @@ func initPromptAndRegister() *prompt.Prompt {
 			passwordSuggest,
 			expireSuggest,
 			ttlSuggest,
+			quotaSuggest,
+			updateFieldsSuggest,
+			extendSuggest,
+			addTagsSuggest,
+			removeTagsSuggest,
+			resetPeriodSuggest,
+			anchorDaySuggest,
+			speedTierSuggest,
 		}),
 		prompt.WithGetSuggestMethod(GetSuggest),
 	)
@@ -110,6 +126,82 @@ func initPromptAndRegister() *prompt.Prompt {
 		prompt.WithGetSuggestMethod(GetSuggest),
 	)
 
+	m.RegisterHandler(suspendUser, "SuspendUser",
+		prompt.WithSuggests([]prompt.Suggest{
+			getSuggestWithTemplate(targetSuggest, WihtDefault("")),
+			userNameSuggest,
+		}),
+		prompt.WithGetSuggestMethod(GetSuggest),
+	)
+
+	m.RegisterHandler(resumeUser, "ResumeUser",
+		prompt.WithSuggests([]prompt.Suggest{
+			getSuggestWithTemplate(targetSuggest, WihtDefault("")),
+			userNameSuggest,
+		}),
+		prompt.WithGetSuggestMethod(GetSuggest),
+	)
+
+	m.RegisterHandler(listPlan, "ListPlan",
+		prompt.WithSuggests([]prompt.Suggest{
+			getSuggestWithTemplate(targetSuggest, WihtDefault("all")),
+		}),
+		prompt.WithGetSuggestMethod(GetSuggest),
+	)
+
+	m.RegisterHandler(setPlan, "SetPlan",
+		prompt.WithSuggests([]prompt.Suggest{
+			getSuggestWithTemplate(targetSuggest, WihtDefault("all")),
+			planSuggest,
+			tagsSuggest,
+			durationSuggest,
+			quotaSuggest,
+			levelSuggest,
+			resetPeriodSuggest,
+			anchorDaySuggest,
+			nodeSelectorSuggest,
+			reapplySuggest,
+		}),
+		prompt.WithGetSuggestMethod(GetSuggest),
+	)
+
+	m.RegisterHandler(deletePlan, "DeletePlan",
+		prompt.WithSuggests([]prompt.Suggest{
+			getSuggestWithTemplate(targetSuggest, WihtDefault("all")),
+			planSuggest,
+		}),
+		prompt.WithGetSuggestMethod(GetSuggest),
+	)
+
+	m.RegisterHandler(getReport, "GetReport",
+		prompt.WithSuggests([]prompt.Suggest{
+			getSuggestWithTemplate(targetSuggest, WihtDefault("all")),
+			trafficTypeSuggest,
+			reportNameSuggest,
+			granularitySuggest,
+			monthSuggest,
+		}),
+		prompt.WithGetSuggestMethod(GetSuggest),
+	)
+
+	m.RegisterHandler(createTrial, "CreateTrial",
+		prompt.WithSuggests([]prompt.Suggest{
+			getSuggestWithTemplate(targetSuggest, WihtDefault("all")),
+		}),
+		prompt.WithGetSuggestMethod(GetSuggest),
+	)
+
+	m.RegisterHandler(getAudit, "GetAudit",
+		prompt.WithSuggests([]prompt.Suggest{
+			getSuggestWithTemplate(targetSuggest, WihtDefault("all")),
+			auditCallerSuggest,
+			auditOperationSuggest,
+			auditLastSuggest,
+			auditLimitSuggest,
+		}),
+		prompt.WithGetSuggestMethod(GetSuggest),
+	)
+
 	m.RegisterHandler(clearUsers, "ClearUsers",
 		prompt.WithSuggests([]prompt.Suggest{
 			getSuggestWithTemplate(targetSuggest, WihtDefault("")),
@@ -118,6 +210,41 @@ func initPromptAndRegister() *prompt.Prompt {
 		prompt.WithGetSuggestMethod(GetSuggest),
 	)
 
+	m.RegisterHandler(exportUsers, "ExportUsers",
+		prompt.WithSuggests([]prompt.Suggest{
+			getSuggestWithTemplate(targetSuggest, WihtDefault("all")),
+			transferFormatSuggest,
+			transferFileSuggest,
+			transferKeySuggest,
+		}),
+		prompt.WithGetSuggestMethod(GetSuggest),
+	)
+
+	m.RegisterHandler(importUsers, "ImportUsers",
+		prompt.WithSuggests([]prompt.Suggest{
+			getSuggestWithTemplate(targetSuggest, WihtDefault("")),
+			transferFormatSuggest,
+			transferFileSuggest,
+			importPolicySuggest,
+			dryRunSuggest,
+			transferKeySuggest,
+		}),
+		prompt.WithGetSuggestMethod(GetSuggest),
+	)
+
+	m.RegisterHandler(reconcile, "Reconcile",
+		prompt.WithSuggests([]prompt.Suggest{
+			reconcileSourceSuggest,
+			getSuggestWithTemplate(targetSuggest, WihtDefault("all")),
+			repairSuggest,
+			pruneSuggest,
+			reconcileFileSuggest,
+			transferFormatSuggest,
+			transferKeySuggest,
+		}),
+		prompt.WithGetSuggestMethod(GetSuggest),
+	)
+
 	m.RegisterHandler(copyUserInbound, "CopyUserInbound",
 		prompt.WithSuggests([]prompt.Suggest{
 			getSuggestWithTemplate(targetSuggest, WihtDefault("")),
@@ -222,8 +349,8 @@ func copyUserBetweenNodes(srcNode, dstNode string) error {
 	return nil
 }
 
-func addUser(target, userName, password, tags string, expire, ttl int) error {
-	result, err := client.AddUser(getHost(), getToken(), target, userName, password, tags, expire, ttl)
+func addUser(target, userName, password, tags string, expire, ttl int, quota, plan string, resetPeriod, anchorDay int, speedTier string) error {
+	result, err := client.AddUser(getHost(), getToken(), target, userName, password, tags, expire, ttl, quota, plan, resetPeriod, anchorDay, speedTier)
 	if err != nil {
 		return err
 	}
@@ -231,8 +358,8 @@ func addUser(target, userName, password, tags string, expire, ttl int) error {
 	return nil
 }
 
-func updateUser(target, userName, password string, expire, ttl int) error {
-	result, err := client.UpdateUser(getHost(), getToken(), target, userName, password, expire, ttl)
+func updateUser(target, userName, password string, expire, ttl int, quota, fields, extend, addTags, removeTags string, resetPeriod, anchorDay int, speedTier string) error {
+	result, err := client.UpdateUser(getHost(), getToken(), target, userName, password, expire, ttl, quota, fields, extend, addTags, removeTags, resetPeriod, anchorDay, speedTier)
 	if err != nil {
 		return err
 	}
@@ -258,6 +385,24 @@ func resetUser(target, userName string) error {
 	return nil
 }
 
+func suspendUser(target, userName string) error {
+	result, err := client.SuspendUser(getHost(), getToken(), target, userName)
+	if err != nil {
+		return err
+	}
+	fmt.Println(result)
+	return nil
+}
+
+func resumeUser(target, userName string) error {
+	result, err := client.ResumeUser(getHost(), getToken(), target, userName)
+	if err != nil {
+		return err
+	}
+	fmt.Println(result)
+	return nil
+}
+
 func listUser(target string) error {
 	result, err := client.ListUser(getHost(), getToken(), target)
 	if err != nil {
@@ -275,6 +420,84 @@ func listUser(target string) error {
 	return nil
 }
 
+func listPlan(target string) error {
+	result, err := client.ListPlan(getHost(), getToken(), target)
+	if err != nil {
+		return err
+	}
+	for node, plans := range result {
+		fmt.Println(node, ":")
+		for _, plan := range plans {
+			fmt.Println(plan)
+		}
+	}
+	return nil
+}
+
+func setPlan(target, plan, tags, duration, quota string, level, resetPeriod, anchorDay int, nodeSelector string, reapply bool) error {
+	result, err := client.SetPlan(getHost(), getToken(), target, plan, tags, duration, quota, level, resetPeriod, anchorDay, nodeSelector, reapply)
+	if err != nil {
+		return err
+	}
+	fmt.Println(result)
+	return nil
+}
+
+func deletePlan(target, plan string) error {
+	result, err := client.DeletePlan(getHost(), getToken(), target, plan)
+	if err != nil {
+		return err
+	}
+	fmt.Println(result)
+	return nil
+}
+
+func getReport(target, statType, name, granularity, month string) error {
+	report, err := client.GetTrafficReport(getHost(), getToken(), target, statType, name, granularity, month)
+	if err != nil {
+		return err
+	}
+	for _, series := range report.Merged {
+		fmt.Printf("%s[%s]: uplink=%d, downlink=%d\n", series.Name, series.Type, series.Uplink, series.Downlink)
+		for _, point := range series.Points {
+			fmt.Printf("\t%s: uplink=%d, downlink=%d\n",
+				time.Unix(point.Timestamp, 0).Format("2006-01-02 15:04"), point.Uplink, point.Downlink)
+		}
+	}
+	return nil
+}
+
+func createTrial(target string) error {
+	trialUser, err := client.CreateTrial(getHost(), getToken(), target)
+	if err != nil {
+		return err
+	}
+	result, _ := json.MarshalIndent(trialUser, "", "  ")
+	fmt.Println(string(result))
+	return nil
+}
+
+func getAudit(target, caller, operation, last, limit string) error {
+	report, err := client.GetAuditLog(getHost(), getToken(), target, caller, operation, last, limit)
+	if err != nil {
+		return err
+	}
+	for _, entry := range report.Entries {
+		result := "succ"
+		if !entry.Success {
+			result = "fail"
+		}
+		fmt.Printf("%s %s[%s]: caller=%s, operation=%s, target=%s, result=%s, params=%s, msg=%s\n",
+			time.UnixMilli(entry.Time).Format("2006-01-02 15:04:05"),
+			entry.Node, entry.Source, entry.Caller, entry.Operation, entry.Target,
+			result, entry.Params, entry.Msg)
+	}
+	for node, errMsg := range report.Failed {
+		fmt.Printf("node %s fail: %s\n", node, errMsg)
+	}
+	return nil
+}
+
 func clearUsers(target, users string) error {
 	result, err := client.ClearUser(getHost(), getToken(), target, users)
 	if err != nil {
@@ -284,6 +507,50 @@ func clearUsers(target, users string) error {
 	return nil
 }
 
+func exportUsers(target, format, file, key string) error {
+	data, err := client.ExportUsers(getHost(), getToken(), target, format, key)
+	if err != nil {
+		return err
+	}
+	if err := os.WriteFile(file, data, 0600); err != nil {
+		return err
+	}
+	fmt.Printf("export users to %s\n", file)
+	return nil
+}
+
+func importUsers(target, format, file, policy string, dryRun bool, key string) error {
+	data, err := os.ReadFile(file)
+	if err != nil {
+		return err
+	}
+	report, err := client.ImportUsers(getHost(), getToken(), target, format, policy, dryRun, key, data)
+	if err != nil {
+		return err
+	}
+	result, _ := json.MarshalIndent(report, "", "  ")
+	fmt.Println(string(result))
+	return nil
+}
+
+func reconcile(source, target string, repair, prune bool, file, format, key string) error {
+	var data []byte = nil
+	if file != "" {
+		d, err := os.ReadFile(file)
+		if err != nil {
+			return err
+		}
+		data = d
+	}
+	report, err := client.Reconcile(getHost(), getToken(), source, target, repair, prune, format, key, data)
+	if err != nil {
+		return err
+	}
+	result, _ := json.MarshalIndent(report, "", "  ")
+	fmt.Println(string(result))
+	return nil
+}
+
 func copyUserInbound(target, srcTag, dstTag string) error {
 	result, err := client.CopyUserInBound(getHost(), getToken(), target, srcTag, dstTag)
 	if err != nil {
@@ -309,7 +576,8 @@ func addAllUserToInbound(target, inboundTag string) error {
 	}
 	users := result[target]
 	for _, user := range users {
-		if err := addUser(target, user.GetName(), user.GetPasswd(), inboundTag, int(user.GetExpireTime()), 0); err != nil {
+		// 节点上只保存密码哈希, 已存在的用户添加到新的inbound时沿用节点上保存的凭证, 不需要传递密码
+		if err := addUser(target, user.GetName(), "", inboundTag, int(user.GetExpireTime()), 0, "", "", 0, 0, ""); err != nil {
 			fmt.Printf("add user[%s] to inbound[%s] fail, err: %v\n", user.GetName(), inboundTag, err)
 		}
 	}
@@ -11,7 +11,14 @@ const (
 
 	CopyUserBetweenNodes = "copyUserBetweenNodes"
 	User                 = "user"
+	Plan                 = "plan"
+	Report               = "report"
 	ClearUsers           = "clearUsers"
+	ExportUsers          = "exportUsers"
+	ImportUsers          = "importUsers"
+	Reconcile            = "reconcile"
+	Audit                = "audit"
+	Trial                = "trial"
 
 	Bound = "bound"
 )
@@ -25,4 +32,6 @@ const (
 	DeleteUser
 	ResetUser
 	ListUser
+	SuspendUser
+	ResumeUser
 )
@@ -1,6 +1,7 @@
 package main
 
 import (
+	"sort"
 	"strings"
 
 	"github.com/lureiny/go-prompt"
@@ -11,7 +12,7 @@ import (
 var (
 	targetSuggest = prompt.Suggest{
 		Text:        "target",
-		Description: "node name",
+		Description: "node name, comma separated names or label selector like region=jp,tier!=free",
 		Default:     "",
 	}
 
@@ -29,7 +30,7 @@ var (
 
 	protocolSuggest = prompt.Suggest{
 		Text:        "protocol",
-		Description: "vless, vmess or trojan",
+		Description: "vless, vmess, trojan, shadowsocks or shadowsocks-2022",
 		Default:     "trojan",
 	}
 
@@ -104,6 +105,132 @@ var (
 		Default:     int(0),
 	}
 
+	quotaSuggest = prompt.Suggest{
+		Text:        "quota",
+		Description: "user traffic quota, eg: 100GB, 0 no limit",
+		Default:     "",
+	}
+
+	updateFieldsSuggest = prompt.Suggest{
+		Text:        "fields",
+		Description: "only update these fields, eg: pwd,expire,quota,billing,sub_token,speed_tier, empty update pwd/expire/quota when no other update option",
+		Default:     "",
+	}
+
+	extendSuggest = prompt.Suggest{
+		Text:        "extend",
+		Description: "extend user expire time, seconds or duration, eg: 720h",
+		Default:     "",
+	}
+
+	addTagsSuggest = prompt.Suggest{
+		Text:        "add_tags",
+		Description: "add user to these inbound tags, eg: tag1,tag2",
+		Default:     "",
+	}
+
+	removeTagsSuggest = prompt.Suggest{
+		Text:        "remove_tags",
+		Description: "remove user from these inbound tags, eg: tag1,tag2",
+		Default:     "",
+	}
+
+	planSuggest = prompt.Suggest{
+		Text:        "plan",
+		Description: "plan name",
+		Default:     "",
+	}
+
+	durationSuggest = prompt.Suggest{
+		Text:        "duration",
+		Description: "plan duration, seconds or duration, eg: 720h, 0 no expire",
+		Default:     "",
+	}
+
+	levelSuggest = prompt.Suggest{
+		Text:        "level",
+		Description: "xray/v2ray user level",
+		Default:     int(0),
+	}
+
+	resetPeriodSuggest = prompt.Suggest{
+		Text:        "reset_period",
+		Description: "traffic reset period in months, 0 no reset",
+		Default:     int(0),
+	}
+
+	anchorDaySuggest = prompt.Suggest{
+		Text:        "anchor_day",
+		Description: "traffic reset day of month, 0 use create day",
+		Default:     int(0),
+	}
+
+	speedTierSuggest = prompt.Suggest{
+		Text:        "speed_tier",
+		Description: "speed tier name in config speed_tiers, empty use level 0 when update",
+		Default:     "",
+	}
+
+	nodeSelectorSuggest = prompt.Suggest{
+		Text:        "node_selector",
+		Description: "nodes the plan applies to, same format as target, e.g. region=jp,tier!=free",
+		Default:     "",
+	}
+
+	reapplySuggest = prompt.Suggest{
+		Text:        "reapply",
+		Description: "reapply plan to every user on it",
+		Default:     false,
+	}
+
+	trafficTypeSuggest = prompt.Suggest{
+		Text:        "type",
+		Description: "traffic type, user/inbound/outbound/node",
+		Default:     "user",
+	}
+
+	reportNameSuggest = prompt.Suggest{
+		Text:        "name",
+		Description: "user name/inbound tag/node name, empty for all",
+		Default:     "",
+	}
+
+	granularitySuggest = prompt.Suggest{
+		Text:        "granularity",
+		Description: "hour or day",
+		Default:     "day",
+	}
+
+	monthSuggest = prompt.Suggest{
+		Text:        "month",
+		Description: "report month, eg: 2022-11, empty for last 30 days",
+		Default:     "",
+	}
+
+	auditCallerSuggest = prompt.Suggest{
+		Text:        "caller",
+		Description: "http client ip or node name, empty for all",
+		Default:     "",
+	}
+
+	auditOperationSuggest = prompt.Suggest{
+		Text:        "operation",
+		Description: "http path or rpc method, eg: /user, AddUsers, empty for all",
+		Default:     "",
+	}
+
+	auditLastSuggest = prompt.Suggest{
+		Text:        "last",
+		Description: "query duration before now, eg: 24h",
+		Default:     "168h",
+	}
+
+	auditLimitSuggest = prompt.Suggest{
+		Text:        "limit",
+		Description: "max number of entries, at most 1000",
+		Default:     "100",
+	}
+
 	srcTagSuggest = prompt.Suggest{
 		Text:        "src_tag",
 		Description: "src inbound tag",
@@ -115,6 +242,60 @@ var (
 		Description: "dst inbound tag",
 		Default:     "",
 	}
+
+	transferFormatSuggest = prompt.Suggest{
+		Text:        "format",
+		Description: "json or csv",
+		Default:     "json",
+	}
+
+	transferFileSuggest = prompt.Suggest{
+		Text:        "file",
+		Description: "local file path of exported/imported users",
+		Default:     "",
+	}
+
+	importPolicySuggest = prompt.Suggest{
+		Text:        "policy",
+		Description: "policy for existing user, skip/overwrite/merge",
+		Default:     "skip",
+	}
+
+	dryRunSuggest = prompt.Suggest{
+		Text:        "dry_run",
+		Description: "only report import actions without change",
+		Default:     false,
+	}
+
+	transferKeySuggest = prompt.Suggest{
+		Text:        "key",
+		Description: "passphrase to encrypt/decrypt user data, empty for plaintext",
+		Default:     "",
+	}
+
+	reconcileSourceSuggest = prompt.Suggest{
+		Text:        "source",
+		Description: "source node as desired state, ignored when file is set",
+		Default:     "",
+	}
+
+	reconcileFileSuggest = prompt.Suggest{
+		Text:        "file",
+		Description: "local file path of exported users as desired state",
+		Default:     "",
+	}
+
+	repairSuggest = prompt.Suggest{
+		Text:        "repair",
+		Description: "add missing users and fix mismatched fields",
+		Default:     false,
+	}
+
+	pruneSuggest = prompt.Suggest{
+		Text:        "prune",
+		Description: "delete users not in desired state when repair",
+		Default:     false,
+	}
 )
 
 type SetSuggestOption func(*prompt.Suggest)
@@ -181,6 +362,7 @@ func GetSuggest(h *prompt.HandlerInfo, input string) ([]prompt.Suggest, error) {
 func isInputNodeName(lastFlag, suggestPrefix string) bool {
 	return lastFlag == suggestPrefix+srcNodeSuggest.Text ||
 		lastFlag == suggestPrefix+dstNodeSuggest.Text ||
+		lastFlag == suggestPrefix+reconcileSourceSuggest.Text ||
 		lastFlag == suggestPrefix+targetSuggest.Text
 }
 
@@ -261,5 +443,25 @@ func getNodeSuggest(input string) ([]prompt.Suggest, error) {
 			})
 		}
 	}
+	// 节点标签作为选择器提示
+	labels := []string{}
+	labelSet := map[string]struct{}{}
+	for _, node := range localNodeList {
+		for key, value := range node.GetLabels() {
+			label := key + "=" + value
+			if _, ok := labelSet[label]; !ok && prompt.IsMatch(input, label) {
+				labelSet[label] = struct{}{}
+				labels = append(labels, label)
+			}
+		}
+	}
+	sort.Strings(labels)
+	for _, label := range labels {
+		results = append(results, prompt.Suggest{
+			Text:        label,
+			Description: "label selector",
+			SuggestType: prompt.SuggestOfHandler,
+		})
+	}
 	return results, nil
 }
@@ -71,6 +71,28 @@ func init() {
 	registerReqToEndNodeFunc(ClearUsersType, ReqClearUsers)
 	// get ping metric
 	registerReqToEndNodeFunc(GetPingMetricType, ReqGetPingMetric)
+	// suspend users
+	registerReqToEndNodeFunc(SuspendUsersReqType, ReqSuspendUsers)
+	// resume users
+	registerReqToEndNodeFunc(ResumeUsersReqType, ReqResumeUsers)
+	// set plans
+	registerReqToEndNodeFunc(SetPlansReqType, ReqSetPlans)
+	// delete plans
+	registerReqToEndNodeFunc(DeletePlansReqType, ReqDeletePlans)
+	// get plans
+	registerReqToEndNodeFunc(GetPlansReqType, ReqGetPlans)
+	// get traffic report
+	registerReqToEndNodeFunc(GetTrafficReportReqType, ReqGetTrafficReport)
+	// export users
+	registerReqToEndNodeFunc(ExportUsersReqType, ReqExportUsers)
+	// import users
+	registerReqToEndNodeFunc(ImportUsersReqType, ReqImportUsers)
+	// reconcile users
+	registerReqToEndNodeFunc(ReconcileUsersReqType, ReqReconcileUsers)
+	// get audit log
+	registerReqToEndNodeFunc(GetAuditLogReqType, ReqGetAuditLog)
+	// issue node cert
+	registerReqToEndNodeFunc(IssueNodeCertType, ReqIssueNodeCert)
 	// register node
 	registerReqToEndNodeFunc(RegisterNodeType, ReqRegisterNode)
 }
@@ -118,6 +140,15 @@ func ReqRegisterNode(ctx context.Context, reqData []byte, endNodeAccessClient pr
 	return endNodeAccessClient.RegisterNode(ctx, registerNodeReq, grpc.ForceCodec(rpc.NewEncryptMessageCodec(token)))
 }
 
+func ReqIssueNodeCert(ctx context.Context, reqData []byte, endNodeAccessClient proto.EndNodeAccessClient, nodeAuthInfo *proto.NodeAuthInfo, token string) (interface{}, error) {
+	issueNodeCertReq := &proto.IssueNodeCertReq{}
+	if err := pb.Unmarshal(reqData, issueNodeCertReq); err != nil {
+		return nil, fmt.Errorf("can't unmarshal req[%v] to issue node cert req > %v", reqData, err)
+	}
+	issueNodeCertReq.NodeAuthInfo = nodeAuthInfo
+	return endNodeAccessClient.IssueNodeCert(ctx, issueNodeCertReq, grpc.ForceCodec(rpc.NewEncryptMessageCodec(token)))
+}
+
 func ReqHeartBeat(ctx context.Context, reqData []byte, endNodeAccessClient proto.EndNodeAccessClient, nodeAuthInfo *proto.NodeAuthInfo, token string) (interface{}, error) {
 	heartBeatReq := &proto.HeartBeatReq{}
 	if err := pb.Unmarshal(reqData, heartBeatReq); err != nil {
@@ -127,6 +158,15 @@ func ReqHeartBeat(ctx context.Context, reqData []byte, endNodeAccessClient proto
 	return endNodeAccessClient.HeartBeat(ctx, heartBeatReq, grpc.ForceCodec(rpc.NewEncryptMessageCodec(token)))
 }
 
+func ReqGossipPing(ctx context.Context, reqData []byte, endNodeAccessClient proto.EndNodeAccessClient, nodeAuthInfo *proto.NodeAuthInfo, token string) (interface{}, error) {
+	gossipPingReq := &proto.GossipPingReq{}
+	if err := pb.Unmarshal(reqData, gossipPingReq); err != nil {
+		return nil, fmt.Errorf("can't unmarshal req[%v] to gossip ping req > %v", reqData, err)
+	}
+	gossipPingReq.NodeAuthInfo = nodeAuthInfo
+	return endNodeAccessClient.GossipPing(ctx, gossipPingReq, grpc.ForceCodec(rpc.NewEncryptMessageCodec(token)))
+}
+
 func ReqAddUsers(ctx context.Context, reqData []byte, endNodeAccessClient proto.EndNodeAccessClient, nodeAuthInfo *proto.NodeAuthInfo, token string) (interface{}, error) {
 	addUsersReq := &proto.UserOpReq{}
 	if err := pb.Unmarshal(reqData, addUsersReq); err != nil {
@@ -171,6 +211,28 @@ func ReqResetUser(ctx context.Context, reqData []byte, endNodeAccessClient proto
 	return processUserOpRsp(rsp, err)
 }
 
+func ReqSuspendUsers(ctx context.Context, reqData []byte, endNodeAccessClient proto.EndNodeAccessClient, nodeAuthInfo *proto.NodeAuthInfo, token string) (interface{}, error) {
+	suspendUsersReq := &proto.UserOpReq{}
+	if err := pb.Unmarshal(reqData, suspendUsersReq); err != nil {
+		return nil, fmt.Errorf("can't unmarshal req[%v] to suspend users req > %v", reqData, err)
+	}
+
+	suspendUsersReq.NodeAuthInfo = nodeAuthInfo
+	rsp, err := endNodeAccessClient.SuspendUsers(ctx, suspendUsersReq, grpc.ForceCodec(rpc.NewEncryptMessageCodec(token)))
+	return processUserOpRsp(rsp, err)
+}
+
+func ReqResumeUsers(ctx context.Context, reqData []byte, endNodeAccessClient proto.EndNodeAccessClient, nodeAuthInfo *proto.NodeAuthInfo, token string) (interface{}, error) {
+	resumeUsersReq := &proto.UserOpReq{}
+	if err := pb.Unmarshal(reqData, resumeUsersReq); err != nil {
+		return nil, fmt.Errorf("can't unmarshal req[%v] to resume users req > %v", reqData, err)
+	}
+
+	resumeUsersReq.NodeAuthInfo = nodeAuthInfo
+	rsp, err := endNodeAccessClient.ResumeUsers(ctx, resumeUsersReq, grpc.ForceCodec(rpc.NewEncryptMessageCodec(token)))
+	return processUserOpRsp(rsp, err)
+}
+
 func ReqGetSub(ctx context.Context, reqData []byte, endNodeAccessClient proto.EndNodeAccessClient, nodeAuthInfo *proto.NodeAuthInfo, token string) (interface{}, error) {
 	getSubReq := &proto.GetSubReq{}
 	if err := pb.Unmarshal(reqData, getSubReq); err != nil {
@@ -185,7 +247,7 @@ func ReqGetSub(ctx context.Context, reqData []byte, endNodeAccessClient proto.En
 	if rsp.GetCode() != 0 {
 		return nil, fmt.Errorf(rsp.GetMsg())
 	}
-	return rsp.GetUris(), nil
+	return rsp, nil
 }
 
 func ReqGetBandwidthStats(ctx context.Context, reqData []byte, endNodeAccessClient proto.EndNodeAccessClient, nodeAuthInfo *proto.NodeAuthInfo, token string) (interface{}, error) {
@@ -293,6 +355,142 @@ func ReqGetUsers(ctx context.Context, reqData []byte, endNodeAccessClient proto.
 	return rsp.GetUsers(), nil
 }
 
+func ReqSetPlans(ctx context.Context, reqData []byte, endNodeAccessClient proto.EndNodeAccessClient, nodeAuthInfo *proto.NodeAuthInfo, token string) (interface{}, error) {
+	setPlansReq := &proto.PlanOpReq{}
+	if err := pb.Unmarshal(reqData, setPlansReq); err != nil {
+		return nil, fmt.Errorf("can't unmarshal req[%v] to set plans req > %v", reqData, err)
+	}
+
+	setPlansReq.NodeAuthInfo = nodeAuthInfo
+	rsp, err := endNodeAccessClient.SetPlans(ctx, setPlansReq, grpc.ForceCodec(rpc.NewEncryptMessageCodec(token)))
+	if err != nil {
+		return nil, err
+	}
+	if rsp.GetCode() != 0 {
+		return nil, fmt.Errorf(rsp.GetMsg())
+	}
+	return nil, nil
+}
+
+func ReqDeletePlans(ctx context.Context, reqData []byte, endNodeAccessClient proto.EndNodeAccessClient, nodeAuthInfo *proto.NodeAuthInfo, token string) (interface{}, error) {
+	deletePlansReq := &proto.PlanOpReq{}
+	if err := pb.Unmarshal(reqData, deletePlansReq); err != nil {
+		return nil, fmt.Errorf("can't unmarshal req[%v] to delete plans req > %v", reqData, err)
+	}
+
+	deletePlansReq.NodeAuthInfo = nodeAuthInfo
+	rsp, err := endNodeAccessClient.DeletePlans(ctx, deletePlansReq, grpc.ForceCodec(rpc.NewEncryptMessageCodec(token)))
+	if err != nil {
+		return nil, err
+	}
+	if rsp.GetCode() != 0 {
+		return nil, fmt.Errorf(rsp.GetMsg())
+	}
+	return nil, nil
+}
+
+func ReqGetPlans(ctx context.Context, reqData []byte, endNodeAccessClient proto.EndNodeAccessClient, nodeAuthInfo *proto.NodeAuthInfo, token string) (interface{}, error) {
+	getPlansReq := &proto.GetPlansReq{}
+	if err := pb.Unmarshal(reqData, getPlansReq); err != nil {
+		return nil, fmt.Errorf("can't unmarshal req[%v] to GetPlansReq > %v", reqData, err)
+	}
+
+	getPlansReq.NodeAuthInfo = nodeAuthInfo
+	rsp, err := endNodeAccessClient.GetPlans(ctx, getPlansReq, grpc.ForceCodec(rpc.NewEncryptMessageCodec(token)))
+	if err != nil {
+		return nil, err
+	}
+	if rsp.GetCode() != 0 {
+		return nil, fmt.Errorf(rsp.GetMsg())
+	}
+	return rsp.GetPlans(), nil
+}
+
+func ReqGetTrafficReport(ctx context.Context, reqData []byte, endNodeAccessClient proto.EndNodeAccessClient, nodeAuthInfo *proto.NodeAuthInfo, token string) (interface{}, error) {
+	getTrafficReportReq := &proto.GetTrafficReportReq{}
+	if err := pb.Unmarshal(reqData, getTrafficReportReq); err != nil {
+		return nil, fmt.Errorf("can't unmarshal req[%v] to GetTrafficReportReq > %v", reqData, err)
+	}
+
+	getTrafficReportReq.NodeAuthInfo = nodeAuthInfo
+	rsp, err := endNodeAccessClient.GetTrafficReport(ctx, getTrafficReportReq, grpc.ForceCodec(rpc.NewEncryptMessageCodec(token)))
+	if err != nil {
+		return nil, err
+	}
+	if rsp.GetCode() != 0 {
+		return nil, fmt.Errorf(rsp.GetMsg())
+	}
+	return rsp.GetSeries(), nil
+}
+
+func ReqExportUsers(ctx context.Context, reqData []byte, endNodeAccessClient proto.EndNodeAccessClient, nodeAuthInfo *proto.NodeAuthInfo, token string) (interface{}, error) {
+	exportUsersReq := &proto.ExportUsersReq{}
+	if err := pb.Unmarshal(reqData, exportUsersReq); err != nil {
+		return nil, fmt.Errorf("can't unmarshal req[%v] to ExportUsersReq > %v", reqData, err)
+	}
+
+	exportUsersReq.NodeAuthInfo = nodeAuthInfo
+	rsp, err := endNodeAccessClient.ExportUsers(ctx, exportUsersReq, grpc.ForceCodec(rpc.NewEncryptMessageCodec(token)))
+	if err != nil {
+		return nil, err
+	}
+	if rsp.GetCode() != 0 {
+		return nil, fmt.Errorf(rsp.GetMsg())
+	}
+	return rsp.GetUsers(), nil
+}
+
+func ReqImportUsers(ctx context.Context, reqData []byte, endNodeAccessClient proto.EndNodeAccessClient, nodeAuthInfo *proto.NodeAuthInfo, token string) (interface{}, error) {
+	importUsersReq := &proto.ImportUsersReq{}
+	if err := pb.Unmarshal(reqData, importUsersReq); err != nil {
+		return nil, fmt.Errorf("can't unmarshal req[%v] to ImportUsersReq > %v", reqData, err)
+	}
+
+	importUsersReq.NodeAuthInfo = nodeAuthInfo
+	rsp, err := endNodeAccessClient.ImportUsers(ctx, importUsersReq, grpc.ForceCodec(rpc.NewEncryptMessageCodec(token)))
+	if err != nil {
+		return nil, err
+	}
+	if rsp.GetCode() != 0 {
+		return nil, fmt.Errorf(rsp.GetMsg())
+	}
+	return rsp.GetResults(), nil
+}
+
+func ReqReconcileUsers(ctx context.Context, reqData []byte, endNodeAccessClient proto.EndNodeAccessClient, nodeAuthInfo *proto.NodeAuthInfo, token string) (interface{}, error) {
+	reconcileUsersReq := &proto.ReconcileUsersReq{}
+	if err := pb.Unmarshal(reqData, reconcileUsersReq); err != nil {
+		return nil, fmt.Errorf("can't unmarshal req[%v] to ReconcileUsersReq > %v", reqData, err)
+	}
+
+	reconcileUsersReq.NodeAuthInfo = nodeAuthInfo
+	rsp, err := endNodeAccessClient.ReconcileUsers(ctx, reconcileUsersReq, grpc.ForceCodec(rpc.NewEncryptMessageCodec(token)))
+	if err != nil {
+		return nil, err
+	}
+	if rsp.GetCode() != 0 {
+		return nil, fmt.Errorf(rsp.GetMsg())
+	}
+	return rsp.GetDrifts(), nil
+}
+
+func ReqGetAuditLog(ctx context.Context, reqData []byte, endNodeAccessClient proto.EndNodeAccessClient, nodeAuthInfo *proto.NodeAuthInfo, token string) (interface{}, error) {
+	getAuditLogReq := &proto.GetAuditLogReq{}
+	if err := pb.Unmarshal(reqData, getAuditLogReq); err != nil {
+		return nil, fmt.Errorf("can't unmarshal req[%v] to GetAuditLogReq > %v", reqData, err)
+	}
+
+	getAuditLogReq.NodeAuthInfo = nodeAuthInfo
+	rsp, err := endNodeAccessClient.GetAuditLog(ctx, getAuditLogReq, grpc.ForceCodec(rpc.NewEncryptMessageCodec(token)))
+	if err != nil {
+		return nil, err
+	}
+	if rsp.GetCode() != 0 {
+		return nil, fmt.Errorf(rsp.GetMsg())
+	}
+	return rsp.GetEntries(), nil
+}
+
 func ReqGetInbound(ctx context.Context, reqData []byte, endNodeAccessClient proto.EndNodeAccessClient, nodeAuthInfo *proto.NodeAuthInfo, token string) (interface{}, error) {
 	getInboundReq := &proto.GetInboundReq{}
 	if err := pb.Unmarshal(reqData, getInboundReq); err != nil {
@@ -28,6 +28,17 @@ const (
 	GetCertsType
 	ClearUsersType
 	GetPingMetricType
+	SuspendUsersReqType
+	ResumeUsersReqType
+	SetPlansReqType
+	DeletePlansReqType
+	GetPlansReqType
+	GetTrafficReportReqType
+	ExportUsersReqType
+	ImportUsersReqType
+	ReconcileUsersReqType
+	GetAuditLogReqType
+	IssueNodeCertType
 	RegisterNodeType
 	HeartBeatType
 )
@@ -0,0 +1,123 @@
+package cluster
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/lureiny/v2raymg/common"
+	gc "github.com/lureiny/v2raymg/global/config"
+	"github.com/lureiny/v2raymg/server/rpc/proto"
+)
+
+type centerNodeAddr struct {
+	Host string `json:"host,omitempty"`
+	Port int32  `json:"port,omitempty"`
+}
+
+// LoadCenterNodes 读取配置中的中心节点地址列表, 忽略无效与重复的地址
+func LoadCenterNodes(key string) ([]*Node, error) {
+	addrs := []centerNodeAddr{}
+	if err := gc.UnmarshalKey(key, &addrs); err != nil {
+		return nil, fmt.Errorf("unmarshal %s fail > %v", key, err)
+	}
+	nodes := []*Node{}
+	exist := map[string]bool{}
+	for _, addr := range addrs {
+		key := fmt.Sprintf("%s:%d", addr.Host, addr.Port)
+		if addr.Host == "" || addr.Port <= 1000 || exist[key] {
+			continue
+		}
+		exist[key] = true
+		nodes = append(nodes, &Node{
+			Node: &proto.Node{
+				Host: addr.Host,
+				Port: addr.Port,
+			},
+		})
+	}
+	return nodes, nil
+}
+
+// Members 获取全部集群中由中心节点收到过心跳的有效节点, 用于中心节点之间同步
+func (ccm *CenterClusterManager) Members() []*proto.MemberNode {
+	return ccm.ClusterMembers(ccm.ClusterNames())
+}
+
+// ClusterNames 获取全部集群的名称
+func (ccm *CenterClusterManager) ClusterNames() []string {
+	ccm.lock.RLock()
+	defer ccm.lock.RUnlock()
+	names := []string{}
+	for name := range ccm.clusters {
+		names = append(names, name)
+	}
+	return names
+}
+
+// ClusterMembers 获取指定集群中由中心节点收到过心跳的有效节点, 不存在的集群会被忽略
+func (ccm *CenterClusterManager) ClusterMembers(clusterNames []string) []*proto.MemberNode {
+	ccm.lock.RLock()
+	defer ccm.lock.RUnlock()
+	members := []*proto.MemberNode{}
+	seen := map[string]bool{}
+	for _, name := range clusterNames {
+		cluster, ok := ccm.clusters[name]
+		if !ok || seen[name] {
+			continue
+		}
+		seen[name] = true
+		nodes := cluster.GetNodesWithFilter(func(n *Node) bool {
+			return n.IsValid() && n.GetHeartBeatTime > 0
+		})
+		for _, n := range nodes {
+			members = append(members, &proto.MemberNode{
+				Node:          n.Node,
+				HeartbeatTime: n.GetHeartBeatTime,
+				Health:        n.GetHealth(),
+			})
+		}
+	}
+	return members
+}
+
+// Merge 合并其他中心节点同步的成员, 同一节点以心跳时间较新的数据为准, 返回新增的节点数
+func (ccm *CenterClusterManager) Merge(members []*proto.MemberNode) int {
+	added := 0
+	now := time.Now().Unix()
+	for _, member := range members {
+		heartbeatTime := member.GetHeartbeatTime()
+		// 已经超时的节点不再同步, 避免在中心节点之间循环续期
+		if heartbeatTime+common.NodeTimeOut <= now || heartbeatTime > now+common.NodeTimeOut {
+			continue
+		}
+		node := &Node{
+			Node:             member.GetNode(),
+			CreateTime:       heartbeatTime,
+			GetHeartBeatTime: heartbeatTime,
+		}
+		node.SetHealth(member.GetHealth())
+		if node.Node == nil || !node.IsComplete() {
+			continue
+		}
+		cluster := ccm.GetCluster(node.ClusterName)
+		var existNode *Node
+		if cluster != nil {
+			existNode = cluster.Get(node.Name)
+		}
+		switch {
+		case existNode == nil:
+			ccm.Add(node.ClusterName, node)
+			added++
+		case existNode.GetHeartBeatTime >= heartbeatTime:
+			continue
+		case !existNode.CompareWithProtoNode(node.Node):
+			// 节点地址发生变化
+			ccm.Add(node.ClusterName, node)
+		default:
+			existNode.GetHeartBeatTime = heartbeatTime
+			cluster.UpdateNodeLabels(node.Name, node.GetLabels())
+			cluster.UpdateNodeHealth(node.Name, node.GetHealth())
+		}
+	}
+	return added
+}
@@ -0,0 +1,59 @@
+package cluster
+
+import (
+	"testing"
+	"time"
+
+	"github.com/lureiny/v2raymg/common"
+	"github.com/lureiny/v2raymg/server/rpc/proto"
+	"github.com/smartystreets/goconvey/convey"
+)
+
+func TestCenterMembershipMerge(t *testing.T) {
+	convey.Convey("merge membership from center peer", t, func() {
+		now := time.Now().Unix()
+		newMember := func(name, host string, heartbeatTime int64) *proto.MemberNode {
+			return &proto.MemberNode{
+				Node:          &proto.Node{Name: name, Host: host, Port: 10000, ClusterName: "test"},
+				HeartbeatTime: heartbeatTime,
+			}
+		}
+		ccm := NewCenterClusterManager()
+
+		convey.Convey("rebuild from empty", func() {
+			added := ccm.Merge([]*proto.MemberNode{
+				newMember("node1", "1.1.1.1", now),
+				newMember("node2", "2.2.2.2", now),
+				// 已经超时的节点不会被同步
+				newMember("node3", "3.3.3.3", now-common.NodeTimeOut-1),
+			})
+			convey.So(added, convey.ShouldEqual, 2)
+			convey.So(len(ccm.Members()), convey.ShouldEqual, 2)
+		})
+
+		convey.Convey("keep newer heartbeat", func() {
+			ccm.Merge([]*proto.MemberNode{newMember("node1", "1.1.1.1", now)})
+			ccm.Merge([]*proto.MemberNode{newMember("node1", "4.4.4.4", now-10)})
+			node := ccm.GetCluster("test").Get("node1")
+			convey.So(node.Host, convey.ShouldEqual, "1.1.1.1")
+			convey.So(node.GetHeartBeatTime, convey.ShouldEqual, now)
+
+			ccm.Merge([]*proto.MemberNode{newMember("node1", "4.4.4.4", now+1)})
+			node = ccm.GetCluster("test").Get("node1")
+			convey.So(node.Host, convey.ShouldEqual, "4.4.4.4")
+			convey.So(node.GetHeartBeatTime, convey.ShouldEqual, now+1)
+		})
+
+		convey.Convey("only return requested clusters", func() {
+			other := newMember("node2", "2.2.2.2", now)
+			other.Node.ClusterName = "other"
+			ccm.Merge([]*proto.MemberNode{newMember("node1", "1.1.1.1", now), other})
+			convey.So(len(ccm.ClusterNames()), convey.ShouldEqual, 2)
+			members := ccm.ClusterMembers([]string{"test", "test", "unknown"})
+			convey.So(len(members), convey.ShouldEqual, 1)
+			convey.So(members[0].GetNode().GetName(), convey.ShouldEqual, "node1")
+			convey.So(len(ccm.ClusterMembers(nil)), convey.ShouldEqual, 0)
+			convey.So(len(ccm.Members()), convey.ShouldEqual, 2)
+		})
+	})
+}
@@ -146,6 +146,26 @@ func (cluster *Cluster) HaveNode(nodeName string) bool {
 	return cluster.NodeManager.HaveNode(nodeName)
 }
 
+// UpdateNodeLabels 更新节点上报的标签
+func (cluster *Cluster) UpdateNodeLabels(nodeName string, labels map[string]string) {
+	cluster.NodeManager.UpdateLabels(nodeName, labels)
+}
+
+// UpdateNodeHealth 更新节点上报的健康状态
+func (cluster *Cluster) UpdateNodeHealth(nodeName string, health *proto.NodeHealth) {
+	cluster.NodeManager.UpdateHealth(nodeName, health)
+}
+
+// UpdateNodeHeartBeat 刷新节点的心跳时间
+func (cluster *Cluster) UpdateNodeHeartBeat(nodeName string, heartBeatTime int64) {
+	cluster.NodeManager.UpdateHeartBeat(nodeName, heartBeatTime)
+}
+
+// ResetNodeOutToken 清空访问节点使用的token
+func (cluster *Cluster) ResetNodeOutToken(nodeName string) {
+	cluster.NodeManager.ResetOutToken(nodeName)
+}
+
 // GetAllNode ...
 func (cluster *Cluster) GetAllNode() map[string]*Node {
 	return cluster.NodeManager.GetAllNode()
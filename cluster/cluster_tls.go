@@ -0,0 +1,326 @@
+package cluster
+
+import (
+	"context"
+	"crypto/tls"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+
+	"github.com/lureiny/v2raymg/common"
+	"github.com/lureiny/v2raymg/common/log/logger"
+	"github.com/lureiny/v2raymg/common/rpc"
+	"github.com/lureiny/v2raymg/common/util"
+	gc "github.com/lureiny/v2raymg/global/config"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/credentials/insecure"
+)
+
+const (
+	defaultClusterTLSDir = "./cluster_tls"
+	// 迁移模式下尝试tls连接的超时时间, 超时后使用明文连接
+	migrateDialTimeout = 3 * time.Second
+	// 迁移模式下缓存对端连接方式的有效期, 过期后重新探测, 以便发现已经开启tls的节点
+	migratePeerModeTTL = 10 * time.Minute
+
+	CACertFile   = "ca.crt"
+	CAKeyFile    = "ca.key"
+	NodeCertFile = "node.crt"
+	NodeKeyFile  = "node.key"
+	// 签发节点记录已签发的节点名称与公钥指纹, 同一名称只能使用同一私钥申请证书
+	IssuedKeysFile = "issued_keys.json"
+)
+
+var globalClusterTLS = &ClusterTLS{Mode: rpc.TLSModeDisable}
+
+// ClusterTLS 集群内节点间grpc通信使用的mTLS配置, 节点证书由集群CA签发
+type ClusterTLS struct {
+	Mode         string
+	Dir          string
+	CertValidity time.Duration
+
+	lock      sync.RWMutex
+	serverTLS *tls.Config
+	clientTLS *tls.Config
+	// 迁移模式下探测到的对端连接方式, addr -> *peerTLSMode
+	peerModes sync.Map
+	// 保证签发时检查与记录公钥指纹的原子性
+	issueLock sync.Mutex
+}
+
+type peerTLSMode struct {
+	tls      bool
+	detectAt time.Time
+}
+
+// GetClusterTLS ...
+func GetClusterTLS() *ClusterTLS {
+	return globalClusterTLS
+}
+
+// InitClusterTLS 根据配置初始化节点间的mTLS, 本地持有CA私钥且缺少节点证书时直接签发
+func InitClusterTLS(nodeName string) error {
+	ct := &ClusterTLS{
+		Mode:         gc.GetString(common.ConfigClusterTLSMode),
+		Dir:          gc.GetString(common.ConfigClusterTLSDir),
+		CertValidity: rpc.DefaultCertValidity,
+	}
+	if ct.Mode == "" {
+		ct.Mode = rpc.TLSModeDisable
+	}
+	if ct.Mode != rpc.TLSModeDisable && ct.Mode != rpc.TLSModeMigrate && ct.Mode != rpc.TLSModeEnforce {
+		return fmt.Errorf("unsupport cluster tls mode %s", ct.Mode)
+	}
+	if ct.Dir == "" {
+		ct.Dir = defaultClusterTLSDir
+	}
+	if validity := gc.GetString(common.ConfigClusterTLSCertValidity); validity != "" {
+		seconds, err := util.ParseSeconds(validity)
+		if err != nil || seconds <= 0 {
+			return fmt.Errorf("invalid cluster tls cert validity %s", validity)
+		}
+		ct.CertValidity = time.Duration(seconds) * time.Second
+	}
+	globalClusterTLS = ct
+	if !ct.Enabled() {
+		return nil
+	}
+	if !ct.fileExist(NodeCertFile) && ct.IsIssuer() {
+		if err := ct.issueLocalNodeCert(nodeName); err != nil {
+			return fmt.Errorf("issue local node cert fail > %v", err)
+		}
+	}
+	return ct.Load()
+}
+
+// Enabled 是否开启了mTLS
+func (ct *ClusterTLS) Enabled() bool {
+	return ct.Mode == rpc.TLSModeMigrate || ct.Mode == rpc.TLSModeEnforce
+}
+
+// Ready 节点证书是否已经加载
+func (ct *ClusterTLS) Ready() bool {
+	ct.lock.RLock()
+	defer ct.lock.RUnlock()
+	return ct.serverTLS != nil && ct.clientTLS != nil
+}
+
+// IsIssuer 本地持有CA私钥时可以为其他节点签发证书
+func (ct *ClusterTLS) IsIssuer() bool {
+	return ct.fileExist(CACertFile) && ct.fileExist(CAKeyFile)
+}
+
+// Load 加载CA与节点证书, 节点证书不存在时保持未就绪
+func (ct *ClusterTLS) Load() error {
+	if !ct.fileExist(NodeCertFile) {
+		return nil
+	}
+	caCertPEM, err := os.ReadFile(ct.path(CACertFile))
+	if err != nil {
+		return fmt.Errorf("read ca cert fail > %v", err)
+	}
+	certPEM, err := os.ReadFile(ct.path(NodeCertFile))
+	if err != nil {
+		return fmt.Errorf("read node cert fail > %v", err)
+	}
+	keyPEM, err := os.ReadFile(ct.path(NodeKeyFile))
+	if err != nil {
+		return fmt.Errorf("read node key fail > %v", err)
+	}
+	serverTLS, err := rpc.NewServerTLSConfig(caCertPEM, certPEM, keyPEM)
+	if err != nil {
+		return err
+	}
+	clientTLS, err := rpc.NewClientTLSConfig(caCertPEM, certPEM, keyPEM)
+	if err != nil {
+		return err
+	}
+	ct.lock.Lock()
+	ct.serverTLS, ct.clientTLS = serverTLS, clientTLS
+	ct.lock.Unlock()
+	return nil
+}
+
+// SaveNodeCert 保存从签发节点获取的证书并重新加载
+func (ct *ClusterTLS) SaveNodeCert(caCertPEM, certPEM, keyPEM []byte) error {
+	if err := os.MkdirAll(ct.Dir, 0700); err != nil {
+		return fmt.Errorf("create cluster tls dir fail > %v", err)
+	}
+	files := map[string][]byte{
+		CACertFile:   caCertPEM,
+		NodeCertFile: certPEM,
+		NodeKeyFile:  keyPEM,
+	}
+	for name, data := range files {
+		if err := os.WriteFile(ct.path(name), data, 0600); err != nil {
+			return fmt.Errorf("write %s fail > %v", name, err)
+		}
+	}
+	return ct.Load()
+}
+
+// SignNodeCSR 为其他节点签发证书, 返回CA证书与节点证书, 证书中的节点名称必须是通过认证的nodeName
+// 节点名称首次签发时与csr的公钥绑定, 此后拒绝使用其他公钥为该名称申请证书
+func (ct *ClusterTLS) SignNodeCSR(csrPEM []byte, nodeName string) ([]byte, []byte, error) {
+	if !ct.IsIssuer() {
+		return nil, nil, fmt.Errorf("local node is not cluster ca issuer")
+	}
+	fingerprint, err := rpc.CSRKeyFingerprint(csrPEM)
+	if err != nil {
+		return nil, nil, err
+	}
+	ct.issueLock.Lock()
+	defer ct.issueLock.Unlock()
+	issuedKeys, err := ct.loadIssuedKeys()
+	if err != nil {
+		return nil, nil, err
+	}
+	if issued, ok := issuedKeys[nodeName]; ok && issued != fingerprint {
+		return nil, nil, fmt.Errorf("node name[%s] is already registered with another key", nodeName)
+	}
+	caCertPEM, err := os.ReadFile(ct.path(CACertFile))
+	if err != nil {
+		return nil, nil, fmt.Errorf("read ca cert fail > %v", err)
+	}
+	caKeyPEM, err := os.ReadFile(ct.path(CAKeyFile))
+	if err != nil {
+		return nil, nil, fmt.Errorf("read ca key fail > %v", err)
+	}
+	certPEM, err := rpc.SignNodeCSR(caCertPEM, caKeyPEM, csrPEM, nodeName, ct.CertValidity)
+	if err != nil {
+		return nil, nil, err
+	}
+	if _, ok := issuedKeys[nodeName]; !ok {
+		issuedKeys[nodeName] = fingerprint
+		if err := ct.saveIssuedKeys(issuedKeys); err != nil {
+			return nil, nil, err
+		}
+	}
+	return caCertPEM, certPEM, nil
+}
+
+// 已签发的节点名称与公钥指纹, 文件不存在时为空
+func (ct *ClusterTLS) loadIssuedKeys() (map[string]string, error) {
+	issuedKeys := map[string]string{}
+	data, err := os.ReadFile(ct.path(IssuedKeysFile))
+	if os.IsNotExist(err) {
+		return issuedKeys, nil
+	} else if err != nil {
+		return nil, fmt.Errorf("read issued keys fail > %v", err)
+	}
+	if err := json.Unmarshal(data, &issuedKeys); err != nil {
+		return nil, fmt.Errorf("unmarshal issued keys fail > %v", err)
+	}
+	return issuedKeys, nil
+}
+
+func (ct *ClusterTLS) saveIssuedKeys(issuedKeys map[string]string) error {
+	data, err := json.MarshalIndent(issuedKeys, "", "  ")
+	if err != nil {
+		return fmt.Errorf("marshal issued keys fail > %v", err)
+	}
+	if err := os.WriteFile(ct.path(IssuedKeysFile), data, 0600); err != nil {
+		return fmt.Errorf("write issued keys fail > %v", err)
+	}
+	return nil
+}
+
+// ServerOptions 节点grpc服务端的凭证, 强制模式下缺少证书时返回错误
+func (ct *ClusterTLS) ServerOptions() ([]grpc.ServerOption, error) {
+	if !ct.Enabled() {
+		return nil, nil
+	}
+	ct.lock.RLock()
+	serverTLS := ct.serverTLS
+	ct.lock.RUnlock()
+	if serverTLS == nil {
+		if ct.Mode == rpc.TLSModeEnforce {
+			return nil, fmt.Errorf("cluster tls is enforced but node cert is not ready")
+		}
+		logger.Error("Err=node cert is not ready, only accept plaintext connection|Mode=%s", ct.Mode)
+		return nil, nil
+	}
+	creds := credentials.NewTLS(serverTLS)
+	if ct.Mode == rpc.TLSModeMigrate {
+		return []grpc.ServerOption{grpc.Creds(rpc.NewMigrateCredentials(creds))}, nil
+	}
+	return []grpc.ServerOption{grpc.Creds(creds)}, nil
+}
+
+// Dial 连接其他节点, 迁移模式下优先使用tls, 失败后使用明文连接
+func (ct *ClusterTLS) Dial(addr string) (*grpc.ClientConn, error) {
+	ct.lock.RLock()
+	clientTLS := ct.clientTLS
+	ct.lock.RUnlock()
+	insecureOption := grpc.WithTransportCredentials(insecure.NewCredentials())
+	// 编码协商与请求的重放保护
+	interceptorOption := grpc.WithUnaryInterceptor(rpc.UnaryClientInterceptor)
+	switch {
+	case !ct.Enabled():
+		return grpc.Dial(addr, insecureOption, interceptorOption)
+	case clientTLS == nil && ct.Mode == rpc.TLSModeEnforce:
+		return nil, fmt.Errorf("cluster tls is enforced but node cert is not ready")
+	case clientTLS == nil:
+		return grpc.Dial(addr, insecureOption, interceptorOption)
+	case ct.Mode == rpc.TLSModeEnforce:
+		return grpc.Dial(addr, grpc.WithTransportCredentials(credentials.NewTLS(clientTLS)), interceptorOption)
+	}
+	tlsOption := grpc.WithTransportCredentials(credentials.NewTLS(clientTLS))
+	// 使用缓存的连接方式, 避免每次连接明文节点都等待tls超时
+	if mode, ok := ct.getPeerMode(addr); ok {
+		if mode.tls {
+			return grpc.Dial(addr, tlsOption, interceptorOption)
+		}
+		return grpc.Dial(addr, insecureOption, interceptorOption)
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), migrateDialTimeout)
+	defer cancel()
+	conn, err := grpc.DialContext(ctx, addr, tlsOption, grpc.WithBlock(), interceptorOption)
+	if err == nil {
+		ct.peerModes.Store(addr, &peerTLSMode{tls: true, detectAt: time.Now()})
+		return conn, nil
+	}
+	logger.Debug("Msg=dial with tls fail, use plaintext|Addr=%s|Err=%v", addr, err)
+	ct.peerModes.Store(addr, &peerTLSMode{tls: false, detectAt: time.Now()})
+	return grpc.Dial(addr, insecureOption, interceptorOption)
+}
+
+// 获取未过期的对端连接方式
+func (ct *ClusterTLS) getPeerMode(addr string) (*peerTLSMode, bool) {
+	value, ok := ct.peerModes.Load(addr)
+	if !ok {
+		return nil, false
+	}
+	mode := value.(*peerTLSMode)
+	if time.Since(mode.detectAt) >= migratePeerModeTTL {
+		ct.peerModes.Delete(addr)
+		return nil, false
+	}
+	return mode, true
+}
+
+func (ct *ClusterTLS) issueLocalNodeCert(nodeName string) error {
+	hosts := []string{gc.GetString(common.ConfigProxyHost), gc.GetString(common.ConfigServerListen)}
+	csrPEM, keyPEM, err := rpc.NewNodeCSR(nodeName, hosts)
+	if err != nil {
+		return err
+	}
+	caCertPEM, certPEM, err := ct.SignNodeCSR(csrPEM, nodeName)
+	if err != nil {
+		return err
+	}
+	return ct.SaveNodeCert(caCertPEM, certPEM, keyPEM)
+}
+
+func (ct *ClusterTLS) path(name string) string {
+	return filepath.Join(ct.Dir, name)
+}
+
+func (ct *ClusterTLS) fileExist(name string) bool {
+	_, err := os.Stat(ct.path(name))
+	return err == nil
+}
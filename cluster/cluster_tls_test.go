@@ -0,0 +1,97 @@
+package cluster
+
+import (
+	"net"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/lureiny/v2raymg/common/rpc"
+	"github.com/smartystreets/goconvey/convey"
+	"google.golang.org/grpc"
+)
+
+func newTestMigrateClusterTLS() (*ClusterTLS, error) {
+	caCert, caKey, err := rpc.NewClusterCA("test", time.Hour)
+	if err != nil {
+		return nil, err
+	}
+	csr, key, err := rpc.NewNodeCSR("node1", []string{"127.0.0.1"})
+	if err != nil {
+		return nil, err
+	}
+	cert, err := rpc.SignNodeCSR(caCert, caKey, csr, "node1", time.Hour)
+	if err != nil {
+		return nil, err
+	}
+	clientTLS, err := rpc.NewClientTLSConfig(caCert, cert, key)
+	if err != nil {
+		return nil, err
+	}
+	return &ClusterTLS{Mode: rpc.TLSModeMigrate, clientTLS: clientTLS}, nil
+}
+
+func TestClusterTLSDial(t *testing.T) {
+	convey.Convey("migrate dial caches plaintext peer", t, func() {
+		ct, err := newTestMigrateClusterTLS()
+		convey.So(err, convey.ShouldBeNil)
+
+		lis, err := net.Listen("tcp", "127.0.0.1:0")
+		convey.So(err, convey.ShouldBeNil)
+		server := grpc.NewServer()
+		go server.Serve(lis)
+		defer server.Stop()
+		addr := lis.Addr().String()
+
+		// 首次连接探测tls失败后使用明文
+		conn, err := ct.Dial(addr)
+		convey.So(err, convey.ShouldBeNil)
+		conn.Close()
+		mode, ok := ct.getPeerMode(addr)
+		convey.So(ok, convey.ShouldBeTrue)
+		convey.So(mode.tls, convey.ShouldBeFalse)
+
+		// 之后直接使用明文, 不再等待tls超时
+		start := time.Now()
+		conn, err = ct.Dial(addr)
+		convey.So(err, convey.ShouldBeNil)
+		conn.Close()
+		convey.So(time.Since(start), convey.ShouldBeLessThan, time.Second)
+
+		// 缓存过期后重新探测
+		ct.peerModes.Store(addr, &peerTLSMode{tls: true, detectAt: time.Now().Add(-migratePeerModeTTL)})
+		_, ok = ct.getPeerMode(addr)
+		convey.So(ok, convey.ShouldBeFalse)
+	})
+}
+
+func TestClusterTLSSignNodeCSR(t *testing.T) {
+	convey.Convey("node name is bound to the first issued key", t, func() {
+		ct := &ClusterTLS{Mode: rpc.TLSModeMigrate, Dir: t.TempDir(), CertValidity: time.Hour}
+		caCert, caKey, err := rpc.NewClusterCA("test", time.Hour)
+		convey.So(err, convey.ShouldBeNil)
+		convey.So(os.WriteFile(ct.path(CACertFile), caCert, 0600), convey.ShouldBeNil)
+		convey.So(os.WriteFile(ct.path(CAKeyFile), caKey, 0600), convey.ShouldBeNil)
+
+		csr1, _, err := rpc.NewNodeCSR("node1", nil)
+		convey.So(err, convey.ShouldBeNil)
+		_, _, err = ct.SignNodeCSR(csr1, "node1")
+		convey.So(err, convey.ShouldBeNil)
+		// 同一私钥可以重新申请, 例如证书过期后
+		_, _, err = ct.SignNodeCSR(csr1, "node1")
+		convey.So(err, convey.ShouldBeNil)
+
+		csr2, _, err := rpc.NewNodeCSR("node1", nil)
+		convey.So(err, convey.ShouldBeNil)
+		_, _, err = ct.SignNodeCSR(csr2, "node1")
+		convey.So(err, convey.ShouldNotBeNil)
+
+		csr3, _, err := rpc.NewNodeCSR("node2", nil)
+		convey.So(err, convey.ShouldBeNil)
+		_, _, err = ct.SignNodeCSR(csr3, "node2")
+		convey.So(err, convey.ShouldBeNil)
+		issuedKeys, err := ct.loadIssuedKeys()
+		convey.So(err, convey.ShouldBeNil)
+		convey.So(len(issuedKeys), convey.ShouldEqual, 2)
+	})
+}
@@ -0,0 +1,494 @@
+package cluster
+
+import (
+	"context"
+	"math"
+	"math/rand"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/lureiny/v2raymg/common/log/logger"
+	"github.com/lureiny/v2raymg/server/rpc/proto"
+	pb "google.golang.org/protobuf/proto"
+)
+
+const (
+	MembershipHeartbeat = "heartbeat"
+	MembershipGossip    = "gossip"
+
+	MemberStateAlive   = "alive"
+	MemberStateSuspect = "suspect"
+	MemberStateDead    = "dead"
+
+	defaultProbeInterval    = time.Second
+	defaultProbeTimeout     = 500 * time.Millisecond
+	defaultIndirectProbes   = 3
+	defaultSuspicionTimeout = 10 * time.Second
+
+	// 每条成员变更捎带的次数为gossipRetransmitMult*log2(n+1)
+	gossipRetransmitMult = 3
+	// 每条消息最多捎带的成员变更数量, 不包括节点自身的状态
+	maxPiggybackMembers = 8
+	// dead状态保留的时长, 用于拒绝旧的alive消息
+	deadMemberRetention = 2 * time.Minute
+)
+
+// GossipTransport gossip消息的传输, 由rpc层实现
+type GossipTransport interface {
+	// Ping 直接探测节点, 返回对端捎带的成员状态
+	Ping(ctx context.Context, node *Node, members []*proto.GossipMember) ([]*proto.GossipMember, error)
+	// PingReq 请求via节点探测target, 返回探测是否成功以及via捎带的成员状态
+	PingReq(ctx context.Context, via *Node, target string, members []*proto.GossipMember) (bool, []*proto.GossipMember, error)
+}
+
+// GossipConfig ...
+type GossipConfig struct {
+	ProbeInterval    time.Duration // 探测周期, 每个周期探测一个节点
+	ProbeTimeout     time.Duration // 直接探测的超时时间
+	IndirectProbes   int           // 直接探测失败后请求间接探测的节点数
+	SuspicionTimeout time.Duration // suspect状态超过该时长未被反驳时判定为dead
+}
+
+func (c *GossipConfig) fillDefault() {
+	if c.ProbeInterval <= 0 {
+		c.ProbeInterval = defaultProbeInterval
+	}
+	if c.ProbeTimeout <= 0 || c.ProbeTimeout >= c.ProbeInterval {
+		c.ProbeTimeout = c.ProbeInterval / 2
+	}
+	if c.IndirectProbes <= 0 {
+		c.IndirectProbes = defaultIndirectProbes
+	}
+	if c.SuspicionTimeout <= 0 {
+		c.SuspicionTimeout = defaultSuspicionTimeout
+	}
+}
+
+type memberState struct {
+	node        *proto.Node
+	state       string
+	incarnation uint64
+	generation  int64
+	stateTime   time.Time // 进入当前状态的时间
+}
+
+func (m *memberState) toProto() *proto.GossipMember {
+	return &proto.GossipMember{
+		Node:        m.node,
+		State:       m.state,
+		Incarnation: m.incarnation,
+		Generation:  m.generation,
+	}
+}
+
+type gossipBroadcast struct {
+	member    *proto.GossipMember
+	transmits int
+}
+
+// Gossip SWIM风格的成员协议, 通过直接与间接探测检测节点故障, 成员变更捎带在探测消息中传播
+// 成员状态通过更新Cluster中节点的心跳时间生效, alive与suspect的节点保持有效, dead的节点等待超时后被清理
+type Gossip struct {
+	cluster   *Cluster
+	localNode *proto.Node
+	config    GossipConfig
+	transport GossipTransport
+
+	lock        sync.Mutex
+	members     map[string]*memberState
+	incarnation uint64
+	generation  int64
+	localHealth *proto.NodeHealth
+	broadcasts  map[string]*gossipBroadcast
+	probeOrder  []string
+	probeIndex  int
+}
+
+// NewGossip ...
+func NewGossip(cluster *Cluster, localNode *proto.Node, config GossipConfig) *Gossip {
+	config.fillDefault()
+	return &Gossip{
+		cluster:    cluster,
+		localNode:  localNode,
+		config:     config,
+		members:    map[string]*memberState{},
+		generation: time.Now().UnixMilli(),
+		broadcasts: map[string]*gossipBroadcast{},
+	}
+}
+
+// Start 开始周期性探测
+func (g *Gossip) Start(transport GossipTransport) {
+	g.transport = transport
+	go func() {
+		ticker := time.NewTicker(g.config.ProbeInterval)
+		for {
+			<-ticker.C
+			g.tick()
+		}
+	}()
+}
+
+// SetLocalHealth 设置本地节点的健康状态, 随节点自身的状态捎带
+func (g *Gossip) SetLocalHealth(health *proto.NodeHealth) {
+	g.lock.Lock()
+	defer g.lock.Unlock()
+	g.localHealth = health
+}
+
+// MemberState 获取节点的成员状态, 未知节点返回空字符串
+func (g *Gossip) MemberState(nodeName string) string {
+	g.lock.Lock()
+	defer g.lock.Unlock()
+	if m, ok := g.members[nodeName]; ok {
+		return m.state
+	}
+	return ""
+}
+
+// HandlePing 处理直接探测, 返回捎带的成员状态
+func (g *Gossip) HandlePing(members []*proto.GossipMember) []*proto.GossipMember {
+	g.Merge(members)
+	return g.piggyback("")
+}
+
+// HandlePingReq 代替其他节点探测target, target需要在合并捎带的成员之前已经是本地的有效成员,
+// 避免调用方通过捎带的成员让本节点连接任意地址
+func (g *Gossip) HandlePingReq(ctx context.Context, target string, members []*proto.GossipMember) (bool, []*proto.GossipMember) {
+	node := g.knownMember(target)
+	g.Merge(members)
+	if node == nil || g.transport == nil {
+		return false, g.piggyback("")
+	}
+	ctx, cancel := context.WithTimeout(ctx, g.config.ProbeTimeout)
+	defer cancel()
+	targetMembers, err := g.transport.Ping(ctx, node, g.piggyback(target))
+	if err != nil {
+		return false, g.piggyback("")
+	}
+	g.Merge(targetMembers)
+	return true, g.piggyback("")
+}
+
+// 本地成员表中未被判定为dead的节点, 不存在时返回nil
+func (g *Gossip) knownMember(name string) *Node {
+	g.lock.Lock()
+	m, ok := g.members[name]
+	g.lock.Unlock()
+	if !ok || m.state == MemberStateDead {
+		return nil
+	}
+	return g.cluster.Get(name)
+}
+
+// Merge 合并其他节点捎带的成员状态, 忽略其他集群的成员
+func (g *Gossip) Merge(members []*proto.GossipMember) {
+	for _, m := range members {
+		node := m.GetNode()
+		if node.GetName() == "" || node.GetClusterName() != g.localNode.GetClusterName() {
+			continue
+		}
+		if node.GetName() == g.localNode.GetName() {
+			g.refute(m)
+			continue
+		}
+		g.applyMember(m)
+	}
+}
+
+func (g *Gossip) tick() {
+	now := time.Now()
+	g.checkSuspects(now)
+	g.refreshMembers(now)
+	if target := g.nextProbeTarget(); target != nil {
+		g.probeNode(target)
+	}
+}
+
+// 探测节点, 直接探测失败后请求其他节点间接探测, 全部失败时将节点标记为suspect
+func (g *Gossip) probeNode(target *Node) {
+	ctx, cancel := context.WithTimeout(context.Background(), g.config.ProbeTimeout)
+	members, err := g.transport.Ping(ctx, target, g.piggyback(target.Name))
+	cancel()
+	if err == nil {
+		g.Merge(members)
+		return
+	}
+	logger.Debug("Msg=gossip ping fail, try indirect probe|Dst=%s|Err=%v", target.Name, err)
+
+	targetName := target.Name
+	vias := g.randomMembers(g.config.IndirectProbes, targetName)
+	acks := make(chan bool, len(vias))
+	for _, via := range vias {
+		go func(via *Node) {
+			ctx, cancel := context.WithTimeout(context.Background(), g.config.ProbeInterval)
+			defer cancel()
+			ack, members, err := g.transport.PingReq(ctx, via, targetName, g.piggyback(targetName))
+			if err == nil {
+				g.Merge(members)
+			}
+			acks <- err == nil && ack
+		}(via)
+	}
+	acked := false
+	for range vias {
+		acked = <-acks || acked
+	}
+	if !acked {
+		g.suspect(target)
+	}
+}
+
+func (g *Gossip) suspect(target *Node) {
+	g.lock.Lock()
+	defer g.lock.Unlock()
+	m, ok := g.members[target.Name]
+	if !ok {
+		m = &memberState{node: target.Node, state: MemberStateAlive}
+		g.members[target.Name] = m
+	}
+	if m.state != MemberStateAlive {
+		return
+	}
+	m.state = MemberStateSuspect
+	m.stateTime = time.Now()
+	g.enqueue(m.toProto())
+	logger.Info("Msg=gossip suspect node|Dst=%s|Incarnation=%d", target.Name, m.incarnation)
+}
+
+// 节点被怀疑时递增incarnation并广播自身为alive
+func (g *Gossip) refute(m *proto.GossipMember) {
+	// generation为0表示对端从未收到过本节点的状态
+	if m.GetState() == MemberStateAlive || (m.GetGeneration() != 0 && m.GetGeneration() != g.generation) {
+		return
+	}
+	g.lock.Lock()
+	defer g.lock.Unlock()
+	if m.GetIncarnation() < g.incarnation {
+		return
+	}
+	g.incarnation = m.GetIncarnation() + 1
+	g.enqueue(&proto.GossipMember{
+		Node:        g.localNode,
+		State:       MemberStateAlive,
+		Incarnation: g.incarnation,
+		Generation:  g.generation,
+	})
+	logger.Info("Msg=gossip refute %s|Incarnation=%d", m.GetState(), g.incarnation)
+}
+
+// 按照SWIM的规则合并成员状态: 更大的incarnation优先, 相同incarnation时dead > suspect > alive
+func (g *Gossip) applyMember(m *proto.GossipMember) {
+	name := m.GetNode().GetName()
+	g.lock.Lock()
+	cur, exist := g.members[name]
+	if exist && m.GetGeneration() < cur.generation {
+		// 节点重启前的消息
+		g.lock.Unlock()
+		return
+	}
+	restarted := exist && m.GetGeneration() > cur.generation
+	accept := !exist || restarted || m.GetIncarnation() > cur.incarnation
+	if exist && !accept && m.GetIncarnation() == cur.incarnation {
+		switch m.GetState() {
+		case MemberStateSuspect:
+			accept = cur.state == MemberStateAlive
+		case MemberStateDead:
+			accept = cur.state != MemberStateDead
+		}
+	}
+	if accept {
+		g.members[name] = &memberState{
+			node:        m.GetNode(),
+			state:       m.GetState(),
+			incarnation: m.GetIncarnation(),
+			generation:  m.GetGeneration(),
+			stateTime:   time.Now(),
+		}
+		g.enqueue(g.members[name].toProto())
+	}
+	g.lock.Unlock()
+
+	// 只有节点自身的状态携带health
+	if m.GetHealth() != nil {
+		g.cluster.UpdateNodeHealth(name, m.GetHealth())
+	}
+	if !accept {
+		return
+	}
+	if !exist || cur.state != m.GetState() {
+		logger.Info("Msg=gossip member state change|Dst=%s|State=%s|Incarnation=%d", name, m.GetState(), m.GetIncarnation())
+	}
+	node := g.cluster.Get(name)
+	switch {
+	case node == nil && m.GetState() != MemberStateDead:
+		newNode := &Node{Node: m.GetNode(), CreateTime: time.Now().Unix()}
+		if newNode.IsComplete() {
+			logger.Info("Msg=Add Node From Gossip|Node=%s:%d|NodeName=%s", newNode.Host, newNode.Port, name)
+			g.cluster.Add(newNode)
+		}
+	case node != nil && m.GetState() != MemberStateDead:
+		// 对端重启后本地持有的token失效, 需要重新注册
+		if restarted && cur.generation != 0 {
+			g.cluster.ResetNodeOutToken(name)
+		}
+		// 标签变化时才替换proto Node, 避免与正在使用该节点的探测产生竞争
+		if !labelsEqual(node.GetLabels(), m.GetNode().GetLabels()) {
+			g.cluster.UpdateNodeLabels(name, m.GetNode().GetLabels())
+		}
+		g.markAlive(name, time.Now())
+	}
+}
+
+func labelsEqual(a, b map[string]string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for k, v := range a {
+		if bv, ok := b[k]; !ok || bv != v {
+			return false
+		}
+	}
+	return true
+}
+
+// 将suspect超时的节点标记为dead, 清理过期的dead状态
+func (g *Gossip) checkSuspects(now time.Time) {
+	g.lock.Lock()
+	defer g.lock.Unlock()
+	for name, m := range g.members {
+		switch {
+		case m.state == MemberStateSuspect && now.Sub(m.stateTime) > g.config.SuspicionTimeout:
+			m.state = MemberStateDead
+			m.stateTime = now
+			g.enqueue(m.toProto())
+			logger.Info("Msg=gossip member state change|Dst=%s|State=%s|Incarnation=%d", name, m.state, m.incarnation)
+		case m.state == MemberStateDead && now.Sub(m.stateTime) > deadMemberRetention && !g.cluster.HaveNode(name):
+			delete(g.members, name)
+		}
+	}
+}
+
+// alive与suspect的节点刷新心跳时间, 保持节点与token有效
+func (g *Gossip) refreshMembers(now time.Time) {
+	names := []string{}
+	g.lock.Lock()
+	for name, m := range g.members {
+		if m.state != MemberStateDead {
+			names = append(names, name)
+		}
+	}
+	g.lock.Unlock()
+	for _, name := range names {
+		g.markAlive(name, now)
+	}
+}
+
+// 节点可能同时被rpc请求读取, 通过NodeManager在锁内更新
+func (g *Gossip) markAlive(name string, now time.Time) {
+	g.cluster.UpdateNodeHeartBeat(name, now.Unix())
+}
+
+// 随机轮询集群中的节点, 每轮结束后重新打乱顺序
+func (g *Gossip) nextProbeTarget() *Node {
+	g.lock.Lock()
+	defer g.lock.Unlock()
+	for retry := 0; retry < 2; retry++ {
+		for g.probeIndex < len(g.probeOrder) {
+			name := g.probeOrder[g.probeIndex]
+			g.probeIndex++
+			if node := g.cluster.Get(name); node != nil {
+				return node
+			}
+		}
+		g.probeOrder = g.otherNodeNames()
+		rand.Shuffle(len(g.probeOrder), func(i, j int) {
+			g.probeOrder[i], g.probeOrder[j] = g.probeOrder[j], g.probeOrder[i]
+		})
+		g.probeIndex = 0
+	}
+	return nil
+}
+
+// 随机选择最多k个非dead的其他节点
+func (g *Gossip) randomMembers(k int, exclude string) []*Node {
+	g.lock.Lock()
+	names := []string{}
+	for _, name := range g.otherNodeNames() {
+		if m, ok := g.members[name]; name != exclude && (!ok || m.state != MemberStateDead) {
+			names = append(names, name)
+		}
+	}
+	g.lock.Unlock()
+	rand.Shuffle(len(names), func(i, j int) { names[i], names[j] = names[j], names[i] })
+	nodes := []*Node{}
+	for _, name := range names {
+		if len(nodes) >= k {
+			break
+		}
+		if node := g.cluster.Get(name); node != nil {
+			nodes = append(nodes, node)
+		}
+	}
+	return nodes
+}
+
+func (g *Gossip) otherNodeNames() []string {
+	names := []string{}
+	for _, node := range g.cluster.GetNodesWithFilter(func(n *Node) bool { return n.Name != g.localNode.GetName() }) {
+		names = append(names, node.Name)
+	}
+	return names
+}
+
+// 获取捎带的成员状态, 首个为节点自身的状态, 优先捎带关于target的状态以便对端尽快反驳
+func (g *Gossip) piggyback(target string) []*proto.GossipMember {
+	g.lock.Lock()
+	defer g.lock.Unlock()
+	members := []*proto.GossipMember{{
+		Node:        g.localNode,
+		State:       MemberStateAlive,
+		Incarnation: g.incarnation,
+		Generation:  g.generation,
+		Health:      g.localHealth,
+	}}
+	names := make([]string, 0, len(g.broadcasts))
+	for name := range g.broadcasts {
+		names = append(names, name)
+	}
+	sort.Slice(names, func(i, j int) bool {
+		if (names[i] == target) != (names[j] == target) {
+			return names[i] == target
+		}
+		bi, bj := g.broadcasts[names[i]], g.broadcasts[names[j]]
+		if bi.transmits != bj.transmits {
+			return bi.transmits > bj.transmits
+		}
+		return names[i] < names[j]
+	})
+	for _, name := range names {
+		if len(members) > maxPiggybackMembers {
+			break
+		}
+		b := g.broadcasts[name]
+		members = append(members, b.member)
+		if b.transmits--; b.transmits <= 0 {
+			delete(g.broadcasts, name)
+		}
+	}
+	return members
+}
+
+// 加入待广播的成员状态, 同一节点只保留最新的状态, 需要在持有锁时调用
+func (g *Gossip) enqueue(m *proto.GossipMember) {
+	clusterSize := len(g.cluster.GetAllNode())
+	transmits := int(gossipRetransmitMult * math.Ceil(math.Log2(float64(clusterSize+1))))
+	if transmits < 1 {
+		transmits = 1
+	}
+	g.broadcasts[m.GetNode().GetName()] = &gossipBroadcast{
+		member:    pb.Clone(m).(*proto.GossipMember),
+		transmits: transmits,
+	}
+}
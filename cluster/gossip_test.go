@@ -0,0 +1,153 @@
+package cluster
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/lureiny/v2raymg/server/rpc/proto"
+	"github.com/smartystreets/goconvey/convey"
+)
+
+// 内存中的gossip网络, blocked中的链路无法直接通信
+type testGossipNetwork struct {
+	nodes   map[string]*Gossip
+	down    map[string]bool
+	blocked map[[2]string]bool
+}
+
+type testGossipTransport struct {
+	network *testGossipNetwork
+	from    string
+}
+
+func (n *testGossipNetwork) reachable(from, to string) bool {
+	return !n.down[to] && !n.blocked[[2]string{from, to}] && !n.blocked[[2]string{to, from}]
+}
+
+func (t *testGossipTransport) Ping(ctx context.Context, node *Node, members []*proto.GossipMember) ([]*proto.GossipMember, error) {
+	if !t.network.reachable(t.from, node.Name) {
+		return nil, fmt.Errorf("unreachable")
+	}
+	return t.network.nodes[node.Name].HandlePing(members), nil
+}
+
+func (t *testGossipTransport) PingReq(ctx context.Context, via *Node, target string, members []*proto.GossipMember) (bool, []*proto.GossipMember, error) {
+	if !t.network.reachable(t.from, via.Name) {
+		return false, nil, fmt.Errorf("unreachable")
+	}
+	ack, rspMembers := t.network.nodes[via.Name].HandlePingReq(ctx, target, members)
+	return ack, rspMembers, nil
+}
+
+func newTestGossipNetwork(names ...string) *testGossipNetwork {
+	network := &testGossipNetwork{
+		nodes:   map[string]*Gossip{},
+		down:    map[string]bool{},
+		blocked: map[[2]string]bool{},
+	}
+	protoNodes := map[string]*proto.Node{}
+	for i, name := range names {
+		protoNodes[name] = &proto.Node{Name: name, Host: fmt.Sprintf("10.0.0.%d", i+1), Port: 10000, ClusterName: "test"}
+	}
+	for _, name := range names {
+		cluster := &Cluster{Name: "test"}
+		cluster.Init()
+		for _, other := range names {
+			cluster.Add(&Node{Node: protoNodes[other], CreateTime: time.Now().Unix()})
+		}
+		g := NewGossip(cluster, protoNodes[name], GossipConfig{SuspicionTimeout: time.Second})
+		g.transport = &testGossipTransport{network: network, from: name}
+		network.nodes[name] = g
+	}
+	return network
+}
+
+// 每个节点探测一轮全部其他节点
+func (n *testGossipNetwork) probeRound() {
+	for name, g := range n.nodes {
+		if n.down[name] {
+			continue
+		}
+		for range g.otherNodeNames() {
+			if target := g.nextProbeTarget(); target != nil {
+				g.probeNode(target)
+			}
+		}
+	}
+}
+
+func TestGossip(t *testing.T) {
+	convey.Convey("gossip membership", t, func() {
+		network := newTestGossipNetwork("a", "b", "c", "d")
+		network.probeRound()
+		convey.So(network.nodes["a"].MemberState("b"), convey.ShouldEqual, MemberStateAlive)
+
+		convey.Convey("flapping link does not evict healthy node", func() {
+			network.blocked[[2]string{"a", "b"}] = true
+			network.probeRound()
+			convey.So(network.nodes["a"].MemberState("b"), convey.ShouldEqual, MemberStateAlive)
+		})
+
+		convey.Convey("detect failed node", func() {
+			network.down["d"] = true
+			network.probeRound()
+			convey.So(network.nodes["a"].MemberState("d"), convey.ShouldEqual, MemberStateSuspect)
+			network.nodes["a"].checkSuspects(time.Now().Add(2 * time.Second))
+			convey.So(network.nodes["a"].MemberState("d"), convey.ShouldEqual, MemberStateDead)
+			// dead状态通过捎带传播到其他节点
+			network.probeRound()
+			convey.So(network.nodes["b"].MemberState("d"), convey.ShouldNotEqual, MemberStateAlive)
+		})
+
+		convey.Convey("refute suspicion", func() {
+			b := network.nodes["b"]
+			b.Merge([]*proto.GossipMember{{
+				Node:        b.localNode,
+				State:       MemberStateSuspect,
+				Incarnation: 0,
+				Generation:  b.generation,
+			}})
+			convey.So(b.incarnation, convey.ShouldEqual, 1)
+			network.nodes["a"].suspect(network.nodes["a"].cluster.Get("b"))
+			network.probeRound()
+			convey.So(network.nodes["a"].MemberState("b"), convey.ShouldEqual, MemberStateAlive)
+		})
+
+		convey.Convey("learn new node from gossip", func() {
+			a := network.nodes["a"]
+			a.Merge([]*proto.GossipMember{{
+				Node:       &proto.Node{Name: "e", Host: "10.0.0.5", Port: 10000, ClusterName: "test"},
+				State:      MemberStateAlive,
+				Generation: 1,
+			}})
+			convey.So(a.cluster.HaveNode("e"), convey.ShouldBeTrue)
+		})
+
+		convey.Convey("ignore members of other cluster", func() {
+			a := network.nodes["a"]
+			a.HandlePing([]*proto.GossipMember{{
+				Node:       &proto.Node{Name: "e", Host: "10.0.0.5", Port: 10000, ClusterName: "other"},
+				State:      MemberStateAlive,
+				Generation: 1,
+			}})
+			convey.So(a.cluster.HaveNode("e"), convey.ShouldBeFalse)
+			convey.So(a.MemberState("e"), convey.ShouldEqual, "")
+		})
+
+		convey.Convey("only probe known members for ping req", func() {
+			a := network.nodes["a"]
+			ack, _ := a.HandlePingReq(context.Background(), "b", nil)
+			convey.So(ack, convey.ShouldBeTrue)
+			// 与target一起捎带的未知成员不会被探测
+			ack, _ = a.HandlePingReq(context.Background(), "e", []*proto.GossipMember{{
+				Node:       &proto.Node{Name: "e", Host: "6.6.6.6", Port: 10000, ClusterName: "test"},
+				State:      MemberStateAlive,
+				Generation: 1,
+			}})
+			convey.So(ack, convey.ShouldBeFalse)
+			convey.So(a.cluster.HaveNode("e"), convey.ShouldBeTrue)
+		})
+	})
+}
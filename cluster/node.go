@@ -1,14 +1,15 @@
 package cluster
 
 import (
+	"encoding/json"
 	"fmt"
+	"sync/atomic"
 	"time"
 
 	"github.com/lureiny/v2raymg/common"
 	"github.com/lureiny/v2raymg/server/rpc/proto"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/connectivity"
-	"google.golang.org/grpc/credentials/insecure"
 )
 
 type Node struct {
@@ -20,9 +21,55 @@ type Node struct {
 	CreateTime          int64
 	isLocal             bool // 是否为从本地文件中加载的node, 本地节点是为了不使用中心节点的场景而设计的
 
+	health atomic.Value // *proto.NodeHealth, 节点最近一次心跳上报的健康状态, 与NodeManager.UpdateHealth并发读写
+
 	grpcClientConn *grpc.ClientConn
 }
 
+const (
+	NodeStatusHealthy  = "healthy"
+	NodeStatusDegraded = "degraded"
+	NodeStatusDown     = "down"
+	// 未上报健康状态的节点, 如旧版本节点
+	NodeStatusUnknown = "unknown"
+)
+
+// Status 节点状态, 失效的节点为down, 其余使用节点上报的状态
+func (node *Node) Status() string {
+	if !node.IsValid() {
+		return NodeStatusDown
+	}
+	if status := node.GetHealth().GetStatus(); status != "" {
+		return status
+	}
+	return NodeStatusUnknown
+}
+
+// GetHealth 节点最近一次上报的健康状态, 未上报时返回nil
+func (node *Node) GetHealth() *proto.NodeHealth {
+	health, _ := node.health.Load().(*proto.NodeHealth)
+	return health
+}
+
+// SetHealth 节点加入NodeManager后需要通过NodeManager.UpdateHealth更新
+func (node *Node) SetHealth(health *proto.NodeHealth) {
+	node.health.Store(health)
+}
+
+// MarshalJSON 输出时附带节点状态
+func (node *Node) MarshalJSON() ([]byte, error) {
+	type nodeAlias Node
+	return json.Marshal(&struct {
+		*nodeAlias
+		Health *proto.NodeHealth
+		Status string `json:"status"`
+	}{
+		nodeAlias: (*nodeAlias)(node),
+		Health:    node.GetHealth(),
+		Status:    node.Status(),
+	})
+}
+
 // 比较两个node是否相同, 相同返回true
 func (n1 *Node) Compare(n2 *Node) bool {
 	return n1.Host == n2.Host && n1.Port == n2.Port && n1.ClusterName == n2.ClusterName && n1.Name == n2.Name
@@ -35,9 +82,15 @@ func (n1 *Node) CompareWithProtoNode(n2 *proto.Node) bool {
 
 func (node *Node) GetGrpcClientConn() (*grpc.ClientConn, error) {
 	var err error = nil
-	if node.grpcClientConn == nil || node.grpcClientConn.GetState() != connectivity.Ready {
+	// 连接中或空闲的连接由grpc自动重连, 仅在连接失败或关闭时重新建立, 避免频繁探测时泄漏连接
+	if node.grpcClientConn == nil ||
+		node.grpcClientConn.GetState() == connectivity.TransientFailure ||
+		node.grpcClientConn.GetState() == connectivity.Shutdown {
+		if node.grpcClientConn != nil {
+			node.grpcClientConn.Close()
+		}
 		addr := fmt.Sprintf("%s:%d", node.GetHost(), node.GetPort())
-		node.grpcClientConn, err = grpc.Dial(addr, grpc.WithTransportCredentials(insecure.NewCredentials()))
+		node.grpcClientConn, err = globalClusterTLS.Dial(addr)
 	}
 	return node.grpcClientConn, err
 }
@@ -8,6 +8,7 @@ import (
 	"github.com/lureiny/v2raymg/common/log/logger"
 	"github.com/lureiny/v2raymg/global/config"
 	"github.com/lureiny/v2raymg/server/rpc/proto"
+	pb "google.golang.org/protobuf/proto"
 )
 
 type NodeManager struct {
@@ -109,6 +110,47 @@ func (nm *NodeManager) Get(nodeName string) *Node {
 	return nil
 }
 
+// UpdateLabels 更新节点标签, 替换为新的proto Node避免与读取方产生竞争
+func (nm *NodeManager) UpdateLabels(nodeName string, labels map[string]string) {
+	nm.lock.Lock()
+	defer nm.lock.Unlock()
+	n, ok := (*nm.nodes)[nodeName]
+	if !ok || n.Node == nil {
+		return
+	}
+	newNode := pb.Clone(n.Node).(*proto.Node)
+	newNode.Labels = labels
+	n.Node = newNode
+}
+
+// UpdateHealth 更新节点上报的健康状态
+func (nm *NodeManager) UpdateHealth(nodeName string, health *proto.NodeHealth) {
+	nm.lock.Lock()
+	defer nm.lock.Unlock()
+	if n, ok := (*nm.nodes)[nodeName]; ok {
+		n.SetHealth(health)
+	}
+}
+
+// UpdateHeartBeat 刷新节点的心跳时间, 保持节点与token有效
+func (nm *NodeManager) UpdateHeartBeat(nodeName string, heartBeatTime int64) {
+	nm.lock.Lock()
+	defer nm.lock.Unlock()
+	if n, ok := (*nm.nodes)[nodeName]; ok {
+		n.GetHeartBeatTime = heartBeatTime
+		n.ReportHeartBeatTime = heartBeatTime
+	}
+}
+
+// ResetOutToken 清空访问节点使用的token, 本地节点会重新向该节点注册
+func (nm *NodeManager) ResetOutToken(nodeName string) {
+	nm.lock.Lock()
+	defer nm.lock.Unlock()
+	if n, ok := (*nm.nodes)[nodeName]; ok {
+		n.OutToken = ""
+	}
+}
+
 // GetAllNode ...
 func (nm *NodeManager) GetAllNode() map[string]*Node {
 	return *nm.nodes
@@ -0,0 +1,118 @@
+package cluster
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/lureiny/v2raymg/common/store"
+	"github.com/lureiny/v2raymg/server/rpc/proto"
+	pb "google.golang.org/protobuf/proto"
+)
+
+const (
+	nodeBucket = "nodes"
+	// DefaultNodeStoreTTL 持久化的节点超过该时长未见时过期
+	DefaultNodeStoreTTL = 24 * time.Hour
+)
+
+// NodeStore 持久化通过级联或中心节点获知的节点, 重启后用于重新注册
+// 节点以MemberNode编码存储, heartbeat_time为最近一次与该节点心跳成功的时间
+type NodeStore struct {
+	store *store.BoltStore
+	ttl   time.Duration
+}
+
+// NewNodeStore ...
+func NewNodeStore(s *store.BoltStore, ttl time.Duration) *NodeStore {
+	if ttl <= 0 {
+		ttl = DefaultNodeStoreTTL
+	}
+	return &NodeStore{store: s, ttl: ttl}
+}
+
+// Load 加载未过期的节点, 同时删除已经过期的节点
+func (ns *NodeStore) Load() ([]*proto.MemberNode, error) {
+	if err := ns.expire(); err != nil {
+		return nil, err
+	}
+	members := []*proto.MemberNode{}
+	err := ns.store.ForEach(nodeBucket, func(k, v []byte) error {
+		member := &proto.MemberNode{}
+		if err := pb.Unmarshal(v, member); err != nil {
+			return fmt.Errorf("unmarshal node[%s] fail > %v", k, err)
+		}
+		members = append(members, member)
+		return nil
+	})
+	return members, err
+}
+
+// Save 保存节点, 已存在的节点会被覆盖, 同时删除已经过期的节点
+func (ns *NodeStore) Save(members ...*proto.MemberNode) error {
+	kvs := map[string][]byte{}
+	for _, member := range members {
+		data, err := pb.Marshal(member)
+		if err != nil {
+			return fmt.Errorf("marshal node[%s] fail > %v", member.GetNode().GetName(), err)
+		}
+		kvs[member.GetNode().GetName()] = data
+	}
+	if len(kvs) != 0 {
+		if err := ns.store.PutBatch(nodeBucket, kvs); err != nil {
+			return err
+		}
+	}
+	return ns.expire()
+}
+
+func (ns *NodeStore) expire() error {
+	deadline := time.Now().Add(-ns.ttl).Unix()
+	_, err := ns.store.DeleteIf(nodeBucket, func(k, v []byte) bool {
+		member := &proto.MemberNode{}
+		// 无法解析的数据同样删除
+		return pb.Unmarshal(v, member) != nil || member.GetHeartbeatTime() < deadline
+	})
+	return err
+}
+
+// lastSeen 最近一次与节点心跳成功的时间, 仅用于非本地配置的远端节点
+func (node *Node) lastSeen() int64 {
+	if node.GetHeartBeatTime > node.ReportHeartBeatTime {
+		return node.GetHeartBeatTime
+	}
+	return node.ReportHeartBeatTime
+}
+
+// LearnedMembers 获取通过级联或中心节点获知且心跳成功过的节点, 不包括本地节点与配置文件中的静态节点
+func (cluster *Cluster) LearnedMembers(localNodeName string) []*proto.MemberNode {
+	members := []*proto.MemberNode{}
+	nodes := cluster.GetNodesWithFilter(func(n *Node) bool {
+		return n.Name != localNodeName && !n.IsLocal() && n.IsValid() && n.lastSeen() > 0
+	})
+	for _, n := range nodes {
+		members = append(members, &proto.MemberNode{
+			Node:          n.Node,
+			HeartbeatTime: n.lastSeen(),
+		})
+	}
+	return members
+}
+
+// LoadMembers 添加持久化的节点, 已经存在的节点以及其他集群的节点会被忽略, 返回添加的节点数
+func (cluster *Cluster) LoadMembers(localNodeName string, members []*proto.MemberNode) int {
+	added := 0
+	for _, member := range members {
+		node := member.GetNode()
+		if node == nil || node.GetName() == localNodeName || node.GetClusterName() != cluster.Name ||
+			cluster.HaveNode(node.GetName()) {
+			continue
+		}
+		n := &Node{Node: node, CreateTime: time.Now().Unix()}
+		if !n.IsComplete() {
+			continue
+		}
+		cluster.Add(n)
+		added++
+	}
+	return added
+}
@@ -0,0 +1,52 @@
+package cluster
+
+import (
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/lureiny/v2raymg/common/store"
+	"github.com/lureiny/v2raymg/server/rpc/proto"
+	"github.com/smartystreets/goconvey/convey"
+)
+
+func TestNodeStore(t *testing.T) {
+	convey.Convey("persist learned nodes", t, func() {
+		s, err := store.OpenBoltStore(filepath.Join(t.TempDir(), "store.db"))
+		convey.So(err, convey.ShouldBeNil)
+		defer s.Close()
+		ns := NewNodeStore(s, time.Hour)
+		now := time.Now().Unix()
+		newMember := func(name string, lastSeen int64) *proto.MemberNode {
+			return &proto.MemberNode{
+				Node: &proto.Node{
+					Name:        name,
+					Host:        "1.1.1.1",
+					Port:        10000,
+					ClusterName: "test",
+					Labels:      map[string]string{"region": "jp"},
+				},
+				HeartbeatTime: lastSeen,
+			}
+		}
+
+		convey.So(ns.Save(newMember("node1", now), newMember("node2", now-7200)), convey.ShouldBeNil)
+		members, err := ns.Load()
+		convey.So(err, convey.ShouldBeNil)
+		// 过期的节点被删除
+		convey.So(len(members), convey.ShouldEqual, 1)
+		convey.So(members[0].GetNode().GetLabels()["region"], convey.ShouldEqual, "jp")
+
+		convey.Convey("load into cluster", func() {
+			cluster := &Cluster{Name: "test"}
+			cluster.Init()
+			other := newMember("node3", now)
+			other.Node.ClusterName = "other"
+			added := cluster.LoadMembers("local", append(members, other, newMember("local", now)))
+			convey.So(added, convey.ShouldEqual, 1)
+			convey.So(cluster.Get("node1").IsValid(), convey.ShouldBeTrue)
+			// 未心跳成功的节点不会被持久化
+			convey.So(len(cluster.LearnedMembers("local")), convey.ShouldEqual, 0)
+		})
+	})
+}
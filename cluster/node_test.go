@@ -0,0 +1,75 @@
+package cluster
+
+import (
+	"encoding/json"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/lureiny/v2raymg/server/rpc/proto"
+	"github.com/smartystreets/goconvey/convey"
+)
+
+func TestNodeHealth(t *testing.T) {
+	convey.Convey("node health", t, func() {
+		nm := NewNodeManager()
+		node := &Node{Node: &proto.Node{Name: "node1"}, GetHeartBeatTime: time.Now().Unix()}
+		nm.Add(node.Name, node)
+		convey.So(node.GetHealth(), convey.ShouldBeNil)
+		convey.So(node.Status(), convey.ShouldEqual, NodeStatusUnknown)
+
+		nm.UpdateHealth(node.Name, &proto.NodeHealth{Status: NodeStatusDegraded})
+		convey.So(node.Status(), convey.ShouldEqual, NodeStatusDegraded)
+		data, err := json.Marshal(node)
+		convey.So(err, convey.ShouldBeNil)
+		result := map[string]interface{}{}
+		convey.So(json.Unmarshal(data, &result), convey.ShouldBeNil)
+		convey.So(result["status"], convey.ShouldEqual, NodeStatusDegraded)
+		convey.So(result["Health"], convey.ShouldNotBeNil)
+
+		// 心跳更新与状态查询并发进行, 配合-race检查
+		wg := sync.WaitGroup{}
+		wg.Add(2)
+		go func() {
+			defer wg.Done()
+			for i := 0; i < 100; i++ {
+				nm.UpdateHealth(node.Name, &proto.NodeHealth{Status: NodeStatusHealthy})
+			}
+		}()
+		go func() {
+			defer wg.Done()
+			for i := 0; i < 100; i++ {
+				node.Status()
+				json.Marshal(node)
+			}
+		}()
+		wg.Wait()
+		convey.So(node.Status(), convey.ShouldEqual, NodeStatusHealthy)
+
+		node.GetHeartBeatTime = 0
+		convey.So(node.Status(), convey.ShouldEqual, NodeStatusDown)
+	})
+}
+
+func TestNodeManagerHeartBeat(t *testing.T) {
+	convey.Convey("update heartbeat and out token", t, func() {
+		nm := NewNodeManager()
+		node := &Node{Node: &proto.Node{Name: "node1"}, OutToken: "token"}
+		nm.Add(node.Name, node)
+
+		now := time.Now().Unix()
+		nm.UpdateHeartBeat(node.Name, now)
+		convey.So(node.GetHeartBeatTime, convey.ShouldEqual, now)
+		convey.So(node.ReportHeartBeatTime, convey.ShouldEqual, now)
+		convey.So(node.RegisteredRemote(), convey.ShouldBeTrue)
+
+		nm.ResetOutToken(node.Name)
+		convey.So(node.OutToken, convey.ShouldEqual, "")
+		convey.So(node.RegisteredRemote(), convey.ShouldBeFalse)
+
+		// 不存在的节点直接忽略
+		nm.UpdateHeartBeat("node2", now)
+		nm.ResetOutToken("node2")
+		convey.So(nm.Get("node2"), convey.ShouldBeNil)
+	})
+}
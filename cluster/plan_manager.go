@@ -0,0 +1,179 @@
+package cluster
+
+import (
+	"fmt"
+	"sort"
+	"sync"
+
+	"github.com/lureiny/v2raymg/common"
+	"github.com/lureiny/v2raymg/common/log/logger"
+	"github.com/lureiny/v2raymg/common/store"
+	"github.com/lureiny/v2raymg/common/util"
+	gc "github.com/lureiny/v2raymg/global/config"
+	"github.com/lureiny/v2raymg/server/rpc/proto"
+	pb "google.golang.org/protobuf/proto"
+)
+
+const planBucket = "plans"
+
+// 配置文件中的套餐格式
+type rawPlan struct {
+	Tags          []string `mapstructure:"tags"`
+	Duration      string   `mapstructure:"duration"` // 秒或者时长, 例如720h
+	Quota         string   `mapstructure:"quota"`    // 支持KB/MB/GB/TB等单位
+	UplinkQuota   string   `mapstructure:"uplink_quota"`
+	DownlinkQuota string   `mapstructure:"downlink_quota"`
+	Level         uint32   `mapstructure:"level"`
+	ResetPeriod   uint32   `mapstructure:"reset_period"` // 单位为月
+	AnchorDay     uint32   `mapstructure:"anchor_day"`
+	NodeSelector  string   `mapstructure:"node_selector"` // 格式与target参数相同
+}
+
+func (raw *rawPlan) toPlan(name string) (*proto.Plan, error) {
+	plan := &proto.Plan{
+		Name:             name,
+		Tags:             raw.Tags,
+		Level:            raw.Level,
+		ResetPeriod:      raw.ResetPeriod,
+		BillingAnchorDay: raw.AnchorDay,
+		NodeSelector:     raw.NodeSelector,
+	}
+	var err error = nil
+	if plan.Duration, err = util.ParseSeconds(raw.Duration); err != nil {
+		return nil, err
+	}
+	if plan.Quota, err = util.ParseByteSize(raw.Quota); err != nil {
+		return nil, err
+	}
+	if plan.UplinkQuota, err = util.ParseByteSize(raw.UplinkQuota); err != nil {
+		return nil, err
+	}
+	if plan.DownlinkQuota, err = util.ParseByteSize(raw.DownlinkQuota); err != nil {
+		return nil, err
+	}
+	return plan, nil
+}
+
+func checkPlan(plan *proto.Plan) error {
+	if plan.Name == "" {
+		return fmt.Errorf("empty plan name")
+	}
+	if plan.Duration < 0 || plan.Quota < 0 || plan.UplinkQuota < 0 || plan.DownlinkQuota < 0 {
+		return fmt.Errorf("plan[%s] duration and quota can not be negative", plan.Name)
+	}
+	if plan.BillingAnchorDay > 31 {
+		return fmt.Errorf("plan[%s] anchor day must be in [1, 31]", plan.Name)
+	}
+	if plan.NodeSelector != "" {
+		if _, err := ParseNodeSelector(plan.NodeSelector); err != nil {
+			return fmt.Errorf("plan[%s] invalid node selector > %v", plan.Name, err)
+		}
+	}
+	return nil
+}
+
+// PlanManager 管理用户套餐, 配置文件中的套餐在启动时会覆盖store中的同名套餐
+type PlanManager struct {
+	plans map[string]*proto.Plan // key = name
+	lock  sync.RWMutex
+	store *store.BoltStore
+}
+
+func NewPlanManager() *PlanManager {
+	return &PlanManager{
+		plans: map[string]*proto.Plan{},
+	}
+}
+
+func (pm *PlanManager) Init(s *store.BoltStore) error {
+	pm.store = s
+	err := pm.store.ForEach(planBucket, func(k, v []byte) error {
+		plan := &proto.Plan{}
+		if err := pb.Unmarshal(v, plan); err != nil {
+			return fmt.Errorf("unmarshal plan[%s] fail > %v", k, err)
+		}
+		pm.plans[plan.Name] = plan
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("load plans fail > %v", err)
+	}
+
+	rawPlans := map[string]*rawPlan{}
+	if err := gc.UnmarshalKey(common.ConfigPlans, &rawPlans); err != nil {
+		return fmt.Errorf("please check plans config > %v", err)
+	}
+	plans := []*proto.Plan{}
+	for name, raw := range rawPlans {
+		plan, err := raw.toPlan(name)
+		if err != nil {
+			return fmt.Errorf("invalid plan[%s] > %v", name, err)
+		}
+		plans = append(plans, plan)
+	}
+	if len(plans) > 0 {
+		logger.Info("Msg=load plans from config|PlanNum=%d", len(plans))
+	}
+	return pm.Set(plans...)
+}
+
+// Get 获取套餐, 不存在时返回nil
+func (pm *PlanManager) Get(name string) *proto.Plan {
+	pm.lock.RLock()
+	defer pm.lock.RUnlock()
+	if plan, ok := pm.plans[name]; ok {
+		return pb.Clone(plan).(*proto.Plan)
+	}
+	return nil
+}
+
+// List 按名称排序的套餐列表
+func (pm *PlanManager) List() []*proto.Plan {
+	pm.lock.RLock()
+	plans := []*proto.Plan{}
+	for _, plan := range pm.plans {
+		plans = append(plans, pb.Clone(plan).(*proto.Plan))
+	}
+	pm.lock.RUnlock()
+	sort.Slice(plans, func(i, j int) bool {
+		return plans[i].Name < plans[j].Name
+	})
+	return plans
+}
+
+// Set 添加或修改套餐
+func (pm *PlanManager) Set(plans ...*proto.Plan) error {
+	kvs := map[string][]byte{}
+	for _, plan := range plans {
+		if err := checkPlan(plan); err != nil {
+			return err
+		}
+		data, err := pb.Marshal(plan)
+		if err != nil {
+			return fmt.Errorf("marshal plan[%s] fail > %v", plan.Name, err)
+		}
+		kvs[plan.Name] = data
+	}
+	if err := pm.store.PutBatch(planBucket, kvs); err != nil {
+		return err
+	}
+	pm.lock.Lock()
+	defer pm.lock.Unlock()
+	for _, plan := range plans {
+		pm.plans[plan.Name] = pb.Clone(plan).(*proto.Plan)
+	}
+	return nil
+}
+
+// Delete 删除套餐, 已经使用该套餐的用户不受影响
+func (pm *PlanManager) Delete(names ...string) error {
+	if err := pm.store.Delete(planBucket, names...); err != nil {
+		return err
+	}
+	pm.lock.Lock()
+	defer pm.lock.Unlock()
+	for _, name := range names {
+		delete(pm.plans, name)
+	}
+	return nil
+}
@@ -0,0 +1,91 @@
+package cluster
+
+import (
+	"fmt"
+	"strings"
+)
+
+const (
+	selectAllNode = "all"
+	// 保留的标签名, 按照节点状态选择
+	statusSelectorKey = "status"
+)
+
+// labelRequirement 单个标签条件, 支持key=value与key!=value
+type labelRequirement struct {
+	key      string
+	value    string
+	notEqual bool
+}
+
+// NodeSelector 选择目标节点, 支持all, 逗号分隔的节点名称以及标签选择器(如region=jp,tier!=free,status=healthy)
+type NodeSelector struct {
+	all          bool
+	names        map[string]struct{}
+	requirements []labelRequirement
+}
+
+// ParseNodeSelector 解析target参数, 包含=时整个target按照标签选择器解析
+func ParseNodeSelector(target string) (*NodeSelector, error) {
+	target = strings.TrimSpace(target)
+	if target == "" {
+		return nil, fmt.Errorf("empty target")
+	}
+	selector := &NodeSelector{}
+	if target == selectAllNode {
+		selector.all = true
+		return selector, nil
+	}
+	if !strings.Contains(target, "=") {
+		selector.names = map[string]struct{}{}
+		for _, name := range strings.Split(target, ",") {
+			if name = strings.TrimSpace(name); name != "" {
+				selector.names[name] = struct{}{}
+			}
+		}
+		return selector, nil
+	}
+	for _, item := range strings.Split(target, ",") {
+		item = strings.TrimSpace(item)
+		requirement := labelRequirement{}
+		var kv []string
+		if strings.Contains(item, "!=") {
+			requirement.notEqual = true
+			kv = strings.SplitN(item, "!=", 2)
+		} else {
+			kv = strings.SplitN(item, "=", 2)
+		}
+		if len(kv) != 2 || strings.TrimSpace(kv[0]) == "" {
+			return nil, fmt.Errorf("invalid label requirement %s", item)
+		}
+		requirement.key = strings.TrimSpace(kv[0])
+		requirement.value = strings.TrimSpace(kv[1])
+		selector.requirements = append(selector.requirements, requirement)
+	}
+	return selector, nil
+}
+
+// Match 判断节点是否满足选择条件, 多个标签条件之间为且的关系
+func (s *NodeSelector) Match(node *Node) bool {
+	if node == nil || node.Node == nil {
+		return false
+	}
+	if s.all {
+		return true
+	}
+	if s.names != nil {
+		_, ok := s.names[node.GetName()]
+		return ok
+	}
+	labels := node.GetLabels()
+	for _, requirement := range s.requirements {
+		value, ok := labels[requirement.key]
+		if requirement.key == statusSelectorKey {
+			value, ok = node.Status(), true
+		}
+		if requirement.notEqual == (ok && value == requirement.value) {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,77 @@
+package cluster
+
+import (
+	"testing"
+	"time"
+
+	"github.com/lureiny/v2raymg/server/rpc/proto"
+	"github.com/smartystreets/goconvey/convey"
+)
+
+func TestNodeSelector(t *testing.T) {
+	convey.Convey("node selector", t, func() {
+		now := time.Now().Unix()
+		jp := &Node{
+			Node:             &proto.Node{Name: "jp1", Labels: map[string]string{"region": "jp", "tier": "free"}},
+			GetHeartBeatTime: now,
+		}
+		jp.SetHealth(&proto.NodeHealth{Status: NodeStatusHealthy})
+		us := &Node{
+			Node:             &proto.Node{Name: "us1", Labels: map[string]string{"region": "us", "tier": "pro"}},
+			GetHeartBeatTime: now,
+		}
+		us.SetHealth(&proto.NodeHealth{Status: NodeStatusDegraded})
+		noLabel := &Node{Node: &proto.Node{Name: "hk1"}, GetHeartBeatTime: now}
+
+		convey.Convey("select all", func() {
+			selector, err := ParseNodeSelector("all")
+			convey.So(err, convey.ShouldBeNil)
+			convey.So(selector.Match(jp), convey.ShouldBeTrue)
+			convey.So(selector.Match(noLabel), convey.ShouldBeTrue)
+		})
+
+		convey.Convey("select by names", func() {
+			selector, err := ParseNodeSelector("jp1, hk1")
+			convey.So(err, convey.ShouldBeNil)
+			convey.So(selector.Match(jp), convey.ShouldBeTrue)
+			convey.So(selector.Match(noLabel), convey.ShouldBeTrue)
+			convey.So(selector.Match(us), convey.ShouldBeFalse)
+		})
+
+		convey.Convey("select by labels", func() {
+			selector, err := ParseNodeSelector("region=jp,tier!=pro")
+			convey.So(err, convey.ShouldBeNil)
+			convey.So(selector.Match(jp), convey.ShouldBeTrue)
+			convey.So(selector.Match(us), convey.ShouldBeFalse)
+			convey.So(selector.Match(noLabel), convey.ShouldBeFalse)
+
+			// 不存在的标签满足不等条件
+			selector, _ = ParseNodeSelector("tier!=pro")
+			convey.So(selector.Match(noLabel), convey.ShouldBeTrue)
+			convey.So(selector.Match(us), convey.ShouldBeFalse)
+		})
+
+		convey.Convey("select by status", func() {
+			selector, err := ParseNodeSelector("status=healthy")
+			convey.So(err, convey.ShouldBeNil)
+			convey.So(selector.Match(jp), convey.ShouldBeTrue)
+			convey.So(selector.Match(us), convey.ShouldBeFalse)
+			convey.So(selector.Match(noLabel), convey.ShouldBeFalse)
+
+			selector, _ = ParseNodeSelector("region=us,status!=down")
+			convey.So(selector.Match(us), convey.ShouldBeTrue)
+			// 心跳超时的节点为down
+			us.GetHeartBeatTime = 0
+			convey.So(selector.Match(us), convey.ShouldBeFalse)
+		})
+
+		convey.Convey("reject invalid selector", func() {
+			_, err := ParseNodeSelector("region=jp,tier")
+			convey.So(err, convey.ShouldNotBeNil)
+			_, err = ParseNodeSelector("=jp")
+			convey.So(err, convey.ShouldNotBeNil)
+			_, err = ParseNodeSelector("")
+			convey.So(err, convey.ShouldNotBeNil)
+		})
+	})
+}
@@ -0,0 +1,131 @@
+package cluster
+
+import (
+	"fmt"
+	"net/url"
+	"sort"
+	"strconv"
+
+	"github.com/lureiny/v2raymg/common/log/logger"
+	"github.com/lureiny/v2raymg/proxy/manager"
+	"github.com/lureiny/v2raymg/server/rpc/proto"
+)
+
+// SetSpeedTiers 设置速度档位并写入proxy的policy配置, 需要在Init之后, proxy启动之前调用
+// 不同档位不能使用相同的level, 否则无法区分档位的policy
+func (um *UserManager) SetSpeedTiers(tiers map[string]*manager.PolicyLevel) error {
+	names := []string{}
+	levels := map[uint32]string{}
+	for name, tier := range tiers {
+		if tier == nil {
+			return fmt.Errorf("speed tier[%s] is empty", name)
+		}
+		if other, ok := levels[tier.Level]; ok {
+			return fmt.Errorf("speed tier[%s] and [%s] use the same level %d", name, other, tier.Level)
+		}
+		levels[tier.Level] = name
+		names = append(names, name)
+	}
+	// 按名称排序, 保证每次写入的配置一致
+	sort.Strings(names)
+	policyLevels := []*manager.PolicyLevel{}
+	for _, name := range names {
+		policyLevels = append(policyLevels, tiers[name])
+	}
+	if len(policyLevels) > 0 && um.proxyManager != nil {
+		if err := um.proxyManager.SetPolicyLevels(policyLevels); err != nil {
+			return fmt.Errorf("set proxy policy levels fail > %v", err)
+		}
+	}
+	um.lock.Lock()
+	um.speedTiers = tiers
+	um.lock.Unlock()
+	return nil
+}
+
+// 根据用户的速度档位设置level, 没有档位时保持原有的level
+func (um *UserManager) applySpeedTier(user *proto.User) error {
+	if user.SpeedTier == "" {
+		return nil
+	}
+	um.lock.RLock()
+	tier, ok := um.speedTiers[user.SpeedTier]
+	um.lock.RUnlock()
+	if !ok {
+		return fmt.Errorf("speed tier[%s] is not exist", user.SpeedTier)
+	}
+	user.Level = tier.Level
+	return nil
+}
+
+// 修改用户的速度档位, 在全部tag上使用新的level重新添加用户, 无需重启proxy
+func (um *UserManager) setUserSpeedTier(name, speedTier string) error {
+	target := &proto.User{Name: name, SpeedTier: speedTier}
+	if err := um.applySpeedTier(target); err != nil {
+		return err
+	}
+	um.lock.Lock()
+	u, ok := um.users[name]
+	if !ok {
+		um.lock.Unlock()
+		return fmt.Errorf("user[%s] is not exist", name)
+	}
+	changed := u.Level != target.Level
+	u.SpeedTier, u.Level = target.SpeedTier, target.Level
+	levelUser := &proto.User{Name: u.Name, Tags: append([]string{}, u.Tags...), Level: u.Level}
+	um.lock.Unlock()
+
+	if !changed || len(levelUser.Tags) == 0 {
+		return nil
+	}
+	if _, _, err := proxyUserOp(levelUser, "level", um.proxyManager); err != nil {
+		return fmt.Errorf("set level fail > %v", err)
+	}
+	return nil
+}
+
+// SyncSpeedTierLevels 配置中档位的level修改后, 将使用该档位的用户更新为新的level, 需要在proxy启动后调用
+func (um *UserManager) SyncSpeedTierLevels() {
+	um.lock.RLock()
+	users := []*proto.User{}
+	for _, u := range um.users {
+		if tier, ok := um.speedTiers[u.SpeedTier]; ok && tier.Level != u.Level {
+			users = append(users, &proto.User{Name: u.Name, SpeedTier: u.SpeedTier})
+		} else if u.SpeedTier != "" && !ok {
+			logger.Error("Err=speed tier is not exist|User=%s|SpeedTier=%s", u.Name, u.SpeedTier)
+		}
+	}
+	um.lock.RUnlock()
+	for _, u := range users {
+		if err := um.setUserSpeedTier(u.Name, u.SpeedTier); err != nil {
+			logger.Error("Err=sync speed tier level fail > %v|User=%s|SpeedTier=%s", err, u.Name, u.SpeedTier)
+		}
+	}
+	if len(users) > 0 {
+		um.FlushUser()
+	}
+}
+
+// 在hysteria订阅中加入档位的上下行带宽, 客户端按照该带宽发送, 服务端不做限制
+func (um *UserManager) withHysteriaBandwidth(uri, speedTier string) string {
+	um.lock.RLock()
+	tier, ok := um.speedTiers[speedTier]
+	um.lock.RUnlock()
+	if uri == "" || !ok || (tier.HysteriaUp == 0 && tier.HysteriaDown == 0) {
+		return uri
+	}
+	parsedUri, err := url.Parse(uri)
+	if err != nil {
+		logger.Error("Err=parse hysteria uri fail > %v|SpeedTier=%s", err, speedTier)
+		return uri
+	}
+	query := parsedUri.Query()
+	if tier.HysteriaUp > 0 {
+		query.Set("upmbps", strconv.FormatUint(uint64(tier.HysteriaUp), 10))
+	}
+	if tier.HysteriaDown > 0 {
+		query.Set("downmbps", strconv.FormatUint(uint64(tier.HysteriaDown), 10))
+	}
+	parsedUri.RawQuery = query.Encode()
+	return parsedUri.String()
+}
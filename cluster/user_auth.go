@@ -0,0 +1,265 @@
+package cluster
+
+import (
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/base64"
+	"encoding/hex"
+	"fmt"
+	"net"
+	"time"
+
+	"github.com/lureiny/v2raymg/common/log/logger"
+	"github.com/lureiny/v2raymg/common/util"
+	"github.com/lureiny/v2raymg/server/rpc/proto"
+	"golang.org/x/crypto/bcrypt"
+)
+
+// 订阅token的随机字节数
+const subTokenSize = 16
+
+// 用户认证索引, key为凭证的sha256, 避免map查找时泄露凭证的比较时间; value为使用该凭证的用户名
+type userAuthIndex map[[sha256.Size]byte][]string
+
+// hysteria认证使用订阅token, 可以使用原始token或base64编码后的token(订阅中使用的是后者)
+func getUserCredentials(subToken string) []string {
+	if subToken == "" {
+		return nil
+	}
+	return []string{subToken, base64.RawStdEncoding.EncodeToString([]byte(subToken))}
+}
+
+func (index userAuthIndex) add(name, subToken string) {
+	for _, credential := range getUserCredentials(subToken) {
+		key := sha256.Sum256([]byte(credential))
+		found := false
+		for _, n := range index[key] {
+			if n == name {
+				found = true
+				break
+			}
+		}
+		if !found {
+			index[key] = append(index[key], name)
+		}
+	}
+}
+
+func (index userAuthIndex) remove(name, subToken string) {
+	for _, credential := range getUserCredentials(subToken) {
+		key := sha256.Sum256([]byte(credential))
+		names := []string{}
+		for _, n := range index[key] {
+			if n != name {
+				names = append(names, n)
+			}
+		}
+		if len(names) == 0 {
+			delete(index, key)
+		} else {
+			index[key] = names
+		}
+	}
+}
+
+// 常量时间比较凭证
+func matchCredential(subToken, credential string) bool {
+	matched := 0
+	for _, c := range getUserCredentials(subToken) {
+		matched |= subtle.ConstantTimeCompare([]byte(c), []byte(credential))
+	}
+	return matched == 1
+}
+
+// NewSubToken 生成随机的订阅token
+func NewSubToken() (string, error) {
+	token, err := util.RandomToken(subTokenSize)
+	if err != nil {
+		return "", fmt.Errorf("generate sub token fail > %v", err)
+	}
+	return token, nil
+}
+
+func hashPasswd(passwd string) (string, error) {
+	hash, err := bcrypt.GenerateFromPassword([]byte(passwd), bcrypt.DefaultCost)
+	if err != nil {
+		return "", fmt.Errorf("hash passwd fail > %v", err)
+	}
+	return string(hash), nil
+}
+
+// 校验密码, 未设置密码的用户无法通过密码校验
+func checkPasswd(user *proto.User, passwd string) bool {
+	if user.PasswdHash == "" || passwd == "" {
+		return false
+	}
+	return bcrypt.CompareHashAndPassword([]byte(user.PasswdHash), []byte(passwd)) == nil
+}
+
+// 订阅时可以使用sub token或者密码, 优先使用sub token
+func checkSubCredential(localUser, user *proto.User) bool {
+	if user.SubToken != "" {
+		return localUser.SubToken != "" &&
+			subtle.ConstantTimeCompare([]byte(localUser.SubToken), []byte(user.SubToken)) == 1
+	}
+	return checkPasswd(localUser, user.Passwd)
+}
+
+// 设置用户凭证: 明文密码转换为加盐哈希后丢弃, 没有订阅token时随机生成
+// 节点间复制用户时只有passwd_hash, 直接沿用
+func setUserCredential(user *proto.User) error {
+	if user.Passwd != "" {
+		hash, err := hashPasswd(user.Passwd)
+		if err != nil {
+			return err
+		}
+		user.PasswdHash, user.Passwd = hash, ""
+	} else if user.PasswdHash != "" {
+		if _, err := bcrypt.Cost([]byte(user.PasswdHash)); err != nil {
+			return fmt.Errorf("invalid passwd hash > %v", err)
+		}
+	}
+	if user.SubToken == "" {
+		token, err := NewSubToken()
+		if err != nil {
+			return err
+		}
+		user.SubToken = token
+	}
+	return nil
+}
+
+// 需要迁移的用户: 旧版本中保存了明文密码或者没有订阅token
+func needMigrateCredential(user *proto.User) bool {
+	return user.Passwd != "" || user.SubToken == ""
+}
+
+// 检查用户当前是否可用, 订阅与hysteria认证共用
+func checkUserAvailable(user *proto.User) error {
+	if user.ExpireTime < time.Now().Unix() && user.ExpireTime > 0 {
+		return fmt.Errorf("expired user[%s], expired time is %d", user.Name, user.ExpireTime)
+	}
+	if user.Suspended {
+		return fmt.Errorf("suspended user[%s]", user.Name)
+	}
+	return nil
+}
+
+// 迁移前明文密码的认证兼容期, 过期后只能使用订阅token认证
+const legacyCredentialWindow = 30 * 24 * time.Hour
+
+// hysteria认证失败的限制, 同一个客户端地址在窗口内超过次数后直接拒绝
+const (
+	authFailLimit  = 10
+	authFailWindow = time.Minute
+)
+
+// 迁移明文密码前记录其认证凭证, 迁移后旧版本的hysteria客户端在兼容期内仍然可以使用原有的密码
+func migrateUserCredential(user *proto.User, legacyCreds map[string][]*LegacyCredential, expireTime int64) error {
+	passwd := user.Passwd
+	if err := setUserCredential(user); err != nil {
+		return err
+	}
+	// 旧版本的客户端使用原始密码或base64编码后的密码, 与订阅token的形式一致
+	for _, credential := range getUserCredentials(passwd) {
+		key := sha256.Sum256([]byte(credential))
+		hexKey := hex.EncodeToString(key[:])
+		legacyCreds[hexKey] = append(legacyCreds[hexKey], &LegacyCredential{
+			Name:       user.Name,
+			PasswdHash: user.PasswdHash,
+			ExpireTime: expireTime,
+		})
+	}
+	return nil
+}
+
+// 加载兼容期内的明文密码认证记录, 认证时只需要一次map查找
+func (um *UserManager) loadLegacyCredentials() error {
+	creds, err := um.store.LoadLegacyCredentials(time.Now().Unix())
+	if err != nil {
+		return err
+	}
+	um.legacyAuthIndex = map[[sha256.Size]byte][]*LegacyCredential{}
+	for hexKey, entries := range creds {
+		data, err := hex.DecodeString(hexKey)
+		if err != nil || len(data) != sha256.Size {
+			continue
+		}
+		key := [sha256.Size]byte{}
+		copy(key[:], data)
+		um.legacyAuthIndex[key] = entries
+	}
+	return nil
+}
+
+// 订阅token未命中时查找兼容期内的明文密码, 密码修改或者兼容期结束后失效
+func (um *UserManager) getLegacyAuthNames(key [sha256.Size]byte) []string {
+	now := time.Now().Unix()
+	names := []string{}
+	for _, entry := range um.legacyAuthIndex[key] {
+		u, ok := um.users[entry.Name]
+		if ok && entry.ExpireTime > now && u.PasswdHash == entry.PasswdHash {
+			names = append(names, entry.Name)
+		}
+	}
+	return names
+}
+
+func getAuthFailKey(addr string) string {
+	host, _, err := net.SplitHostPort(addr)
+	if err != nil {
+		return addr
+	}
+	return host
+}
+
+// 记录认证失败, 未初始化限制时不处理
+func (um *UserManager) recordAuthFail(addr string) {
+	if um.authFailLimiter != nil {
+		um.authFailLimiter.Allow(getAuthFailKey(addr))
+	}
+}
+
+// AuthUser 通过凭证认证用户, 用于hysteria认证, 认证成功后记录客户端地址
+// 优先使用订阅token认证, 未命中时使用兼容期内的明文密码认证, 均为一次map查找
+func (um *UserManager) AuthUser(credential, addr string) (*proto.User, error) {
+	if um.authFailLimiter != nil && um.authFailLimiter.Limited(getAuthFailKey(addr)) {
+		return nil, fmt.Errorf("too many failed auth from %s", addr)
+	}
+	key := sha256.Sum256([]byte(credential))
+	um.lock.Lock()
+	defer um.lock.Unlock()
+	names := um.authIndex[key]
+	byLegacy := len(names) == 0
+	if byLegacy {
+		names = um.getLegacyAuthNames(key)
+	}
+	if len(names) == 0 {
+		um.recordAuthFail(addr)
+		return nil, fmt.Errorf("invalid credential")
+	}
+	// 多个用户使用相同的凭证时无法确定用户身份, 直接拒绝
+	if len(names) > 1 {
+		um.recordAuthFail(addr)
+		return nil, fmt.Errorf("credential is shared by users %v", names)
+	}
+	u, ok := um.users[names[0]]
+	if !ok || (!byLegacy && !matchCredential(u.SubToken, credential)) {
+		um.recordAuthFail(addr)
+		return nil, fmt.Errorf("invalid credential")
+	}
+	if err := checkUserAvailable(u); err != nil {
+		return nil, err
+	}
+	if u.OverQuota {
+		return nil, fmt.Errorf("over quota user[%s]", u.Name)
+	}
+	// 全部tag都已删除的用户等待清除
+	if len(u.Tags) == 0 {
+		return nil, fmt.Errorf("user[%s] has no enabled tag", u.Name)
+	}
+	u.LastLoginAddr = addr
+	u.LastLoginTime = time.Now().Unix()
+	logger.Info("Msg=user login|User=%s|Addr=%s", u.Name, addr)
+	return &proto.User{Name: u.Name}, nil
+}
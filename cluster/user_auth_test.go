@@ -0,0 +1,128 @@
+package cluster
+
+import (
+	"encoding/base64"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/lureiny/v2raymg/common/store"
+	"github.com/lureiny/v2raymg/common/util"
+	"github.com/lureiny/v2raymg/server/rpc/proto"
+	"github.com/smartystreets/goconvey/convey"
+)
+
+func TestAuthUser(t *testing.T) {
+	convey.Convey("auth hysteria user by sub token or legacy passwd", t, func() {
+		s, err := store.OpenBoltStore(filepath.Join(t.TempDir(), "store.db"))
+		convey.So(err, convey.ShouldBeNil)
+		defer s.Close()
+		um := &UserManager{users: map[string]*proto.User{}, authIndex: userAuthIndex{}, store: NewBoltUserStore(s)}
+		legacyCreds := map[string][]*LegacyCredential{}
+		expireTime := time.Now().Add(legacyCredentialWindow).Unix()
+		for _, name := range []string{"u1", "u2"} {
+			u := &proto.User{Name: name, Passwd: name + "-passwd", Tags: []string{"hy2"}}
+			convey.So(migrateUserCredential(u, legacyCreds, expireTime), convey.ShouldBeNil)
+			um.users[name] = u
+			um.authIndex.add(name, u.SubToken)
+		}
+		convey.So(um.store.SaveLegacyCredentials(legacyCreds), convey.ShouldBeNil)
+		convey.So(um.loadLegacyCredentials(), convey.ShouldBeNil)
+
+		u, err := um.AuthUser(um.users["u1"].SubToken, "1.1.1.1:1000")
+		convey.So(err, convey.ShouldBeNil)
+		convey.So(u.Name, convey.ShouldEqual, "u1")
+
+		// 迁移前的客户端使用原始密码或者base64编码后的密码
+		u, err = um.AuthUser("u2-passwd", "1.1.1.1:1000")
+		convey.So(err, convey.ShouldBeNil)
+		convey.So(u.Name, convey.ShouldEqual, "u2")
+		u, err = um.AuthUser(base64.RawStdEncoding.EncodeToString([]byte("u2-passwd")), "1.1.1.1:1000")
+		convey.So(err, convey.ShouldBeNil)
+		convey.So(u.Name, convey.ShouldEqual, "u2")
+
+		_, err = um.AuthUser("wrong", "1.1.1.1:1000")
+		convey.So(err, convey.ShouldNotBeNil)
+
+		convey.Convey("legacy passwd is invalid after passwd changed", func() {
+			hash, err := hashPasswd("new-passwd")
+			convey.So(err, convey.ShouldBeNil)
+			um.users["u2"].PasswdHash = hash
+			_, err = um.AuthUser("u2-passwd", "1.1.1.1:1000")
+			convey.So(err, convey.ShouldNotBeNil)
+			// 新密码不能用于hysteria认证
+			_, err = um.AuthUser("new-passwd", "1.1.1.1:1000")
+			convey.So(err, convey.ShouldNotBeNil)
+		})
+
+		convey.Convey("legacy passwd is invalid after window", func() {
+			for _, entries := range um.legacyAuthIndex {
+				for _, entry := range entries {
+					entry.ExpireTime = time.Now().Unix() - 1
+				}
+			}
+			_, err = um.AuthUser("u2-passwd", "1.1.1.1:1000")
+			convey.So(err, convey.ShouldNotBeNil)
+		})
+
+		convey.Convey("suspended user is rejected", func() {
+			um.users["u2"].Suspended = true
+			_, err = um.AuthUser("u2-passwd", "1.1.1.1:1000")
+			convey.So(err, convey.ShouldNotBeNil)
+		})
+
+		convey.Convey("reject address after too many failures", func() {
+			um.authFailLimiter = util.NewRateLimiter(authFailLimit, authFailWindow)
+			for i := 0; i < authFailLimit; i++ {
+				_, err = um.AuthUser("wrong", "2.2.2.2:1000")
+				convey.So(err, convey.ShouldNotBeNil)
+			}
+			_, err = um.AuthUser(um.users["u1"].SubToken, "2.2.2.2:2000")
+			convey.So(err, convey.ShouldNotBeNil)
+			// 其他地址不受影响
+			_, err = um.AuthUser(um.users["u1"].SubToken, "1.1.1.1:1000")
+			convey.So(err, convey.ShouldBeNil)
+		})
+	})
+}
+
+func TestAuthUserAvailable(t *testing.T) {
+	convey.Convey("reject unavailable hysteria user", t, func() {
+		um := &UserManager{users: map[string]*proto.User{}, authIndex: userAuthIndex{}}
+		add := func(u *proto.User) {
+			um.users[u.Name] = u
+			um.authIndex.add(u.Name, u.SubToken)
+		}
+		add(&proto.User{Name: "ok", SubToken: "token-ok", Tags: []string{"hy2"}})
+		add(&proto.User{Name: "expired", SubToken: "token-expired", Tags: []string{"hy2"}, ExpireTime: time.Now().Unix() - 10})
+		add(&proto.User{Name: "suspended", SubToken: "token-suspended", Tags: []string{"hy2"}, Suspended: true})
+		add(&proto.User{Name: "over_quota", SubToken: "token-over_quota", Tags: []string{"hy2"}, OverQuota: true})
+		add(&proto.User{Name: "no_tag", SubToken: "token-no_tag"})
+
+		u, err := um.AuthUser("token-ok", "1.1.1.1:1000")
+		convey.So(err, convey.ShouldBeNil)
+		convey.So(u.Name, convey.ShouldEqual, "ok")
+		convey.So(um.users["ok"].LastLoginAddr, convey.ShouldEqual, "1.1.1.1:1000")
+		// 订阅中使用base64编码后的token
+		_, err = um.AuthUser(base64.RawStdEncoding.EncodeToString([]byte("token-ok")), "1.1.1.1:1000")
+		convey.So(err, convey.ShouldBeNil)
+
+		for _, name := range []string{"expired", "suspended", "over_quota", "no_tag"} {
+			_, err := um.AuthUser("token-"+name, "1.1.1.1:1000")
+			convey.So(err, convey.ShouldNotBeNil)
+		}
+
+		convey.Convey("shared credential is rejected", func() {
+			add(&proto.User{Name: "ok2", SubToken: "token-ok", Tags: []string{"hy2"}})
+			_, err := um.AuthUser("token-ok", "1.1.1.1:1000")
+			convey.So(err, convey.ShouldNotBeNil)
+		})
+
+		convey.Convey("removed credential is invalid", func() {
+			um.authIndex.remove("ok", "token-ok")
+			convey.So(len(um.authIndex), convey.ShouldEqual, 8)
+			_, err := um.AuthUser("token-ok", "1.1.1.1:1000")
+			convey.So(err, convey.ShouldNotBeNil)
+		})
+	})
+}
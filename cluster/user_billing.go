@@ -0,0 +1,134 @@
+package cluster
+
+import (
+	"time"
+
+	"github.com/lureiny/v2raymg/common/log/logger"
+	"github.com/lureiny/v2raymg/server/rpc/proto"
+	"github.com/robfig/cron/v3"
+)
+
+// 每小时检查一次需要重置流量的用户, 重置时间以周期开始时间为准, 错过的重置会在下一次检查时补上
+const defaultBillingCron = "0 * * * *"
+
+// 指定月份的重置日, 超过当月天数时取当月最后一天
+func anchorDate(year int, month time.Month, anchorDay uint32) time.Time {
+	lastDay := time.Date(year, month+1, 0, 0, 0, 0, 0, time.Local).Day()
+	day := int(anchorDay)
+	if day > lastDay {
+		day = lastDay
+	}
+	return time.Date(year, month, day, 0, 0, 0, 0, time.Local)
+}
+
+// 当前时间所在统计周期的开始时间, 即不晚于now的最近一个重置日
+func currentPeriodStart(now time.Time, anchorDay uint32) time.Time {
+	now = now.In(time.Local)
+	start := anchorDate(now.Year(), now.Month(), anchorDay)
+	if start.After(now) {
+		start = anchorDate(now.Year(), now.Month()-1, anchorDay)
+	}
+	return start
+}
+
+// 下一个周期的开始时间
+func nextPeriodStart(start time.Time, anchorDay, resetPeriod uint32) time.Time {
+	start = start.In(time.Local)
+	return anchorDate(start.Year(), start.Month()+time.Month(resetPeriod), anchorDay)
+}
+
+// 开启流量重置的用户需要有重置日与周期开始时间, 未指定重置日时使用用户的创建日
+func initUserBilling(user *proto.User) {
+	if user.ResetPeriod == 0 {
+		user.BillingAnchorDay, user.PeriodStart = 0, 0
+		return
+	}
+	if user.BillingAnchorDay == 0 || user.BillingAnchorDay > 31 {
+		createTime := time.Now()
+		if user.CreateTime > 0 {
+			createTime = time.Unix(user.CreateTime, 0)
+		}
+		user.BillingAnchorDay = uint32(createTime.In(time.Local).Day())
+	}
+	if user.PeriodStart == 0 {
+		user.PeriodStart = currentPeriodStart(time.Now(), user.BillingAnchorDay).Unix()
+	}
+}
+
+// 启动流量重置的定时任务
+func (um *UserManager) startBillingCron() {
+	um.billingCron = cron.New()
+	if _, err := um.billingCron.AddFunc(defaultBillingCron, um.ResetTrafficPeriods); err != nil {
+		logger.Error("Err=add billing cron func fail > %v", err)
+		return
+	}
+	um.billingCron.Start()
+}
+
+// ResetTrafficPeriods 到达重置日的用户保存本周期的流量到历史记录, 并清零流量计数, 因超出配额停用的用户会被恢复
+func (um *UserManager) ResetTrafficPeriods() {
+	um.resetTrafficPeriods(time.Now())
+}
+
+func (um *UserManager) resetTrafficPeriods(now time.Time) {
+	usages := []*proto.UserUsage{}
+	startUsers := []*proto.User{}
+	um.lock.Lock()
+	for _, u := range um.users {
+		if u.ResetPeriod == 0 {
+			continue
+		}
+		if u.PeriodStart == 0 {
+			initUserBilling(u)
+			continue
+		}
+		start := time.Unix(u.PeriodStart, 0)
+		next := nextPeriodStart(start, u.BillingAnchorDay, u.ResetPeriod)
+		if now.Before(next) {
+			continue
+		}
+		// 错过多个周期时(例如长时间停机), 这些周期的流量合并为一条记录
+		for !now.Before(next) {
+			start = next
+			next = nextPeriodStart(start, u.BillingAnchorDay, u.ResetPeriod)
+		}
+		usages = append(usages, &proto.UserUsage{
+			Name:     u.Name,
+			Start:    u.PeriodStart,
+			End:      start.Unix(),
+			Uplink:   u.UsedUplink,
+			Downlink: u.UsedDownlink,
+		})
+		u.UsedUplink, u.UsedDownlink = 0, 0
+		u.PeriodStart = start.Unix()
+		if u.OverQuota {
+			u.OverQuota = false
+			if !u.Suspended {
+				startUsers = append(startUsers, &proto.User{Name: u.Name, Tags: u.Tags, Level: u.Level})
+			}
+		}
+		logger.Info(
+			"Msg=reset user traffic|User=%s|PeriodStart=%d|Uplink=%d|Downlink=%d",
+			u.Name,
+			u.PeriodStart,
+			usages[len(usages)-1].Uplink,
+			usages[len(usages)-1].Downlink,
+		)
+	}
+	um.lock.Unlock()
+
+	if len(usages) > 0 {
+		if err := um.store.SaveUsage(usages...); err != nil {
+			logger.Error("Err=save user usage fail > %v", err)
+		}
+	}
+	for _, u := range startUsers {
+		um.startUser(u)
+	}
+	um.FlushUser()
+}
+
+// GetUserUsageHistory 用户历史周期的流量, 按周期开始时间排序
+func (um *UserManager) GetUserUsageHistory(name string) ([]*proto.UserUsage, error) {
+	return um.store.LoadUsage(name)
+}
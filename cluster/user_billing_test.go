@@ -0,0 +1,138 @@
+package cluster
+
+import (
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/lureiny/v2raymg/common/store"
+	"github.com/lureiny/v2raymg/server/rpc/proto"
+	"github.com/smartystreets/goconvey/convey"
+)
+
+func localDate(year int, month time.Month, day int) time.Time {
+	return time.Date(year, month, day, 0, 0, 0, 0, time.Local)
+}
+
+func TestBillingPeriod(t *testing.T) {
+	convey.Convey("anchor date", t, func() {
+		cases := []struct {
+			year      int
+			month     time.Month
+			anchorDay uint32
+			expect    time.Time
+		}{
+			{2023, time.February, 31, localDate(2023, time.February, 28)},
+			{2024, time.February, 31, localDate(2024, time.February, 29)},
+			{2024, time.February, 30, localDate(2024, time.February, 29)},
+			{2024, time.April, 31, localDate(2024, time.April, 30)},
+			{2024, time.June, 31, localDate(2024, time.June, 30)},
+			{2024, time.January, 31, localDate(2024, time.January, 31)},
+			{2024, time.March, 15, localDate(2024, time.March, 15)},
+			// 月份溢出时按年滚动
+			{2023, time.Month(13), 31, localDate(2024, time.January, 31)},
+			{2024, time.Month(0), 31, localDate(2023, time.December, 31)},
+		}
+		for _, c := range cases {
+			convey.So(anchorDate(c.year, c.month, c.anchorDay), convey.ShouldEqual, c.expect)
+		}
+	})
+
+	convey.Convey("current period start", t, func() {
+		cases := []struct {
+			now       time.Time
+			anchorDay uint32
+			expect    time.Time
+		}{
+			{localDate(2024, time.March, 10), 31, localDate(2024, time.February, 29)},
+			{localDate(2024, time.March, 31).Add(12 * time.Hour), 31, localDate(2024, time.March, 31)},
+			{localDate(2024, time.April, 30).Add(time.Hour), 31, localDate(2024, time.April, 30)},
+			{localDate(2024, time.May, 1), 31, localDate(2024, time.April, 30)},
+			{localDate(2024, time.January, 5), 10, localDate(2023, time.December, 10)},
+			{localDate(2024, time.January, 10), 10, localDate(2024, time.January, 10)},
+		}
+		for _, c := range cases {
+			convey.So(currentPeriodStart(c.now, c.anchorDay), convey.ShouldEqual, c.expect)
+		}
+	})
+
+	convey.Convey("next period start", t, func() {
+		cases := []struct {
+			start       time.Time
+			anchorDay   uint32
+			resetPeriod uint32
+			expect      time.Time
+		}{
+			{localDate(2023, time.December, 31), 31, 1, localDate(2024, time.January, 31)},
+			{localDate(2024, time.January, 31), 31, 1, localDate(2024, time.February, 29)},
+			// 短月之后恢复到重置日, 不会逐月前移
+			{localDate(2024, time.February, 29), 31, 1, localDate(2024, time.March, 31)},
+			{localDate(2024, time.March, 31), 31, 1, localDate(2024, time.April, 30)},
+			{localDate(2023, time.November, 30), 31, 3, localDate(2024, time.February, 29)},
+			{localDate(2023, time.December, 15), 15, 12, localDate(2024, time.December, 15)},
+		}
+		for _, c := range cases {
+			convey.So(nextPeriodStart(c.start, c.anchorDay, c.resetPeriod), convey.ShouldEqual, c.expect)
+		}
+	})
+}
+
+func TestResetTrafficPeriods(t *testing.T) {
+	convey.Convey("reset traffic periods", t, func() {
+		s, err := store.OpenBoltStore(filepath.Join(t.TempDir(), "store.db"))
+		convey.So(err, convey.ShouldBeNil)
+		defer s.Close()
+		um := &UserManager{users: map[string]*proto.User{}, store: NewBoltUserStore(s)}
+		newUser := func(name string, anchorDay uint32, periodStart time.Time) *proto.User {
+			u := &proto.User{
+				Name:             name,
+				ResetPeriod:      1,
+				BillingAnchorDay: anchorDay,
+				PeriodStart:      periodStart.Unix(),
+				UsedUplink:       100,
+				UsedDownlink:     200,
+			}
+			um.users[name] = u
+			return u
+		}
+
+		convey.Convey("not reach next period", func() {
+			u := newUser("u1", 31, localDate(2024, time.January, 31))
+			um.resetTrafficPeriods(localDate(2024, time.February, 28).Add(23 * time.Hour))
+			convey.So(u.PeriodStart, convey.ShouldEqual, localDate(2024, time.January, 31).Unix())
+			convey.So(u.UsedUplink, convey.ShouldEqual, 100)
+		})
+
+		convey.Convey("reset at anchor day across year", func() {
+			u := newUser("u1", 31, localDate(2023, time.December, 31))
+			um.resetTrafficPeriods(localDate(2024, time.January, 31).Add(time.Hour))
+			convey.So(u.PeriodStart, convey.ShouldEqual, localDate(2024, time.January, 31).Unix())
+			convey.So(u.UsedUplink+u.UsedDownlink, convey.ShouldEqual, 0)
+			usages, err := um.GetUserUsageHistory("u1")
+			convey.So(err, convey.ShouldBeNil)
+			convey.So(len(usages), convey.ShouldEqual, 1)
+			convey.So(usages[0].Start, convey.ShouldEqual, localDate(2023, time.December, 31).Unix())
+			convey.So(usages[0].End, convey.ShouldEqual, localDate(2024, time.January, 31).Unix())
+		})
+
+		convey.Convey("node is down across multiple reset boundaries", func() {
+			// 错过2月29日与3月31日两次重置, 合并为一条记录, 新周期从3月31日开始
+			u := newUser("u1", 31, localDate(2024, time.January, 31))
+			um.resetTrafficPeriods(localDate(2024, time.April, 5))
+			convey.So(u.PeriodStart, convey.ShouldEqual, localDate(2024, time.March, 31).Unix())
+			convey.So(u.UsedUplink+u.UsedDownlink, convey.ShouldEqual, 0)
+			usages, err := um.GetUserUsageHistory("u1")
+			convey.So(err, convey.ShouldBeNil)
+			convey.So(len(usages), convey.ShouldEqual, 1)
+			convey.So(usages[0].Start, convey.ShouldEqual, localDate(2024, time.January, 31).Unix())
+			convey.So(usages[0].End, convey.ShouldEqual, localDate(2024, time.March, 31).Unix())
+			convey.So(usages[0].Uplink, convey.ShouldEqual, 100)
+			convey.So(usages[0].Downlink, convey.ShouldEqual, 200)
+
+			// 再次检查时不会重复重置
+			u.UsedUplink = 10
+			um.resetTrafficPeriods(localDate(2024, time.April, 6))
+			convey.So(u.UsedUplink, convey.ShouldEqual, 10)
+		})
+	})
+}
@@ -1,8 +1,8 @@
 package cluster
 
 import (
+	"crypto/sha256"
 	"fmt"
-	"strconv"
 	"strings"
 	"sync"
 	"time"
@@ -10,11 +10,14 @@ import (
 	"github.com/lureiny/v2raymg/common"
 	"github.com/lureiny/v2raymg/common/log/logger"
 	"github.com/lureiny/v2raymg/common/util"
+	"github.com/lureiny/v2raymg/common/webhook"
 	gc "github.com/lureiny/v2raymg/global/config"
 	"github.com/lureiny/v2raymg/proxy/manager"
 	"github.com/lureiny/v2raymg/proxy/sub"
 	"github.com/lureiny/v2raymg/proxy/sub/expand"
 	"github.com/lureiny/v2raymg/server/rpc/proto"
+	"github.com/robfig/cron/v3"
+	pb "google.golang.org/protobuf/proto"
 )
 
 var defaultTags = []string{}
@@ -24,6 +27,22 @@ type UserManager struct {
 	users        map[string]*proto.User // key = name, value = User
 	lock         sync.RWMutex
 	proxyManager *manager.ProxyManager
+	store        UserStore
+	authIndex    userAuthIndex
+	planManager  *PlanManager
+	// 迁移前明文密码的认证记录, 与authIndex一样在lock下访问
+	legacyAuthIndex map[[sha256.Size]byte][]*LegacyCredential
+	authFailLimiter *util.RateLimiter
+	billingCron     *cron.Cron
+
+	// 过期与配额通知, notifier为nil时不通知
+	notifier              *webhook.Notifier
+	notifyExpireBefore    []int64
+	notifyQuotaThresholds []int
+	lastExpireCheck       int64
+
+	// 速度档位, key为档位名称
+	speedTiers map[string]*manager.PolicyLevel
 }
 
 func NewUserManager() *UserManager {
@@ -31,9 +50,13 @@ func NewUserManager() *UserManager {
 	return um
 }
 
-func (um *UserManager) Init(proxyManager *manager.ProxyManager) {
+func (um *UserManager) Init(proxyManager *manager.ProxyManager, store UserStore, planManager *PlanManager) error {
 	um.proxyManager = proxyManager
-	um.LoadUser()
+	um.store = store
+	um.planManager = planManager
+	if err := um.LoadUser(); err != nil {
+		return err
+	}
 	// 不指定tag同时default_tag配置为空, 则获取全部tag的订阅
 	defaultTags = gc.GetStringSlice(common.ConfigProxyDefaultTags)
 
@@ -51,6 +74,7 @@ func (um *UserManager) Init(proxyManager *manager.ProxyManager) {
 				u := &manager.User{
 					Tag:   tag,
 					Email: name,
+					UUID:  user.Uuid,
 				}
 				// 添加到默认tag的inbound中
 				err := um.proxyManager.AddUser(u)
@@ -66,41 +90,110 @@ func (um *UserManager) Init(proxyManager *manager.ProxyManager) {
 				}
 			}
 		}
+		// 重启后重新停用超出配额或暂停的用户
+		if isUserStopped(user) {
+			um.stopUser(&proto.User{Name: name, Tags: user.Tags})
+		}
 	}
+	// 补上停机期间错过的流量重置
+	um.ResetTrafficPeriods()
+	um.startBillingCron()
+	return nil
 }
 
-// 从配置文件中加载用户列表, 对于不存在的用户添加到默认的inbound下
-func (um *UserManager) LoadUser() {
+// 从store中加载用户列表, 第一次启动时会迁移配置文件中的用户
+func (um *UserManager) LoadUser() error {
 	um.users = map[string]*proto.User{}
+	um.authIndex = userAuthIndex{}
+	um.authFailLimiter = util.NewRateLimiter(authFailLimit, authFailWindow)
 	um.lock = sync.RWMutex{}
-	usersLocal := gc.GetStringMapString(common.ConfigUsers)
+	if err := um.migrateUsers(); err != nil {
+		return fmt.Errorf("migrate users fail > %v", err)
+	}
+	users, err := um.store.Load()
+	if err != nil {
+		return fmt.Errorf("load users fail > %v", err)
+	}
 	um.lock.Lock()
 	defer um.lock.Unlock()
 
+	// 用户所在的tag以proxy配置为准
 	userTagMap := um.proxyManager.GetUsersTag()
-	for k, v := range usersLocal {
-		l := strings.Split(v, "|")
-		passwd := ""
-		var expireTime int64 = 0
-		if len(l) != 2 {
-			passwd = v
-		} else {
-			e, err := strconv.ParseInt(l[1], 10, 64)
-			if err != nil {
-				passwd = v
-				expireTime = 0
-			} else {
-				passwd = l[0]
-				expireTime = e
+	migratedUsers := []*proto.User{}
+	legacyCreds := map[string][]*LegacyCredential{}
+	legacyExpireTime := time.Now().Add(legacyCredentialWindow).Unix()
+	for name, user := range users {
+		user.Tags = userTagMap[name]
+		user.OverQuota = IsOverQuota(user)
+		migrated := false
+		// 旧版本保存的明文密码转换为哈希, 并生成订阅token
+		if needMigrateCredential(user) {
+			if err := migrateUserCredential(user, legacyCreds, legacyExpireTime); err != nil {
+				return fmt.Errorf("migrate user[%s] credential fail > %v", name, err)
+			}
+			migrated = true
+		}
+		// 旧版本的用户沿用当前节点上的uuid, 各节点可能不一致, 可以通过/reconcile的repair_uuid参数统一为期望状态中的uuid
+		if user.Uuid == "" {
+			if err := setUserUUID(user, um.proxyManager); err != nil {
+				return fmt.Errorf("migrate user[%s] uuid fail > %v", name, err)
+			}
+			migrated = true
+		}
+		if migrated {
+			migratedUsers = append(migratedUsers, user)
+		}
+		um.users[name] = user
+		um.authIndex.add(name, user.SubToken)
+	}
+	// 先保存明文密码的认证记录, 避免用户保存后明文密码丢失
+	if len(legacyCreds) > 0 {
+		if err := um.store.SaveLegacyCredentials(legacyCreds); err != nil {
+			return fmt.Errorf("save legacy credentials fail > %v", err)
+		}
+	}
+	if len(migratedUsers) > 0 {
+		if err := um.store.Save(migratedUsers...); err != nil {
+			return fmt.Errorf("save migrated users fail > %v", err)
+		}
+		logger.Info("Msg=migrate user credentials|UserNum=%d", len(migratedUsers))
+	}
+	if err := um.loadLegacyCredentials(); err != nil {
+		return fmt.Errorf("load legacy credentials fail > %v", err)
+	}
+	return nil
+}
+
+// 将配置文件users中的用户迁移到store, 迁移完成后清空配置文件中的用户
+func (um *UserManager) migrateUsers() error {
+	if um.store.IsMigrated() {
+		return nil
+	}
+	usersLocal := gc.GetStringMapString(common.ConfigUsers)
+	if len(usersLocal) > 0 {
+		users := []*proto.User{}
+		legacyCreds := map[string][]*LegacyCredential{}
+		legacyExpireTime := time.Now().Add(legacyCredentialWindow).Unix()
+		for _, user := range parseLegacyUsers(usersLocal) {
+			user.CreateTime = time.Now().Unix()
+			if err := migrateUserCredential(user, legacyCreds, legacyExpireTime); err != nil {
+				return fmt.Errorf("migrate user[%s] credential fail > %v", user.Name, err)
 			}
+			users = append(users, user)
 		}
-		um.users[k] = &proto.User{
-			Name:       k,
-			Passwd:     passwd,
-			ExpireTime: expireTime,
-			Tags:       userTagMap[k],
+		if err := um.store.SaveLegacyCredentials(legacyCreds); err != nil {
+			return fmt.Errorf("save legacy credentials fail > %v", err)
 		}
+		if err := um.store.Save(users...); err != nil {
+			return err
+		}
+		logger.Info("Msg=migrate users from config to store|UserNum=%d", len(users))
+	}
+	if err := um.store.SetMigrated(); err != nil {
+		return err
 	}
+	gc.Set(common.ConfigUsers, map[string]string{})
+	return nil
 }
 
 func proxyUserOp(user *proto.User, opType string, proxyManager *manager.ProxyManager) (succTags, faileTags []string, err error) {
@@ -111,7 +204,7 @@ func proxyUserOp(user *proto.User, opType string, proxyManager *manager.ProxyMan
 	}
 
 	for _, tag := range user.Tags {
-		bUser, err := manager.NewUser(user.Name, tag)
+		bUser, err := manager.NewUser(user.Name, tag, manager.Level(user.Level), manager.UUID(user.Uuid))
 		if err != nil {
 			logger.Error(
 				"Err=%v|User=%s|Tag=%s",
@@ -129,6 +222,12 @@ func proxyUserOp(user *proto.User, opType string, proxyManager *manager.ProxyMan
 			err = proxyManager.RemoveUser(bUser)
 		case "reset":
 			err = proxyManager.ResetUser(bUser)
+		case "disable":
+			err = proxyManager.DisableUser(bUser)
+		case "enable":
+			err = proxyManager.EnableUser(bUser)
+		case "level":
+			err = proxyManager.SetUserLevel(bUser)
 		default:
 			err = fmt.Errorf("unsupport proxy user op[%s]", opType)
 		}
@@ -166,11 +265,38 @@ func checkUserTag(user *proto.User, proxyManager *manager.ProxyManager) {
 func (um *UserManager) Add(user *proto.User) error {
 	if user.Name == "" {
 		return fmt.Errorf("Empty user")
-	} else if !um.HaveUser(user) && user.Passwd == "" {
+	} else if !um.HaveUser(user) && user.Passwd == "" && user.PasswdHash == "" {
 		// 第一次添加
 		return fmt.Errorf("Empty passwd")
 	}
 
+	um.lock.RLock()
+	localUser, exist := um.users[user.Name]
+	if exist {
+		// 已存在的用户在新的tag下沿用原有的level, 速度档位与uuid
+		user.Level = localUser.Level
+		user.SpeedTier = localUser.SpeedTier
+		user.Uuid = localUser.Uuid
+	}
+	um.lock.RUnlock()
+	if !exist && user.Plan != "" {
+		if err := um.applyPlan(user); err != nil {
+			return err
+		}
+	}
+	if !exist {
+		// 速度档位优先于套餐中的level
+		if err := um.applySpeedTier(user); err != nil {
+			return err
+		}
+		if err := setUserCredential(user); err != nil {
+			return err
+		}
+		if err := setUserUUID(user, um.proxyManager); err != nil {
+			return err
+		}
+	}
+
 	checkUserTag(user, um.proxyManager)
 	// 先尝试添加到proxy
 	succTags, _, err := proxyUserOp(user, "add", um.proxyManager)
@@ -178,14 +304,27 @@ func (um *UserManager) Add(user *proto.User) error {
 		return err
 	}
 	// 添加到配置文件中
+	stopped := false
 	um.lock.Lock()
 	if _, ok := (um.users)[user.Name]; !ok {
 		user.Tags = succTags
+		// 流量统计按节点计算, 新用户从0开始
+		user.UsedUplink, user.UsedDownlink, user.RemainingQuota = 0, 0, 0
+		user.OverQuota, user.Suspended = false, false
+		user.CreateTime = time.Now().Unix()
+		user.PeriodStart = 0
+		initUserBilling(user)
 		um.users[user.Name] = user
+		um.authIndex.add(user.Name, user.SubToken)
 	} else {
 		um.users[user.Name].Tags = append(um.users[user.Name].Tags, succTags...)
+		stopped = isUserStopped(um.users[user.Name])
 	}
 	um.lock.Unlock()
+	// 超出配额或暂停的用户在新的tag下同样需要停用
+	if stopped {
+		um.stopUser(&proto.User{Name: user.Name, Tags: succTags})
+	}
 	um.FlushUser()
 
 	return err
@@ -198,6 +337,7 @@ func (um *UserManager) Delete(user *proto.User) error {
 	}
 	um.lock.RLock()
 	if localUser, ok := um.users[user.Name]; !ok {
+		um.lock.RUnlock()
 		return fmt.Errorf("user[%s] is not exist", user.Name)
 	} else if len(user.Tags) == 0 {
 		user.Tags = localUser.Tags
@@ -209,6 +349,7 @@ func (um *UserManager) Delete(user *proto.User) error {
 	}
 
 	// 从配置文件中删除
+	kick := false
 	um.lock.Lock()
 	if u, ok := (um.users)[user.Name]; ok {
 		// 删除对应tag
@@ -227,43 +368,208 @@ func (um *UserManager) Delete(user *proto.User) error {
 		// 通过设置expire time让程序自动清除
 		if len(u.Tags) == 0 {
 			u.ExpireTime = 1
+			kick = true
 		}
 	} else {
 		logger.Warn("user[%s] is not exist", user.Name)
 	}
 	um.lock.Unlock()
+	if kick {
+		um.kickUsers(user.Name)
+	}
 	um.FlushUser()
 
 	return err
 }
 
-// 更新用户passwd
-func (um *UserManager) Update(user *proto.User) error {
-	if user.Passwd == "" {
+// 更新用户信息, 只修改mask中指定的字段, mask为空时全量更新passwd, expire time与quota
+func (um *UserManager) Update(user *proto.User, mask *proto.UserUpdateMask) error {
+	if mask == nil {
+		if user.Passwd == "" {
+			return fmt.Errorf("empty passwd")
+		}
+		mask = &proto.UserUpdateMask{Passwd: true, ExpireTime: true, Quota: true}
+	}
+	if mask.Passwd && user.Passwd == "" {
 		return fmt.Errorf("empty passwd")
 	}
+	// 哈希计算较慢, 在加锁前完成
+	passwdHash := ""
+	if mask.Passwd {
+		hash, err := hashPasswd(user.Passwd)
+		if err != nil {
+			return err
+		}
+		passwdHash = hash
+	}
+	subToken := user.GetSubToken()
+	if mask.SubToken && subToken == "" {
+		token, err := NewSubToken()
+		if err != nil {
+			return err
+		}
+		subToken = token
+	}
+	// 档位不存在时不做任何修改
+	if mask.SpeedTier {
+		if err := um.applySpeedTier(&proto.User{SpeedTier: user.GetSpeedTier()}); err != nil {
+			return err
+		}
+	}
 	var err error = nil
+	var stopUser, startUser *proto.User = nil, nil
 	// 只更新存在的用户
 	um.lock.Lock()
 	if u, ok := (um.users)[user.Name]; ok {
-		// TODO: 需要细化变更类型, 否则每次都需要传递passwd
-		u.ExpireTime = user.GetExpireTime()
-		u.Passwd = user.GetPasswd()
+		if mask.ExpireTime {
+			u.ExpireTime = user.GetExpireTime()
+		}
+		// 不过期的用户无需延长
+		if mask.ExtendExpire > 0 && u.ExpireTime > 0 {
+			now := time.Now().Unix()
+			if u.ExpireTime < now {
+				u.ExpireTime = now
+			}
+			u.ExpireTime += mask.ExtendExpire
+		}
+		if mask.Passwd {
+			u.PasswdHash = passwdHash
+		}
+		// 重置订阅token后旧的订阅链接与hysteria认证失效
+		if mask.SubToken {
+			um.authIndex.remove(u.Name, u.SubToken)
+			u.SubToken = subToken
+			um.authIndex.add(u.Name, u.SubToken)
+		}
+		if mask.Quota {
+			updateQuota(&u.Quota, user.GetQuota())
+			updateQuota(&u.UplinkQuota, user.GetUplinkQuota())
+			updateQuota(&u.DownlinkQuota, user.GetDownlinkQuota())
+		}
+		// 修改重置日或周期后重新计算当前周期的开始时间, 已使用的流量保留
+		if mask.Billing {
+			u.ResetPeriod = user.GetResetPeriod()
+			u.BillingAnchorDay = user.GetBillingAnchorDay()
+			u.PeriodStart = 0
+			initUserBilling(u)
+		}
+		// 配额变更后重新判断用户是否需要停用或恢复, 暂停的用户保持停用, 恢复时再判断配额
+		if overQuota := IsOverQuota(u); overQuota != u.OverQuota {
+			u.OverQuota = overQuota
+			if overQuota && !u.Suspended {
+				stopUser = &proto.User{Name: u.Name, Tags: u.Tags}
+			} else if !u.Suspended {
+				startUser = &proto.User{Name: u.Name, Tags: u.Tags, Level: u.Level}
+			}
+		}
 	} else {
 		err = fmt.Errorf("user[%s] is not exist", user.Name)
 	}
 	um.lock.Unlock()
+	if err != nil {
+		return err
+	}
+	if stopUser != nil {
+		um.stopUser(stopUser)
+	}
+	if startUser != nil {
+		um.startUser(startUser)
+	}
+	if mask.SpeedTier {
+		if err := um.setUserSpeedTier(user.Name, user.GetSpeedTier()); err != nil {
+			um.FlushUser()
+			return fmt.Errorf("set speed tier fail > %v", err)
+		}
+	}
 	um.FlushUser()
-	return err
+
+	// tag的变更复用Add与Delete, 删除全部tag的用户会被清除
+	if len(mask.AddTags) > 0 {
+		if err := um.Add(&proto.User{Name: user.Name, Tags: mask.AddTags}); err != nil {
+			return fmt.Errorf("add tags fail > %v", err)
+		}
+	}
+	if len(mask.RemoveTags) > 0 {
+		if err := um.Delete(&proto.User{Name: user.Name, Tags: mask.RemoveTags}); err != nil {
+			return fmt.Errorf("remove tags fail > %v", err)
+		}
+	}
+	return nil
 }
 
-// 重置用户proxy的uuid
+// 重置用户proxy的uuid, 使用请求中的uuid以保证各节点重置后一致, 请求中没有时随机生成
 func (um *UserManager) Reset(user *proto.User) error {
-	checkUserTag(user, um.proxyManager)
-	_, _, err := proxyUserOp(user, "reset", um.proxyManager)
+	id := user.Uuid
+	if id == "" {
+		id = NewUserUUID()
+	} else if err := checkUserUUID(id); err != nil {
+		return err
+	}
+	um.lock.Lock()
+	u, ok := um.users[user.Name]
+	if !ok {
+		um.lock.Unlock()
+		return fmt.Errorf("user[%s] is not exist", user.Name)
+	}
+	u.Uuid = id
+	// 全部tag使用同一个uuid, 因此总是重置用户所在的全部tag
+	resetUser := &proto.User{Name: u.Name, Tags: append([]string{}, u.Tags...), Level: u.Level, Uuid: id}
+	um.lock.Unlock()
+	if len(resetUser.Tags) == 0 {
+		um.FlushUser()
+		return nil
+	}
+	_, _, err := proxyUserOp(resetUser, "reset", um.proxyManager)
+	um.FlushUser()
 	return err
 }
 
+// 暂停用户, 从proxy runtime中移除, 保留用户信息与tag
+func (um *UserManager) Suspend(user *proto.User) error {
+	var stopUser *proto.User = nil
+	um.lock.Lock()
+	u, ok := um.users[user.Name]
+	if !ok {
+		um.lock.Unlock()
+		return fmt.Errorf("user[%s] is not exist", user.Name)
+	}
+	if !u.Suspended {
+		if !u.OverQuota {
+			stopUser = &proto.User{Name: u.Name, Tags: u.Tags}
+		}
+		u.Suspended = true
+	}
+	um.lock.Unlock()
+	if stopUser != nil {
+		um.stopUser(stopUser)
+	}
+	um.FlushUser()
+	return nil
+}
+
+// 恢复暂停的用户, 沿用暂停前的tag与uuid/password, 超出配额的用户仍保持停用
+func (um *UserManager) Resume(user *proto.User) error {
+	var startUser *proto.User = nil
+	um.lock.Lock()
+	u, ok := um.users[user.Name]
+	if !ok {
+		um.lock.Unlock()
+		return fmt.Errorf("user[%s] is not exist", user.Name)
+	}
+	if u.Suspended {
+		if !u.OverQuota {
+			startUser = &proto.User{Name: u.Name, Tags: u.Tags, Level: u.Level}
+		}
+		u.Suspended = false
+	}
+	um.lock.Unlock()
+	if startUser != nil {
+		um.startUser(startUser)
+	}
+	um.FlushUser()
+	return nil
+}
+
 func (um *UserManager) HaveUser(user *proto.User) bool {
 	_, ok := um.users[user.Name]
 	return ok
@@ -280,6 +586,8 @@ func (um *UserManager) ListUsers() []string {
 // 清除无效用户, 全局级, 不区分tag, proxy manager中支持tag级的user管理
 // 过期和无有效tag均为无效用户
 func (um *UserManager) ClearInvalideUser() {
+	// 清除前先发送过期通知
+	um.checkExpireNotify()
 	currentTime := time.Now().Unix()
 	expireUser := []*proto.User{}
 	um.lock.RLock()
@@ -290,6 +598,8 @@ func (um *UserManager) ClearInvalideUser() {
 	}
 	um.lock.RUnlock()
 
+	names := []string{}
+	events := []*webhook.Event{}
 	um.lock.Lock()
 	for _, user := range expireUser {
 		if len(user.Tags) > 0 {
@@ -301,10 +611,22 @@ func (um *UserManager) ClearInvalideUser() {
 		}
 		// 强制删除, 不论proxy中是否删除成功
 		delete(um.users, user.Name)
-		logger.Info("clear invalide user: %v", user)
+		um.authIndex.remove(user.Name, user.SubToken)
+		names = append(names, user.Name)
+		if um.notifier != nil {
+			events = append(events, clearedEvent(user))
+		}
+		logger.Info("Msg=clear invalide user|User=%s|Tags=%v|ExpireTime=%d", user.Name, user.Tags, user.ExpireTime)
 	}
 	um.lock.Unlock()
-	um.FlushUser()
+	um.notify(events)
+	// 过期的用户可能仍有hysteria连接
+	um.kickUsers(names...)
+	if len(names) > 0 {
+		if err := um.store.Delete(names...); err != nil {
+			logger.Error("Err=delete users from store fail > %v|Users=%v", err, names)
+		}
+	}
 }
 
 func (um *UserManager) Get(userName string) *proto.User {
@@ -314,23 +636,55 @@ func (um *UserManager) Get(userName string) *proto.User {
 	return nil
 }
 
+// GetUserList 返回用户的副本, 调用方可以修改返回的用户而不影响本地保存的用户
 func (um *UserManager) GetUserList() map[string]*proto.User {
 	um.lock.RLock()
 	defer um.lock.RUnlock()
-	return um.users
+	users := make(map[string]*proto.User, len(um.users))
+	for name, u := range um.users {
+		users[name] = pb.Clone(u).(*proto.User)
+	}
+	return users
 }
 
-// flush user to config file
-// flush不是实时的, 最多有一秒的延迟
+// Count 用户数量
+func (um *UserManager) Count() int {
+	um.lock.RLock()
+	defer um.lock.RUnlock()
+	return len(um.users)
+}
+
+// flush user to store
 func (um *UserManager) FlushUser() {
-	userMap := map[string]string{}
+	users := []*proto.User{}
 	um.lock.RLock()
-	for k, v := range um.users {
-		userMap[k] = fmt.Sprintf("%s|%d", v.Passwd, v.ExpireTime)
+	for _, v := range um.users {
+		users = append(users, pb.Clone(v).(*proto.User))
 	}
 	um.lock.RUnlock()
 
-	gc.Set("users", userMap)
+	if err := um.store.Save(users...); err != nil {
+		logger.Error("Err=flush users to store fail > %v", err)
+	}
+}
+
+// 仅保存指定的用户, 用于流量统计等频繁更新少量用户的场景
+func (um *UserManager) flushUsers(names ...string) {
+	if len(names) == 0 {
+		return
+	}
+	users := []*proto.User{}
+	um.lock.RLock()
+	for _, name := range names {
+		if u, ok := um.users[name]; ok {
+			users = append(users, pb.Clone(u).(*proto.User))
+		}
+	}
+	um.lock.RUnlock()
+
+	if err := um.store.Save(users...); err != nil {
+		logger.Error("Err=flush users to store fail > %v|Users=%v", err, names)
+	}
 }
 
 // 获取用户订阅信息
@@ -339,16 +693,19 @@ func (um *UserManager) GetUserSub(user *proto.User, excludeProtocols *util.Strin
 		return nil, fmt.Errorf("user[%s] is not exist", user.Name)
 	}
 	localUser := um.users[user.Name]
-	if localUser.ExpireTime < time.Now().Unix() && localUser.ExpireTime > 0 {
-		return nil, fmt.Errorf("expired user[%s], expired time is %d", user.Name, localUser.ExpireTime)
+	if !checkSubCredential(localUser, user) {
+		return nil, fmt.Errorf("wrong passwd or sub token")
 	}
-	if localUser.Passwd != user.Passwd {
-		return nil, fmt.Errorf("wrong passwd")
+	if err := checkUserAvailable(localUser); err != nil {
+		return nil, err
 	}
 
 	if len(user.Tags) == 0 {
 		user.Tags = localUser.Tags
 	}
+	// hysteria订阅中使用sub token作为认证凭证
+	user.SubToken = localUser.SubToken
+	user.SpeedTier = localUser.SpeedTier
 	return getUserSubUri(user, excludeProtocols, useSNI, um)
 }
 
@@ -380,8 +737,8 @@ func getUserSubUri(user *proto.User, excludeProtocols *util.StringList, useSNI b
 	}
 
 	// get hysteria sub
-	if hysteriaUri := um.proxyManager.GetUserSub(user.Name, user.Passwd, globalLocalNode.Name); !excludeProtocols.Contains(getSubUriHead(hysteriaUri)) {
-		uris = append(uris, hysteriaUri)
+	if hysteriaUri := um.proxyManager.GetUserSub(user.Name, user.SubToken, globalLocalNode.Name); !excludeProtocols.Contains(getSubUriHead(hysteriaUri)) {
+		uris = append(uris, um.withHysteriaBandwidth(hysteriaUri, user.SpeedTier))
 	}
 
 	// get thrid sub
@@ -403,7 +760,7 @@ func getUserSubUri(user *proto.User, excludeProtocols *util.StringList, useSNI b
 
 func IsUserComplete(user *proto.User, checkPasswd bool) bool {
 	if checkPasswd {
-		return user.GetName() != "" && user.GetPasswd() != ""
+		return user.GetName() != "" && (user.GetPasswd() != "" || user.GetSubToken() != "")
 	} else {
 		return user.GetName() != ""
 	}
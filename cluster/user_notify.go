@@ -0,0 +1,137 @@
+package cluster
+
+import (
+	"time"
+
+	"github.com/lureiny/v2raymg/common/webhook"
+	"github.com/lureiny/v2raymg/server/rpc/proto"
+)
+
+// 用户被清除的原因
+const (
+	clearReasonExpired = "expired"
+	clearReasonDeleted = "deleted"
+)
+
+// SetNotifier 设置过期与配额通知, expireBefore为过期前的通知时长(秒), quotaThresholds为配额使用百分比
+func (um *UserManager) SetNotifier(notifier *webhook.Notifier, expireBefore []int64, quotaThresholds []int) {
+	um.notifier = notifier
+	um.notifyExpireBefore = expireBefore
+	um.notifyQuotaThresholds = quotaThresholds
+	um.lastExpireCheck = time.Now().Unix()
+}
+
+func newEventUser(user *proto.User) *webhook.EventUser {
+	return &webhook.EventUser{
+		Name:          user.Name,
+		Tags:          append([]string{}, user.Tags...),
+		ExpireTime:    user.ExpireTime,
+		Quota:         user.Quota,
+		UplinkQuota:   user.UplinkQuota,
+		DownlinkQuota: user.DownlinkQuota,
+		UsedUplink:    user.UsedUplink,
+		UsedDownlink:  user.UsedDownlink,
+	}
+}
+
+func (um *UserManager) notify(events []*webhook.Event) {
+	for _, event := range events {
+		um.notifier.Notify(event)
+	}
+}
+
+// 配额使用百分比, 取各项配额中使用比例最高的一项, 没有配额时为0
+func quotaUsagePercent(user *proto.User) float64 {
+	percent := 0.0
+	check := func(quota, used int64) {
+		if quota <= 0 {
+			return
+		}
+		if p := float64(used) * 100 / float64(quota); p > percent {
+			percent = p
+		}
+	}
+	check(user.Quota, user.UsedUplink+user.UsedDownlink)
+	check(user.UplinkQuota, user.UsedUplink)
+	check(user.DownlinkQuota, user.UsedDownlink)
+	return percent
+}
+
+// 流量累加前后跨过的配额阈值, 只在跨过时通知一次, 流量重置后重新计算
+// 各节点都会检查, 事件id由用户, 统计周期, 配额与阈值派生, 接收方据此去重
+func (um *UserManager) quotaThresholdEvents(user *proto.User, before float64) []*webhook.Event {
+	if um.notifier == nil {
+		return nil
+	}
+	events := []*webhook.Event{}
+	after := quotaUsagePercent(user)
+	for _, threshold := range um.notifyQuotaThresholds {
+		if before < float64(threshold) && after >= float64(threshold) {
+			events = append(events, &webhook.Event{
+				ID: webhook.NewEventID(
+					webhook.EventUserQuotaThreshold,
+					user.Name,
+					user.PeriodStart,
+					user.Quota,
+					user.UplinkQuota,
+					user.DownlinkQuota,
+					threshold,
+				),
+				Type:      webhook.EventUserQuotaThreshold,
+				User:      newEventUser(user),
+				Threshold: threshold,
+			})
+		}
+	}
+	return events
+}
+
+// 检查上次检查以来到达提前通知时间或过期时间的用户, 重启后从启动时间开始检查
+// 各节点都会检查, 事件id由用户与过期时间派生, 接收方据此去重
+func (um *UserManager) checkExpireNotify() {
+	if um.notifier == nil {
+		return
+	}
+	now := time.Now().Unix()
+	events := []*webhook.Event{}
+	um.lock.Lock()
+	last := um.lastExpireCheck
+	um.lastExpireCheck = now
+	for _, u := range um.users {
+		if u.ExpireTime <= 0 {
+			continue
+		}
+		for _, before := range um.notifyExpireBefore {
+			if t := u.ExpireTime - before; last < t && t <= now {
+				events = append(events, &webhook.Event{
+					ID:     webhook.NewEventID(webhook.EventUserExpiring, u.Name, u.ExpireTime, before),
+					Type:   webhook.EventUserExpiring,
+					User:   newEventUser(u),
+					Before: before,
+				})
+			}
+		}
+		if last < u.ExpireTime && u.ExpireTime <= now {
+			events = append(events, &webhook.Event{
+				ID:   webhook.NewEventID(webhook.EventUserExpired, u.Name, u.ExpireTime),
+				Type: webhook.EventUserExpired,
+				User: newEventUser(u),
+			})
+		}
+	}
+	um.lock.Unlock()
+	um.notify(events)
+}
+
+// 删除全部tag的用户通过将过期时间设置为1清除
+func clearedEvent(user *proto.User) *webhook.Event {
+	reason := clearReasonExpired
+	if user.ExpireTime == 1 {
+		reason = clearReasonDeleted
+	}
+	return &webhook.Event{
+		Type:   webhook.EventUserCleared,
+		User:   newEventUser(user),
+		Reason: reason,
+	}
+}
@@ -0,0 +1,89 @@
+package cluster
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/lureiny/v2raymg/common/webhook"
+	"github.com/lureiny/v2raymg/server/rpc/proto"
+	"github.com/smartystreets/goconvey/convey"
+)
+
+// 模拟webhook接收方, 记录收到的事件
+func newTestWebhookReceiver() (*httptest.Server, chan *webhook.Event) {
+	received := make(chan *webhook.Event, 10)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		event := &webhook.Event{}
+		if err := json.Unmarshal(body, event); err == nil {
+			received <- event
+		}
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	return server, received
+}
+
+func newTestNotifyUserManager(node string, endpoint string, users map[string]*proto.User) *UserManager {
+	um := &UserManager{users: users, authIndex: userAuthIndex{}}
+	notifier := webhook.NewNotifier(node, []*webhook.Endpoint{{URL: endpoint}}, 0, time.Millisecond)
+	um.SetNotifier(notifier, []int64{3600}, []int{80})
+	return um
+}
+
+func TestUserNotify(t *testing.T) {
+	convey.Convey("quota threshold event id is the same on every node", t, func() {
+		user := &proto.User{Name: "user1", Quota: 100, UsedUplink: 85, PeriodStart: 1700000000}
+		um1 := newTestNotifyUserManager("node1", "", map[string]*proto.User{})
+		um2 := newTestNotifyUserManager("node2", "", map[string]*proto.User{})
+		events1 := um1.quotaThresholdEvents(user, 50)
+		events2 := um2.quotaThresholdEvents(user, 60)
+		convey.So(len(events1), convey.ShouldEqual, 1)
+		convey.So(len(events2), convey.ShouldEqual, 1)
+		convey.So(events1[0].ID, convey.ShouldNotBeEmpty)
+		convey.So(events1[0].ID, convey.ShouldEqual, events2[0].ID)
+
+		// 没有跨过阈值时不通知
+		convey.So(um1.quotaThresholdEvents(user, 81), convey.ShouldBeEmpty)
+
+		// 新的统计周期或者配额变化后是不同的事件
+		nextPeriod := &proto.User{Name: "user1", Quota: 100, UsedUplink: 85, PeriodStart: 1702592000}
+		convey.So(um1.quotaThresholdEvents(nextPeriod, 0)[0].ID, convey.ShouldNotEqual, events1[0].ID)
+		newQuota := &proto.User{Name: "user1", Quota: 105, UsedUplink: 85, PeriodStart: 1700000000}
+		convey.So(um1.quotaThresholdEvents(newQuota, 0)[0].ID, convey.ShouldNotEqual, events1[0].ID)
+	})
+
+	convey.Convey("expire event id is the same on every node", t, func() {
+		server, received := newTestWebhookReceiver()
+		defer server.Close()
+		now := time.Now().Unix()
+		ids := map[string][]string{}
+		for _, node := range []string{"node1", "node2"} {
+			users := map[string]*proto.User{
+				"expiring": {Name: "expiring", ExpireTime: now + 3600 - 30},
+				"expired":  {Name: "expired", ExpireTime: now - 10},
+				"other":    {Name: "other", ExpireTime: now + 7200},
+			}
+			um := newTestNotifyUserManager(node, server.URL, users)
+			um.lastExpireCheck = now - 60
+			um.checkExpireNotify()
+			for i := 0; i < 2; i++ {
+				select {
+				case e := <-received:
+					convey.So(e.Node, convey.ShouldEqual, node)
+					ids[e.Type] = append(ids[e.Type], e.ID)
+				case <-time.After(2 * time.Second):
+					t.Fatal("wait webhook event timeout")
+				}
+			}
+		}
+		convey.So(len(ids[webhook.EventUserExpiring]), convey.ShouldEqual, 2)
+		convey.So(ids[webhook.EventUserExpiring][0], convey.ShouldEqual, ids[webhook.EventUserExpiring][1])
+		convey.So(len(ids[webhook.EventUserExpired]), convey.ShouldEqual, 2)
+		convey.So(ids[webhook.EventUserExpired][0], convey.ShouldEqual, ids[webhook.EventUserExpired][1])
+		convey.So(ids[webhook.EventUserExpired][0], convey.ShouldNotEqual, ids[webhook.EventUserExpiring][0])
+	})
+}
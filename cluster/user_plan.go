@@ -0,0 +1,123 @@
+package cluster
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/lureiny/v2raymg/common/util"
+	"github.com/lureiny/v2raymg/server/rpc/proto"
+)
+
+// 按照套餐填充新用户的tag, 过期时间, 配额与level, 用户中已经指定的字段优先
+func (um *UserManager) applyPlan(user *proto.User) error {
+	plan := um.planManager.Get(user.Plan)
+	if plan == nil {
+		return fmt.Errorf("plan[%s] is not exist", user.Plan)
+	}
+	if len(user.Tags) == 0 {
+		user.Tags = plan.Tags
+	}
+	if user.ExpireTime == 0 && plan.Duration > 0 {
+		user.ExpireTime = time.Now().Unix() + plan.Duration
+	}
+	if user.Quota == 0 {
+		user.Quota = plan.Quota
+	}
+	if user.UplinkQuota == 0 {
+		user.UplinkQuota = plan.UplinkQuota
+	}
+	if user.DownlinkQuota == 0 {
+		user.DownlinkQuota = plan.DownlinkQuota
+	}
+	if user.Level == 0 {
+		user.Level = plan.Level
+	}
+	if user.ResetPeriod == 0 {
+		user.ResetPeriod = plan.ResetPeriod
+		user.BillingAnchorDay = plan.BillingAnchorDay
+	}
+	return nil
+}
+
+// 套餐中配额为0表示不限制, 更新时需要转换为取消限制
+func toUpdateQuota(quota int64) int64 {
+	if quota == 0 {
+		return -1
+	}
+	return quota
+}
+
+// ReapplyPlan 将修改后的套餐重新应用到使用该套餐的用户, 更新tag, 配额, level与流量重置周期, 不修改过期时间
+func (um *UserManager) ReapplyPlan(name string) error {
+	plan := um.planManager.Get(name)
+	if plan == nil {
+		return fmt.Errorf("plan[%s] is not exist", name)
+	}
+	names := []string{}
+	um.lock.RLock()
+	for _, u := range um.users {
+		if u.Plan == name {
+			names = append(names, u.Name)
+		}
+	}
+	um.lock.RUnlock()
+
+	errMsgs := []string{}
+	for _, n := range names {
+		if err := um.reapplyPlanToUser(n, plan); err != nil {
+			errMsgs = append(errMsgs, fmt.Sprintf("%s: %v", n, err))
+		}
+	}
+	if len(errMsgs) > 0 {
+		return fmt.Errorf("reapply plan[%s] fail > %s", name, strings.Join(errMsgs, "; "))
+	}
+	return nil
+}
+
+func (um *UserManager) reapplyPlanToUser(name string, plan *proto.Plan) error {
+	user := &proto.User{
+		Name:             name,
+		Quota:            toUpdateQuota(plan.Quota),
+		UplinkQuota:      toUpdateQuota(plan.UplinkQuota),
+		DownlinkQuota:    toUpdateQuota(plan.DownlinkQuota),
+		ResetPeriod:      plan.ResetPeriod,
+		BillingAnchorDay: plan.BillingAnchorDay,
+	}
+	mask := &proto.UserUpdateMask{Quota: true, Billing: true}
+	var levelUser *proto.User = nil
+
+	um.lock.Lock()
+	u, ok := um.users[name]
+	if !ok {
+		um.lock.Unlock()
+		return nil
+	}
+	// 套餐未指定tag时保持用户当前的tag
+	if len(plan.Tags) > 0 {
+		userTags, planTags := util.StringList(u.Tags), util.StringList(plan.Tags)
+		for _, tag := range plan.Tags {
+			if !userTags.Contains(tag) {
+				mask.AddTags = append(mask.AddTags, tag)
+			}
+		}
+		for _, tag := range u.Tags {
+			if !planTags.Contains(tag) {
+				mask.RemoveTags = append(mask.RemoveTags, tag)
+			}
+		}
+	}
+	if u.Level != plan.Level {
+		u.Level = plan.Level
+		levelUser = &proto.User{Name: u.Name, Tags: append([]string{}, u.Tags...), Level: u.Level}
+	}
+	um.lock.Unlock()
+
+	if levelUser != nil && len(levelUser.Tags) > 0 {
+		if _, _, err := proxyUserOp(levelUser, "level", um.proxyManager); err != nil {
+			return fmt.Errorf("set level fail > %v", err)
+		}
+	}
+	// 先添加新的tag再删除多余的tag, 避免用户因为没有tag而被清除
+	return um.Update(user, mask)
+}
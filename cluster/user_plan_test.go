@@ -0,0 +1,87 @@
+package cluster
+
+import (
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/lureiny/v2raymg/common/store"
+	"github.com/lureiny/v2raymg/server/rpc/proto"
+	"github.com/smartystreets/goconvey/convey"
+)
+
+func TestUserPlan(t *testing.T) {
+	convey.Convey("user plan", t, func() {
+		s, err := store.OpenBoltStore(filepath.Join(t.TempDir(), "store.db"))
+		convey.So(err, convey.ShouldBeNil)
+		defer s.Close()
+		pm := NewPlanManager()
+		pm.store = s
+		um := &UserManager{
+			users:       map[string]*proto.User{},
+			authIndex:   userAuthIndex{},
+			store:       NewBoltUserStore(s),
+			planManager: pm,
+		}
+		convey.So(pm.Set(&proto.Plan{
+			Name:             "basic",
+			Tags:             []string{"vless"},
+			Duration:         3600,
+			Quota:            1000,
+			Level:            1,
+			ResetPeriod:      1,
+			BillingAnchorDay: 15,
+			NodeSelector:     "region=jp",
+		}), convey.ShouldBeNil)
+
+		convey.Convey("check plan", func() {
+			convey.So(pm.Set(&proto.Plan{Name: "bad", Quota: -1}), convey.ShouldNotBeNil)
+			convey.So(pm.Set(&proto.Plan{Name: "bad", BillingAnchorDay: 32}), convey.ShouldNotBeNil)
+			convey.So(pm.Set(&proto.Plan{Name: "bad", NodeSelector: "region=jp,=x"}), convey.ShouldNotBeNil)
+			convey.So(pm.Get("bad"), convey.ShouldBeNil)
+			convey.So(pm.Get("basic").GetNodeSelector(), convey.ShouldEqual, "region=jp")
+			convey.So(pm.Delete("basic"), convey.ShouldBeNil)
+			convey.So(len(pm.List()), convey.ShouldEqual, 0)
+		})
+
+		convey.Convey("apply plan to new user", func() {
+			u := &proto.User{Name: "u1", Plan: "basic"}
+			convey.So(um.applyPlan(u), convey.ShouldBeNil)
+			convey.So(u.Tags, convey.ShouldResemble, []string{"vless"})
+			convey.So(u.ExpireTime, convey.ShouldBeGreaterThan, time.Now().Unix())
+			convey.So(u.Quota, convey.ShouldEqual, 1000)
+			convey.So(u.Level, convey.ShouldEqual, 1)
+			convey.So(u.ResetPeriod, convey.ShouldEqual, 1)
+			convey.So(u.BillingAnchorDay, convey.ShouldEqual, 15)
+
+			// 用户中已经指定的字段优先
+			u = &proto.User{Name: "u2", Plan: "basic", Tags: []string{"vmess"}, ExpireTime: 100, Quota: 50}
+			convey.So(um.applyPlan(u), convey.ShouldBeNil)
+			convey.So(u.Tags, convey.ShouldResemble, []string{"vmess"})
+			convey.So(u.ExpireTime, convey.ShouldEqual, 100)
+			convey.So(u.Quota, convey.ShouldEqual, 50)
+
+			convey.So(um.applyPlan(&proto.User{Name: "u3", Plan: "not-exist"}), convey.ShouldNotBeNil)
+		})
+
+		convey.Convey("reapply plan", func() {
+			um.users["u1"] = &proto.User{Name: "u1", Plan: "basic", Quota: 1000, Level: 1, ExpireTime: 100, UsedUplink: 10}
+			um.users["u2"] = &proto.User{Name: "u2", Plan: "other", Quota: 1000, Level: 1}
+			// 套餐中配额为0表示不限制
+			convey.So(pm.Set(&proto.Plan{Name: "basic", Quota: 0, UplinkQuota: 500, Level: 1, ResetPeriod: 1, BillingAnchorDay: 20}), convey.ShouldBeNil)
+			convey.So(um.ReapplyPlan("basic"), convey.ShouldBeNil)
+			u1 := um.users["u1"]
+			convey.So(u1.Quota, convey.ShouldEqual, 0)
+			convey.So(u1.UplinkQuota, convey.ShouldEqual, 500)
+			convey.So(u1.ResetPeriod, convey.ShouldEqual, 1)
+			convey.So(u1.BillingAnchorDay, convey.ShouldEqual, 20)
+			// 不修改过期时间与已使用流量
+			convey.So(u1.ExpireTime, convey.ShouldEqual, 100)
+			convey.So(u1.UsedUplink, convey.ShouldEqual, 10)
+			// 其他套餐的用户不受影响
+			convey.So(um.users["u2"].Quota, convey.ShouldEqual, 1000)
+
+			convey.So(um.ReapplyPlan("not-exist"), convey.ShouldNotBeNil)
+		})
+	})
+}
@@ -0,0 +1,155 @@
+package cluster
+
+import (
+	"strconv"
+
+	"github.com/lureiny/v2raymg/common/log/logger"
+	"github.com/lureiny/v2raymg/common/webhook"
+	"github.com/lureiny/v2raymg/server/rpc/proto"
+)
+
+// 用户流量统计的类型, 与proxy中stats的type保持一致
+const userTrafficType = "user"
+
+// IsOverQuota 用户是否超出流量配额, 任一配额超出即视为超出
+func IsOverQuota(user *proto.User) bool {
+	return (user.Quota > 0 && user.UsedUplink+user.UsedDownlink >= user.Quota) ||
+		(user.UplinkQuota > 0 && user.UsedUplink >= user.UplinkQuota) ||
+		(user.DownlinkQuota > 0 && user.UsedDownlink >= user.DownlinkQuota)
+}
+
+// GetRemainingQuota 剩余流量配额, 取各项配额中剩余最少的一项, -1表示不限制
+func GetRemainingQuota(user *proto.User) int64 {
+	var remaining int64 = -1
+	check := func(quota, used int64) {
+		if quota <= 0 {
+			return
+		}
+		r := quota - used
+		if r < 0 {
+			r = 0
+		}
+		if remaining < 0 || r < remaining {
+			remaining = r
+		}
+	}
+	check(user.Quota, user.UsedUplink+user.UsedDownlink)
+	check(user.UplinkQuota, user.UsedUplink)
+	check(user.DownlinkQuota, user.UsedDownlink)
+	return remaining
+}
+
+// 更新时配额为0表示不修改, 小于0表示取消限制
+func updateQuota(dst *int64, quota int64) {
+	if quota == 0 {
+		return
+	}
+	if quota < 0 {
+		quota = 0
+	}
+	*dst = quota
+}
+
+// 旧版配置文件中的配额信息格式: {quota}|{uplink_quota}|{downlink_quota}|{used_uplink}|{used_downlink}
+func parseUserQuota(user *proto.User, fields []string) {
+	values := []*int64{
+		&user.Quota,
+		&user.UplinkQuota,
+		&user.DownlinkQuota,
+		&user.UsedUplink,
+		&user.UsedDownlink,
+	}
+	for i, field := range fields {
+		if i >= len(values) {
+			break
+		}
+		v, err := strconv.ParseInt(field, 10, 64)
+		if err != nil {
+			logger.Error("Err=invalid quota info > %v|User=%s", err, user.Name)
+			continue
+		}
+		*values[i] = v
+	}
+	user.OverQuota = IsOverQuota(user)
+}
+
+// AddTraffic 累加用户已使用的流量, 超出配额的用户会被停用
+// xray与hysteria的用户流量类型均为user, 按用户名累加到同一个用户上
+func (um *UserManager) AddTraffic(stats map[string]*proto.Stats) {
+	overQuotaUsers := []*proto.User{}
+	events := []*webhook.Event{}
+	changedUsers := map[string]struct{}{}
+	um.lock.Lock()
+	for _, stat := range stats {
+		if stat.Type != userTrafficType {
+			continue
+		}
+		u, ok := um.users[stat.Name]
+		if !ok || (stat.Uplink == 0 && stat.Downlink == 0) {
+			continue
+		}
+		changedUsers[u.Name] = struct{}{}
+		before := quotaUsagePercent(u)
+		u.UsedUplink += stat.Uplink
+		u.UsedDownlink += stat.Downlink
+		events = append(events, um.quotaThresholdEvents(u, before)...)
+		if !u.OverQuota && IsOverQuota(u) {
+			u.OverQuota = true
+			// 暂停的用户已经停用
+			if !u.Suspended {
+				overQuotaUsers = append(overQuotaUsers, &proto.User{Name: u.Name, Tags: u.Tags})
+			}
+			logger.Info(
+				"Msg=user is over quota|User=%s|Quota=%d|UplinkQuota=%d|DownlinkQuota=%d|UsedUplink=%d|UsedDownlink=%d",
+				u.Name,
+				u.Quota,
+				u.UplinkQuota,
+				u.DownlinkQuota,
+				u.UsedUplink,
+				u.UsedDownlink,
+			)
+		}
+	}
+	um.lock.Unlock()
+	um.notify(events)
+
+	for _, u := range overQuotaUsers {
+		um.stopUser(u)
+	}
+	// 每个统计周期只保存流量有变化的用户
+	names := make([]string, 0, len(changedUsers))
+	for name := range changedUsers {
+		names = append(names, name)
+	}
+	um.flushUsers(names...)
+}
+
+// 超出配额或暂停的用户都需要从proxy中停用
+func isUserStopped(user *proto.User) bool {
+	return user.OverQuota || user.Suspended
+}
+
+// 停用用户, 仅从proxy runtime中移除, 保留用户信息与tag; hysteria在认证时拒绝停用的用户
+func (um *UserManager) stopUser(user *proto.User) {
+	if _, _, err := proxyUserOp(user, "disable", um.proxyManager); err != nil {
+		logger.Error("Err=stop user fail > %v|User=%s", err, user.Name)
+	}
+	um.kickUsers(user.Name)
+}
+
+// 断开用户在hysteria中的连接, hysteria只在连接建立时认证, 需要主动断开已有连接
+func (um *UserManager) kickUsers(names ...string) {
+	if um.proxyManager == nil || len(names) == 0 {
+		return
+	}
+	if err := um.proxyManager.KickUsers(names...); err != nil {
+		logger.Error("Err=kick hysteria user fail > %v|Users=%v", err, names)
+	}
+}
+
+// 恢复停用的用户, 沿用停用前的tag与uuid/password
+func (um *UserManager) startUser(user *proto.User) {
+	if _, _, err := proxyUserOp(user, "enable", um.proxyManager); err != nil {
+		logger.Error("Err=start user fail > %v|User=%s", err, user.Name)
+	}
+}
@@ -0,0 +1,87 @@
+package cluster
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/lureiny/v2raymg/common/store"
+	"github.com/lureiny/v2raymg/server/rpc/proto"
+	"github.com/smartystreets/goconvey/convey"
+)
+
+func TestUserQuota(t *testing.T) {
+	convey.Convey("is over quota", t, func() {
+		cases := []struct {
+			user   *proto.User
+			expect bool
+		}{
+			{&proto.User{UsedUplink: 100, UsedDownlink: 100}, false},
+			{&proto.User{Quota: 300, UsedUplink: 100, UsedDownlink: 100}, false},
+			{&proto.User{Quota: 200, UsedUplink: 100, UsedDownlink: 100}, true},
+			{&proto.User{UplinkQuota: 100, UsedUplink: 100}, true},
+			{&proto.User{UplinkQuota: 100, UsedUplink: 99, UsedDownlink: 1000}, false},
+			{&proto.User{DownlinkQuota: 100, UsedDownlink: 101}, true},
+			// 任一配额超出即视为超出
+			{&proto.User{Quota: 1000, DownlinkQuota: 100, UsedDownlink: 101}, true},
+		}
+		for _, c := range cases {
+			convey.So(IsOverQuota(c.user), convey.ShouldEqual, c.expect)
+		}
+	})
+
+	convey.Convey("remaining quota", t, func() {
+		convey.So(GetRemainingQuota(&proto.User{UsedUplink: 100}), convey.ShouldEqual, -1)
+		convey.So(GetRemainingQuota(&proto.User{Quota: 300, UsedUplink: 100, UsedDownlink: 100}), convey.ShouldEqual, 100)
+		convey.So(GetRemainingQuota(&proto.User{Quota: 300, UplinkQuota: 150, UsedUplink: 100}), convey.ShouldEqual, 50)
+		convey.So(GetRemainingQuota(&proto.User{Quota: 100, UsedUplink: 200}), convey.ShouldEqual, 0)
+	})
+
+	convey.Convey("update quota", t, func() {
+		var quota int64 = 100
+		// 0表示不修改
+		updateQuota(&quota, 0)
+		convey.So(quota, convey.ShouldEqual, 100)
+		updateQuota(&quota, 200)
+		convey.So(quota, convey.ShouldEqual, 200)
+		// 小于0表示取消限制
+		updateQuota(&quota, -1)
+		convey.So(quota, convey.ShouldEqual, 0)
+	})
+
+	convey.Convey("parse legacy quota info", t, func() {
+		u := &proto.User{Name: "u1"}
+		parseUserQuota(u, []string{"100", "0", "0", "60", "50"})
+		convey.So(u.Quota, convey.ShouldEqual, 100)
+		convey.So(u.UsedUplink, convey.ShouldEqual, 60)
+		convey.So(u.UsedDownlink, convey.ShouldEqual, 50)
+		convey.So(u.OverQuota, convey.ShouldBeTrue)
+	})
+}
+
+func TestAddTraffic(t *testing.T) {
+	convey.Convey("add traffic and flush changed users", t, func() {
+		s, err := store.OpenBoltStore(filepath.Join(t.TempDir(), "store.db"))
+		convey.So(err, convey.ShouldBeNil)
+		defer s.Close()
+		um := &UserManager{
+			users: map[string]*proto.User{
+				"u1": {Name: "u1", Quota: 1000},
+				"u2": {Name: "u2", Quota: 1000},
+			},
+			store: NewBoltUserStore(s),
+		}
+		um.AddTraffic(map[string]*proto.Stats{
+			"u1_user_xray":     {Name: "u1", Type: "user", Uplink: 10, Downlink: 20},
+			"u1_user_hysteria": {Name: "u1", Type: "user", Uplink: 1, Downlink: 2},
+			"u2_user_xray":     {Name: "u2", Type: "user"},
+			"tag_inbound":      {Name: "tag", Type: "inbound", Uplink: 100},
+		})
+		convey.So(um.users["u1"].UsedUplink, convey.ShouldEqual, 11)
+		convey.So(um.users["u1"].UsedDownlink, convey.ShouldEqual, 22)
+		users, err := um.store.Load()
+		convey.So(err, convey.ShouldBeNil)
+		// 没有流量变化的用户不会保存
+		convey.So(len(users), convey.ShouldEqual, 1)
+		convey.So(users["u1"].UsedUplink, convey.ShouldEqual, 11)
+	})
+}
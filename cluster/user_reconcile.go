@@ -0,0 +1,162 @@
+package cluster
+
+import (
+	"sort"
+
+	"github.com/lureiny/v2raymg/common/util"
+	"github.com/lureiny/v2raymg/server/rpc/proto"
+	pb "google.golang.org/protobuf/proto"
+)
+
+// 用户差异类型
+const (
+	UserDriftMissing  = "missing"  // 期望状态中存在, 节点上缺失
+	UserDriftExtra    = "extra"    // 节点上存在, 期望状态中没有
+	UserDriftMismatch = "mismatch" // 字段不一致
+)
+
+// 参与对比的字段
+const (
+	driftFieldTags       = "tags"
+	driftFieldExpireTime = "expire_time"
+	driftFieldQuota      = "quota"
+	driftFieldSuspended  = "suspended"
+	driftFieldUuid       = "uuid"
+)
+
+// 两组tag是否一致, 不考虑顺序
+func sameTags(a, b []string) bool {
+	return len(diffTags(a, b)) == 0 && len(diffTags(b, a)) == 0
+}
+
+// 对比期望状态与节点上的用户, 期望状态中没有本节点可用的tag时不比较tag
+func diffUserFields(desired, local *proto.User) []string {
+	fields := []string{}
+	if len(desired.Tags) > 0 && !sameTags(desired.Tags, local.Tags) {
+		fields = append(fields, driftFieldTags)
+	}
+	if desired.ExpireTime != local.ExpireTime {
+		fields = append(fields, driftFieldExpireTime)
+	}
+	if desired.Quota != local.Quota ||
+		desired.UplinkQuota != local.UplinkQuota ||
+		desired.DownlinkQuota != local.DownlinkQuota {
+		fields = append(fields, driftFieldQuota)
+	}
+	if desired.Suspended != local.Suspended {
+		fields = append(fields, driftFieldSuspended)
+	}
+	if desired.Uuid != "" && desired.Uuid != local.Uuid {
+		fields = append(fields, driftFieldUuid)
+	}
+	return fields
+}
+
+// ReconcileUsers 以desired为期望状态对比节点上的用户, 返回按用户名排序的差异
+// repair为true时修复缺失与不一致的用户, prune为true时同时删除期望状态中不存在的用户
+// repairUuid为true时即使repair为false也会统一uuid, 修复旧版本迁移时沿用各节点uuid导致的不一致
+// 各节点的inbound可能不同, 只比较本节点上存在的tag; 流量按节点统计, 不参与对比
+func (um *UserManager) ReconcileUsers(desired []*proto.User, repair, prune, repairUuid bool) []*proto.UserDrift {
+	var localInbounds util.StringList = []string{}
+	if um.proxyManager != nil {
+		localInbounds = um.proxyManager.GetTags()
+	}
+
+	drifts := []*proto.UserDrift{}
+	desiredNames := map[string]bool{}
+	for _, user := range desired {
+		if user.GetName() == "" || desiredNames[user.Name] {
+			continue
+		}
+		desiredNames[user.Name] = true
+
+		d := pb.Clone(user).(*proto.User)
+		d.Tags = util.StringList(d.Tags).Filter(localInbounds.Contains)
+		um.lock.RLock()
+		var local *proto.User = nil
+		if u, ok := um.users[user.Name]; ok {
+			local = pb.Clone(u).(*proto.User)
+		}
+		um.lock.RUnlock()
+
+		var drift *proto.UserDrift = nil
+		var err error = nil
+		repaired := false
+		if local == nil {
+			drift = &proto.UserDrift{Name: d.Name, Type: UserDriftMissing}
+			if repair {
+				err = um.addReconciledUser(d)
+				repaired = err == nil
+			}
+		} else if fields := diffUserFields(d, local); len(fields) > 0 {
+			drift = &proto.UserDrift{Name: d.Name, Type: UserDriftMismatch, Fields: fields}
+			if repair {
+				err = um.repairUser(d, local, fields)
+				repaired = err == nil
+			} else if repairUuid && util.StringList(fields).Contains(driftFieldUuid) {
+				// 只统一uuid, 其他字段不一致时仍视为未修复
+				err = um.repairUser(d, local, []string{driftFieldUuid})
+				repaired = err == nil && len(fields) == 1
+			}
+		}
+		if drift == nil {
+			continue
+		}
+		drift.Repaired = repaired
+		if err != nil {
+			drift.Msg = err.Error()
+		}
+		drifts = append(drifts, drift)
+	}
+
+	um.lock.RLock()
+	extraUsers := []string{}
+	for name := range um.users {
+		if !desiredNames[name] {
+			extraUsers = append(extraUsers, name)
+		}
+	}
+	um.lock.RUnlock()
+	for _, name := range extraUsers {
+		drift := &proto.UserDrift{Name: name, Type: UserDriftExtra}
+		if repair && prune {
+			if err := um.Delete(&proto.User{Name: name}); err != nil {
+				drift.Msg = err.Error()
+			} else {
+				drift.Repaired = true
+			}
+		}
+		drifts = append(drifts, drift)
+	}
+
+	sort.Slice(drifts, func(i, j int) bool {
+		return drifts[i].Name < drifts[j].Name
+	})
+	return drifts
+}
+
+// 补上缺失的用户, 沿用期望状态中的凭证与uuid, 流量从0开始
+func (um *UserManager) addReconciledUser(user *proto.User) error {
+	if err := checkImportCredential(user); err != nil {
+		return err
+	}
+	user.UsedUplink, user.UsedDownlink = 0, 0
+	return um.importNewUser(user)
+}
+
+// 修复不一致的字段, uuid不一致时在全部tag上重置为期望的uuid
+func (um *UserManager) repairUser(desired, local *proto.User, fields []string) error {
+	var fieldList util.StringList = fields
+	if fieldList.Contains(driftFieldTags) ||
+		fieldList.Contains(driftFieldExpireTime) ||
+		fieldList.Contains(driftFieldQuota) ||
+		fieldList.Contains(driftFieldSuspended) {
+		if err := um.overwriteUser(desired, local.Tags); err != nil {
+			return err
+		}
+	}
+	if fieldList.Contains(driftFieldUuid) {
+		return um.Reset(&proto.User{Name: desired.Name, Uuid: desired.Uuid})
+	}
+	return nil
+}
@@ -0,0 +1,214 @@
+package cluster
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/lureiny/v2raymg/common/store"
+	"github.com/lureiny/v2raymg/server/rpc/proto"
+	pb "google.golang.org/protobuf/proto"
+)
+
+// UserStore 用户信息的持久化存储, UserManager通过它读写用户
+type UserStore interface {
+	// Load 加载全部用户, key = name
+	Load() (map[string]*proto.User, error)
+	// Save 保存用户, 已存在的用户会被覆盖
+	Save(users ...*proto.User) error
+	// Delete 删除用户, 不存在的用户会被忽略
+	Delete(names ...string) error
+	// IsMigrated 是否已经从配置文件中迁移过用户
+	IsMigrated() bool
+	// SetMigrated 标记已经完成迁移
+	SetMigrated() error
+	// SaveUsage 保存用户历史周期的流量
+	SaveUsage(usages ...*proto.UserUsage) error
+	// LoadUsage 加载用户历史周期的流量, 按周期开始时间排序
+	LoadUsage(name string) ([]*proto.UserUsage, error)
+	// SaveLegacyCredentials 追加迁移前明文密码的认证记录, key为凭证sha256的hex编码
+	SaveLegacyCredentials(creds map[string][]*LegacyCredential) error
+	// LoadLegacyCredentials 加载未过期的认证记录, 同时删除已经过期的记录
+	LoadLegacyCredentials(now int64) (map[string][]*LegacyCredential, error)
+}
+
+// LegacyCredential 迁移前的hysteria客户端使用明文密码认证, 迁移时记录密码的sha256, 兼容期内仍然可以认证
+// 只在密码哈希与迁移时一致时有效, 修改密码后失效
+type LegacyCredential struct {
+	Name       string `json:"name"`
+	PasswdHash string `json:"passwd_hash"`
+	ExpireTime int64  `json:"expire_time"`
+}
+
+const (
+	userBucket      = "users"
+	metaBucket      = "meta"
+	usageBucket     = "user_usages"
+	legacyBucket    = "legacy_credentials"
+	migratedMetaKey = "users_migrated"
+)
+
+// BoltUserStore 默认的用户存储, 基于bbolt, 用户以proto编码存储
+type BoltUserStore struct {
+	store *store.BoltStore
+}
+
+// NewBoltUserStore ...
+func NewBoltUserStore(s *store.BoltStore) *BoltUserStore {
+	return &BoltUserStore{store: s}
+}
+
+func (s *BoltUserStore) Load() (map[string]*proto.User, error) {
+	users := map[string]*proto.User{}
+	err := s.store.ForEach(userBucket, func(k, v []byte) error {
+		user := &proto.User{}
+		if err := pb.Unmarshal(v, user); err != nil {
+			return fmt.Errorf("unmarshal user[%s] fail > %v", k, err)
+		}
+		users[user.Name] = user
+		return nil
+	})
+	return users, err
+}
+
+func (s *BoltUserStore) Save(users ...*proto.User) error {
+	kvs := map[string][]byte{}
+	for _, user := range users {
+		data, err := pb.Marshal(user)
+		if err != nil {
+			return fmt.Errorf("marshal user[%s] fail > %v", user.Name, err)
+		}
+		kvs[user.Name] = data
+	}
+	return s.store.PutBatch(userBucket, kvs)
+}
+
+func (s *BoltUserStore) Delete(names ...string) error {
+	return s.store.Delete(userBucket, names...)
+}
+
+func (s *BoltUserStore) IsMigrated() bool {
+	v, err := s.store.Get(metaBucket, migratedMetaKey)
+	return err == nil && v != nil
+}
+
+func (s *BoltUserStore) SetMigrated() error {
+	return s.store.Put(metaBucket, migratedMetaKey, []byte("1"))
+}
+
+// 周期流量的key为{name}\x00{start}, start补齐长度保证按时间排序
+// 旧版本使用/分隔, 名称为{name}/开头的其他用户的流量也会匹配前缀, 读取时需要校验用户名
+func usageKey(name string, start int64) string {
+	return fmt.Sprintf("%s\x00%020d", name, start)
+}
+
+func (s *BoltUserStore) SaveUsage(usages ...*proto.UserUsage) error {
+	kvs := map[string][]byte{}
+	for _, usage := range usages {
+		data, err := pb.Marshal(usage)
+		if err != nil {
+			return fmt.Errorf("marshal user[%s] usage fail > %v", usage.Name, err)
+		}
+		kvs[usageKey(usage.Name, usage.Start)] = data
+	}
+	return s.store.PutBatch(usageBucket, kvs)
+}
+
+func (s *BoltUserStore) LoadUsage(name string) ([]*proto.UserUsage, error) {
+	usageMap := map[int64]*proto.UserUsage{}
+	// 先读取旧版本的key, 同一周期以新的key为准
+	for _, prefix := range []string{name + "/", name + "\x00"} {
+		err := s.store.ForEachPrefix(usageBucket, prefix, func(k, v []byte) error {
+			usage := &proto.UserUsage{}
+			if err := pb.Unmarshal(v, usage); err != nil {
+				return fmt.Errorf("unmarshal usage[%q] fail > %v", k, err)
+			}
+			if usage.GetName() == name {
+				usageMap[usage.GetStart()] = usage
+			}
+			return nil
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+	usages := make([]*proto.UserUsage, 0, len(usageMap))
+	for _, usage := range usageMap {
+		usages = append(usages, usage)
+	}
+	sort.Slice(usages, func(i, j int) bool {
+		return usages[i].GetStart() < usages[j].GetStart()
+	})
+	return usages, nil
+}
+
+func (s *BoltUserStore) SaveLegacyCredentials(creds map[string][]*LegacyCredential) error {
+	keys := make([]string, 0, len(creds))
+	for key := range creds {
+		keys = append(keys, key)
+	}
+	return s.store.ModifyBatch(legacyBucket, keys, func(key string, old []byte) ([]byte, error) {
+		entries := []*LegacyCredential{}
+		if old != nil {
+			if err := json.Unmarshal(old, &entries); err != nil {
+				return nil, fmt.Errorf("unmarshal legacy credential fail > %v", err)
+			}
+		}
+		return json.Marshal(append(entries, creds[key]...))
+	})
+}
+
+func (s *BoltUserStore) LoadLegacyCredentials(now int64) (map[string][]*LegacyCredential, error) {
+	creds := map[string][]*LegacyCredential{}
+	_, err := s.store.DeleteIf(legacyBucket, func(k, v []byte) bool {
+		entries := []*LegacyCredential{}
+		if err := json.Unmarshal(v, &entries); err != nil {
+			return true
+		}
+		valid := []*LegacyCredential{}
+		for _, entry := range entries {
+			if entry.ExpireTime > now {
+				valid = append(valid, entry)
+			}
+		}
+		if len(valid) == 0 {
+			return true
+		}
+		creds[string(k)] = valid
+		return false
+	})
+	return creds, err
+}
+
+// 解析旧版配置文件中的用户, 格式为 {passwd}|{expire}[|{quota}|{uplink_quota}|{downlink_quota}|{used_uplink}|{used_downlink}]
+func parseLegacyUsers(usersLocal map[string]string) map[string]*proto.User {
+	users := map[string]*proto.User{}
+	for k, v := range usersLocal {
+		l := strings.Split(v, "|")
+		passwd := ""
+		var expireTime int64 = 0
+		quotaFields := []string{}
+		if len(l) < 2 {
+			passwd = v
+		} else {
+			e, err := strconv.ParseInt(l[1], 10, 64)
+			if err != nil {
+				passwd = v
+				expireTime = 0
+			} else {
+				passwd = l[0]
+				expireTime = e
+				quotaFields = l[2:]
+			}
+		}
+		users[k] = &proto.User{
+			Name:       k,
+			Passwd:     passwd,
+			ExpireTime: expireTime,
+		}
+		parseUserQuota(users[k], quotaFields)
+	}
+	return users
+}
@@ -0,0 +1,133 @@
+package cluster
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/lureiny/v2raymg/common"
+	"github.com/lureiny/v2raymg/common/store"
+	gc "github.com/lureiny/v2raymg/global/config"
+	"github.com/lureiny/v2raymg/server/rpc/proto"
+	"github.com/smartystreets/goconvey/convey"
+	pb "google.golang.org/protobuf/proto"
+)
+
+func TestParseLegacyUsers(t *testing.T) {
+	convey.Convey("parse legacy users", t, func() {
+		users := parseLegacyUsers(map[string]string{
+			"u1": "passwd1",
+			"u2": "passwd2|1700000000",
+			"u3": "passwd3|1700000000|100|0|0|60|50",
+			// 过期时间无法解析时整体作为密码
+			"u4": "pass|wd4",
+		})
+		convey.So(len(users), convey.ShouldEqual, 4)
+		convey.So(users["u1"].Passwd, convey.ShouldEqual, "passwd1")
+		convey.So(users["u1"].ExpireTime, convey.ShouldEqual, 0)
+		convey.So(users["u2"].Passwd, convey.ShouldEqual, "passwd2")
+		convey.So(users["u2"].ExpireTime, convey.ShouldEqual, 1700000000)
+		convey.So(users["u3"].Quota, convey.ShouldEqual, 100)
+		convey.So(users["u3"].UsedUplink, convey.ShouldEqual, 60)
+		convey.So(users["u3"].OverQuota, convey.ShouldBeTrue)
+		convey.So(users["u4"].Passwd, convey.ShouldEqual, "pass|wd4")
+		convey.So(users["u4"].ExpireTime, convey.ShouldEqual, 0)
+	})
+}
+
+func TestBoltUserStore(t *testing.T) {
+	convey.Convey("bolt user store", t, func() {
+		dir := t.TempDir()
+		s, err := store.OpenBoltStore(filepath.Join(dir, "store.db"))
+		convey.So(err, convey.ShouldBeNil)
+		defer s.Close()
+		userStore := NewBoltUserStore(s)
+
+		convey.Convey("save load and delete", func() {
+			convey.So(userStore.Save(&proto.User{Name: "u1", Quota: 100}, &proto.User{Name: "u2"}), convey.ShouldBeNil)
+			convey.So(userStore.Save(&proto.User{Name: "u1", Quota: 200}), convey.ShouldBeNil)
+			convey.So(userStore.Delete("u2", "not-exist"), convey.ShouldBeNil)
+			users, err := userStore.Load()
+			convey.So(err, convey.ShouldBeNil)
+			convey.So(len(users), convey.ShouldEqual, 1)
+			convey.So(users["u1"].Quota, convey.ShouldEqual, 200)
+		})
+
+		convey.Convey("legacy credentials expire", func() {
+			now := time.Now().Unix()
+			convey.So(userStore.SaveLegacyCredentials(map[string][]*LegacyCredential{
+				"k1": {{Name: "u1", PasswdHash: "h1", ExpireTime: now + 100}},
+				"k2": {{Name: "u2", PasswdHash: "h2", ExpireTime: now - 1}},
+			}), convey.ShouldBeNil)
+			// 相同的key追加记录
+			convey.So(userStore.SaveLegacyCredentials(map[string][]*LegacyCredential{
+				"k1": {{Name: "u3", PasswdHash: "h3", ExpireTime: now - 1}},
+			}), convey.ShouldBeNil)
+			creds, err := userStore.LoadLegacyCredentials(now)
+			convey.So(err, convey.ShouldBeNil)
+			convey.So(len(creds), convey.ShouldEqual, 1)
+			convey.So(len(creds["k1"]), convey.ShouldEqual, 1)
+			convey.So(creds["k1"][0].Name, convey.ShouldEqual, "u1")
+			// 过期的记录被删除
+			creds, err = userStore.LoadLegacyCredentials(now + 200)
+			convey.So(err, convey.ShouldBeNil)
+			convey.So(len(creds), convey.ShouldEqual, 0)
+		})
+
+		convey.Convey("load usage of one user", func() {
+			convey.So(userStore.SaveUsage(
+				&proto.UserUsage{Name: "a", Start: 200, Uplink: 2},
+				&proto.UserUsage{Name: "a/b", Start: 100, Uplink: 3},
+			), convey.ShouldBeNil)
+			// 旧版本使用/分隔的key
+			for _, usage := range []*proto.UserUsage{{Name: "a", Start: 100, Uplink: 1}, {Name: "a/b", Start: 50, Uplink: 4}} {
+				data, err := pb.Marshal(usage)
+				convey.So(err, convey.ShouldBeNil)
+				convey.So(s.Put(usageBucket, fmt.Sprintf("%s/%020d", usage.Name, usage.Start), data), convey.ShouldBeNil)
+			}
+			usages, err := userStore.LoadUsage("a")
+			convey.So(err, convey.ShouldBeNil)
+			convey.So(len(usages), convey.ShouldEqual, 2)
+			convey.So(usages[0].Uplink, convey.ShouldEqual, 1)
+			convey.So(usages[1].Uplink, convey.ShouldEqual, 2)
+			usages, err = userStore.LoadUsage("a/b")
+			convey.So(err, convey.ShouldBeNil)
+			convey.So(len(usages), convey.ShouldEqual, 2)
+			convey.So(usages[0].Uplink, convey.ShouldEqual, 4)
+		})
+
+		convey.Convey("migrate users from config once", func() {
+			configFile := filepath.Join(dir, "config.yaml")
+			content := "users:\n  u1: \"passwd1|0|100|0|0|10|20\"\n  u2: \"passwd2\"\n"
+			convey.So(os.WriteFile(configFile, []byte(content), 0644), convey.ShouldBeNil)
+			convey.So(gc.InitGlobalConfig(configFile), convey.ShouldBeNil)
+
+			um := &UserManager{store: userStore}
+			convey.So(um.migrateUsers(), convey.ShouldBeNil)
+			convey.So(userStore.IsMigrated(), convey.ShouldBeTrue)
+			// 迁移后配置文件中的用户被清空
+			convey.So(len(gc.GetStringMapString(common.ConfigUsers)), convey.ShouldEqual, 0)
+			users, err := userStore.Load()
+			convey.So(err, convey.ShouldBeNil)
+			convey.So(len(users), convey.ShouldEqual, 2)
+			convey.So(users["u1"].UsedDownlink, convey.ShouldEqual, 20)
+			// 明文密码只保存哈希
+			convey.So(users["u1"].Passwd, convey.ShouldBeEmpty)
+			convey.So(checkPasswd(users["u1"], "passwd1"), convey.ShouldBeTrue)
+			convey.So(users["u2"].SubToken, convey.ShouldNotBeEmpty)
+			// 记录明文密码的认证凭证, 原始密码与base64编码各一条
+			creds, err := userStore.LoadLegacyCredentials(time.Now().Unix())
+			convey.So(err, convey.ShouldBeNil)
+			convey.So(len(creds), convey.ShouldEqual, 4)
+
+			// 已迁移时不会再次迁移
+			gc.Set(common.ConfigUsers, map[string]string{"u3": "passwd3"})
+			convey.So(um.migrateUsers(), convey.ShouldBeNil)
+			users, err = userStore.Load()
+			convey.So(err, convey.ShouldBeNil)
+			convey.So(len(users), convey.ShouldEqual, 2)
+		})
+	})
+}
@@ -0,0 +1,232 @@
+package cluster
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/lureiny/v2raymg/common/util"
+	"github.com/lureiny/v2raymg/server/rpc/proto"
+	"golang.org/x/crypto/bcrypt"
+	pb "google.golang.org/protobuf/proto"
+)
+
+// 导入用户时已存在用户的处理方式
+const (
+	ImportPolicySkip      = "skip"      // 跳过已存在的用户
+	ImportPolicyOverwrite = "overwrite" // 使用导入数据覆盖过期时间, 配额, 流量重置周期, 凭证与tag
+	ImportPolicyMerge     = "merge"     // 只将用户添加到新的tag中
+)
+
+// 单个用户的导入结果
+const (
+	importActionAdd       = "add"
+	importActionSkip      = "skip"
+	importActionOverwrite = "overwrite"
+	importActionMerge     = "merge"
+	importActionFail      = "fail"
+)
+
+// ExportUsers 导出全部用户, 包含密码哈希, 订阅token与流量使用情况, 按用户名排序
+func (um *UserManager) ExportUsers() []*proto.User {
+	um.lock.RLock()
+	users := make([]*proto.User, 0, len(um.users))
+	for _, u := range um.users {
+		user := pb.Clone(u).(*proto.User)
+		user.RemainingQuota = GetRemainingQuota(user)
+		users = append(users, user)
+	}
+	um.lock.RUnlock()
+	sort.Slice(users, func(i, j int) bool {
+		return users[i].Name < users[j].Name
+	})
+	return users
+}
+
+func checkImportPolicy(policy string) error {
+	switch policy {
+	case ImportPolicySkip, ImportPolicyOverwrite, ImportPolicyMerge:
+		return nil
+	}
+	return fmt.Errorf("unsupport conflict policy %s", policy)
+}
+
+// 导入的用户需要明文密码或者密码哈希
+func checkImportCredential(user *proto.User) error {
+	if user.Passwd != "" {
+		return nil
+	}
+	if user.PasswdHash == "" {
+		return fmt.Errorf("empty passwd")
+	}
+	if _, err := bcrypt.Cost([]byte(user.PasswdHash)); err != nil {
+		return fmt.Errorf("invalid passwd hash > %v", err)
+	}
+	return nil
+}
+
+// 在src中但不在dst中的tag
+func diffTags(src, dst []string) []string {
+	dstTags := util.StringList(dst)
+	tags := []string{}
+	for _, tag := range src {
+		if !dstTags.Contains(tag) {
+			tags = append(tags, tag)
+		}
+	}
+	return tags
+}
+
+// ImportUsers 批量导入用户, dryRun为true时只返回每个用户的处理结果, 不做任何修改
+func (um *UserManager) ImportUsers(users []*proto.User, policy string, dryRun bool) ([]*proto.ImportUserResult, error) {
+	if policy == "" {
+		policy = ImportPolicySkip
+	}
+	if err := checkImportPolicy(policy); err != nil {
+		return nil, err
+	}
+	results := []*proto.ImportUserResult{}
+	seen := map[string]bool{}
+	for _, user := range users {
+		result := &proto.ImportUserResult{Name: user.GetName()}
+		results = append(results, result)
+		if user.GetName() == "" {
+			result.Action, result.Msg = importActionFail, "empty user name"
+			continue
+		}
+		if seen[user.Name] {
+			result.Action, result.Msg = importActionFail, "duplicate user in import data"
+			continue
+		}
+		seen[user.Name] = true
+
+		um.lock.RLock()
+		localTags := []string{}
+		localUser, exist := um.users[user.Name]
+		if exist {
+			localTags = append(localTags, localUser.Tags...)
+		}
+		um.lock.RUnlock()
+
+		var err error = nil
+		switch {
+		case !exist:
+			result.Action = importActionAdd
+			if err = checkImportCredential(user); err == nil && !dryRun {
+				err = um.importNewUser(user)
+			}
+		case policy == ImportPolicySkip:
+			result.Action, result.Msg = importActionSkip, "user already exists"
+		case policy == ImportPolicyOverwrite:
+			result.Action = importActionOverwrite
+			if user.PasswdHash != "" && user.Passwd == "" {
+				err = checkImportCredential(user)
+			}
+			if err == nil && !dryRun {
+				err = um.overwriteUser(user, localTags)
+			}
+		case policy == ImportPolicyMerge:
+			result.Action = importActionMerge
+			addTags := diffTags(user.Tags, localTags)
+			if len(addTags) == 0 {
+				result.Msg = "no new tag"
+			} else {
+				result.Msg = fmt.Sprintf("add tags %v", addTags)
+				if !dryRun {
+					err = um.Update(&proto.User{Name: user.Name}, &proto.UserUpdateMask{AddTags: addTags})
+				}
+			}
+		}
+		if err != nil {
+			result.Action, result.Msg = importActionFail, err.Error()
+		}
+	}
+	return results, nil
+}
+
+// 导入新用户, 导入前已使用的流量计入当前统计周期
+func (um *UserManager) importNewUser(user *proto.User) error {
+	user = pb.Clone(user).(*proto.User)
+	usedUplink, usedDownlink, suspended := user.UsedUplink, user.UsedDownlink, user.Suspended
+	if err := um.Add(user); err != nil {
+		return err
+	}
+	if usedUplink > 0 || usedDownlink > 0 {
+		um.AddTraffic(map[string]*proto.Stats{
+			user.Name: {
+				Name:     user.Name,
+				Type:     userTrafficType,
+				Uplink:   usedUplink,
+				Downlink: usedDownlink,
+			},
+		})
+	}
+	if suspended {
+		return um.Suspend(&proto.User{Name: user.Name})
+	}
+	return nil
+}
+
+// 覆盖已存在的用户, 导入数据中没有tag时保留原有的tag, 已使用的流量以本地统计为准
+func (um *UserManager) overwriteUser(user *proto.User, localTags []string) error {
+	mask := &proto.UserUpdateMask{ExpireTime: true, Quota: true, Billing: true}
+	if user.SpeedTier != "" {
+		mask.SpeedTier = true
+	}
+	if len(user.Tags) > 0 {
+		mask.AddTags = diffTags(user.Tags, localTags)
+		mask.RemoveTags = diffTags(localTags, user.Tags)
+	}
+	// 先更新凭证, 删除全部tag的用户会被清除
+	if err := um.importCredential(user); err != nil {
+		return err
+	}
+	err := um.Update(&proto.User{
+		Name:             user.Name,
+		ExpireTime:       user.ExpireTime,
+		Quota:            toUpdateQuota(user.Quota),
+		UplinkQuota:      toUpdateQuota(user.UplinkQuota),
+		DownlinkQuota:    toUpdateQuota(user.DownlinkQuota),
+		ResetPeriod:      user.ResetPeriod,
+		BillingAnchorDay: user.BillingAnchorDay,
+		SpeedTier:        user.SpeedTier,
+	}, mask)
+	if err != nil {
+		return err
+	}
+	if user.Suspended {
+		return um.Suspend(&proto.User{Name: user.Name})
+	}
+	return um.Resume(&proto.User{Name: user.Name})
+}
+
+// 使用导入数据中的密码与订阅token, 未指定的凭证保持不变
+func (um *UserManager) importCredential(user *proto.User) error {
+	passwdHash := user.PasswdHash
+	if user.Passwd != "" {
+		hash, err := hashPasswd(user.Passwd)
+		if err != nil {
+			return err
+		}
+		passwdHash = hash
+	}
+	if passwdHash == "" && user.SubToken == "" {
+		return nil
+	}
+	um.lock.Lock()
+	u, ok := um.users[user.Name]
+	if !ok {
+		um.lock.Unlock()
+		return fmt.Errorf("user[%s] is not exist", user.Name)
+	}
+	if passwdHash != "" {
+		u.PasswdHash = passwdHash
+	}
+	if user.SubToken != "" && user.SubToken != u.SubToken {
+		um.authIndex.remove(u.Name, u.SubToken)
+		u.SubToken = user.SubToken
+		um.authIndex.add(u.Name, u.SubToken)
+	}
+	um.lock.Unlock()
+	um.FlushUser()
+	return nil
+}
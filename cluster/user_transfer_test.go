@@ -0,0 +1,85 @@
+package cluster
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/lureiny/v2raymg/common/store"
+	"github.com/lureiny/v2raymg/server/rpc/proto"
+	"github.com/smartystreets/goconvey/convey"
+)
+
+func TestImportUsers(t *testing.T) {
+	convey.Convey("import users", t, func() {
+		s, err := store.OpenBoltStore(filepath.Join(t.TempDir(), "store.db"))
+		convey.So(err, convey.ShouldBeNil)
+		defer s.Close()
+		um := &UserManager{users: map[string]*proto.User{}, authIndex: userAuthIndex{}, store: NewBoltUserStore(s)}
+		hash, err := hashPasswd("passwd")
+		convey.So(err, convey.ShouldBeNil)
+		um.users["u1"] = &proto.User{Name: "u1", Tags: []string{"vless"}, ExpireTime: 100, Quota: 1000, PasswdHash: hash, SubToken: "old-token"}
+		um.authIndex.add("u1", "old-token")
+		actions := func(results []*proto.ImportUserResult) []string {
+			result := []string{}
+			for _, r := range results {
+				result = append(result, r.Action)
+			}
+			return result
+		}
+
+		_, err = um.ImportUsers(nil, "replace", false)
+		convey.So(err, convey.ShouldNotBeNil)
+
+		convey.Convey("dry run does not modify users", func() {
+			for _, policy := range []string{ImportPolicySkip, ImportPolicyOverwrite, ImportPolicyMerge} {
+				results, err := um.ImportUsers([]*proto.User{
+					{Name: "u1", Tags: []string{"vmess"}, ExpireTime: 200},
+					{Name: "u2", PasswdHash: hash},
+					{Name: "u3"},
+					{Name: "u2", Passwd: "passwd"},
+					{Name: ""},
+				}, policy, true)
+				convey.So(err, convey.ShouldBeNil)
+				convey.So(actions(results)[1:], convey.ShouldResemble, []string{"add", "fail", "fail", "fail"})
+				convey.So(len(um.users), convey.ShouldEqual, 1)
+				convey.So(um.users["u1"].ExpireTime, convey.ShouldEqual, 100)
+				convey.So(um.users["u1"].Tags, convey.ShouldResemble, []string{"vless"})
+			}
+		})
+
+		convey.Convey("conflict policy", func() {
+			results, err := um.ImportUsers([]*proto.User{{Name: "u1", ExpireTime: 200}}, "", false)
+			convey.So(err, convey.ShouldBeNil)
+			convey.So(actions(results), convey.ShouldResemble, []string{"skip"})
+			convey.So(um.users["u1"].ExpireTime, convey.ShouldEqual, 100)
+
+			results, err = um.ImportUsers([]*proto.User{{Name: "u1", Tags: []string{"vless", "vmess"}}}, ImportPolicyMerge, true)
+			convey.So(err, convey.ShouldBeNil)
+			convey.So(actions(results), convey.ShouldResemble, []string{"merge"})
+			convey.So(results[0].Msg, convey.ShouldEqual, "add tags [vmess]")
+
+			results, err = um.ImportUsers([]*proto.User{{Name: "u1", Tags: []string{"vless"}}}, ImportPolicyMerge, false)
+			convey.So(err, convey.ShouldBeNil)
+			convey.So(results[0].Msg, convey.ShouldEqual, "no new tag")
+
+			// 覆盖时导入数据中没有tag则保留原有的tag
+			// 过期时间为0表示不过期
+			results, err = um.ImportUsers([]*proto.User{{Name: "u1", SubToken: "new-token"}}, ImportPolicyOverwrite, false)
+			convey.So(err, convey.ShouldBeNil)
+			convey.So(actions(results), convey.ShouldResemble, []string{"overwrite"})
+			u1 := um.users["u1"]
+			convey.So(u1.ExpireTime, convey.ShouldEqual, 0)
+			// 配额为0表示不限制
+			convey.So(u1.Quota, convey.ShouldEqual, 0)
+			convey.So(u1.Tags, convey.ShouldResemble, []string{"vless"})
+			convey.So(u1.PasswdHash, convey.ShouldEqual, hash)
+			convey.So(u1.SubToken, convey.ShouldEqual, "new-token")
+			_, err = um.AuthUser("new-token", "1.1.1.1:1000")
+			convey.So(err, convey.ShouldBeNil)
+
+			results, err = um.ImportUsers([]*proto.User{{Name: "u1", PasswdHash: "invalid"}}, ImportPolicyOverwrite, false)
+			convey.So(err, convey.ShouldBeNil)
+			convey.So(actions(results), convey.ShouldResemble, []string{"fail"})
+		})
+	})
+}
@@ -0,0 +1,35 @@
+package cluster
+
+import (
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/lureiny/v2raymg/proxy/manager"
+	"github.com/lureiny/v2raymg/server/rpc/proto"
+)
+
+// NewUserUUID 生成用户在xray/v2ray中使用的uuid, 在请求入口生成后下发到各个节点, 保证同一用户在各节点的凭证一致
+func NewUserUUID() string {
+	return uuid.New().String()
+}
+
+func checkUserUUID(id string) error {
+	if _, err := uuid.Parse(id); err != nil {
+		return fmt.Errorf("invalid uuid[%s] > %v", id, err)
+	}
+	return nil
+}
+
+// 设置用户的uuid: 请求中指定时直接使用, 否则沿用proxy配置中已有的uuid(旧版本用户), 都没有时随机生成
+func setUserUUID(user *proto.User, proxyManager *manager.ProxyManager) error {
+	if user.Uuid != "" {
+		return checkUserUUID(user.Uuid)
+	}
+	if proxyManager != nil {
+		user.Uuid = proxyManager.GetUserUUID(user.Name)
+	}
+	if user.Uuid == "" {
+		user.Uuid = NewUserUUID()
+	}
+	return nil
+}
@@ -0,0 +1,49 @@
+package cmd
+
+import (
+	"log"
+	"os"
+	"path/filepath"
+
+	"github.com/lureiny/v2raymg/cluster"
+	"github.com/lureiny/v2raymg/common/rpc"
+	"github.com/spf13/cobra"
+)
+
+// caCmd 创建节点间mTLS使用的集群CA
+var caCmd = &cobra.Command{
+	Use:   "ca",
+	Short: "Create cluster ca for mTLS between nodes.",
+	Run:   createCA,
+}
+
+var (
+	caDir         = ""
+	caClusterName = ""
+)
+
+func init() {
+	caCmd.Flags().StringVarP(&caDir, "dir", "d", "./cluster_tls", "Dir to save ca.crt and ca.key, same as cluster.tls.dir of the issuer node")
+	caCmd.Flags().StringVarP(&caClusterName, "cluster", "n", "", "Cluster name")
+}
+
+func createCA(cmd *cobra.Command, args []string) {
+	keyFile := filepath.Join(caDir, cluster.CAKeyFile)
+	if _, err := os.Stat(keyFile); err == nil {
+		log.Fatalf("ca key %s already exist", keyFile)
+	}
+	certPEM, keyPEM, err := rpc.NewClusterCA(caClusterName, rpc.DefaultCAValidity)
+	if err != nil {
+		log.Fatalf("create ca fail > %v", err)
+	}
+	if err := os.MkdirAll(caDir, 0700); err != nil {
+		log.Fatalf("create dir fail > %v", err)
+	}
+	if err := os.WriteFile(filepath.Join(caDir, cluster.CACertFile), certPEM, 0600); err != nil {
+		log.Fatalf("write ca cert fail > %v", err)
+	}
+	if err := os.WriteFile(keyFile, keyPEM, 0600); err != nil {
+		log.Fatalf("write ca key fail > %v", err)
+	}
+	log.Printf("cluster ca is created in %s", caDir)
+}
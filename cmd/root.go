@@ -24,6 +24,7 @@ func init() {
 	rootCmd.AddCommand(subCmd)
 	rootCmd.AddCommand(resetCmd)
 	rootCmd.AddCommand(serverCmd)
+	rootCmd.AddCommand(caCmd)
 
 	rootCmd.PersistentFlags().StringVar(&host, "host", "localhost", "V2ray api host")
 	rootCmd.PersistentFlags().IntVar(&port, "port", 10085, "V2ray api port")
@@ -14,6 +14,8 @@ import (
 	"github.com/lureiny/v2raymg/global/config"
 	globalLego "github.com/lureiny/v2raymg/global/lego"
 	"github.com/lureiny/v2raymg/global/proxy"
+	"github.com/lureiny/v2raymg/global/store"
+	"github.com/lureiny/v2raymg/global/user"
 	"github.com/lureiny/v2raymg/lego"
 	"github.com/lureiny/v2raymg/server/http"
 	"github.com/lureiny/v2raymg/server/rpc"
@@ -87,4 +89,6 @@ func startServer(cmd *cobra.Command, args []string) {
 	logger.Info("Msg=Exit With signal: %v", signal)
 	// TODO: use context
 	proxy.StopProxyServer()
+	user.FlushUser()
+	store.Close()
 }
@@ -0,0 +1,179 @@
+package audit
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/lureiny/v2raymg/common/log/logger"
+	"github.com/lureiny/v2raymg/common/store"
+	"github.com/lureiny/v2raymg/server/rpc/proto"
+	pb "google.golang.org/protobuf/proto"
+)
+
+// 操作来源
+const (
+	SourceHttp = "http"
+	SourceRpc  = "rpc"
+)
+
+const (
+	auditBucket = "audit_log"
+
+	DefaultRetention  = 90 * 24 * time.Hour
+	DefaultQueryLimit = 100
+	MaxQueryLimit     = 1000
+
+	// 参数与返回结果的最大长度, 避免批量导入等请求写入过大的记录
+	maxParamsLen = 2048
+	maxMsgLen    = 256
+
+	redactedValue = "***"
+)
+
+// key格式为{纳秒时间戳}{序号}, 均补齐到固定长度, 保证按时间排序且同一时刻的记录不会覆盖
+func entryKey(t time.Time, seq uint32) string {
+	return fmt.Sprintf("%020d%06d", t.UnixNano(), seq%1000000)
+}
+
+func parseEntryKey(key string) (int64, error) {
+	if len(key) < 20 {
+		return 0, fmt.Errorf("invalid audit key %s", key)
+	}
+	return strconv.ParseInt(key[:20], 10, 64)
+}
+
+func truncate(s string, maxLen int) string {
+	if len(s) <= maxLen {
+		return s
+	}
+	return s[:maxLen] + "..."
+}
+
+// Recorder 将管理操作追加写入store, 只会按保留时长清理, 不会修改已有记录
+type Recorder struct {
+	store           *store.BoltStore
+	node            string
+	retention       time.Duration
+	seq             uint32
+	lock            sync.Mutex
+	lastCleanUpTime time.Time
+	cleanUpInterval time.Duration
+}
+
+func NewRecorder(s *store.BoltStore, node string, retention time.Duration) *Recorder {
+	if retention <= 0 {
+		retention = DefaultRetention
+	}
+	return &Recorder{
+		store:           s,
+		node:            node,
+		retention:       retention,
+		cleanUpInterval: time.Hour,
+	}
+}
+
+// Record 写入一条记录, params与msg超长时会被截断; recorder为nil时不做处理
+func (r *Recorder) Record(entry *proto.AuditEntry) error {
+	if r == nil {
+		return nil
+	}
+	now := time.Now()
+	entry.Time = now.UnixMilli()
+	entry.Node = r.node
+	entry.Params = truncate(entry.Params, maxParamsLen)
+	entry.Msg = truncate(entry.Msg, maxMsgLen)
+	data, err := pb.Marshal(entry)
+	if err != nil {
+		return fmt.Errorf("marshal audit entry fail > %v", err)
+	}
+	if err := r.store.Put(auditBucket, entryKey(now, atomic.AddUint32(&r.seq, 1)), data); err != nil {
+		return fmt.Errorf("save audit entry fail > %v", err)
+	}
+
+	r.lock.Lock()
+	needCleanUp := now.Sub(r.lastCleanUpTime) >= r.cleanUpInterval
+	if needCleanUp {
+		r.lastCleanUpTime = now
+	}
+	r.lock.Unlock()
+	if needCleanUp {
+		r.cleanUp(now)
+	}
+	return nil
+}
+
+// 清理超出保留时间的记录
+func (r *Recorder) cleanUp(now time.Time) {
+	deadline := now.Add(-r.retention).UnixNano()
+	count, err := r.store.DeleteIf(auditBucket, func(k, v []byte) bool {
+		timestamp, err := parseEntryKey(string(k))
+		return err != nil || timestamp < deadline
+	})
+	if err != nil {
+		logger.Error("Err=clean up audit log fail > %v", err)
+		return
+	}
+	if count > 0 {
+		logger.Info("Msg=clean up audit log|Count=%d", count)
+	}
+}
+
+// Query 查询[start, end)时间段内的记录, 返回最近的limit条, 按时间倒序
+func (r *Recorder) Query(req *proto.GetAuditLogReq) ([]*proto.AuditEntry, error) {
+	limit := int(req.GetLimit())
+	if limit <= 0 {
+		limit = DefaultQueryLimit
+	}
+	if limit > MaxQueryLimit {
+		limit = MaxQueryLimit
+	}
+	start := req.GetStart() * int64(time.Second)
+	end := req.GetEnd() * int64(time.Second)
+
+	// key按时间排序, 只遍历时间段内的记录
+	startKey, endKey := entryKey(time.Unix(0, start), 0), ""
+	if end > 0 {
+		endKey = entryKey(time.Unix(0, end), 0)
+	}
+	entries := []*proto.AuditEntry{}
+	err := r.store.ForEachRange(auditBucket, startKey, endKey, func(k, v []byte) error {
+		entry := &proto.AuditEntry{}
+		if err := pb.Unmarshal(v, entry); err != nil {
+			return fmt.Errorf("unmarshal audit entry[%s] fail > %v", k, err)
+		}
+		if (req.GetCaller() != "" && entry.Caller != req.GetCaller()) ||
+			(req.GetOperation() != "" && !strings.EqualFold(entry.Operation, req.GetOperation())) {
+			return nil
+		}
+		entries = append(entries, entry)
+		if len(entries) > limit {
+			entries = entries[1:]
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	for i, j := 0, len(entries)-1; i < j; i, j = i+1, j-1 {
+		entries[i], entries[j] = entries[j], entries[i]
+	}
+	return entries, nil
+}
+
+// RedactParams 将http请求参数中的密码, token等替换后转换为json
+func RedactParams(params map[string]string) string {
+	redacted := map[string]string{}
+	for k, v := range params {
+		if v != "" && isSensitive(k) {
+			v = redactedValue
+		}
+		redacted[k] = v
+	}
+	data, _ := json.Marshal(redacted)
+	return string(data)
+}
@@ -0,0 +1,102 @@
+package audit
+
+import (
+	"encoding/json"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/lureiny/v2raymg/common/store"
+	"github.com/lureiny/v2raymg/server/rpc/proto"
+	"github.com/smartystreets/goconvey/convey"
+	pb "google.golang.org/protobuf/proto"
+)
+
+func TestRedact(t *testing.T) {
+	convey.Convey("redact sensitive fields", t, func() {
+		convey.Convey("redact http params", func() {
+			params := map[string]string{}
+			json.Unmarshal([]byte(RedactParams(map[string]string{
+				"token": "t",
+				"pwd":   "p",
+				"user":  "user1",
+				"key":   "",
+			})), &params)
+			convey.So(params["token"], convey.ShouldEqual, redactedValue)
+			convey.So(params["pwd"], convey.ShouldEqual, redactedValue)
+			convey.So(params["user"], convey.ShouldEqual, "user1")
+			convey.So(params["key"], convey.ShouldEqual, "")
+		})
+
+		convey.Convey("redact rpc req", func() {
+			req := &proto.UserOpReq{
+				NodeAuthInfo: &proto.NodeAuthInfo{Token: "cluster_token"},
+				Users: []*proto.User{{
+					Name:     "user1",
+					Passwd:   "passwd1",
+					SubToken: "sub_token1",
+					Uuid:     "uuid1",
+				}},
+			}
+			params := RedactMessage(req)
+			convey.So(params, convey.ShouldContainSubstring, "user1")
+			for _, secret := range []string{"cluster_token", "passwd1", "sub_token1", "uuid1"} {
+				convey.So(params, convey.ShouldNotContainSubstring, secret)
+			}
+			// 不修改原请求
+			convey.So(req.Users[0].Passwd, convey.ShouldEqual, "passwd1")
+			convey.So(req.NodeAuthInfo.Token, convey.ShouldEqual, "cluster_token")
+		})
+	})
+}
+
+func TestRecorder(t *testing.T) {
+	convey.Convey("audit recorder", t, func() {
+		s, err := store.OpenBoltStore(filepath.Join(t.TempDir(), "audit.db"))
+		convey.So(err, convey.ShouldBeNil)
+		defer s.Close()
+		r := NewRecorder(s, "node1", 0)
+
+		for _, op := range []string{"AddUsers", "DeleteUsers", "AddUsers"} {
+			convey.So(r.Record(&proto.AuditEntry{
+				Source:    SourceRpc,
+				Caller:    "node2",
+				Operation: op,
+				Success:   true,
+				Msg:       strings.Repeat("m", maxMsgLen+10),
+			}), convey.ShouldBeNil)
+		}
+
+		entries, err := r.Query(&proto.GetAuditLogReq{})
+		convey.So(err, convey.ShouldBeNil)
+		convey.So(len(entries), convey.ShouldEqual, 3)
+		convey.So(entries[0].Operation, convey.ShouldEqual, "AddUsers")
+		convey.So(entries[1].Operation, convey.ShouldEqual, "DeleteUsers")
+		convey.So(entries[0].Node, convey.ShouldEqual, "node1")
+		convey.So(len(entries[0].Msg), convey.ShouldEqual, maxMsgLen+3)
+
+		entries, err = r.Query(&proto.GetAuditLogReq{Operation: "addusers", Limit: 1})
+		convey.So(err, convey.ShouldBeNil)
+		convey.So(len(entries), convey.ShouldEqual, 1)
+
+		entries, err = r.Query(&proto.GetAuditLogReq{Caller: "node3"})
+		convey.So(err, convey.ShouldBeNil)
+		convey.So(len(entries), convey.ShouldEqual, 0)
+
+		// 只返回[start, end)时间段内的记录
+		for i, ts := range []int64{100, 200, 300} {
+			data, err := pb.Marshal(&proto.AuditEntry{Time: ts * 1000, Operation: "GetUsers"})
+			convey.So(err, convey.ShouldBeNil)
+			convey.So(s.Put(auditBucket, entryKey(time.Unix(ts, 0), uint32(i)), data), convey.ShouldBeNil)
+		}
+		entries, err = r.Query(&proto.GetAuditLogReq{Start: 200, End: 300})
+		convey.So(err, convey.ShouldBeNil)
+		convey.So(len(entries), convey.ShouldEqual, 1)
+		convey.So(entries[0].Time, convey.ShouldEqual, 200000)
+		entries, err = r.Query(&proto.GetAuditLogReq{Start: 101, End: 301})
+		convey.So(err, convey.ShouldBeNil)
+		convey.So(len(entries), convey.ShouldEqual, 2)
+		convey.So(entries[0].Time, convey.ShouldEqual, 300000)
+	})
+}
@@ -0,0 +1,82 @@
+package audit
+
+import (
+	"strings"
+
+	"google.golang.org/protobuf/encoding/protojson"
+	pb "google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// 需要脱敏的http参数与proto字段, 不区分大小写
+var sensitiveFields = map[string]bool{
+	"token":            true,
+	"node_auth_info":   true,
+	"pwd":              true,
+	"passwd":           true,
+	"passwd_hash":      true,
+	"sub_token":        true,
+	"uuid":             true,
+	"secret":           true,
+	"key":              true,
+	"key_datas":        true,
+	"cert_data":        true,
+	"inboundinfo":      true, // 添加inbound时包含完整的inbound配置
+	"bound_raw_string": true,
+}
+
+func isSensitive(name string) bool {
+	return sensitiveFields[strings.ToLower(name)]
+}
+
+// RedactMessage 将rpc请求中的敏感字段替换后转换为json, 不会修改原请求
+func RedactMessage(m pb.Message) string {
+	if m == nil {
+		return ""
+	}
+	clone := pb.Clone(m)
+	redactMessage(clone.ProtoReflect())
+	data, err := protojson.MarshalOptions{UseProtoNames: true}.Marshal(clone)
+	if err != nil {
+		return ""
+	}
+	return string(data)
+}
+
+func redactMessage(m protoreflect.Message) {
+	// 遍历结束后再修改, 避免在Range中修改字段
+	sensitive := []protoreflect.FieldDescriptor{}
+	m.Range(func(fd protoreflect.FieldDescriptor, v protoreflect.Value) bool {
+		if isSensitive(string(fd.Name())) {
+			sensitive = append(sensitive, fd)
+			return true
+		}
+		if fd.Kind() != protoreflect.MessageKind && fd.Kind() != protoreflect.GroupKind {
+			return true
+		}
+		switch {
+		case fd.IsList():
+			list := v.List()
+			for i := 0; i < list.Len(); i++ {
+				redactMessage(list.Get(i).Message())
+			}
+		case fd.IsMap():
+			if fd.MapValue().Kind() == protoreflect.MessageKind {
+				v.Map().Range(func(_ protoreflect.MapKey, mv protoreflect.Value) bool {
+					redactMessage(mv.Message())
+					return true
+				})
+			}
+		default:
+			redactMessage(v.Message())
+		}
+		return true
+	})
+	for _, fd := range sensitive {
+		if fd.Kind() == protoreflect.StringKind && fd.Cardinality() != protoreflect.Repeated {
+			m.Set(fd, protoreflect.ValueOfString(redactedValue))
+		} else {
+			m.Clear(fd)
+		}
+	}
+}
@@ -20,6 +20,7 @@ const (
 	ConfigServerHttpPort       = "server.http.port"
 	ConfigServerHttpToken      = "server.http.token"
 	ConfigServerName           = "server.name"
+	ConfigServerLabels         = "server.labels"
 	ConfigSupportPrometheus    = "server.http.support_prometheus"
 	ConfigServerRpcPort        = "server.rpc.port"
 	ConfigServerRpcOnlyGateway = "server.rpc.only_gateway"
@@ -29,10 +30,63 @@ const (
 	ConfigClusterToken   = "cluster.token"
 	ConfigCenterNodeHost = "cluster.center_node.host"
 	ConfigCenterNodePort = "cluster.center_node.port"
+	ConfigCenterNodes    = "cluster.center_nodes"
+	ConfigCenterPeers    = "cluster.center_peers"
+	ConfigCenterToken    = "cluster.center_token"
 	ConfigClusterNodes   = "cluster.nodes"
+	// 持久化的节点超过该时长未见时过期, 默认24h
+	ConfigClusterNodeTTL = "cluster.node_ttl"
+	// 成员协议, heartbeat或gossip, 默认为heartbeat
+	ConfigClusterMembership             = "cluster.membership"
+	ConfigClusterGossipProbeInterval    = "cluster.gossip.probe_interval"
+	ConfigClusterGossipProbeTimeout     = "cluster.gossip.probe_timeout"
+	ConfigClusterGossipIndirectProbes   = "cluster.gossip.indirect_probes"
+	ConfigClusterGossipSuspicionTimeout = "cluster.gossip.suspicion_timeout"
+	// 节点间rpc仅使用AEAD编码并拒绝旧版本编码的请求, 集群全部节点升级后开启
+	ConfigClusterStrictCodec = "cluster.strict_codec"
+
+	// 节点间grpc的mTLS
+	ConfigClusterTLSMode         = "cluster.tls.mode"          // disable, migrate或enforce, 默认为disable
+	ConfigClusterTLSDir          = "cluster.tls.dir"           // 集群CA与节点证书所在目录, 默认为./cluster_tls
+	ConfigClusterTLSIssuer       = "cluster.tls.issuer"        // 持有CA私钥的节点地址, host:port, 缺少节点证书时向该节点申请
+	ConfigClusterTLSCertValidity = "cluster.tls.cert_validity" // 签发的节点证书有效期, 默认为8760h
+
+	// reconcile
+	ConfigReconcileSource    = "cluster.reconcile.source"     // 作为期望状态的节点
+	ConfigReconcileStateFile = "cluster.reconcile.state_file" // 作为期望状态的用户文件, 格式与/exportUsers导出的json一致, 优先于source
+	ConfigReconcileInterval  = "cluster.reconcile.interval"   // 检查周期, 单位秒, 0表示不检查
+	ConfigReconcileRepair    = "cluster.reconcile.repair"
+	ConfigReconcilePrune     = "cluster.reconcile.prune"
 
 	// user
 	ConfigUsers = "users"
+	ConfigPlans = "plans"
+	// 速度档位, 对应xray/v2ray的policy level
+	ConfigSpeedTiers = "speed_tiers"
+
+	// trial
+	ConfigTrialNamePrefix = "trial.name_prefix"
+	ConfigTrialDuration   = "trial.duration"
+	ConfigTrialQuota      = "trial.quota"
+	ConfigTrialTags       = "trial.tags"
+	ConfigTrialSubUrl     = "trial.sub_url"     // 生成订阅地址使用的http地址, 为空时使用请求的地址
+	ConfigTrialRateLimit  = "trial.rate_limit"  // 每个调用方在rate_window内最多创建的试用用户数
+	ConfigTrialRateWindow = "trial.rate_window" // 限流的时间窗口
+	// 反向代理写入的客户端地址请求头, 例如X-Real-Ip, 为空时使用tcp连接的来源地址
+	ConfigTrialTrustedProxyHeader = "trial.trusted_proxy_header"
+	ConfigTrialTrustedProxies     = "trial.trusted_proxies" // 只信任来自这些地址的请求头, ip或者cidr
+
+	// webhook
+	ConfigWebhookEndpoints       = "webhook.endpoints"
+	ConfigWebhookExpireBefore    = "webhook.expire_before"    // 过期前的通知时长列表, 默认为168h, 24h
+	ConfigWebhookQuotaThresholds = "webhook.quota_thresholds" // 配额使用百分比列表, 默认为80, 100
+	ConfigWebhookMaxRetry        = "webhook.max_retry"        // 发送失败时的重试次数, 默认为3
+
+	// store
+	ConfigStorePath                   = "store.path"
+	ConfigStoreTrafficHourlyRetention = "store.traffic_hourly_retention"
+	ConfigStoreTrafficDailyRetention  = "store.traffic_daily_retention"
+	ConfigStoreAuditRetention         = "store.audit_retention"
 
 	// cert
 	ConfigCertEmail       = "cert.email"
@@ -49,6 +103,11 @@ const (
 	DefaultNodeType = EndNodeType
 )
 
+// store
+const (
+	DefaultStorePath = "/usr/local/etc/v2raymg/v2raymg.db"
+)
+
 // cluster
 const (
 	// node连续60s没有更新则认为无效
@@ -0,0 +1,96 @@
+package rpc
+
+import (
+	"testing"
+	"time"
+
+	"github.com/lureiny/v2raymg/server/rpc/proto"
+	"github.com/smartystreets/goconvey/convey"
+	"google.golang.org/grpc"
+)
+
+func TestAEADMessageCodec(t *testing.T) {
+	convey.Convey("aead message codec", t, func() {
+		codec := NewAEADMessageCodec("token")
+		req := &proto.NodeAuthInfo{Token: "token", Node: &proto.Node{Name: "node1"}}
+
+		convey.Convey("marshal and unmarshal", func() {
+			data, err := codec.Marshal(req)
+			convey.So(err, convey.ShouldBeNil)
+			// 相同消息每次编码结果不同
+			other, _ := codec.Marshal(req)
+			convey.So(string(data), convey.ShouldNotEqual, string(other))
+			result := &proto.NodeAuthInfo{}
+			convey.So(codec.Unmarshal(data, result), convey.ShouldBeNil)
+			convey.So(result.GetNode().GetName(), convey.ShouldEqual, "node1")
+		})
+
+		convey.Convey("reject tampered data", func() {
+			data, _ := codec.Marshal(req)
+			data[len(data)-1] ^= 0xff
+			convey.So(codec.Unmarshal(data, &proto.NodeAuthInfo{}), convey.ShouldNotBeNil)
+		})
+
+		convey.Convey("reject other token and legacy data", func() {
+			data, _ := NewAEADMessageCodec("other").Marshal(req)
+			convey.So(codec.Unmarshal(data, &proto.NodeAuthInfo{}), convey.ShouldNotBeNil)
+			legacyData, _ := NewEncryptMessageCodec("token").Marshal(req)
+			convey.So(codec.Unmarshal(legacyData, &proto.NodeAuthInfo{}), convey.ShouldNotBeNil)
+		})
+
+		convey.Convey("legacy content type", func() {
+			convey.So(IsLegacyContentType("application/grpc+encryptmessagecodec"), convey.ShouldBeTrue)
+			convey.So(IsLegacyContentType("application/grpc+encryptmessagecodecv2"), convey.ShouldBeFalse)
+		})
+	})
+}
+
+func TestCodecNegotiation(t *testing.T) {
+	convey.Convey("codec negotiation", t, func() {
+		opts := []grpc.CallOption{grpc.ForceCodec(NewEncryptMessageCodec("token"))}
+
+		convey.Convey("keep legacy codec for unknown peer", func() {
+			newOpts := upgradeCodec("unknown:1000", opts)
+			convey.So(newOpts[0].(grpc.ForceCodecCallOption).Codec.Name(), convey.ShouldEqual, legacyCodecName)
+		})
+
+		convey.Convey("upgrade codec for peer support aead", func() {
+			peerCodecVersions.Store("upgraded:1000", CodecVersionAEAD)
+			newOpts := upgradeCodec("upgraded:1000", opts)
+			convey.So(newOpts[0].(grpc.ForceCodecCallOption).Codec.Name(), convey.ShouldEqual, aeadCodecName)
+			// 原有的选项不会被修改
+			convey.So(opts[0].(grpc.ForceCodecCallOption).Codec.Name(), convey.ShouldEqual, legacyCodecName)
+		})
+
+		convey.Convey("add nonce to a copy of request", func() {
+			req := &proto.RegisterNodeReq{NodeAuthInfo: &proto.NodeAuthInfo{Token: "token"}}
+			newReq := withRequestNonce(req).(*proto.RegisterNodeReq)
+			convey.So(newReq.GetNodeAuthInfo().GetTimestamp(), convey.ShouldBeGreaterThan, 0)
+			convey.So(len(newReq.GetNodeAuthInfo().GetNonce()), convey.ShouldEqual, requestNonceLen)
+			convey.So(req.GetNodeAuthInfo().GetTimestamp(), convey.ShouldEqual, 0)
+		})
+	})
+}
+
+func TestReplayGuard(t *testing.T) {
+	convey.Convey("replay guard", t, func() {
+		guard := NewReplayGuard(time.Minute)
+		now := time.Now().UnixMilli()
+
+		convey.So(guard.Check(now, []byte("nonce1")), convey.ShouldBeNil)
+		convey.So(guard.Check(now, []byte("nonce1")), convey.ShouldNotBeNil)
+		convey.So(guard.Check(now, []byte("nonce2")), convey.ShouldBeNil)
+		convey.So(guard.Check(now-2*time.Minute.Milliseconds(), []byte("nonce3")), convey.ShouldNotBeNil)
+		convey.So(guard.Check(now+2*time.Minute.Milliseconds(), []byte("nonce4")), convey.ShouldNotBeNil)
+		convey.So(guard.Check(0, nil), convey.ShouldNotBeNil)
+	})
+}
+
+func TestLegacyRequestCount(t *testing.T) {
+	convey.Convey("count accepted legacy requests", t, func() {
+		before := GetLegacyRequestCount()
+		convey.So(RecordLegacyRequest(), convey.ShouldEqual, before+1)
+		convey.So(RecordLegacyRequest(), convey.ShouldEqual, before+2)
+		convey.So(GetLegacyRequestCount(), convey.ShouldEqual, before+2)
+	})
+}
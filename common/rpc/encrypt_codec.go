@@ -7,13 +7,18 @@ import (
 	"google.golang.org/protobuf/proto"
 )
 
+const legacyCodecName = "EncryptMessageCodec"
+
+// EncryptMessageCodec 旧版本的rpc编码, 仅用于兼容未升级的节点, 客户端与对端协商后会替换为AEADMessageCodec
 type EncryptMessageCodec struct {
-	key []byte
+	key   []byte
+	token string
 }
 
 func NewEncryptMessageCodec(token string) *EncryptMessageCodec {
 	return &EncryptMessageCodec{
-		key: GetRpcKeyByToken(token),
+		key:   GetRpcKeyByToken(token),
+		token: token,
 	}
 }
 
@@ -39,7 +44,7 @@ func (e *EncryptMessageCodec) Unmarshal(data []byte, v interface{}) error {
 }
 
 func (e *EncryptMessageCodec) Name() string {
-	return "EncryptMessageCodec"
+	return legacyCodecName
 }
 
 const rpcServerKeyLen = 32
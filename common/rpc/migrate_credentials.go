@@ -0,0 +1,66 @@
+package rpc
+
+import (
+	"bufio"
+	"context"
+	"net"
+
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/credentials/insecure"
+)
+
+// tls握手的第一个字节为handshake record type
+const tlsRecordTypeHandshake = 0x16
+
+// MigrateCredentials 迁移模式下服务端使用的凭证, 根据连接的第一个字节判断使用tls或者明文
+// 客户端握手始终使用tls, 明文连接由调用方使用insecure凭证建立
+type MigrateCredentials struct {
+	tlsCreds      credentials.TransportCredentials
+	insecureCreds credentials.TransportCredentials
+}
+
+// NewMigrateCredentials ...
+func NewMigrateCredentials(tlsCreds credentials.TransportCredentials) *MigrateCredentials {
+	return &MigrateCredentials{
+		tlsCreds:      tlsCreds,
+		insecureCreds: insecure.NewCredentials(),
+	}
+}
+
+func (c *MigrateCredentials) ClientHandshake(ctx context.Context, authority string, rawConn net.Conn) (net.Conn, credentials.AuthInfo, error) {
+	return c.tlsCreds.ClientHandshake(ctx, authority, rawConn)
+}
+
+func (c *MigrateCredentials) ServerHandshake(rawConn net.Conn) (net.Conn, credentials.AuthInfo, error) {
+	conn := &peekedConn{Conn: rawConn, reader: bufio.NewReader(rawConn)}
+	head, err := conn.reader.Peek(1)
+	if err != nil {
+		return nil, nil, err
+	}
+	if head[0] == tlsRecordTypeHandshake {
+		return c.tlsCreds.ServerHandshake(conn)
+	}
+	return c.insecureCreds.ServerHandshake(conn)
+}
+
+func (c *MigrateCredentials) Info() credentials.ProtocolInfo {
+	return c.tlsCreds.Info()
+}
+
+func (c *MigrateCredentials) Clone() credentials.TransportCredentials {
+	return NewMigrateCredentials(c.tlsCreds.Clone())
+}
+
+func (c *MigrateCredentials) OverrideServerName(serverName string) error {
+	return c.tlsCreds.OverrideServerName(serverName)
+}
+
+// 读取时优先使用已经peek的数据
+type peekedConn struct {
+	net.Conn
+	reader *bufio.Reader
+}
+
+func (c *peekedConn) Read(b []byte) (int, error) {
+	return c.reader.Read(b)
+}
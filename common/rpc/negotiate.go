@@ -0,0 +1,90 @@
+package rpc
+
+import (
+	"context"
+	"crypto/rand"
+	"strconv"
+	"sync"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// CodecVersionHeader 服务端在响应头中返回支持的最高编码版本, 客户端据此升级编码
+const CodecVersionHeader = "x-v2raymg-codec"
+
+const requestNonceLen = 16
+
+var (
+	strictCodec = false
+	// 对端地址 -> 支持的编码版本
+	peerCodecVersions sync.Map
+)
+
+// SetStrictCodec 严格模式下客户端始终使用AEADMessageCodec, 服务端拒绝旧版本编码的请求, 全部节点升级后开启
+func SetStrictCodec(strict bool) {
+	strictCodec = strict
+}
+
+// IsStrictCodec ...
+func IsStrictCodec() bool {
+	return strictCodec
+}
+
+// UnaryClientInterceptor 为请求添加时间戳与nonce, 对端支持时将旧版本编码替换为AEADMessageCodec
+func UnaryClientInterceptor(ctx context.Context, method string, req, reply interface{}, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+	opts = upgradeCodec(cc.Target(), opts)
+	if m, ok := req.(proto.Message); ok {
+		req = withRequestNonce(m)
+	}
+	header := metadata.MD{}
+	err := invoker(ctx, method, req, reply, cc, append(opts, grpc.Header(&header))...)
+	if values := header.Get(CodecVersionHeader); len(values) > 0 {
+		if version, e := strconv.Atoi(values[0]); e == nil {
+			peerCodecVersions.Store(cc.Target(), version)
+		}
+	}
+	return err
+}
+
+func upgradeCodec(target string, opts []grpc.CallOption) []grpc.CallOption {
+	version, _ := peerCodecVersions.Load(target)
+	if !strictCodec && (version == nil || version.(int) < CodecVersionAEAD) {
+		return opts
+	}
+	newOpts := make([]grpc.CallOption, 0, len(opts))
+	for _, opt := range opts {
+		if forceCodec, ok := opt.(grpc.ForceCodecCallOption); ok {
+			if legacy, ok := forceCodec.Codec.(*EncryptMessageCodec); ok {
+				opt = grpc.ForceCodec(NewAEADMessageCodec(legacy.token))
+			}
+		}
+		newOpts = append(newOpts, opt)
+	}
+	return newOpts
+}
+
+// 在node_auth_info中写入时间戳与nonce, 请求可能被并发发送到多个节点, 因此在副本上修改
+func withRequestNonce(m proto.Message) proto.Message {
+	fd := m.ProtoReflect().Descriptor().Fields().ByName("node_auth_info")
+	if fd == nil || !m.ProtoReflect().Has(fd) {
+		return m
+	}
+	nonce := make([]byte, requestNonceLen)
+	if _, err := rand.Read(nonce); err != nil {
+		return m
+	}
+	clone := proto.Clone(m)
+	authInfo := clone.ProtoReflect().Mutable(fd).Message()
+	fields := authInfo.Descriptor().Fields()
+	timestampField, nonceField := fields.ByName("timestamp"), fields.ByName("nonce")
+	if timestampField == nil || nonceField == nil {
+		return m
+	}
+	authInfo.Set(timestampField, protoreflect.ValueOfInt64(time.Now().UnixMilli()))
+	authInfo.Set(nonceField, protoreflect.ValueOfBytes(nonce))
+	return clone
+}
@@ -0,0 +1,69 @@
+package rpc
+
+import (
+	"encoding/hex"
+	"fmt"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+// DefaultReplayWindow 请求时间与本地时间允许的最大偏差, 超出时拒绝请求, 窗口内使用nonce去重
+const DefaultReplayWindow = 5 * time.Minute
+
+// 非严格模式下放行的不带时间戳的旧版本请求数
+var legacyRequestCount int64 = 0
+
+// RecordLegacyRequest 记录一次放行的旧版本请求, 返回累计次数
+func RecordLegacyRequest() int64 {
+	return atomic.AddInt64(&legacyRequestCount, 1)
+}
+
+// GetLegacyRequestCount ...
+func GetLegacyRequestCount() int64 {
+	return atomic.LoadInt64(&legacyRequestCount)
+}
+
+// ReplayGuard 基于时间戳与nonce的重放检查
+type ReplayGuard struct {
+	window    time.Duration
+	lock      sync.Mutex
+	nonces    map[string]int64 // nonce -> 请求时间, 毫秒
+	lastPrune int64
+}
+
+// NewReplayGuard ...
+func NewReplayGuard(window time.Duration) *ReplayGuard {
+	return &ReplayGuard{
+		window: window,
+		nonces: map[string]int64{},
+	}
+}
+
+// Check 检查请求是否在时间窗口内且nonce没有出现过, timestamp单位为毫秒
+func (g *ReplayGuard) Check(timestamp int64, nonce []byte) error {
+	if timestamp == 0 || len(nonce) == 0 {
+		return fmt.Errorf("missing request timestamp or nonce")
+	}
+	now := time.Now().UnixMilli()
+	window := g.window.Milliseconds()
+	if timestamp < now-window || timestamp > now+window {
+		return fmt.Errorf("request timestamp %d is out of window", timestamp)
+	}
+	key := hex.EncodeToString(nonce)
+	g.lock.Lock()
+	defer g.lock.Unlock()
+	if now-g.lastPrune > window {
+		for k, t := range g.nonces {
+			if t < now-window {
+				delete(g.nonces, k)
+			}
+		}
+		g.lastPrune = now
+	}
+	if _, ok := g.nonces[key]; ok {
+		return fmt.Errorf("replayed request")
+	}
+	g.nonces[key] = timestamp
+	return nil
+}
@@ -0,0 +1,251 @@
+package rpc
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/hex"
+	"encoding/pem"
+	"fmt"
+	"math/big"
+	"net"
+	"time"
+
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/peer"
+)
+
+const (
+	// 集群内节点间的mTLS模式
+	TLSModeDisable = "disable" // 不使用tls, 与旧版本一致
+	TLSModeMigrate = "migrate" // 服务端同时接受tls与明文连接, 客户端优先使用tls, 用于滚动升级
+	TLSModeEnforce = "enforce" // 仅使用tls, 并校验对端证书
+
+	// 节点证书中统一包含的域名, 客户端使用该名称校验服务端证书, 节点的地址可以变化
+	ClusterTLSServerName = "v2raymg.cluster"
+
+	DefaultCAValidity   = 10 * 365 * 24 * time.Hour
+	DefaultCertValidity = 365 * 24 * time.Hour
+)
+
+// NewClusterCA 创建集群CA, 返回pem格式的证书与私钥
+func NewClusterCA(clusterName string, validity time.Duration) ([]byte, []byte, error) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		return nil, nil, fmt.Errorf("generate ca key fail > %v", err)
+	}
+	serial, err := newSerialNumber()
+	if err != nil {
+		return nil, nil, err
+	}
+	now := time.Now()
+	template := &x509.Certificate{
+		SerialNumber:          serial,
+		Subject:               pkix.Name{CommonName: fmt.Sprintf("v2raymg cluster ca %s", clusterName)},
+		NotBefore:             now.Add(-time.Hour),
+		NotAfter:              now.Add(validity),
+		KeyUsage:              x509.KeyUsageCertSign | x509.KeyUsageCRLSign,
+		BasicConstraintsValid: true,
+		IsCA:                  true,
+		MaxPathLenZero:        true,
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	if err != nil {
+		return nil, nil, fmt.Errorf("create ca cert fail > %v", err)
+	}
+	keyPEM, err := encodeECKey(key)
+	if err != nil {
+		return nil, nil, err
+	}
+	return pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}), keyPEM, nil
+}
+
+// NewNodeCSR 生成节点的私钥与证书请求, hosts为节点的地址, 可以为空
+func NewNodeCSR(nodeName string, hosts []string) ([]byte, []byte, error) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		return nil, nil, fmt.Errorf("generate node key fail > %v", err)
+	}
+	template := &x509.CertificateRequest{Subject: pkix.Name{CommonName: nodeName}}
+	for _, host := range hosts {
+		if ip := net.ParseIP(host); ip != nil {
+			template.IPAddresses = append(template.IPAddresses, ip)
+		} else if host != "" {
+			template.DNSNames = append(template.DNSNames, host)
+		}
+	}
+	der, err := x509.CreateCertificateRequest(rand.Reader, template, key)
+	if err != nil {
+		return nil, nil, fmt.Errorf("create csr fail > %v", err)
+	}
+	keyPEM, err := encodeECKey(key)
+	if err != nil {
+		return nil, nil, err
+	}
+	return pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE REQUEST", Bytes: der}), keyPEM, nil
+}
+
+// SignNodeCSR 使用集群CA签发节点证书, 证书同时用于服务端与客户端认证, csr中的节点名称需要与nodeName一致
+func SignNodeCSR(caCertPEM, caKeyPEM, csrPEM []byte, nodeName string, validity time.Duration) ([]byte, error) {
+	caCert, err := parseCert(caCertPEM)
+	if err != nil {
+		return nil, fmt.Errorf("parse ca cert fail > %v", err)
+	}
+	caKey, err := parseECKey(caKeyPEM)
+	if err != nil {
+		return nil, fmt.Errorf("parse ca key fail > %v", err)
+	}
+	csr, err := parseCSR(csrPEM)
+	if err != nil {
+		return nil, err
+	}
+	if csr.Subject.CommonName == "" {
+		return nil, fmt.Errorf("empty node name in csr")
+	}
+	if csr.Subject.CommonName != nodeName {
+		return nil, fmt.Errorf("node name[%s] in csr mismatch with request node[%s]", csr.Subject.CommonName, nodeName)
+	}
+	serial, err := newSerialNumber()
+	if err != nil {
+		return nil, err
+	}
+	now := time.Now()
+	notAfter := now.Add(validity)
+	if notAfter.After(caCert.NotAfter) {
+		notAfter = caCert.NotAfter
+	}
+	template := &x509.Certificate{
+		SerialNumber: serial,
+		Subject:      pkix.Name{CommonName: csr.Subject.CommonName},
+		NotBefore:    now.Add(-time.Hour),
+		NotAfter:     notAfter,
+		KeyUsage:     x509.KeyUsageDigitalSignature,
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth, x509.ExtKeyUsageClientAuth},
+		DNSNames:     append([]string{ClusterTLSServerName}, csr.DNSNames...),
+		IPAddresses:  csr.IPAddresses,
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, caCert, csr.PublicKey, caKey)
+	if err != nil {
+		return nil, fmt.Errorf("sign node cert fail > %v", err)
+	}
+	return pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}), nil
+}
+
+// CSRKeyFingerprint csr中公钥的sha256指纹, 用于绑定节点名称与节点私钥
+func CSRKeyFingerprint(csrPEM []byte) (string, error) {
+	csr, err := parseCSR(csrPEM)
+	if err != nil {
+		return "", err
+	}
+	der, err := x509.MarshalPKIXPublicKey(csr.PublicKey)
+	if err != nil {
+		return "", fmt.Errorf("marshal csr public key fail > %v", err)
+	}
+	sum := sha256.Sum256(der)
+	return hex.EncodeToString(sum[:]), nil
+}
+
+// PeerCommonName 对端客户端证书中的节点名称, 明文连接时返回false
+func PeerCommonName(ctx context.Context) (string, bool) {
+	p, ok := peer.FromContext(ctx)
+	if !ok {
+		return "", false
+	}
+	tlsInfo, ok := p.AuthInfo.(credentials.TLSInfo)
+	if !ok || len(tlsInfo.State.PeerCertificates) == 0 {
+		return "", false
+	}
+	return tlsInfo.State.PeerCertificates[0].Subject.CommonName, true
+}
+
+func parseCSR(csrPEM []byte) (*x509.CertificateRequest, error) {
+	block, _ := pem.Decode(csrPEM)
+	if block == nil || block.Type != "CERTIFICATE REQUEST" {
+		return nil, fmt.Errorf("invalid csr")
+	}
+	csr, err := x509.ParseCertificateRequest(block.Bytes)
+	if err != nil {
+		return nil, fmt.Errorf("parse csr fail > %v", err)
+	}
+	if err := csr.CheckSignature(); err != nil {
+		return nil, fmt.Errorf("check csr signature fail > %v", err)
+	}
+	return csr, nil
+}
+
+// NewServerTLSConfig 服务端配置, 要求客户端提供由集群CA签发的证书
+func NewServerTLSConfig(caCertPEM, certPEM, keyPEM []byte) (*tls.Config, error) {
+	pool, cert, err := loadKeyPairWithCA(caCertPEM, certPEM, keyPEM)
+	if err != nil {
+		return nil, err
+	}
+	return &tls.Config{
+		Certificates: []tls.Certificate{cert},
+		ClientCAs:    pool,
+		ClientAuth:   tls.RequireAndVerifyClientCert,
+		MinVersion:   tls.VersionTLS12,
+	}, nil
+}
+
+// NewClientTLSConfig 客户端配置, 使用集群CA校验服务端证书
+func NewClientTLSConfig(caCertPEM, certPEM, keyPEM []byte) (*tls.Config, error) {
+	pool, cert, err := loadKeyPairWithCA(caCertPEM, certPEM, keyPEM)
+	if err != nil {
+		return nil, err
+	}
+	return &tls.Config{
+		Certificates: []tls.Certificate{cert},
+		RootCAs:      pool,
+		ServerName:   ClusterTLSServerName,
+		MinVersion:   tls.VersionTLS12,
+	}, nil
+}
+
+func loadKeyPairWithCA(caCertPEM, certPEM, keyPEM []byte) (*x509.CertPool, tls.Certificate, error) {
+	pool := x509.NewCertPool()
+	if !pool.AppendCertsFromPEM(caCertPEM) {
+		return nil, tls.Certificate{}, fmt.Errorf("invalid ca cert")
+	}
+	cert, err := tls.X509KeyPair(certPEM, keyPEM)
+	if err != nil {
+		return nil, tls.Certificate{}, fmt.Errorf("load node cert fail > %v", err)
+	}
+	return pool, cert, nil
+}
+
+func newSerialNumber() (*big.Int, error) {
+	serial, err := rand.Int(rand.Reader, new(big.Int).Lsh(big.NewInt(1), 128))
+	if err != nil {
+		return nil, fmt.Errorf("generate serial number fail > %v", err)
+	}
+	return serial, nil
+}
+
+func encodeECKey(key *ecdsa.PrivateKey) ([]byte, error) {
+	der, err := x509.MarshalECPrivateKey(key)
+	if err != nil {
+		return nil, fmt.Errorf("marshal key fail > %v", err)
+	}
+	return pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: der}), nil
+}
+
+func parseECKey(keyPEM []byte) (*ecdsa.PrivateKey, error) {
+	block, _ := pem.Decode(keyPEM)
+	if block == nil {
+		return nil, fmt.Errorf("invalid key pem")
+	}
+	return x509.ParseECPrivateKey(block.Bytes)
+}
+
+func parseCert(certPEM []byte) (*x509.Certificate, error) {
+	block, _ := pem.Decode(certPEM)
+	if block == nil {
+		return nil, fmt.Errorf("invalid cert pem")
+	}
+	return x509.ParseCertificate(block.Bytes)
+}
@@ -0,0 +1,184 @@
+package rpc
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/pem"
+	"io"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/smartystreets/goconvey/convey"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/peer"
+)
+
+type nodeCert struct {
+	caCert, cert, key []byte
+}
+
+func issueTestNodeCert(caCert, caKey []byte, nodeName string) (*nodeCert, error) {
+	csr, key, err := NewNodeCSR(nodeName, []string{"127.0.0.1", "node.example.com"})
+	if err != nil {
+		return nil, err
+	}
+	cert, err := SignNodeCSR(caCert, caKey, csr, nodeName, time.Hour)
+	if err != nil {
+		return nil, err
+	}
+	return &nodeCert{caCert: caCert, cert: cert, key: key}, nil
+}
+
+// 通过本地tcp连接完成一次tls握手, 返回双方的错误
+func tlsHandshake(server, client *nodeCert) (error, error) {
+	serverConfig, err := NewServerTLSConfig(server.caCert, server.cert, server.key)
+	if err != nil {
+		return err, nil
+	}
+	clientConfig, err := NewClientTLSConfig(client.caCert, client.cert, client.key)
+	if err != nil {
+		return nil, err
+	}
+	lis, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		return err, nil
+	}
+	defer lis.Close()
+	serverErr := make(chan error, 1)
+	go func() {
+		conn, err := lis.Accept()
+		if err != nil {
+			serverErr <- err
+			return
+		}
+		defer conn.Close()
+		serverErr <- tls.Server(conn, serverConfig).Handshake()
+	}()
+	conn, err := net.Dial("tcp", lis.Addr().String())
+	if err != nil {
+		return nil, err
+	}
+	defer conn.Close()
+	clientErr := tls.Client(conn, clientConfig).Handshake()
+	return <-serverErr, clientErr
+}
+
+func TestClusterCert(t *testing.T) {
+	convey.Convey("cluster ca and node cert", t, func() {
+		caCert, caKey, err := NewClusterCA("test", time.Hour*24)
+		convey.So(err, convey.ShouldBeNil)
+
+		convey.Convey("issue node cert", func() {
+			node, err := issueTestNodeCert(caCert, caKey, "node1")
+			convey.So(err, convey.ShouldBeNil)
+			block, _ := pem.Decode(node.cert)
+			cert, err := x509.ParseCertificate(block.Bytes)
+			convey.So(err, convey.ShouldBeNil)
+			convey.So(cert.Subject.CommonName, convey.ShouldEqual, "node1")
+			convey.So(cert.DNSNames, convey.ShouldContain, ClusterTLSServerName)
+			convey.So(cert.DNSNames, convey.ShouldContain, "node.example.com")
+			convey.So(len(cert.IPAddresses), convey.ShouldEqual, 1)
+			// 证书有效期不超过CA
+			convey.So(cert.NotAfter.After(time.Now().Add(time.Hour*2)), convey.ShouldBeFalse)
+		})
+
+		convey.Convey("reject invalid csr", func() {
+			_, err := SignNodeCSR(caCert, caKey, []byte("invalid"), "node1", time.Hour)
+			convey.So(err, convey.ShouldNotBeNil)
+		})
+
+		convey.Convey("reject csr of other node", func() {
+			csr, _, err := NewNodeCSR("node2", []string{"127.0.0.1"})
+			convey.So(err, convey.ShouldBeNil)
+			_, err = SignNodeCSR(caCert, caKey, csr, "node1", time.Hour)
+			convey.So(err, convey.ShouldNotBeNil)
+			_, err = SignNodeCSR(caCert, caKey, csr, "", time.Hour)
+			convey.So(err, convey.ShouldNotBeNil)
+		})
+
+		convey.Convey("mutual tls handshake", func() {
+			server, err := issueTestNodeCert(caCert, caKey, "server")
+			convey.So(err, convey.ShouldBeNil)
+			client, err := issueTestNodeCert(caCert, caKey, "client")
+			convey.So(err, convey.ShouldBeNil)
+			serverErr, clientErr := tlsHandshake(server, client)
+			convey.So(serverErr, convey.ShouldBeNil)
+			convey.So(clientErr, convey.ShouldBeNil)
+		})
+
+		convey.Convey("reject client cert from other ca", func() {
+			server, err := issueTestNodeCert(caCert, caKey, "server")
+			convey.So(err, convey.ShouldBeNil)
+			otherCACert, otherCAKey, err := NewClusterCA("other", time.Hour*24)
+			convey.So(err, convey.ShouldBeNil)
+			client, err := issueTestNodeCert(otherCACert, otherCAKey, "client")
+			convey.So(err, convey.ShouldBeNil)
+			// 客户端信任服务端的CA, 服务端校验客户端证书失败
+			client.caCert = caCert
+			serverErr, _ := tlsHandshake(server, client)
+			convey.So(serverErr, convey.ShouldNotBeNil)
+		})
+	})
+}
+
+func TestMigrateCredentials(t *testing.T) {
+	convey.Convey("migrate credentials accept plaintext", t, func() {
+		caCert, caKey, err := NewClusterCA("test", time.Hour*24)
+		convey.So(err, convey.ShouldBeNil)
+		server, err := issueTestNodeCert(caCert, caKey, "server")
+		convey.So(err, convey.ShouldBeNil)
+		serverConfig, err := NewServerTLSConfig(server.caCert, server.cert, server.key)
+		convey.So(err, convey.ShouldBeNil)
+		creds := NewMigrateCredentials(credentials.NewTLS(serverConfig))
+
+		serverConn, clientConn := net.Pipe()
+		defer serverConn.Close()
+		defer clientConn.Close()
+		preface := []byte("PRI * HTTP/2.0\r\n\r\nSM\r\n\r\n")
+		go clientConn.Write(preface)
+
+		conn, authInfo, err := creds.ServerHandshake(serverConn)
+		convey.So(err, convey.ShouldBeNil)
+		convey.So(authInfo.AuthType(), convey.ShouldEqual, "insecure")
+		// 已经peek的数据不会丢失
+		data := make([]byte, len(preface))
+		_, err = io.ReadFull(conn, data)
+		convey.So(err, convey.ShouldBeNil)
+		convey.So(string(data), convey.ShouldEqual, string(preface))
+	})
+}
+
+func TestPeerCommonName(t *testing.T) {
+	convey.Convey("peer common name", t, func() {
+		caCert, caKey, err := NewClusterCA("test", time.Hour)
+		convey.So(err, convey.ShouldBeNil)
+		node, err := issueTestNodeCert(caCert, caKey, "node1")
+		convey.So(err, convey.ShouldBeNil)
+		block, _ := pem.Decode(node.cert)
+		cert, err := x509.ParseCertificate(block.Bytes)
+		convey.So(err, convey.ShouldBeNil)
+
+		_, ok := PeerCommonName(context.Background())
+		convey.So(ok, convey.ShouldBeFalse)
+		// 明文连接没有对端证书
+		_, ok = PeerCommonName(peer.NewContext(context.Background(), &peer.Peer{}))
+		convey.So(ok, convey.ShouldBeFalse)
+		ctx := peer.NewContext(context.Background(), &peer.Peer{
+			AuthInfo: credentials.TLSInfo{State: tls.ConnectionState{PeerCertificates: []*x509.Certificate{cert}}},
+		})
+		name, ok := PeerCommonName(ctx)
+		convey.So(ok, convey.ShouldBeTrue)
+		convey.So(name, convey.ShouldEqual, "node1")
+
+		// 同一csr的公钥指纹一致
+		csr, _, err := NewNodeCSR("node1", nil)
+		convey.So(err, convey.ShouldBeNil)
+		fp1, err := CSRKeyFingerprint(csr)
+		convey.So(err, convey.ShouldBeNil)
+		fp2, err := CSRKeyFingerprint(csr)
+		convey.So(err, convey.ShouldBeNil)
+		convey.So(fp1, convey.ShouldEqual, fp2)
+	})
+}
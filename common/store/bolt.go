@@ -0,0 +1,185 @@
+package store
+
+import (
+	"bytes"
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+
+	"go.etcd.io/bbolt"
+)
+
+// BoltStore 基于bbolt的嵌入式kv存储, 按bucket区分不同类型的数据
+type BoltStore struct {
+	db *bbolt.DB
+}
+
+// OpenBoltStore 打开或创建数据库文件
+func OpenBoltStore(path string) (*BoltStore, error) {
+	if err := os.MkdirAll(filepath.Dir(path), os.ModePerm); err != nil {
+		return nil, fmt.Errorf("create store dir fail > %v", err)
+	}
+	db, err := bbolt.Open(path, 0600, &bbolt.Options{Timeout: 3 * time.Second})
+	if err != nil {
+		return nil, fmt.Errorf("open store[%s] fail > %v", path, err)
+	}
+	return &BoltStore{db: db}, nil
+}
+
+// Put 写入单个key
+func (s *BoltStore) Put(bucket, key string, value []byte) error {
+	return s.PutBatch(bucket, map[string][]byte{key: value})
+}
+
+// PutBatch 在同一个事务中写入多个key
+func (s *BoltStore) PutBatch(bucket string, kvs map[string][]byte) error {
+	return s.db.Update(func(tx *bbolt.Tx) error {
+		b, err := tx.CreateBucketIfNotExists([]byte(bucket))
+		if err != nil {
+			return err
+		}
+		for k, v := range kvs {
+			if err := b.Put([]byte(k), v); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+// ModifyBatch 在同一个事务中读取并修改多个key, fn的old为nil表示key不存在, 返回nil时删除该key
+func (s *BoltStore) ModifyBatch(bucket string, keys []string, fn func(key string, old []byte) ([]byte, error)) error {
+	return s.db.Update(func(tx *bbolt.Tx) error {
+		b, err := tx.CreateBucketIfNotExists([]byte(bucket))
+		if err != nil {
+			return err
+		}
+		for _, k := range keys {
+			v, err := fn(k, b.Get([]byte(k)))
+			if err != nil {
+				return err
+			}
+			if v == nil {
+				err = b.Delete([]byte(k))
+			} else {
+				err = b.Put([]byte(k), v)
+			}
+			if err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+// Get key不存在时返回nil
+func (s *BoltStore) Get(bucket, key string) ([]byte, error) {
+	var value []byte = nil
+	err := s.db.View(func(tx *bbolt.Tx) error {
+		b := tx.Bucket([]byte(bucket))
+		if b == nil {
+			return nil
+		}
+		if v := b.Get([]byte(key)); v != nil {
+			value = append([]byte{}, v...)
+		}
+		return nil
+	})
+	return value, err
+}
+
+// Delete 删除多个key, 不存在的key会被忽略
+func (s *BoltStore) Delete(bucket string, keys ...string) error {
+	return s.db.Update(func(tx *bbolt.Tx) error {
+		b := tx.Bucket([]byte(bucket))
+		if b == nil {
+			return nil
+		}
+		for _, k := range keys {
+			if err := b.Delete([]byte(k)); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+// DeleteIf 删除bucket中满足条件的key, 返回删除的数量
+func (s *BoltStore) DeleteIf(bucket string, fn func(k, v []byte) bool) (int, error) {
+	count := 0
+	err := s.db.Update(func(tx *bbolt.Tx) error {
+		b := tx.Bucket([]byte(bucket))
+		if b == nil {
+			return nil
+		}
+		c := b.Cursor()
+		for k, v := c.First(); k != nil; {
+			if !fn(k, v) {
+				k, v = c.Next()
+				continue
+			}
+			// 删除后直接调用Next会跳过一个key, 需要重新定位
+			key := append([]byte{}, k...)
+			if err := c.Delete(); err != nil {
+				return err
+			}
+			count++
+			k, v = c.Seek(key)
+		}
+		return nil
+	})
+	return count, err
+}
+
+// ForEach 遍历bucket, fn中的key/value仅在回调内有效
+func (s *BoltStore) ForEach(bucket string, fn func(k, v []byte) error) error {
+	return s.db.View(func(tx *bbolt.Tx) error {
+		b := tx.Bucket([]byte(bucket))
+		if b == nil {
+			return nil
+		}
+		return b.ForEach(fn)
+	})
+}
+
+// ForEachPrefix 遍历bucket中指定前缀的key, 按key排序
+func (s *BoltStore) ForEachPrefix(bucket, prefix string, fn func(k, v []byte) error) error {
+	return s.db.View(func(tx *bbolt.Tx) error {
+		b := tx.Bucket([]byte(bucket))
+		if b == nil {
+			return nil
+		}
+		c := b.Cursor()
+		p := []byte(prefix)
+		for k, v := c.Seek(p); k != nil && bytes.HasPrefix(k, p); k, v = c.Next() {
+			if err := fn(k, v); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+// ForEachRange 按key排序遍历bucket中[start, end)范围内的key, end为空时遍历到最后
+func (s *BoltStore) ForEachRange(bucket, start, end string, fn func(k, v []byte) error) error {
+	return s.db.View(func(tx *bbolt.Tx) error {
+		b := tx.Bucket([]byte(bucket))
+		if b == nil {
+			return nil
+		}
+		c := b.Cursor()
+		e := []byte(end)
+		for k, v := c.Seek([]byte(start)); k != nil && (end == "" || bytes.Compare(k, e) < 0); k, v = c.Next() {
+			if err := fn(k, v); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+// Close ...
+func (s *BoltStore) Close() error {
+	return s.db.Close()
+}
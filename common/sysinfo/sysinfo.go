@@ -0,0 +1,200 @@
+package sysinfo
+
+import (
+	"bufio"
+	"bytes"
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	procStatFile    = "/proc/stat"
+	procMemInfoFile = "/proc/meminfo"
+	procNetDevFile  = "/proc/net/dev"
+	procLoadAvgFile = "/proc/loadavg"
+)
+
+var processStartTime = time.Now()
+
+// Uptime 当前进程的运行时长
+func Uptime() time.Duration {
+	return time.Since(processStartTime)
+}
+
+// Stats 系统资源使用情况, cpu与网络速率为两次采样之间的平均值
+type Stats struct {
+	CPUPercent float64
+	MemTotal   uint64
+	MemUsed    uint64
+	NetRxRate  uint64
+	NetTxRate  uint64
+	Load1      float64
+}
+
+type cpuTimes struct {
+	idle  uint64
+	total uint64
+}
+
+type netBytes struct {
+	rx uint64
+	tx uint64
+}
+
+// Sampler 从/proc中读取系统资源使用情况, 保存上一次的采样用于计算速率
+type Sampler struct {
+	lock       sync.Mutex
+	lastCPU    *cpuTimes
+	lastNet    *netBytes
+	lastSample time.Time
+}
+
+// NewSampler ...
+func NewSampler() *Sampler {
+	return &Sampler{}
+}
+
+// Sample 采集一次资源使用情况, 首次采样时cpu与网络速率为0
+func (s *Sampler) Sample() (*Stats, error) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	stats := &Stats{}
+	now := time.Now()
+
+	data, err := os.ReadFile(procStatFile)
+	if err != nil {
+		return nil, fmt.Errorf("read %s fail > %v", procStatFile, err)
+	}
+	cpu, err := parseCPUTimes(data)
+	if err != nil {
+		return nil, err
+	}
+	if s.lastCPU != nil && cpu.total > s.lastCPU.total {
+		totalDelta := cpu.total - s.lastCPU.total
+		idleDelta := cpu.idle - s.lastCPU.idle
+		stats.CPUPercent = float64(totalDelta-idleDelta) / float64(totalDelta) * 100
+	}
+	s.lastCPU = cpu
+
+	if data, err = os.ReadFile(procMemInfoFile); err != nil {
+		return nil, fmt.Errorf("read %s fail > %v", procMemInfoFile, err)
+	}
+	if stats.MemTotal, stats.MemUsed, err = parseMemInfo(data); err != nil {
+		return nil, err
+	}
+
+	if data, err = os.ReadFile(procNetDevFile); err != nil {
+		return nil, fmt.Errorf("read %s fail > %v", procNetDevFile, err)
+	}
+	net, err := parseNetDev(data)
+	if err != nil {
+		return nil, err
+	}
+	if seconds := now.Sub(s.lastSample).Seconds(); s.lastNet != nil && seconds > 0 {
+		// 网卡计数可能因重置变小, 此时忽略本次速率
+		if net.rx >= s.lastNet.rx && net.tx >= s.lastNet.tx {
+			stats.NetRxRate = uint64(float64(net.rx-s.lastNet.rx) / seconds)
+			stats.NetTxRate = uint64(float64(net.tx-s.lastNet.tx) / seconds)
+		}
+	}
+	s.lastNet = net
+	s.lastSample = now
+
+	// loadavg读取失败不影响其他数据
+	if data, err = os.ReadFile(procLoadAvgFile); err == nil {
+		if fields := strings.Fields(string(data)); len(fields) > 0 {
+			stats.Load1, _ = strconv.ParseFloat(fields[0], 64)
+		}
+	}
+	return stats, nil
+}
+
+// 解析/proc/stat中汇总的cpu行
+func parseCPUTimes(data []byte) (*cpuTimes, error) {
+	scanner := bufio.NewScanner(bytes.NewReader(data))
+	for scanner.Scan() {
+		fields := strings.Fields(scanner.Text())
+		if len(fields) < 5 || fields[0] != "cpu" {
+			continue
+		}
+		times := &cpuTimes{}
+		for i, field := range fields[1:] {
+			value, err := strconv.ParseUint(field, 10, 64)
+			if err != nil {
+				return nil, fmt.Errorf("invalid cpu time %s > %v", field, err)
+			}
+			times.total += value
+			// idle与iowait
+			if i == 3 || i == 4 {
+				times.idle += value
+			}
+		}
+		return times, nil
+	}
+	return nil, fmt.Errorf("not found cpu line in %s", procStatFile)
+}
+
+// 解析/proc/meminfo, 返回总内存与已使用内存, 单位字节
+func parseMemInfo(data []byte) (uint64, uint64, error) {
+	values := map[string]uint64{}
+	scanner := bufio.NewScanner(bytes.NewReader(data))
+	for scanner.Scan() {
+		fields := strings.Fields(scanner.Text())
+		if len(fields) < 2 {
+			continue
+		}
+		value, err := strconv.ParseUint(fields[1], 10, 64)
+		if err != nil {
+			continue
+		}
+		values[strings.TrimSuffix(fields[0], ":")] = value * 1024
+	}
+	total, ok := values["MemTotal"]
+	if !ok {
+		return 0, 0, fmt.Errorf("not found MemTotal in %s", procMemInfoFile)
+	}
+	available, ok := values["MemAvailable"]
+	if !ok {
+		// 旧内核没有MemAvailable
+		available = values["MemFree"] + values["Buffers"] + values["Cached"]
+	}
+	if available > total {
+		available = total
+	}
+	return total, total - available, nil
+}
+
+// 解析/proc/net/dev, 返回除lo外全部网卡的收发字节数之和
+func parseNetDev(data []byte) (*netBytes, error) {
+	result := &netBytes{}
+	scanner := bufio.NewScanner(bytes.NewReader(data))
+	for scanner.Scan() {
+		line := scanner.Text()
+		index := strings.Index(line, ":")
+		if index == -1 {
+			continue
+		}
+		if strings.TrimSpace(line[:index]) == "lo" {
+			continue
+		}
+		fields := strings.Fields(line[index+1:])
+		if len(fields) < 9 {
+			return nil, fmt.Errorf("invalid net dev line %s", line)
+		}
+		rx, err := strconv.ParseUint(fields[0], 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid rx bytes %s > %v", fields[0], err)
+		}
+		tx, err := strconv.ParseUint(fields[8], 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid tx bytes %s > %v", fields[8], err)
+		}
+		result.rx += rx
+		result.tx += tx
+	}
+	return result, nil
+}
@@ -0,0 +1,40 @@
+package sysinfo
+
+import (
+	"testing"
+
+	"github.com/smartystreets/goconvey/convey"
+)
+
+func TestParseProc(t *testing.T) {
+	convey.Convey("parse proc files", t, func() {
+		convey.Convey("cpu times", func() {
+			data := []byte("cpu  100 0 50 800 50 0 0 0 0 0\ncpu0 100 0 50 800 50 0 0 0 0 0\n")
+			times, err := parseCPUTimes(data)
+			convey.So(err, convey.ShouldBeNil)
+			convey.So(times.total, convey.ShouldEqual, 1000)
+			convey.So(times.idle, convey.ShouldEqual, 850)
+		})
+
+		convey.Convey("mem info", func() {
+			data := []byte("MemTotal:        2048 kB\nMemFree:          512 kB\nMemAvailable:    1024 kB\n")
+			total, used, err := parseMemInfo(data)
+			convey.So(err, convey.ShouldBeNil)
+			convey.So(total, convey.ShouldEqual, 2048*1024)
+			convey.So(used, convey.ShouldEqual, 1024*1024)
+		})
+
+		convey.Convey("net dev", func() {
+			data := []byte(`Inter-|   Receive                                                |  Transmit
+ face |bytes    packets errs drop fifo frame compressed multicast|bytes    packets errs drop fifo colls carrier compressed
+    lo:  1000      10    0    0    0     0          0         0     1000      10    0    0    0     0       0          0
+  eth0:  2000      20    0    0    0     0          0         0     3000      30    0    0    0     0       0          0
+  eth1:   500       5    0    0    0     0          0         0      100       1    0    0    0     0       0          0
+`)
+			net, err := parseNetDev(data)
+			convey.So(err, convey.ShouldBeNil)
+			convey.So(net.rx, convey.ShouldEqual, 2500)
+			convey.So(net.tx, convey.ShouldEqual, 3100)
+		})
+	})
+}
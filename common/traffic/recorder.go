@@ -0,0 +1,253 @@
+package traffic
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/lureiny/v2raymg/common/log/logger"
+	"github.com/lureiny/v2raymg/common/store"
+	"github.com/lureiny/v2raymg/server/rpc/proto"
+	pb "google.golang.org/protobuf/proto"
+)
+
+// 流量统计的聚合粒度
+const (
+	GranularityHour = "hour"
+	GranularityDay  = "day"
+)
+
+// 节点总流量的统计类型, 其余类型与proxy中stats的type保持一致
+const (
+	NodeTrafficType     = "node"
+	InboundTrafficType  = "inbound"
+	OutboundTrafficType = "outbound"
+)
+
+const (
+	hourlyBucket = "traffic_hourly"
+	dailyBucket  = "traffic_daily"
+
+	DefaultHourlyRetention = 31 * 24 * time.Hour
+	DefaultDailyRetention  = 366 * 24 * time.Hour
+)
+
+// key格式为{type}/{name}/{timestamp}, timestamp补齐到固定长度, 保证同一个name的数据按时间排序
+func seriesPrefix(statType, name string) string {
+	if name == "" {
+		return statType + "/"
+	}
+	return statType + "/" + name + "/"
+}
+
+func pointKey(statType, name string, timestamp int64) string {
+	return fmt.Sprintf("%s%020d", seriesPrefix(statType, name), timestamp)
+}
+
+func parsePointKey(key string) (statType, name string, timestamp int64, err error) {
+	first := strings.Index(key, "/")
+	last := strings.LastIndex(key, "/")
+	if first < 0 || first == last {
+		return "", "", 0, fmt.Errorf("invalid traffic key %s", key)
+	}
+	timestamp, err = strconv.ParseInt(key[last+1:], 10, 64)
+	if err != nil {
+		return "", "", 0, fmt.Errorf("invalid traffic key %s > %v", key, err)
+	}
+	return key[:first], key[first+1 : last], timestamp, nil
+}
+
+// 时间段的开始时间, 按天聚合时使用本地时区, 与用户的流量重置日保持一致
+func truncate(t time.Time, granularity string) time.Time {
+	if granularity == GranularityDay {
+		t = t.In(time.Local)
+		return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.Local)
+	}
+	return t.Truncate(time.Hour)
+}
+
+func getBucket(granularity string) (string, error) {
+	switch granularity {
+	case GranularityHour:
+		return hourlyBucket, nil
+	case GranularityDay:
+		return dailyBucket, nil
+	}
+	return "", fmt.Errorf("unsupport granularity %s", granularity)
+}
+
+// 节点总流量: 有inbound统计的proxy使用inbound流量之和, 否则(例如hysteria)使用用户流量之和
+func sumNodeTraffic(stats map[string]*proto.Stats) (uplink, downlink int64) {
+	hasInbound := map[string]bool{}
+	for _, stat := range stats {
+		if stat.Type == InboundTrafficType {
+			hasInbound[stat.Proxy] = true
+		}
+	}
+	for _, stat := range stats {
+		if (hasInbound[stat.Proxy] && stat.Type == InboundTrafficType) ||
+			(!hasInbound[stat.Proxy] && stat.Type != InboundTrafficType && stat.Type != OutboundTrafficType) {
+			uplink += stat.Uplink
+			downlink += stat.Downlink
+		}
+	}
+	return
+}
+
+// Recorder 将采集到的流量按小时与天聚合后持久化, 重启后不会丢失
+type Recorder struct {
+	store           *store.BoltStore
+	source          string
+	hourlyRetention time.Duration
+	dailyRetention  time.Duration
+	lastCleanUpTime time.Time
+	cleanUpInterval time.Duration
+}
+
+func NewRecorder(s *store.BoltStore, source string, hourlyRetention, dailyRetention time.Duration) *Recorder {
+	if hourlyRetention <= 0 {
+		hourlyRetention = DefaultHourlyRetention
+	}
+	if dailyRetention <= 0 {
+		dailyRetention = DefaultDailyRetention
+	}
+	return &Recorder{
+		store:           s,
+		source:          source,
+		hourlyRetention: hourlyRetention,
+		dailyRetention:  dailyRetention,
+		cleanUpInterval: time.Hour,
+	}
+}
+
+// Record 累加一次采集的流量, stats为proxy重置计数后的增量
+func (r *Recorder) Record(stats map[string]*proto.Stats, t time.Time) error {
+	points := map[[2]string]*proto.TrafficPoint{} // key = {type, name}
+	add := func(statType, name string, uplink, downlink int64) {
+		if uplink == 0 && downlink == 0 {
+			return
+		}
+		key := [2]string{statType, name}
+		if p, ok := points[key]; ok {
+			p.Uplink += uplink
+			p.Downlink += downlink
+			return
+		}
+		points[key] = &proto.TrafficPoint{Uplink: uplink, Downlink: downlink}
+	}
+	for _, stat := range stats {
+		add(stat.Type, stat.Name, stat.Uplink, stat.Downlink)
+	}
+	uplink, downlink := sumNodeTraffic(stats)
+	add(NodeTrafficType, r.source, uplink, downlink)
+	if len(points) == 0 {
+		return nil
+	}
+
+	for _, granularity := range []string{GranularityHour, GranularityDay} {
+		bucket, _ := getBucket(granularity)
+		timestamp := truncate(t, granularity).Unix()
+		keys := []string{}
+		deltas := map[string]*proto.TrafficPoint{}
+		for k, p := range points {
+			key := pointKey(k[0], k[1], timestamp)
+			keys = append(keys, key)
+			deltas[key] = p
+		}
+		err := r.store.ModifyBatch(bucket, keys, func(key string, old []byte) ([]byte, error) {
+			point := &proto.TrafficPoint{Timestamp: timestamp}
+			if old != nil {
+				if err := pb.Unmarshal(old, point); err != nil {
+					logger.Error("Err=unmarshal traffic point fail > %v|Key=%s", err, key)
+				}
+			}
+			point.Uplink += deltas[key].Uplink
+			point.Downlink += deltas[key].Downlink
+			return pb.Marshal(point)
+		})
+		if err != nil {
+			return fmt.Errorf("save %s traffic fail > %v", granularity, err)
+		}
+	}
+
+	if t.Sub(r.lastCleanUpTime) >= r.cleanUpInterval {
+		r.lastCleanUpTime = t
+		r.cleanUp(t)
+	}
+	return nil
+}
+
+// 清理超出保留时间的数据
+func (r *Recorder) cleanUp(now time.Time) {
+	retentions := map[string]time.Duration{
+		hourlyBucket: r.hourlyRetention,
+		dailyBucket:  r.dailyRetention,
+	}
+	for bucket, retention := range retentions {
+		deadline := now.Add(-retention).Unix()
+		count, err := r.store.DeleteIf(bucket, func(k, v []byte) bool {
+			_, _, timestamp, err := parsePointKey(string(k))
+			return err != nil || timestamp < deadline
+		})
+		if err != nil {
+			logger.Error("Err=clean up traffic fail > %v|Bucket=%s", err, bucket)
+			continue
+		}
+		if count > 0 {
+			logger.Info("Msg=clean up traffic|Bucket=%s|Count=%d", bucket, count)
+		}
+	}
+}
+
+// Report 查询[start, end)时间段内的流量, name为空时返回该类型下全部name的数据, 按name排序
+func (r *Recorder) Report(req *proto.GetTrafficReportReq) ([]*proto.TrafficSeries, error) {
+	bucket, err := getBucket(req.GetGranularity())
+	if err != nil {
+		return nil, err
+	}
+	if req.GetType() == "" {
+		return nil, fmt.Errorf("empty traffic type")
+	}
+	// 开始时间对齐到时间段的开始, 保证包含start所在的时间段
+	start := truncate(time.Unix(req.GetStart(), 0), req.GetGranularity()).Unix()
+	seriesMap := map[string]*proto.TrafficSeries{}
+	err = r.store.ForEachPrefix(bucket, seriesPrefix(req.GetType(), req.GetName()), func(k, v []byte) error {
+		statType, name, timestamp, err := parsePointKey(string(k))
+		if err != nil {
+			logger.Error("Err=%v", err)
+			return nil
+		}
+		// name中可能包含"/", 指定name时前缀可能匹配到其他name
+		if (req.GetName() != "" && name != req.GetName()) ||
+			timestamp < start || (req.GetEnd() > 0 && timestamp >= req.GetEnd()) {
+			return nil
+		}
+		point := &proto.TrafficPoint{}
+		if err := pb.Unmarshal(v, point); err != nil {
+			return fmt.Errorf("unmarshal traffic point[%s] fail > %v", k, err)
+		}
+		point.Timestamp = timestamp
+		series, ok := seriesMap[name]
+		if !ok {
+			series = &proto.TrafficSeries{Name: name, Type: statType, Source: r.source}
+			seriesMap[name] = series
+		}
+		series.Points = append(series.Points, point)
+		series.Uplink += point.Uplink
+		series.Downlink += point.Downlink
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	result := []*proto.TrafficSeries{}
+	for _, series := range seriesMap {
+		result = append(result, series)
+	}
+	sort.Slice(result, func(i, j int) bool {
+		return result[i].Name < result[j].Name
+	})
+	return result, nil
+}
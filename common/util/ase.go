@@ -4,7 +4,10 @@ import (
 	"bytes"
 	"crypto/aes"
 	"crypto/cipher"
+	"crypto/rand"
 	"fmt"
+
+	"golang.org/x/crypto/scrypt"
 )
 
 // 使用PKCS7标准进行填充
@@ -55,3 +58,63 @@ func DecryptWithAES(encryptedData, key []byte) ([]byte, error) {
 	blockMode.CryptBlocks(plaintextWithPadding, encryptedData)
 	return PKCS7UnPadding(plaintextWithPadding)
 }
+
+const passphraseSaltLen = 16
+
+func passphraseKey(passphrase string, salt []byte) ([]byte, error) {
+	return scrypt.Key([]byte(passphrase), salt, 1<<15, 8, 1, 32)
+}
+
+// 使用口令加密数据, 密钥由scrypt派生, 加密算法为AES-GCM, 结果格式为salt|nonce|ciphertext
+func EncryptWithPassphrase(plaintext []byte, passphrase string) ([]byte, error) {
+	salt := make([]byte, passphraseSaltLen)
+	if _, err := rand.Read(salt); err != nil {
+		return nil, err
+	}
+	key, err := passphraseKey(passphrase, salt)
+	if err != nil {
+		return nil, err
+	}
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+	gcm, err := cipher.NewGCM(block)
+	if err != nil {
+		return nil, err
+	}
+	nonce := make([]byte, gcm.NonceSize())
+	if _, err := rand.Read(nonce); err != nil {
+		return nil, err
+	}
+	data := append(salt, nonce...)
+	return gcm.Seal(data, nonce, plaintext, nil), nil
+}
+
+// 解密EncryptWithPassphrase加密的数据
+func DecryptWithPassphrase(encryptedData []byte, passphrase string) ([]byte, error) {
+	if len(encryptedData) < passphraseSaltLen {
+		return nil, fmt.Errorf("invalid data")
+	}
+	key, err := passphraseKey(passphrase, encryptedData[:passphraseSaltLen])
+	if err != nil {
+		return nil, err
+	}
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+	gcm, err := cipher.NewGCM(block)
+	if err != nil {
+		return nil, err
+	}
+	encryptedData = encryptedData[passphraseSaltLen:]
+	if len(encryptedData) < gcm.NonceSize() {
+		return nil, fmt.Errorf("invalid data")
+	}
+	plaintext, err := gcm.Open(nil, encryptedData[:gcm.NonceSize()], encryptedData[gcm.NonceSize():], nil)
+	if err != nil {
+		return nil, fmt.Errorf("wrong key or corrupted data > %v", err)
+	}
+	return plaintext, nil
+}
@@ -0,0 +1,35 @@
+package util
+
+import (
+	"testing"
+
+	"github.com/smartystreets/goconvey/convey"
+)
+
+func TestPassphraseEncryption(t *testing.T) {
+	convey.Convey("encrypt with passphrase", t, func() {
+		plaintext := []byte(`[{"name":"u1"}]`)
+		encrypted, err := EncryptWithPassphrase(plaintext, "secret")
+		convey.So(err, convey.ShouldBeNil)
+		convey.So(string(encrypted), convey.ShouldNotContainSubstring, "u1")
+
+		decrypted, err := DecryptWithPassphrase(encrypted, "secret")
+		convey.So(err, convey.ShouldBeNil)
+		convey.So(decrypted, convey.ShouldResemble, plaintext)
+
+		// 相同数据每次加密使用不同的salt与nonce
+		another, err := EncryptWithPassphrase(plaintext, "secret")
+		convey.So(err, convey.ShouldBeNil)
+		convey.So(another, convey.ShouldNotResemble, encrypted)
+
+		_, err = DecryptWithPassphrase(encrypted, "wrong")
+		convey.So(err, convey.ShouldNotBeNil)
+
+		encrypted[len(encrypted)-1] ^= 0xff
+		_, err = DecryptWithPassphrase(encrypted, "secret")
+		convey.So(err, convey.ShouldNotBeNil)
+
+		_, err = DecryptWithPassphrase([]byte("short"), "secret")
+		convey.So(err, convey.ShouldNotBeNil)
+	})
+}
@@ -0,0 +1,24 @@
+package util
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// ParseSeconds 解析时长, 支持纯数字(秒)以及go duration格式, 例如 3600, 720h, 返回秒数
+func ParseSeconds(s string) (int64, error) {
+	s = strings.TrimSpace(s)
+	if s == "" {
+		return 0, nil
+	}
+	if seconds, err := strconv.ParseInt(s, 10, 64); err == nil {
+		return seconds, nil
+	}
+	d, err := time.ParseDuration(s)
+	if err != nil {
+		return 0, fmt.Errorf("invalid duration %s > %v", s, err)
+	}
+	return int64(d.Seconds()), nil
+}
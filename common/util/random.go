@@ -0,0 +1,15 @@
+package util
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+)
+
+// RandomToken 生成n字节的随机token, 以hex编码返回
+func RandomToken(n int) (string, error) {
+	b := make([]byte, n)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(b), nil
+}
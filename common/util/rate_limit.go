@@ -0,0 +1,73 @@
+package util
+
+import (
+	"sync"
+	"time"
+)
+
+// RateLimiter 按key统计滑动时间窗口内的请求次数
+type RateLimiter struct {
+	limit  int
+	window time.Duration
+	lock   sync.Mutex
+	hits   map[string][]time.Time
+}
+
+func NewRateLimiter(limit int, window time.Duration) *RateLimiter {
+	return &RateLimiter{
+		limit:  limit,
+		window: window,
+		hits:   map[string][]time.Time{},
+	}
+}
+
+// Allow 未超过限制时记录本次请求并返回true, limit不大于0时不限制
+func (l *RateLimiter) Allow(key string) bool {
+	return l.allow(key, time.Now())
+}
+
+// Limited 是否已经达到限制, 不记录本次请求
+func (l *RateLimiter) Limited(key string) bool {
+	return l.limited(key, time.Now())
+}
+
+func (l *RateLimiter) limited(key string, now time.Time) bool {
+	if l.limit <= 0 {
+		return false
+	}
+	l.lock.Lock()
+	defer l.lock.Unlock()
+	count := 0
+	for _, t := range l.hits[key] {
+		if now.Sub(t) < l.window {
+			count++
+		}
+	}
+	return count >= l.limit
+}
+
+func (l *RateLimiter) allow(key string, now time.Time) bool {
+	if l.limit <= 0 {
+		return true
+	}
+	l.lock.Lock()
+	defer l.lock.Unlock()
+	hits := []time.Time{}
+	for _, t := range l.hits[key] {
+		if now.Sub(t) < l.window {
+			hits = append(hits, t)
+		}
+	}
+	if len(hits) >= l.limit {
+		l.hits[key] = hits
+		return false
+	}
+	l.hits[key] = append(hits, now)
+	// 顺便清理窗口外的key, 避免map无限增长
+	for k, v := range l.hits {
+		if len(v) == 0 || now.Sub(v[len(v)-1]) >= l.window {
+			delete(l.hits, k)
+		}
+	}
+	return true
+}
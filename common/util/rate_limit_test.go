@@ -0,0 +1,70 @@
+package util
+
+import (
+	"testing"
+	"time"
+
+	"github.com/smartystreets/goconvey/convey"
+)
+
+func TestRateLimiter(t *testing.T) {
+	convey.Convey("窗口内超过限制时拒绝", t, func() {
+		l := NewRateLimiter(2, time.Minute)
+		now := time.Unix(1700000000, 0)
+		convey.So(l.allow("a", now), convey.ShouldBeTrue)
+		convey.So(l.allow("a", now.Add(time.Second)), convey.ShouldBeTrue)
+		convey.So(l.allow("a", now.Add(2*time.Second)), convey.ShouldBeFalse)
+		// 不同key分别计数
+		convey.So(l.allow("b", now.Add(2*time.Second)), convey.ShouldBeTrue)
+	})
+
+	convey.Convey("窗口过期后重新允许", t, func() {
+		l := NewRateLimiter(2, time.Minute)
+		now := time.Unix(1700000000, 0)
+		convey.So(l.allow("a", now), convey.ShouldBeTrue)
+		convey.So(l.allow("a", now.Add(30*time.Second)), convey.ShouldBeTrue)
+		convey.So(l.allow("a", now.Add(59*time.Second)), convey.ShouldBeFalse)
+		// 第一次请求移出窗口, 释放一个名额
+		convey.So(l.allow("a", now.Add(time.Minute)), convey.ShouldBeTrue)
+		convey.So(l.allow("a", now.Add(time.Minute+time.Second)), convey.ShouldBeFalse)
+		// 全部请求移出窗口
+		convey.So(l.allow("a", now.Add(3*time.Minute)), convey.ShouldBeTrue)
+		convey.So(l.allow("a", now.Add(3*time.Minute)), convey.ShouldBeTrue)
+	})
+
+	convey.Convey("被拒绝的请求不计入窗口", t, func() {
+		l := NewRateLimiter(1, time.Minute)
+		now := time.Unix(1700000000, 0)
+		convey.So(l.allow("a", now), convey.ShouldBeTrue)
+		convey.So(l.allow("a", now.Add(50*time.Second)), convey.ShouldBeFalse)
+		convey.So(l.allow("a", now.Add(time.Minute)), convey.ShouldBeTrue)
+	})
+
+	convey.Convey("清理窗口外的key", t, func() {
+		l := NewRateLimiter(1, time.Minute)
+		now := time.Unix(1700000000, 0)
+		convey.So(l.allow("a", now), convey.ShouldBeTrue)
+		convey.So(l.allow("b", now.Add(2*time.Minute)), convey.ShouldBeTrue)
+		convey.So(l.hits, convey.ShouldContainKey, "b")
+		convey.So(l.hits, convey.ShouldNotContainKey, "a")
+	})
+
+	convey.Convey("检查是否达到限制但不记录", t, func() {
+		l := NewRateLimiter(2, time.Minute)
+		now := time.Unix(1700000000, 0)
+		convey.So(l.limited("a", now), convey.ShouldBeFalse)
+		convey.So(l.allow("a", now), convey.ShouldBeTrue)
+		convey.So(l.limited("a", now), convey.ShouldBeFalse)
+		convey.So(l.allow("a", now), convey.ShouldBeTrue)
+		convey.So(l.limited("a", now), convey.ShouldBeTrue)
+		convey.So(l.limited("a", now.Add(time.Minute)), convey.ShouldBeFalse)
+		convey.So(NewRateLimiter(0, time.Minute).Limited("a"), convey.ShouldBeFalse)
+	})
+
+	convey.Convey("limit不大于0时不限制", t, func() {
+		l := NewRateLimiter(0, time.Minute)
+		for i := 0; i < 10; i++ {
+			convey.So(l.Allow("a"), convey.ShouldBeTrue)
+		}
+	})
+}
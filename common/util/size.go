@@ -0,0 +1,46 @@
+package util
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+var byteSizeUnits = []struct {
+	suffix string
+	size   int64
+}{
+	{"TB", 1 << 40},
+	{"GB", 1 << 30},
+	{"MB", 1 << 20},
+	{"KB", 1 << 10},
+	{"T", 1 << 40},
+	{"G", 1 << 30},
+	{"M", 1 << 20},
+	{"K", 1 << 10},
+	{"B", 1},
+}
+
+// ParseByteSize 解析流量大小, 支持纯数字(byte)以及带单位的格式, 例如 100GB, 512M, 1.5T
+func ParseByteSize(s string) (int64, error) {
+	s = strings.ToUpper(strings.TrimSpace(s))
+	if s == "" {
+		return 0, nil
+	}
+	var unit int64 = 1
+	for _, u := range byteSizeUnits {
+		if strings.HasSuffix(s, u.suffix) {
+			unit = u.size
+			s = strings.TrimSpace(strings.TrimSuffix(s, u.suffix))
+			break
+		}
+	}
+	if i, err := strconv.ParseInt(s, 10, 64); err == nil {
+		return i * unit, nil
+	}
+	f, err := strconv.ParseFloat(s, 64)
+	if err != nil {
+		return 0, fmt.Errorf("invalid size[%s] > %v", s, err)
+	}
+	return int64(f * float64(unit)), nil
+}
@@ -0,0 +1,206 @@
+package webhook
+
+import (
+	"bytes"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/lureiny/v2raymg/common/log/logger"
+)
+
+// 通知事件类型
+const (
+	EventUserExpiring       = "user.expiring"        // 即将过期
+	EventUserExpired        = "user.expired"         // 已过期
+	EventUserQuotaThreshold = "user.quota_threshold" // 流量使用达到配额的指定百分比
+	EventUserCleared        = "user.cleared"         // 用户被清除
+)
+
+// 请求头, 签名为hex(hmac_sha256(secret, "{timestamp}.{body}"))
+const (
+	EventHeader     = "X-V2raymg-Event"
+	DeliveryHeader  = "X-V2raymg-Delivery"
+	TimestampHeader = "X-V2raymg-Timestamp"
+	SignatureHeader = "X-V2raymg-Signature"
+)
+
+const (
+	DefaultMaxRetry      = 3
+	DefaultRetryInterval = 2 * time.Second
+	defaultTimeout       = 5 * time.Second
+)
+
+// EventUser 事件中的用户信息
+type EventUser struct {
+	Name          string   `json:"name"`
+	Tags          []string `json:"tags"`
+	ExpireTime    int64    `json:"expire_time"`
+	Quota         int64    `json:"quota"`
+	UplinkQuota   int64    `json:"uplink_quota"`
+	DownlinkQuota int64    `json:"downlink_quota"`
+	UsedUplink    int64    `json:"used_uplink"`
+	UsedDownlink  int64    `json:"used_downlink"`
+}
+
+// Event webhook请求的body, 同一个事件重试时id不变, 接收方可以用于去重
+type Event struct {
+	ID        string     `json:"id"`
+	Type      string     `json:"type"`
+	Time      int64      `json:"time"`
+	Node      string     `json:"node"`
+	User      *EventUser `json:"user"`
+	Before    int64      `json:"before,omitempty"`    // user.expiring: 提前通知的时长, 单位秒
+	Threshold int        `json:"threshold,omitempty"` // user.quota_threshold: 达到的配额百分比
+	Reason    string     `json:"reason,omitempty"`    // user.cleared: expired或deleted
+}
+
+// Endpoint webhook地址, events为空时接收全部事件
+type Endpoint struct {
+	URL    string   `mapstructure:"url"`
+	Secret string   `mapstructure:"secret"`
+	Events []string `mapstructure:"events"`
+}
+
+func (e *Endpoint) accept(eventType string) bool {
+	if len(e.Events) == 0 {
+		return true
+	}
+	for _, t := range e.Events {
+		if t == eventType {
+			return true
+		}
+	}
+	return false
+}
+
+// Sign 计算请求签名, timestamp用于接收方拒绝过期的请求
+func Sign(secret string, timestamp int64, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(strconv.FormatInt(timestamp, 10) + "."))
+	mac.Write(body)
+	return "sha256=" + hex.EncodeToString(mac.Sum(nil))
+}
+
+// NewEventID 由事件类型与关键字段派生事件id, 集群内各节点对同一事件生成相同的id, 接收方可以据此去重
+func NewEventID(eventType string, keys ...interface{}) string {
+	h := sha256.New()
+	h.Write([]byte(eventType))
+	for _, key := range keys {
+		fmt.Fprintf(h, "|%v", key)
+	}
+	return hex.EncodeToString(h.Sum(nil)[:16])
+}
+
+func newEventID() string {
+	b := make([]byte, 16)
+	if _, err := rand.Read(b); err != nil {
+		return strconv.FormatInt(time.Now().UnixNano(), 16)
+	}
+	return hex.EncodeToString(b)
+}
+
+// Notifier 异步发送事件到全部订阅了该事件的endpoint, 失败时按指数退避重试
+type Notifier struct {
+	node          string
+	endpoints     []*Endpoint
+	client        *http.Client
+	maxRetry      int
+	retryInterval time.Duration
+}
+
+func NewNotifier(node string, endpoints []*Endpoint, maxRetry int, retryInterval time.Duration) *Notifier {
+	if maxRetry < 0 {
+		maxRetry = DefaultMaxRetry
+	}
+	if retryInterval <= 0 {
+		retryInterval = DefaultRetryInterval
+	}
+	return &Notifier{
+		node:          node,
+		endpoints:     endpoints,
+		client:        &http.Client{Timeout: defaultTimeout},
+		maxRetry:      maxRetry,
+		retryInterval: retryInterval,
+	}
+}
+
+// Notify 发送事件, 不阻塞调用方, 未指定id时随机生成; notifier为nil时不做处理
+func (n *Notifier) Notify(event *Event) {
+	if n == nil {
+		return
+	}
+	if event.ID == "" {
+		event.ID = newEventID()
+	}
+	event.Time = time.Now().Unix()
+	event.Node = n.node
+	body, err := json.Marshal(event)
+	if err != nil {
+		logger.Error("Err=marshal webhook event fail > %v|Event=%s", err, event.Type)
+		return
+	}
+	for _, endpoint := range n.endpoints {
+		if endpoint.accept(event.Type) {
+			go n.deliver(endpoint, event, body)
+		}
+	}
+}
+
+func (n *Notifier) deliver(endpoint *Endpoint, event *Event, body []byte) {
+	var err error = nil
+	interval := n.retryInterval
+	for i := 0; i <= n.maxRetry; i++ {
+		if i > 0 {
+			time.Sleep(interval)
+			interval *= 2
+		}
+		if err = n.post(endpoint, event, body); err == nil {
+			return
+		}
+	}
+	userName := ""
+	if event.User != nil {
+		userName = event.User.Name
+	}
+	logger.Error(
+		"Err=deliver webhook fail > %v|Url=%s|Event=%s|User=%s|Retry=%d",
+		err,
+		endpoint.URL,
+		event.Type,
+		userName,
+		n.maxRetry,
+	)
+}
+
+func (n *Notifier) post(endpoint *Endpoint, event *Event, body []byte) error {
+	req, err := http.NewRequest("POST", endpoint.URL, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	timestamp := time.Now().Unix()
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set(EventHeader, event.Type)
+	req.Header.Set(DeliveryHeader, event.ID)
+	req.Header.Set(TimestampHeader, strconv.FormatInt(timestamp, 10))
+	if endpoint.Secret != "" {
+		req.Header.Set(SignatureHeader, Sign(endpoint.Secret, timestamp, body))
+	}
+	resp, err := n.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	io.Copy(io.Discard, resp.Body)
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return fmt.Errorf("status code %d", resp.StatusCode)
+	}
+	return nil
+}
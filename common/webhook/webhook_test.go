@@ -0,0 +1,147 @@
+package webhook
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/smartystreets/goconvey/convey"
+)
+
+const testWebhookSecret = "test_secret"
+
+type receivedEvent struct {
+	event     *Event
+	delivery  string
+	signValid bool
+}
+
+// 模拟webhook接收方, 前failTimes次请求返回500
+func newFakeReceiver(failTimes int) (*httptest.Server, chan *receivedEvent) {
+	received := make(chan *receivedEvent, 10)
+	lock := sync.Mutex{}
+	count := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		lock.Lock()
+		count++
+		fail := count <= failTimes
+		lock.Unlock()
+		if fail {
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+		body, _ := io.ReadAll(r.Body)
+		timestamp, _ := strconv.ParseInt(r.Header.Get(TimestampHeader), 10, 64)
+		event := &Event{}
+		if err := json.Unmarshal(body, event); err != nil || event.Type != r.Header.Get(EventHeader) {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		received <- &receivedEvent{
+			event:     event,
+			delivery:  r.Header.Get(DeliveryHeader),
+			signValid: r.Header.Get(SignatureHeader) == Sign(testWebhookSecret, timestamp, body),
+		}
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	return server, received
+}
+
+func waitEvent(received chan *receivedEvent) *receivedEvent {
+	select {
+	case e := <-received:
+		return e
+	case <-time.After(2 * time.Second):
+		return nil
+	}
+}
+
+func TestNotifier(t *testing.T) {
+	convey.Convey("webhook notifier", t, func() {
+		convey.Convey("deliver signed event", func() {
+			server, received := newFakeReceiver(0)
+			defer server.Close()
+			notifier := NewNotifier("node1", []*Endpoint{{URL: server.URL, Secret: testWebhookSecret}}, 0, time.Millisecond)
+			notifier.Notify(&Event{
+				Type:      EventUserQuotaThreshold,
+				User:      &EventUser{Name: "user1", Quota: 100, UsedUplink: 80},
+				Threshold: 80,
+			})
+			e := waitEvent(received)
+			convey.So(e, convey.ShouldNotBeNil)
+			convey.So(e.signValid, convey.ShouldBeTrue)
+			convey.So(e.delivery, convey.ShouldEqual, e.event.ID)
+			convey.So(e.event.Node, convey.ShouldEqual, "node1")
+			convey.So(e.event.User.Name, convey.ShouldEqual, "user1")
+			convey.So(e.event.Threshold, convey.ShouldEqual, 80)
+		})
+
+		convey.Convey("retry until success", func() {
+			server, received := newFakeReceiver(2)
+			defer server.Close()
+			notifier := NewNotifier("node1", []*Endpoint{{URL: server.URL, Secret: testWebhookSecret}}, 2, time.Millisecond)
+			notifier.Notify(&Event{Type: EventUserExpired, User: &EventUser{Name: "user1"}})
+			e := waitEvent(received)
+			convey.So(e, convey.ShouldNotBeNil)
+			convey.So(e.event.Type, convey.ShouldEqual, EventUserExpired)
+		})
+
+		convey.Convey("give up after max retry", func() {
+			server, received := newFakeReceiver(3)
+			defer server.Close()
+			notifier := NewNotifier("node1", []*Endpoint{{URL: server.URL, Secret: testWebhookSecret}}, 2, time.Millisecond)
+			notifier.Notify(&Event{Type: EventUserExpired, User: &EventUser{Name: "user1"}})
+			convey.So(waitEvent(received), convey.ShouldBeNil)
+		})
+
+		convey.Convey("filter events by endpoint", func() {
+			server, received := newFakeReceiver(0)
+			defer server.Close()
+			notifier := NewNotifier("node1", []*Endpoint{{
+				URL:    server.URL,
+				Secret: testWebhookSecret,
+				Events: []string{EventUserCleared},
+			}}, 0, time.Millisecond)
+			notifier.Notify(&Event{Type: EventUserExpiring, User: &EventUser{Name: "user1"}})
+			notifier.Notify(&Event{Type: EventUserCleared, User: &EventUser{Name: "user1"}, Reason: "expired"})
+			e := waitEvent(received)
+			convey.So(e, convey.ShouldNotBeNil)
+			convey.So(e.event.Type, convey.ShouldEqual, EventUserCleared)
+			convey.So(e.event.Reason, convey.ShouldEqual, "expired")
+			convey.So(waitEvent(received), convey.ShouldBeNil)
+		})
+
+		convey.Convey("keep derived event id", func() {
+			server, received := newFakeReceiver(0)
+			defer server.Close()
+			notifier := NewNotifier("node1", []*Endpoint{{URL: server.URL, Secret: testWebhookSecret}}, 0, time.Millisecond)
+			id := NewEventID(EventUserExpired, "user1", 1700000000)
+			notifier.Notify(&Event{ID: id, Type: EventUserExpired, User: &EventUser{Name: "user1"}})
+			e := waitEvent(received)
+			convey.So(e, convey.ShouldNotBeNil)
+			convey.So(e.event.ID, convey.ShouldEqual, id)
+			convey.So(e.delivery, convey.ShouldEqual, id)
+		})
+
+		convey.Convey("nil notifier", func() {
+			var notifier *Notifier = nil
+			convey.So(func() { notifier.Notify(&Event{Type: EventUserExpired}) }, convey.ShouldNotPanic)
+		})
+	})
+}
+
+func TestNewEventID(t *testing.T) {
+	convey.Convey("derive event id", t, func() {
+		id := NewEventID(EventUserExpiring, "user1", int64(1700000000), int64(86400))
+		convey.So(len(id), convey.ShouldEqual, 32)
+		convey.So(NewEventID(EventUserExpiring, "user1", int64(1700000000), int64(86400)), convey.ShouldEqual, id)
+		convey.So(NewEventID(EventUserExpiring, "user1", int64(1700000000), int64(3600)), convey.ShouldNotEqual, id)
+		convey.So(NewEventID(EventUserExpired, "user1", int64(1700000000), int64(86400)), convey.ShouldNotEqual, id)
+		convey.So(NewEventID(EventUserExpiring, "user2", int64(1700000000), int64(86400)), convey.ShouldNotEqual, id)
+	})
+}
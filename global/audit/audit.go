@@ -0,0 +1,50 @@
+package audit
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/lureiny/v2raymg/common"
+	"github.com/lureiny/v2raymg/common/audit"
+	"github.com/lureiny/v2raymg/common/log/logger"
+	"github.com/lureiny/v2raymg/common/util"
+	"github.com/lureiny/v2raymg/global/config"
+	"github.com/lureiny/v2raymg/global/store"
+	"github.com/lureiny/v2raymg/server/rpc/proto"
+)
+
+var auditRecorder *audit.Recorder = nil
+
+// InitAuditRecorder 需要在store初始化之后调用
+func InitAuditRecorder() error {
+	retention, err := util.ParseSeconds(config.GetString(common.ConfigStoreAuditRetention))
+	if err != nil {
+		return fmt.Errorf("invalid audit retention > %v", err)
+	}
+	auditRecorder = audit.NewRecorder(
+		store.GetStore(),
+		config.GetString(common.ConfigServerName),
+		time.Duration(retention)*time.Second,
+	)
+	return nil
+}
+
+// Record 记录失败只打印日志, 不影响操作本身
+func Record(entry *proto.AuditEntry) {
+	if err := auditRecorder.Record(entry); err != nil {
+		logger.Error(
+			"Err=record audit log fail > %v|Caller=%s|Operation=%s",
+			err,
+			entry.Caller,
+			entry.Operation,
+		)
+	}
+}
+
+// Query ...
+func Query(req *proto.GetAuditLogReq) ([]*proto.AuditEntry, error) {
+	if auditRecorder == nil {
+		return nil, fmt.Errorf("audit recorder is not initialized")
+	}
+	return auditRecorder.Query(req)
+}
@@ -1,18 +1,25 @@
 package cluster
 
 import (
+	"fmt"
 	"math"
 	"time"
 
 	"github.com/google/uuid"
 	"github.com/lureiny/v2raymg/cluster"
 	"github.com/lureiny/v2raymg/common"
+	"github.com/lureiny/v2raymg/common/log/logger"
+	"github.com/lureiny/v2raymg/common/rpc"
+	"github.com/lureiny/v2raymg/common/util"
 	"github.com/lureiny/v2raymg/global/config"
+	"github.com/lureiny/v2raymg/global/store"
 	"github.com/lureiny/v2raymg/server/rpc/proto"
 )
 
 var globalEndNodeClusterManager = &cluster.EndNodeClusterManager{}
 var LocalNode = cluster.GetLocalNode()
+var nodeStore *cluster.NodeStore = nil
+var globalGossip *cluster.Gossip = nil
 
 // GetClusterToken ...
 func GetClusterToken() string {
@@ -30,14 +37,50 @@ func initLocalNode() {
 		Port:        int32(config.GetInt(common.ConfigServerRpcPort)),
 		ClusterName: config.GetString(common.ConfigClusterName),
 		Name:        config.GetString(common.ConfigServerName),
+		Labels:      config.GetStringMapString(common.ConfigServerLabels),
 	}
 }
 
+// 是否配置了其他节点, 包括静态节点, 中心节点与gossip成员协议
+func isMultiNodeCluster() bool {
+	for _, key := range []string{common.ConfigClusterNodes, common.ConfigCenterNodes} {
+		nodes := []map[string]interface{}{}
+		if err := config.UnmarshalKey(key, &nodes); err == nil && len(nodes) > 0 {
+			return true
+		}
+	}
+	return config.GetInt(common.ConfigCenterNodePort) != 0 ||
+		config.GetString(common.ConfigClusterMembership) == cluster.MembershipGossip
+}
+
+// AES-GCM编码的密钥由集群token派生, token为空时任何人都可以伪造节点间的消息
+// 单节点与未开启严格模式时仅告警, 以便逐个节点升级; 开启严格模式或者配置了其他节点时必须设置token
+func checkClusterToken(token string) error {
+	if token != "" {
+		return nil
+	}
+	if config.GetBool(common.ConfigClusterStrictCodec) {
+		return fmt.Errorf("cluster token is empty, it is required when strict codec is enabled")
+	}
+	if isMultiNodeCluster() {
+		return fmt.Errorf("cluster token is empty, it is required when other nodes are configured")
+	}
+	logger.Warn("Msg=cluster token is empty, rpc messages between nodes can be forged by anyone, set cluster.token before adding other nodes")
+	return nil
+}
+
 func InitCluster() error {
 	initLocalNode()
+	if err := cluster.InitClusterTLS(LocalNode.Name); err != nil {
+		return fmt.Errorf("init cluster tls fail > %v", err)
+	}
 	globalEndNodeClusterManager.Init()
 	globalEndNodeClusterManager.Name = config.GetString(common.ConfigClusterName)
 	globalEndNodeClusterManager.Token = config.GetString(common.ConfigClusterToken)
+	if err := checkClusterToken(globalEndNodeClusterManager.Token); err != nil {
+		return err
+	}
+	rpc.SetStrictCodec(config.GetBool(common.ConfigClusterStrictCodec))
 	globalEndNodeClusterManager.Add(&cluster.Node{
 		InToken:             LocalNode.Token,
 		OutToken:            LocalNode.Token,
@@ -46,7 +89,76 @@ func InitCluster() error {
 		GetHeartBeatTime:    math.MaxInt64 - common.NodeTimeOut,
 		CreateTime:          time.Now().Unix(),
 	})
-	return globalEndNodeClusterManager.LoadStaticNode()
+	if err := globalEndNodeClusterManager.LoadStaticNode(); err != nil {
+		return err
+	}
+	if err := initGossip(); err != nil {
+		return err
+	}
+	return loadStoredNodes()
+}
+
+func initGossip() error {
+	membership := config.GetString(common.ConfigClusterMembership)
+	switch membership {
+	case "", cluster.MembershipHeartbeat:
+		return nil
+	case cluster.MembershipGossip:
+	default:
+		return fmt.Errorf("unsupport cluster membership %s", membership)
+	}
+	gossipConfig := cluster.GossipConfig{
+		IndirectProbes: config.GetInt(common.ConfigClusterGossipIndirectProbes),
+	}
+	durations := map[string]*time.Duration{
+		common.ConfigClusterGossipProbeInterval:    &gossipConfig.ProbeInterval,
+		common.ConfigClusterGossipProbeTimeout:     &gossipConfig.ProbeTimeout,
+		common.ConfigClusterGossipSuspicionTimeout: &gossipConfig.SuspicionTimeout,
+	}
+	for key, d := range durations {
+		value := config.GetString(key)
+		if value == "" {
+			continue
+		}
+		parsed, err := time.ParseDuration(value)
+		if err != nil {
+			return fmt.Errorf("invalid %s > %v", key, err)
+		}
+		*d = parsed
+	}
+	globalGossip = cluster.NewGossip(&globalEndNodeClusterManager.Cluster, &LocalNode.Node, gossipConfig)
+	return nil
+}
+
+// GetGossip 未开启gossip成员协议时返回nil
+func GetGossip() *cluster.Gossip {
+	return globalGossip
+}
+
+// 加载持久化的节点, 需要在store初始化之后调用
+func loadStoredNodes() error {
+	ttl, err := util.ParseSeconds(config.GetString(common.ConfigClusterNodeTTL))
+	if err != nil {
+		return fmt.Errorf("invalid cluster node ttl > %v", err)
+	}
+	nodeStore = cluster.NewNodeStore(store.GetStore(), time.Duration(ttl)*time.Second)
+	members, err := nodeStore.Load()
+	if err != nil {
+		return fmt.Errorf("load stored nodes fail > %v", err)
+	}
+	added := globalEndNodeClusterManager.LoadMembers(LocalNode.Name, members)
+	logger.Info("Msg=load stored nodes|Count=%d|Added=%d", len(members), added)
+	return nil
+}
+
+// FlushNodes 持久化通过级联或中心节点获知的节点
+func FlushNodes() {
+	if nodeStore == nil {
+		return
+	}
+	if err := nodeStore.Save(globalEndNodeClusterManager.LearnedMembers(LocalNode.Name)...); err != nil {
+		logger.Error("Err=flush nodes to store fail > %v", err)
+	}
 }
 
 // AddNode ...
@@ -99,6 +211,16 @@ func Delete(nodeName string) {
 	globalEndNodeClusterManager.Delete(nodeName)
 }
 
+// UpdateNodeLabels 更新节点上报的标签
+func UpdateNodeLabels(nodeName string, labels map[string]string) {
+	globalEndNodeClusterManager.UpdateNodeLabels(nodeName, labels)
+}
+
+// UpdateNodeHealth 更新节点上报的健康状态
+func UpdateNodeHealth(nodeName string, health *proto.NodeHealth) {
+	globalEndNodeClusterManager.UpdateNodeHealth(nodeName, health)
+}
+
 // GetAllNode ...
 func GetAllNode() map[string]*cluster.Node {
 	return globalEndNodeClusterManager.GetAllNode()
@@ -7,6 +7,7 @@ import (
 	"github.com/lureiny/v2raymg/common/log/logger"
 	"github.com/lureiny/v2raymg/global/config"
 	"github.com/lureiny/v2raymg/global/proxy"
+	"github.com/lureiny/v2raymg/global/user"
 	"github.com/lureiny/v2raymg/server/rpc/proto"
 )
 
@@ -44,6 +45,10 @@ func collectStats(source string) {
 		StatsForPrometheus.Ch <- stat
 		SumStats.Ch <- stat
 	}
+	// 持久化流量, 用于流量报表
+	recordTraffic(stats)
+	// 累加用户流量, 用于流量配额
+	user.AddTraffic(stats)
 }
 
 func init() {
@@ -0,0 +1,52 @@
+package collecter
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/lureiny/v2raymg/common"
+	"github.com/lureiny/v2raymg/common/log/logger"
+	"github.com/lureiny/v2raymg/common/traffic"
+	"github.com/lureiny/v2raymg/common/util"
+	"github.com/lureiny/v2raymg/global/config"
+	"github.com/lureiny/v2raymg/global/store"
+	"github.com/lureiny/v2raymg/server/rpc/proto"
+)
+
+var trafficRecorder *traffic.Recorder = nil
+
+// InitTrafficRecorder 需要在store初始化之后调用
+func InitTrafficRecorder() error {
+	hourlyRetention, err := util.ParseSeconds(config.GetString(common.ConfigStoreTrafficHourlyRetention))
+	if err != nil {
+		return fmt.Errorf("invalid traffic hourly retention > %v", err)
+	}
+	dailyRetention, err := util.ParseSeconds(config.GetString(common.ConfigStoreTrafficDailyRetention))
+	if err != nil {
+		return fmt.Errorf("invalid traffic daily retention > %v", err)
+	}
+	trafficRecorder = traffic.NewRecorder(
+		store.GetStore(),
+		config.GetString(common.ConfigServerName),
+		time.Duration(hourlyRetention)*time.Second,
+		time.Duration(dailyRetention)*time.Second,
+	)
+	return nil
+}
+
+func recordTraffic(stats map[string]*proto.Stats) {
+	if trafficRecorder == nil {
+		return
+	}
+	if err := trafficRecorder.Record(stats, time.Now()); err != nil {
+		logger.Error("Err=record traffic fail > %v", err)
+	}
+}
+
+// GetTrafficReport ...
+func GetTrafficReport(req *proto.GetTrafficReportReq) ([]*proto.TrafficSeries, error) {
+	if trafficRecorder == nil {
+		return nil, fmt.Errorf("traffic recorder is not initialized")
+	}
+	return trafficRecorder.Report(req)
+}
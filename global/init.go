@@ -6,10 +6,13 @@ import (
 
 	"github.com/lureiny/v2raymg/common"
 	"github.com/lureiny/v2raymg/common/log/logger"
+	"github.com/lureiny/v2raymg/global/audit"
 	"github.com/lureiny/v2raymg/global/cluster"
+	"github.com/lureiny/v2raymg/global/collecter"
 	"github.com/lureiny/v2raymg/global/config"
 	"github.com/lureiny/v2raymg/global/lego"
 	"github.com/lureiny/v2raymg/global/proxy"
+	"github.com/lureiny/v2raymg/global/store"
 	"github.com/lureiny/v2raymg/global/user"
 )
 
@@ -59,6 +62,27 @@ func initCertManager() error {
 	return lego.InitCertManager()
 }
 
+func initStore() error {
+	if err := store.InitStore(); err != nil {
+		return fmt.Errorf("init store fail, err: %v", err)
+	}
+	return nil
+}
+
+func initTrafficRecorder() error {
+	if err := collecter.InitTrafficRecorder(); err != nil {
+		return fmt.Errorf("init traffic recorder fail, err: %v", err)
+	}
+	return nil
+}
+
+func initAuditRecorder() error {
+	if err := audit.InitAuditRecorder(); err != nil {
+		return fmt.Errorf("init audit recorder fail, err: %v", err)
+	}
+	return nil
+}
+
 func initUserManager() error {
 	return user.InitUserManager()
 }
@@ -73,6 +97,15 @@ func InitGlobalInfra(configFile string) error {
 	if strings.EqualFold(config.GetString(common.ConfigRpcServerType), common.CenterNodeType) {
 		return nil
 	}
+	if err := initStore(); err != nil {
+		return err
+	}
+	if err := initTrafficRecorder(); err != nil {
+		return err
+	}
+	if err := initAuditRecorder(); err != nil {
+		return err
+	}
 	if err := initCluster(); err != nil {
 		return err
 	}
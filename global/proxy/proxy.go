@@ -120,6 +120,16 @@ func GetProxyServerVersion() string {
 	return proxyManager.GetProxyServerVersion()
 }
 
+// GetProxyServerState ...
+func GetProxyServerState() *manager.ProxyServerState {
+	return proxyManager.GetProxyServerState()
+}
+
+// GetHysteriaServerState 未配置hysteria时返回nil
+func GetHysteriaServerState() *manager.ProxyServerState {
+	return proxyManager.GetHysteriaServerState()
+}
+
 // AddAdaptivePort 添加port用于自动更换
 func AddAdaptivePort(port interface{}) error {
 	return proxyManager.AddAdaptivePort(port)
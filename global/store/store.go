@@ -0,0 +1,36 @@
+package store
+
+import (
+	"github.com/lureiny/v2raymg/common"
+	"github.com/lureiny/v2raymg/common/store"
+	"github.com/lureiny/v2raymg/global/config"
+)
+
+var globalStore *store.BoltStore = nil
+
+// InitStore ...
+func InitStore() error {
+	path := config.GetString(common.ConfigStorePath)
+	if path == "" {
+		path = common.DefaultStorePath
+	}
+	s, err := store.OpenBoltStore(path)
+	if err != nil {
+		return err
+	}
+	globalStore = s
+	return nil
+}
+
+// GetStore ...
+func GetStore() *store.BoltStore {
+	return globalStore
+}
+
+// Close ...
+func Close() error {
+	if globalStore == nil {
+		return nil
+	}
+	return globalStore.Close()
+}
@@ -0,0 +1,78 @@
+package user
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/lureiny/v2raymg/common"
+	"github.com/lureiny/v2raymg/common/log/logger"
+	"github.com/lureiny/v2raymg/common/util"
+	"github.com/lureiny/v2raymg/common/webhook"
+	"github.com/lureiny/v2raymg/global/config"
+)
+
+var (
+	defaultExpireBefore    = []string{"168h", "24h"}
+	defaultQuotaThresholds = []int{80, 100}
+)
+
+// 根据配置初始化过期与配额通知, 没有配置webhook时不通知
+func initNotifier() error {
+	endpoints := []*webhook.Endpoint{}
+	if err := config.UnmarshalKey(common.ConfigWebhookEndpoints, &endpoints); err != nil {
+		return fmt.Errorf("please check webhook endpoints config > %v", err)
+	}
+	if len(endpoints) == 0 {
+		return nil
+	}
+	for _, endpoint := range endpoints {
+		if endpoint.URL == "" {
+			return fmt.Errorf("webhook url can't be empty")
+		}
+	}
+
+	rawExpireBefore := config.GetStringSlice(common.ConfigWebhookExpireBefore)
+	if len(rawExpireBefore) == 0 {
+		rawExpireBefore = defaultExpireBefore
+	}
+	expireBefore := []int64{}
+	for _, raw := range rawExpireBefore {
+		seconds, err := util.ParseSeconds(raw)
+		if err != nil || seconds <= 0 {
+			return fmt.Errorf("invalid webhook expire_before %s > %v", raw, err)
+		}
+		expireBefore = append(expireBefore, seconds)
+	}
+
+	quotaThresholds := []int{}
+	if err := config.UnmarshalKey(common.ConfigWebhookQuotaThresholds, &quotaThresholds); err != nil {
+		return fmt.Errorf("please check webhook quota_thresholds config > %v", err)
+	}
+	if len(quotaThresholds) == 0 {
+		quotaThresholds = defaultQuotaThresholds
+	}
+
+	maxRetry := webhook.DefaultMaxRetry
+	if raw := config.GetString(common.ConfigWebhookMaxRetry); raw != "" {
+		retry, err := strconv.Atoi(raw)
+		if err != nil || retry < 0 {
+			return fmt.Errorf("invalid webhook max_retry %s", raw)
+		}
+		maxRetry = retry
+	}
+
+	notifier := webhook.NewNotifier(
+		config.GetString(common.ConfigServerName),
+		endpoints,
+		maxRetry,
+		webhook.DefaultRetryInterval,
+	)
+	globalUserManager.SetNotifier(notifier, expireBefore, quotaThresholds)
+	logger.Info(
+		"Msg=init webhook notifier|EndpointNum=%d|ExpireBefore=%v|QuotaThresholds=%v",
+		len(endpoints),
+		rawExpireBefore,
+		quotaThresholds,
+	)
+	return nil
+}
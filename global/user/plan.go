@@ -0,0 +1,33 @@
+package user
+
+import (
+	"github.com/lureiny/v2raymg/cluster"
+	"github.com/lureiny/v2raymg/server/rpc/proto"
+)
+
+var globalPlanManager *cluster.PlanManager = cluster.NewPlanManager()
+
+// GetPlanManager ...
+func GetPlanManager() *cluster.PlanManager {
+	return globalPlanManager
+}
+
+// SetPlans add or update plans
+func SetPlans(plans ...*proto.Plan) error {
+	return globalPlanManager.Set(plans...)
+}
+
+// DeletePlans users on the deleted plans keep their current settings
+func DeletePlans(names ...string) error {
+	return globalPlanManager.Delete(names...)
+}
+
+// GetPlans ...
+func GetPlans() []*proto.Plan {
+	return globalPlanManager.List()
+}
+
+// ReapplyPlan apply plan tags, quota and level to every user on the plan
+func ReapplyPlan(name string) error {
+	return globalUserManager.ReapplyPlan(name)
+}
@@ -0,0 +1,23 @@
+package user
+
+import (
+	"fmt"
+
+	"github.com/lureiny/v2raymg/common"
+	"github.com/lureiny/v2raymg/global/config"
+	"github.com/lureiny/v2raymg/proxy/manager"
+)
+
+// 根据配置初始化速度档位, 档位对应xray/v2ray的policy level
+func initSpeedTiers() error {
+	tiers := map[string]*manager.PolicyLevel{}
+	if err := config.UnmarshalKey(common.ConfigSpeedTiers, &tiers); err != nil {
+		return fmt.Errorf("please check speed tiers config > %v", err)
+	}
+	return globalUserManager.SetSpeedTiers(tiers)
+}
+
+// SyncSpeedTierLevels 将用户的level与档位配置同步, 需要在proxy启动后调用
+func SyncSpeedTierLevels() {
+	globalUserManager.SyncSpeedTierLevels()
+}
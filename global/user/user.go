@@ -4,6 +4,7 @@ import (
 	"github.com/lureiny/v2raymg/cluster"
 	"github.com/lureiny/v2raymg/common/util"
 	"github.com/lureiny/v2raymg/global/proxy"
+	"github.com/lureiny/v2raymg/global/store"
 	"github.com/lureiny/v2raymg/server/rpc/proto"
 )
 
@@ -11,8 +12,16 @@ var globalUserManager *cluster.UserManager = cluster.NewUserManager()
 
 // InitUserManager ...
 func InitUserManager() error {
-	globalUserManager.Init(proxy.GetProxyManager())
-	return nil
+	if err := globalPlanManager.Init(store.GetStore()); err != nil {
+		return err
+	}
+	if err := initNotifier(); err != nil {
+		return err
+	}
+	if err := globalUserManager.Init(proxy.GetProxyManager(), cluster.NewBoltUserStore(store.GetStore()), globalPlanManager); err != nil {
+		return err
+	}
+	return initSpeedTiers()
 }
 
 // GetUserManager ...
@@ -30,9 +39,9 @@ func Delete(user *proto.User) error {
 	return globalUserManager.Delete(user)
 }
 
-// Update udpate passwd, expire time
-func Update(user *proto.User) error {
-	return globalUserManager.Update(user)
+// Update udpate passwd, expire time, quota, tags, only fields in mask will be changed
+func Update(user *proto.User, mask *proto.UserUpdateMask) error {
+	return globalUserManager.Update(user, mask)
 }
 
 // Reset reset user uuid, user need to update sub info
@@ -40,6 +49,21 @@ func Reset(user *proto.User) error {
 	return globalUserManager.Reset(user)
 }
 
+// Suspend suspend user, keep user info and tags
+func Suspend(user *proto.User) error {
+	return globalUserManager.Suspend(user)
+}
+
+// Resume resume suspended user with the same tags and credentials
+func Resume(user *proto.User) error {
+	return globalUserManager.Resume(user)
+}
+
+// AuthUser auth user by credential, used by hysteria
+func AuthUser(credential, addr string) (*proto.User, error) {
+	return globalUserManager.AuthUser(credential, addr)
+}
+
 // HaveUser have user?
 func HaveUser(user *proto.User) bool {
 	return globalUserManager.HaveUser(user)
@@ -60,12 +84,17 @@ func Get(userName string) *proto.User {
 	return globalUserManager.Get(userName)
 }
 
+// Count 用户数量
+func Count() int {
+	return globalUserManager.Count()
+}
+
 // GetUserList ...
 func GetUserList() map[string]*proto.User {
 	return globalUserManager.GetUserList()
 }
 
-// FlushUser ...
+// FlushUser flush user to store
 func FlushUser() {
 	globalUserManager.FlushUser()
 }
@@ -74,3 +103,23 @@ func FlushUser() {
 func GetUserSub(user *proto.User, excludeProtocols *util.StringList, useSNI bool) ([]string, error) {
 	return globalUserManager.GetUserSub(user, excludeProtocols, useSNI)
 }
+
+// AddTraffic 累加用户流量, 超出配额的用户会被停用
+func AddTraffic(stats map[string]*proto.Stats) {
+	globalUserManager.AddTraffic(stats)
+}
+
+// ExportUsers 导出全部用户
+func ExportUsers() []*proto.User {
+	return globalUserManager.ExportUsers()
+}
+
+// ImportUsers 批量导入用户, policy为已存在用户的处理方式
+func ImportUsers(users []*proto.User, policy string, dryRun bool) ([]*proto.ImportUserResult, error) {
+	return globalUserManager.ImportUsers(users, policy, dryRun)
+}
+
+// ReconcileUsers 以users为期望状态对比并修复本节点的用户
+func ReconcileUsers(users []*proto.User, repair, prune, repairUuid bool) []*proto.UserDrift {
+	return globalUserManager.ReconcileUsers(users, repair, prune, repairUuid)
+}
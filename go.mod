@@ -20,6 +20,8 @@ require (
 	github.com/urfave/cli/v2 v2.24.3
 	github.com/v2fly/v2ray-core/v5 v5.1.0
 	github.com/xtls/xray-core v1.6.0
+	go.etcd.io/bbolt v1.3.7
+	golang.org/x/crypto v0.7.0
 	golang.org/x/net v0.8.0
 	google.golang.org/grpc v1.49.0
 	google.golang.org/protobuf v1.33.0
@@ -196,7 +198,6 @@ require (
 	go.uber.org/ratelimit v0.2.0 // indirect
 	go4.org/intern v0.0.0-20220301175310-a089fc204883 // indirect
 	go4.org/unsafe/assume-no-moving-gc v0.0.0-20220617031537-928513b29760 // indirect
-	golang.org/x/crypto v0.7.0 // indirect
 	golang.org/x/exp v0.0.0-20220916125017-b168a2c6b86b // indirect
 	golang.org/x/mod v0.8.0 // indirect
 	golang.org/x/oauth2 v0.4.0 // indirect
@@ -345,6 +345,17 @@ func (certManager *CertManager) GetAllCert() []*proto.Cert {
 	return certs
 }
 
+// GetCertExpiries 获取全部证书的过期时间, key = 域名, value = unix时间戳
+func (certManager *CertManager) GetCertExpiries() map[string]int64 {
+	certManager.certMutex.Lock()
+	defer certManager.certMutex.Unlock()
+	expiries := map[string]int64{}
+	for domain, cert := range certManager.Certs {
+		expiries[domain] = cert.ExpireTime.Unix()
+	}
+	return expiries
+}
+
 func getWildcardDomain(domain string) string {
 	index := strings.Index(domain, ".")
 	if index == -1 {
@@ -0,0 +1,126 @@
+package config
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"strings"
+)
+
+// 多用户模式下可用的shadowsocks加密方式
+const (
+	DefaultShadowsocksMethod     = "chacha20-ietf-poly1305"
+	DefaultShadowsocks2022Method = "2022-blake3-aes-128-gcm"
+)
+
+var shadowsocksAEADMethods = "aes-128-gcm|aes-256-gcm|chacha20-poly1305|chacha20-ietf-poly1305|xchacha20-poly1305|xchacha20-ietf-poly1305"
+
+type ShadowsocksInboundUser struct {
+	Method   string `json:"method,omitempty"` // shadowsocks 2022的用户不能指定method
+	Password string `json:"password"`
+	Level    byte   `json:"level,omitempty"`
+	Email    string `json:"email"`
+}
+
+// shadowsocks inbound的settings, clients为空时xray使用password作为单用户
+type ShadowsocksInboundConfig struct {
+	Method   string                    `json:"method"`
+	Password string                    `json:"password,omitempty"`
+	Level    byte                      `json:"level,omitempty"`
+	Email    string                    `json:"email,omitempty"`
+	Clients  []*ShadowsocksInboundUser `json:"clients"`
+	Network  json.RawMessage           `json:"network,omitempty"`
+	IVCheck  bool                      `json:"ivCheck,omitempty"`
+}
+
+// IsShadowsocks2022Method 是否为shadowsocks 2022的加密方式
+func IsShadowsocks2022Method(method string) bool {
+	return strings.HasPrefix(strings.ToLower(method), "2022-blake3-")
+}
+
+// IsShadowsocksAEADMethod 传统加密方式中只有AEAD支持单端口多用户
+func IsShadowsocksAEADMethod(method string) bool {
+	for _, m := range strings.Split(shadowsocksAEADMethods, "|") {
+		if strings.ToLower(method) == m {
+			return true
+		}
+	}
+	return false
+}
+
+func shadowsocks2022KeySize(method string) int {
+	if strings.Contains(strings.ToLower(method), "aes-128") {
+		return 16
+	}
+	return 32
+}
+
+// NewShadowsocks2022Key 生成shadowsocks 2022使用的随机密钥, 长度与加密方式一致, 以base64编码返回
+func NewShadowsocks2022Key(method string) (string, error) {
+	key := make([]byte, shadowsocks2022KeySize(method))
+	if _, err := rand.Read(key); err != nil {
+		return "", err
+	}
+	return base64.StdEncoding.EncodeToString(key), nil
+}
+
+// DeriveShadowsocks2022Key 由用户的uuid派生shadowsocks 2022的用户密钥, 保证各节点上同一用户的密钥一致
+func DeriveShadowsocks2022Key(method, seed string) string {
+	sum := sha256.Sum256([]byte(seed))
+	return base64.StdEncoding.EncodeToString(sum[:shadowsocks2022KeySize(method)])
+}
+
+// IsValidShadowsocks2022Key 密钥需要是base64编码且长度与加密方式一致
+func IsValidShadowsocks2022Key(method, key string) bool {
+	data, err := base64.StdEncoding.DecodeString(key)
+	return err == nil && len(data) == shadowsocks2022KeySize(method)
+}
+
+// GetShadowsocksUserMethod 获取inbound中用户使用的加密方式, shadowsocks 2022的用户不需要指定加密方式
+func GetShadowsocksUserMethod(ssConfig *ShadowsocksInboundConfig) (string, error) {
+	method := strings.ToLower(ssConfig.Method)
+	if IsShadowsocks2022Method(method) {
+		if !strings.Contains(method, "aes") {
+			return "", fmt.Errorf("shadowsocks 2022 multi-user only support blake3-aes-*-gcm, but %s", ssConfig.Method)
+		}
+		return "", nil
+	}
+	if method == "" {
+		// 使用已有用户的加密方式
+		for _, client := range ssConfig.Clients {
+			if client.Method != "" {
+				return client.Method, nil
+			}
+		}
+		return DefaultShadowsocksMethod, nil
+	}
+	if !IsShadowsocksAEADMethod(method) {
+		return "", fmt.Errorf("shadowsocks multi-user only support AEAD method, but %s", ssConfig.Method)
+	}
+	return method, nil
+}
+
+// ShadowsocksSettingBuilder 多用户shadowsocks inbound, 不使用tls
+type ShadowsocksSettingBuilder struct {
+	Method string
+}
+
+func (s *ShadowsocksSettingBuilder) Build() *json.RawMessage {
+	ssSettingConfig := &ShadowsocksInboundConfig{
+		Method:  s.Method,
+		Clients: []*ShadowsocksInboundUser{},
+		Network: json.RawMessage(`"tcp,udp"`),
+	}
+	// shadowsocks 2022多用户模式需要服务端密钥
+	if IsShadowsocks2022Method(s.Method) {
+		ssSettingConfig.Password, _ = NewShadowsocks2022Key(s.Method)
+	}
+	data, _ := json.MarshalIndent(ssSettingConfig, "", "    ")
+	return (*json.RawMessage)(&data)
+}
+
+func (s *ShadowsocksSettingBuilder) GetProtocol() string {
+	return "shadowsocks"
+}
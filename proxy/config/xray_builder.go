@@ -308,6 +308,14 @@ func init() {
 		InboundSettingBuilder: &TrojanSettingBuilder{},
 		Mutex:                 &sync.Mutex{},
 	}
+	inboundSettingBuilders[proto.BuilderType_ShadowsocksSettingBuilderType] = &InboundSettingBuilderWithMutex{
+		InboundSettingBuilder: &ShadowsocksSettingBuilder{Method: DefaultShadowsocksMethod},
+		Mutex:                 &sync.Mutex{},
+	}
+	inboundSettingBuilders[proto.BuilderType_Shadowsocks2022SettingBuilderType] = &InboundSettingBuilderWithMutex{
+		InboundSettingBuilder: &ShadowsocksSettingBuilder{Method: DefaultShadowsocks2022Method},
+		Mutex:                 &sync.Mutex{},
+	}
 
 	streamSettingBuilders[proto.BuilderType_TCPBuilderType] = &StreamSettingBuilderWithMutex{
 		StreamSettingBuilder: &TCPBuilder{},
@@ -56,3 +56,16 @@ func configPolicy(c *config.V2rayConfig) {
 		},
 	}
 }
+
+// 用户流量统计依赖policy中的stats配置, 各level均需要开启
+func configPolicyLevel(c *config.V2rayConfig, level *PolicyLevel) {
+	c.Policy.Levels[level.Level] = &conf.Policy{
+		Handshake:         level.Handshake,
+		ConnectionIdle:    level.ConnIdle,
+		UplinkOnly:        level.UplinkOnly,
+		DownlinkOnly:      level.DownlinkOnly,
+		BufferSize:        level.BufferSize,
+		StatsUserUplink:   true,
+		StatsUserDownlink: true,
+	}
+}
@@ -0,0 +1,26 @@
+package manager
+
+// PolicyLevel 速度档位对应的xray/v2ray policy level, 未设置的字段使用xray/v2ray的默认值
+// 时间单位为秒, buffer_size单位为KB, 0表示不使用缓存
+type PolicyLevel struct {
+	Level        uint32  `mapstructure:"level"`
+	Handshake    *uint32 `mapstructure:"handshake"`
+	ConnIdle     *uint32 `mapstructure:"conn_idle"`
+	UplinkOnly   *uint32 `mapstructure:"uplink_only"`
+	DownlinkOnly *uint32 `mapstructure:"downlink_only"`
+	BufferSize   *int32  `mapstructure:"buffer_size"`
+	// hysteria服务端不支持按用户限速, 仅在订阅中告知客户端的上下行带宽, 单位为Mbps, 0表示不设置
+	HysteriaUp   uint32 `mapstructure:"hysteria_up"`
+	HysteriaDown uint32 `mapstructure:"hysteria_down"`
+}
+
+// SetPolicyLevels 将速度档位写入配置文件中的policy, 需要在proxy启动前调用, 修改后重启proxy生效
+func (proxyManager *ProxyManager) SetPolicyLevels(levels []*PolicyLevel) error {
+	proxyManager.rwmutex.Lock()
+	configPolicy(&proxyManager.Config)
+	for _, level := range levels {
+		configPolicyLevel(&proxyManager.Config, level)
+	}
+	proxyManager.rwmutex.Unlock()
+	return proxyManager.Flush()
+}
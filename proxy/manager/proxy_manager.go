@@ -53,6 +53,8 @@ type ProxyManager struct {
 	adaptive       Adaptive
 	adaptiveMutex  sync.Mutex // 操作自适应变更时的锁
 	certManager    *lego.CertManager
+	disabledUsers  map[string]map[string]bool // key = tag, value = 被停用的用户email集合
+	disabledMutex  sync.Mutex
 }
 
 func NewProxyManager() *ProxyManager {
@@ -60,6 +62,7 @@ func NewProxyManager() *ProxyManager {
 		rwmutex:        sync.RWMutex{},
 		InboundManager: NewInboundManager(),
 		adaptiveMutex:  sync.Mutex{},
+		disabledUsers:  map[string]map[string]bool{},
 		adaptive: Adaptive{
 			Tags:  map[string]bool{},
 			Ports: map[int64]bool{},
@@ -283,10 +286,22 @@ func (proxyManager *ProxyManager) AutoFlush(cycle int64) {
 
 // AddUser ...
 func (proxyManager *ProxyManager) AddUser(user *User) error {
-	err := CompleteUserInformation(user, proxyManager.GetInbound(user.Tag))
+	inbound := proxyManager.GetInbound(user.Tag)
+	err := CompleteUserInformation(user, inbound)
 	if err != nil {
 		return err
 	}
+	// shadowsocks 2022需要先写入配置再重新加载inbound
+	if inbound.isShadowsocks2022() {
+		if err := proxyManager.addUserToFile(user); err != nil {
+			return err
+		}
+		if err := proxyManager.reloadInbound(user.Tag); err != nil {
+			proxyManager.removeUserFromFile(user)
+			return err
+		}
+		return nil
+	}
 	err = addUserToRuntime(&proxyManager.RuntimeConfig, user)
 	if err != nil {
 		return err
@@ -312,26 +327,40 @@ func (proxyManager *ProxyManager) addUserToFile(user *User) error {
 		err = addVlessUser(&inbound.Config, user)
 	case TrojanProtocolName:
 		err = addTrojanUser(&inbound.Config, user)
+	case ShadowsocksProtocolName:
+		err = addShadowsocksUser(&inbound.Config, user)
 	}
 	if err == nil {
 		proxyManager.needFlush = true
-		logger.Debug("Add user to config file, user: %v", user)
+		logger.Debug("Add user to config file, user: %s, tag: %s", user.Email, user.Tag)
 	}
 	return err
 }
 
 // RemoveUser ...
 func (proxyManager *ProxyManager) RemoveUser(user *User) error {
-	err := CompleteUserInformation(user, proxyManager.GetInbound(user.Tag))
+	inbound := proxyManager.GetInbound(user.Tag)
+	err := CompleteUserInformation(user, inbound)
 	if err != nil {
 		return err
 	}
-	err = removeUserFromRuntime(&proxyManager.RuntimeConfig, user)
-	if err != nil {
-		return err
+	reload := inbound.isShadowsocks2022()
+	// 停用的用户已经不在runtime中, 只需要从配置文件中删除
+	if proxyManager.IsUserDisabled(user) {
+		proxyManager.setUserDisabled(user, false)
+	} else if !reload {
+		if err = removeUserFromRuntime(&proxyManager.RuntimeConfig, user); err != nil {
+			return err
+		}
 	}
 
-	return proxyManager.removeUserFromFile(user)
+	if err := proxyManager.removeUserFromFile(user); err != nil {
+		return err
+	}
+	if reload {
+		return proxyManager.reloadInbound(user.Tag)
+	}
+	return nil
 }
 
 func (proxyManager *ProxyManager) removeUserFromFile(user *User) error {
@@ -351,6 +380,8 @@ func (proxyManager *ProxyManager) removeUserFromFile(user *User) error {
 		err = removeVlessUser(&inbound.Config, user)
 	case TrojanProtocolName:
 		err = removeTrojanUser(&inbound.Config, user)
+	case ShadowsocksProtocolName:
+		err = removeShadowsocksUser(&inbound.Config, user)
 	}
 	if err == nil {
 		proxyManager.needFlush = true
@@ -361,12 +392,184 @@ func (proxyManager *ProxyManager) removeUserFromFile(user *User) error {
 
 // ResetUser reset user inbound uuid/password
 func (proxyManager *ProxyManager) ResetUser(user *User) error {
+	disabled := proxyManager.IsUserDisabled(user)
 	err := proxyManager.RemoveUser(user)
 	if err != nil {
 		return err
 	}
 
-	return proxyManager.AddUser(user)
+	if err := proxyManager.AddUser(user); err != nil {
+		return err
+	}
+	// 重置后保持停用状态
+	if disabled {
+		return proxyManager.DisableUser(user)
+	}
+	return nil
+}
+
+// SetUserLevel 修改用户的level, 沿用原有的uuid/password
+func (proxyManager *ProxyManager) SetUserLevel(user *User) error {
+	inbound := proxyManager.GetInbound(user.Tag)
+	if err := CompleteUserInformation(user, inbound); err != nil {
+		return err
+	}
+	inbound.RWMutex.RLock()
+	id, err := getInboundUserUUID(&inbound.Config, user.Email)
+	inbound.RWMutex.RUnlock()
+	if err != nil {
+		return err
+	}
+	// 使用原有的uuid重新添加
+	user.UUID = id
+	return proxyManager.ResetUser(user)
+}
+
+// DisableUser 将用户从runtime中移除, 配置文件中的用户信息保留, 恢复时沿用原有的uuid/password
+func (proxyManager *ProxyManager) DisableUser(user *User) error {
+	if proxyManager.IsUserDisabled(user) {
+		return nil
+	}
+	err := CompleteUserInformation(user, proxyManager.GetInbound(user.Tag))
+	if err != nil {
+		return err
+	}
+	// 先记录停用状态, proxy未启动时移除失败也会在启动后重新移除
+	proxyManager.setUserDisabled(user, true)
+	return proxyManager.removeRuntimeUser(user)
+}
+
+// 从runtime中移除用户, shadowsocks 2022重新加载inbound时会过滤掉停用的用户
+func (proxyManager *ProxyManager) removeRuntimeUser(user *User) error {
+	if proxyManager.GetInbound(user.Tag).isShadowsocks2022() {
+		return proxyManager.reloadInbound(user.Tag)
+	}
+	return removeUserFromRuntime(&proxyManager.RuntimeConfig, user)
+}
+
+// 按照配置文件重新加载inbound, 停用的用户不会加载, 用于不支持动态修改用户的shadowsocks 2022
+func (proxyManager *ProxyManager) reloadInbound(tag string) error {
+	inbound := proxyManager.GetInbound(tag)
+	if inbound == nil {
+		return fmt.Errorf("inbound with tag(%s) is not exist", tag)
+	}
+	inbound.RWMutex.RLock()
+	inboundConfigByte, count, err := filterShadowsocksUsers(inbound.Config, func(email string) bool {
+		return !proxyManager.IsUserDisabled(&User{Tag: tag, Email: email})
+	})
+	inbound.RWMutex.RUnlock()
+	if err != nil {
+		return err
+	}
+	// runtime中可能不存在该inbound, 忽略移除失败
+	if err := RemoveInboundFromRuntime(&proxyManager.RuntimeConfig, tag); err != nil {
+		logger.Debug("remove inbound[%s] from runtime fail > %v", tag, err)
+	}
+	// 没有可用用户时不加载, 避免xray退化为只使用服务端密码的单用户模式
+	if count == 0 {
+		return nil
+	}
+	return AddInboundToRuntime(&proxyManager.RuntimeConfig, inboundConfigByte)
+}
+
+// EnableUser 按照配置文件中的用户信息将停用的用户重新添加到runtime
+func (proxyManager *ProxyManager) EnableUser(user *User) error {
+	if !proxyManager.IsUserDisabled(user) {
+		return nil
+	}
+	inbound := proxyManager.GetInbound(user.Tag)
+	err := CompleteUserInformation(user, inbound)
+	if err != nil {
+		return err
+	}
+	if inbound.isShadowsocks2022() {
+		proxyManager.setUserDisabled(user, false)
+		if err := proxyManager.reloadInbound(user.Tag); err != nil {
+			proxyManager.setUserDisabled(user, true)
+			return err
+		}
+		return nil
+	}
+	inbound.RWMutex.RLock()
+	id, err := getInboundUserUUID(&inbound.Config, user.Email)
+	inbound.RWMutex.RUnlock()
+	if err != nil {
+		return err
+	}
+	user.UUID = id
+	if err := SetUserAccount(user); err != nil {
+		return err
+	}
+	if err := addUserToRuntime(&proxyManager.RuntimeConfig, user); err != nil {
+		return err
+	}
+	proxyManager.setUserDisabled(user, false)
+	return nil
+}
+
+// IsUserDisabled 用户在指定tag下是否被停用
+func (proxyManager *ProxyManager) IsUserDisabled(user *User) bool {
+	proxyManager.disabledMutex.Lock()
+	defer proxyManager.disabledMutex.Unlock()
+	return proxyManager.disabledUsers[user.Tag][user.Email]
+}
+
+func (proxyManager *ProxyManager) setUserDisabled(user *User, disabled bool) {
+	proxyManager.disabledMutex.Lock()
+	defer proxyManager.disabledMutex.Unlock()
+	if !disabled {
+		delete(proxyManager.disabledUsers[user.Tag], user.Email)
+		return
+	}
+	if _, ok := proxyManager.disabledUsers[user.Tag]; !ok {
+		proxyManager.disabledUsers[user.Tag] = map[string]bool{}
+	}
+	proxyManager.disabledUsers[user.Tag][user.Email] = true
+}
+
+const reapplyDisabledUsersRetry = 5
+
+// proxy重启后会按照配置文件加载全部用户, 需要重新移除停用的用户
+// shadowsocks 2022的inbound统一重新加载, 同时避免没有用户时以单用户模式启动
+func (proxyManager *ProxyManager) reapplyDisabledUsers() {
+	users := []*User{}
+	reloadTags := map[string]bool{}
+	for _, tag := range proxyManager.GetTags() {
+		if proxyManager.GetInbound(tag).isShadowsocks2022() {
+			reloadTags[tag] = true
+			users = append(users, &User{Tag: tag})
+		}
+	}
+	proxyManager.disabledMutex.Lock()
+	for tag, emails := range proxyManager.disabledUsers {
+		if reloadTags[tag] {
+			continue
+		}
+		for email := range emails {
+			users = append(users, &User{Tag: tag, Email: email})
+		}
+	}
+	proxyManager.disabledMutex.Unlock()
+	if len(users) == 0 {
+		return
+	}
+
+	go func() {
+		// runtime api在proxy启动后需要一段时间才可用
+		for i := 0; i < reapplyDisabledUsersRetry && len(users) > 0; i++ {
+			time.Sleep(time.Second)
+			failedUsers := []*User{}
+			for _, user := range users {
+				if err := proxyManager.removeRuntimeUser(user); err != nil {
+					failedUsers = append(failedUsers, user)
+				}
+			}
+			users = failedUsers
+		}
+		for _, user := range users {
+			logger.Error("Err=reapply disabled user fail|User=%s|Tag=%s", user.Email, user.Tag)
+		}
+	}()
 }
 
 // QueryStats query user/inbound stat
@@ -397,6 +600,17 @@ func (proxyManager *ProxyManager) QueryStats(pattern string, reset bool) (map[st
 	return result, fmt.Errorf(errMsg)
 }
 
+// KickUsers 断开用户在hysteria中已建立的连接, 未配置hysteria时不做处理
+func (proxyManager *ProxyManager) KickUsers(names ...string) error {
+	if proxyManager.hyConfig == nil {
+		return nil
+	}
+	return KickHysteriaUsers(
+		proxyManager.hyConfig.TrafficStats.Listen,
+		proxyManager.hyConfig.TrafficStats.Secret,
+		names)
+}
+
 // TransferInbound 搬迁inbound, 适用于修改端口的场景
 func (proxyManager *ProxyManager) TransferInbound(tag string, newPort uint32) error {
 	if tag == apiTag {
@@ -483,6 +697,27 @@ func (proxyManager *ProxyManager) GetUsersTag() map[string][]string {
 	return userTagMap
 }
 
+// GetUserUUID 获取用户在vmess/vless/trojan inbound中使用的uuid, 用于旧版本用户的迁移, 不存在时返回空
+func (proxyManager *ProxyManager) GetUserUUID(email string) string {
+	proxyManager.rwmutex.RLock()
+	defer proxyManager.rwmutex.RUnlock()
+
+	for _, inbound := range proxyManager.Config.InboundConfigs {
+		// shadowsocks 2022的用户密钥不是uuid
+		if strings.ToLower(inbound.Protocol) == ShadowsocksProtocolName {
+			continue
+		}
+		id, err := getInboundUserUUID(&inbound, email)
+		if err != nil {
+			continue
+		}
+		if _, err := uuid.Parse(id); err == nil {
+			return id
+		}
+	}
+	return ""
+}
+
 // GetTags 获取全部tag
 func (proxyManager *ProxyManager) GetTags() []string {
 	proxyManager.rwmutex.RLock()
@@ -563,6 +798,7 @@ func (proxyManager *ProxyManager) StartProxyServer() error {
 		logger.Error("start v2ray/xray server fail, err: %v", err)
 		return err
 	}
+	proxyManager.reapplyDisabledUsers()
 	if err := proxyManager.hysteriaServer.Start(); err != nil {
 		logger.Error("start hysteria server fail, err: %v", err)
 		return err
@@ -579,12 +815,20 @@ func (proxyManager *ProxyManager) StopProxyServer() {
 // RestartProxyServer ...
 func (proxyManager *ProxyManager) RestartProxyServer() error {
 	proxyManager.proxyServer.Stop()
-	return proxyManager.proxyServer.Start()
+	if err := proxyManager.proxyServer.Start(); err != nil {
+		return err
+	}
+	proxyManager.reapplyDisabledUsers()
+	return nil
 }
 
 // UpdateProxyServer update proxy server by git tag
 func (proxyManager *ProxyManager) UpdateProxyServer(tag string) error {
-	return proxyManager.proxyServer.Update(tag)
+	if err := proxyManager.proxyServer.Update(tag); err != nil {
+		return err
+	}
+	proxyManager.reapplyDisabledUsers()
+	return nil
 }
 
 // GetProxyServerVersion ...
@@ -592,6 +836,19 @@ func (proxyManager *ProxyManager) GetProxyServerVersion() string {
 	return proxyManager.proxyServer.currentVersion
 }
 
+// GetProxyServerState 获取xray/v2ray进程状态
+func (proxyManager *ProxyManager) GetProxyServerState() *ProxyServerState {
+	return proxyManager.proxyServer.State()
+}
+
+// GetHysteriaServerState 获取hysteria进程状态, 未配置hysteria时返回nil
+func (proxyManager *ProxyManager) GetHysteriaServerState() *ProxyServerState {
+	if proxyManager.hyConfig == nil || proxyManager.hysteriaServer == nil {
+		return nil
+	}
+	return proxyManager.hysteriaServer.State()
+}
+
 // AddAdaptivePort 添加port用于自动更换
 func (proxyManager *ProxyManager) AddAdaptivePort(port interface{}) error {
 	return proxyManager.adaptive.AddPort(port)
@@ -691,10 +948,10 @@ func (proxyManager *ProxyManager) CycleAdaptive() {
 }
 
 // TODO: 暂时这么处理
-func (proxyManager *ProxyManager) GetUserSub(user, password, nodeName string) string {
+func (proxyManager *ProxyManager) GetUserSub(user, credential, nodeName string) string {
 	// TODO: 临时兼容方案, 此处只考虑hysteria的订阅链接
 	if proxyManager.hysteriaServer != nil {
-		p := base64.RawStdEncoding.EncodeToString([]byte(password))
+		p := base64.RawStdEncoding.EncodeToString([]byte(credential))
 		uri := "hysteria2://" + p + "@" + proxyManager.hyConfig.ACME.Domains[0] + ":443" + "#" + nodeName
 		return uri
 	}
@@ -12,6 +12,8 @@ import (
 	"os/exec"
 	"regexp"
 	"strings"
+	"syscall"
+	"time"
 
 	"github.com/google/go-github/v48/github"
 )
@@ -29,6 +31,14 @@ type ProxyServer struct {
 	stdout             io.ReadCloser
 	softwareName       string // xray/v2ray/hysteria
 	softwareGithubInfo *SoftwareGithubInfo
+	startTime          time.Time
+}
+
+// ProxyServerState proxy进程的运行状态
+type ProxyServerState struct {
+	Running   bool
+	Version   string
+	StartTime int64
 }
 
 func NewProxyServer(file, version, softwareName string) *ProxyServer {
@@ -73,6 +83,7 @@ func (s *ProxyServer) Start() error {
 			return err
 		}
 		s.isRunning = true
+		s.startTime = time.Now()
 		return nil
 	}
 
@@ -100,6 +111,7 @@ func (s *ProxyServer) Start() error {
 		return err
 	}
 	s.isRunning = true
+	s.startTime = time.Now()
 	return nil
 }
 
@@ -111,6 +123,35 @@ func (s *ProxyServer) Stop() {
 	}
 }
 
+// IsRunning 进程是否仍在运行, 进程异常退出后isRunning不会被修改, 因此需要检查进程状态
+func (s *ProxyServer) IsRunning() bool {
+	if !s.isRunning || s.cmd == nil || s.cmd.Process == nil {
+		return false
+	}
+	if err := s.cmd.Process.Signal(syscall.Signal(0)); err != nil {
+		return false
+	}
+	// 退出后未被回收的进程仍然可以接收信号
+	data, err := os.ReadFile(fmt.Sprintf("/proc/%d/stat", s.cmd.Process.Pid))
+	if err != nil {
+		return !os.IsNotExist(err)
+	}
+	index := bytes.LastIndexByte(data, ')')
+	return index == -1 || index+2 >= len(data) || data[index+2] != 'Z'
+}
+
+// State ...
+func (s *ProxyServer) State() *ProxyServerState {
+	state := &ProxyServerState{
+		Running: s.IsRunning(),
+		Version: s.currentVersion,
+	}
+	if state.Running {
+		state.StartTime = s.startTime.Unix()
+	}
+	return state
+}
+
 const latestTagName = "latest"
 const tempShuffix = ".tmp"
 
@@ -1,6 +1,7 @@
 package manager
 
 import (
+	"bytes"
 	"encoding/json"
 	"fmt"
 	"io"
@@ -19,41 +20,59 @@ type HysteriaTraffic struct {
 	RX int64 `json:"rx"`
 }
 
-// QueryHysteriaStats ...
-func QueryHysteriaStats(host, secret string, clear bool) (map[string]*proto.Stats, error) {
+// 生成hysteria trafficStats api的请求地址, 监听地址为空时使用本地地址
+func hysteriaApiUrl(host, path string) (string, error) {
 	hosts := strings.Split(host, ":")
 	if len(hosts) != 2 {
-		return nil, fmt.Errorf("hysteria traffic listen config err > host[%v]", host)
+		return "", fmt.Errorf("hysteria traffic listen config err > host[%v]", host)
 	}
 	if hosts[0] == "" {
 		hosts[0] = "127.0.0.1"
 	}
-	params := ""
-	if clear {
-		params += "&clear=1"
-	}
-	reqUrl := "http://" + strings.Join(hosts, ":") + "/traffic"
-	if params != "" {
-		reqUrl += "?" + params
-	}
-	req, err := http.NewRequest("GET", reqUrl, nil)
+	return "http://" + strings.Join(hosts, ":") + path, nil
+}
+
+func doHysteriaRequest(method, reqUrl, secret string, body io.Reader) ([]byte, error) {
+	req, err := http.NewRequest(method, reqUrl, body)
 	if err != nil {
 		return nil, fmt.Errorf("get new request fail > %v", err)
 	}
 	if secret != "" {
 		req.Header.Add("Authorization", secret)
 	}
+	if body != nil {
+		req.Header.Add("Content-Type", "application/json")
+	}
 	client := http.Client{Timeout: 1 * time.Second}
 	resp, err := client.Do(req)
 	if err != nil {
-		return nil, fmt.Errorf("req hystreia traffic fail > %v", err)
+		return nil, err
 	}
 	defer resp.Body.Close()
-	hyStats := map[string]*HysteriaTraffic{}
 	data, err := io.ReadAll(resp.Body)
 	if err != nil {
-		return nil, fmt.Errorf("read hystreia traffic fail > %v", err)
+		return nil, err
+	}
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("status code %d, body: %s", resp.StatusCode, string(data))
+	}
+	return data, nil
+}
+
+// QueryHysteriaStats 查询hysteria的用户流量, 用户名与xray中的用户名一致, 统计类型均为user
+func QueryHysteriaStats(host, secret string, clear bool) (map[string]*proto.Stats, error) {
+	reqUrl, err := hysteriaApiUrl(host, "/traffic")
+	if err != nil {
+		return nil, err
 	}
+	if clear {
+		reqUrl += "?clear=1"
+	}
+	data, err := doHysteriaRequest("GET", reqUrl, secret, nil)
+	if err != nil {
+		return nil, fmt.Errorf("req hystreia traffic fail > %v", err)
+	}
+	hyStats := map[string]*HysteriaTraffic{}
 	if err := json.Unmarshal(data, &hyStats); err != nil {
 		return nil, fmt.Errorf("unmarshal hystreia traffic fail > %v", err)
 	}
@@ -69,3 +88,22 @@ func QueryHysteriaStats(host, secret string, clear bool) (map[string]*proto.Stat
 	}
 	return result, nil
 }
+
+// KickHysteriaUsers 断开用户在hysteria中的全部连接, 用户重连时会重新认证
+func KickHysteriaUsers(host, secret string, names []string) error {
+	if len(names) == 0 {
+		return nil
+	}
+	reqUrl, err := hysteriaApiUrl(host, "/kick")
+	if err != nil {
+		return err
+	}
+	data, err := json.Marshal(names)
+	if err != nil {
+		return fmt.Errorf("marshal kick users fail > %v", err)
+	}
+	if _, err := doHysteriaRequest("POST", reqUrl, secret, bytes.NewReader(data)); err != nil {
+		return fmt.Errorf("req hysteria kick fail > %v", err)
+	}
+	return nil
+}
@@ -0,0 +1,94 @@
+package manager
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/smartystreets/goconvey/convey"
+)
+
+const testHysteriaSecret = "test_secret"
+
+// 模拟hysteria的trafficStats api
+func newFakeHysteriaServer(traffic map[string]*HysteriaTraffic, kicked *[]string) *httptest.Server {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/traffic", func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Authorization") != testHysteriaSecret {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		data, _ := json.Marshal(traffic)
+		if r.URL.Query().Get("clear") == "1" {
+			for name := range traffic {
+				delete(traffic, name)
+			}
+		}
+		w.Write(data)
+	})
+	mux.HandleFunc("/kick", func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost || r.Header.Get("Authorization") != testHysteriaSecret {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		names := []string{}
+		if err := json.NewDecoder(r.Body).Decode(&names); err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		*kicked = append(*kicked, names...)
+		w.WriteHeader(http.StatusOK)
+	})
+	return httptest.NewServer(mux)
+}
+
+func TestHysteriaTrafficStats(t *testing.T) {
+	convey.Convey("hysteria traffic stats api", t, func() {
+		kicked := []string{}
+		server := newFakeHysteriaServer(map[string]*HysteriaTraffic{
+			"user1": {TX: 100, RX: 10},
+			"user2": {TX: 200, RX: 20},
+		}, &kicked)
+		defer server.Close()
+		host := strings.TrimPrefix(server.URL, "http://")
+
+		convey.Convey("query and clear stats", func() {
+			stats, err := QueryHysteriaStats(host, testHysteriaSecret, true)
+			convey.So(err, convey.ShouldBeNil)
+			convey.So(len(stats), convey.ShouldEqual, 2)
+			stat := stats[hyProxyName+"_user1"]
+			convey.So(stat, convey.ShouldNotBeNil)
+			convey.So(stat.Name, convey.ShouldEqual, "user1")
+			convey.So(stat.Type, convey.ShouldEqual, hyTrafficType)
+			convey.So(stat.Downlink, convey.ShouldEqual, 100)
+			convey.So(stat.Uplink, convey.ShouldEqual, 10)
+
+			stats, err = QueryHysteriaStats(host, testHysteriaSecret, false)
+			convey.So(err, convey.ShouldBeNil)
+			convey.So(len(stats), convey.ShouldEqual, 0)
+		})
+
+		convey.Convey("query stats with wrong secret", func() {
+			_, err := QueryHysteriaStats(host, "wrong", false)
+			convey.So(err, convey.ShouldNotBeNil)
+		})
+
+		convey.Convey("kick users", func() {
+			err := KickHysteriaUsers(host, testHysteriaSecret, []string{"user1", "user2"})
+			convey.So(err, convey.ShouldBeNil)
+			convey.So(kicked, convey.ShouldResemble, []string{"user1", "user2"})
+
+			err = KickHysteriaUsers(host, "wrong", []string{"user1"})
+			convey.So(err, convey.ShouldNotBeNil)
+		})
+
+		convey.Convey("invalid listen address", func() {
+			_, err := QueryHysteriaStats("127.0.0.1", testHysteriaSecret, false)
+			convey.So(err, convey.ShouldNotBeNil)
+			err = KickHysteriaUsers("127.0.0.1", testHysteriaSecret, []string{"user1"})
+			convey.So(err, convey.ShouldNotBeNil)
+		})
+	})
+}
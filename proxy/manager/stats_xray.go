@@ -27,8 +27,13 @@ func parseQueryStats(resp *command.QueryStatsResponse) (map[string]*proto.Stats,
 	result := make(map[string]*proto.Stats)
 	for _, stat := range resp.GetStat() {
 		reResult := regexCompile.FindStringSubmatch(stat.GetName())
-		if _, ok := result[proxyName+"_"+reResult[2]]; !ok {
-			result[reResult[2]] = &proto.Stats{
+		if len(reResult) != 4 {
+			continue
+		}
+		// 同名的user/inbound/outbound需要区分开, 否则上下行流量会相互覆盖
+		key := reResult[1] + "_" + reResult[2]
+		if _, ok := result[key]; !ok {
+			result[key] = &proto.Stats{
 				Name:  reResult[2],
 				Type:  reResult[1],
 				Proxy: proxyName,
@@ -37,9 +42,9 @@ func parseQueryStats(resp *command.QueryStatsResponse) (map[string]*proto.Stats,
 		// 填充数据流量
 		switch reResult[3] {
 		case "downlink":
-			result[reResult[2]].Downlink = stat.GetValue()
+			result[key].Downlink = stat.GetValue()
 		case "uplink":
-			result[reResult[2]].Uplink = stat.GetValue()
+			result[key].Uplink = stat.GetValue()
 		}
 	}
 	return result, nil
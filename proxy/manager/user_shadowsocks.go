@@ -0,0 +1,138 @@
+package manager
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/lureiny/v2raymg/proxy/config"
+)
+
+const ShadowsocksProtocolName = "shadowsocks"
+
+func NewShadowsocksInboundConfig(in *config.InboundDetourConfig) (*config.ShadowsocksInboundConfig, error) {
+	if strings.ToLower(in.Protocol) != ShadowsocksProtocolName {
+		return nil, fmt.Errorf("wrong protocol, need %s, but %s", ShadowsocksProtocolName, in.Protocol)
+	}
+	ssInboundConfig := new(config.ShadowsocksInboundConfig)
+	err := json.Unmarshal([]byte(*(in.Settings)), ssInboundConfig)
+	if err != nil {
+		return nil, err
+	}
+	return ssInboundConfig, nil
+}
+
+// shadowsocks 2022的多用户inbound不支持在runtime中动态修改用户, 需要重新加载整个inbound
+func isShadowsocks2022Inbound(in *config.InboundDetourConfig) bool {
+	if strings.ToLower(in.Protocol) != ShadowsocksProtocolName {
+		return false
+	}
+	ssConfig, err := NewShadowsocksInboundConfig(in)
+	return err == nil && config.IsShadowsocks2022Method(ssConfig.Method)
+}
+
+// 补全shadowsocks用户的加密方式, shadowsocks 2022的用户需要使用与加密方式长度一致的base64密钥, 由uuid派生
+func completeShadowsocksUser(user *User, in *config.InboundDetourConfig) error {
+	ssConfig, err := NewShadowsocksInboundConfig(in)
+	if err != nil {
+		return err
+	}
+	if user.Method, err = config.GetShadowsocksUserMethod(ssConfig); err != nil {
+		return err
+	}
+	if config.IsShadowsocks2022Method(ssConfig.Method) && !config.IsValidShadowsocks2022Key(ssConfig.Method, user.UUID) {
+		user.UUID = config.DeriveShadowsocks2022Key(ssConfig.Method, user.UUID)
+	}
+	return nil
+}
+
+func setShadowsocksSettings(in *config.InboundDetourConfig, ssConfig *config.ShadowsocksInboundConfig) error {
+	ssConfigBytes, err := json.MarshalIndent(ssConfig, "", "    ")
+	if err != nil {
+		return err
+	}
+	in.Settings = (*json.RawMessage)(&ssConfigBytes)
+	return nil
+}
+
+func addShadowsocksUser(in *config.InboundDetourConfig, user *User) error {
+	ssConfig, err := NewShadowsocksInboundConfig(in)
+	if err != nil {
+		return err
+	}
+	ssConfig.Clients = append(ssConfig.Clients, &config.ShadowsocksInboundUser{
+		Method:   user.Method,
+		Password: user.UUID,
+		Level:    byte(user.Level),
+		Email:    user.Email,
+	})
+	return setShadowsocksSettings(in, ssConfig)
+}
+
+func removeShadowsocksUser(in *config.InboundDetourConfig, user *User) error {
+	ssConfig, err := NewShadowsocksInboundConfig(in)
+	if err != nil {
+		return err
+	}
+	for index, client := range ssConfig.Clients {
+		if client.Email == user.Email {
+			ssConfig.Clients = append(ssConfig.Clients[:index], ssConfig.Clients[index+1:]...)
+			return setShadowsocksSettings(in, ssConfig)
+		}
+	}
+	return fmt.Errorf("No User " + user.Email)
+}
+
+func getShadowsocksUsers(in *config.InboundDetourConfig) []string {
+	users := []string{}
+	ssConfig, err := NewShadowsocksInboundConfig(in)
+	if err != nil {
+		return users
+	}
+	for _, client := range ssConfig.Clients {
+		users = append(users, client.Email)
+	}
+	return users
+}
+
+func getShadowsocksUserPassword(in *config.InboundDetourConfig, email string) (string, error) {
+	ssConfig, err := NewShadowsocksInboundConfig(in)
+	if err != nil {
+		return "", err
+	}
+	for _, client := range ssConfig.Clients {
+		if client.Email == email {
+			return client.Password, nil
+		}
+	}
+	return "", fmt.Errorf("user[%s] is not exist in inbound[%s]", email, in.Tag)
+}
+
+// 生成重新加载用的inbound配置, 只保留keep返回true的用户, 返回保留的用户数
+func filterShadowsocksUsers(in config.InboundDetourConfig, keep func(email string) bool) ([]byte, int, error) {
+	ssConfig, err := NewShadowsocksInboundConfig(&in)
+	if err != nil {
+		return nil, 0, err
+	}
+	clients := []*config.ShadowsocksInboundUser{}
+	for _, client := range ssConfig.Clients {
+		if keep(client.Email) {
+			clients = append(clients, client)
+		}
+	}
+	ssConfig.Clients = clients
+	if err := setShadowsocksSettings(&in, ssConfig); err != nil {
+		return nil, 0, err
+	}
+	data, err := json.Marshal(in)
+	return data, len(clients), err
+}
+
+func (inbound *Inbound) isShadowsocks2022() bool {
+	if inbound == nil {
+		return false
+	}
+	inbound.RWMutex.RLock()
+	defer inbound.RWMutex.RUnlock()
+	return isShadowsocks2022Inbound(&inbound.Config)
+}
@@ -0,0 +1,109 @@
+package manager
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/lureiny/v2raymg/proxy/config"
+	"github.com/smartystreets/goconvey/convey"
+)
+
+func newTestShadowsocksInbound(ssConfig *config.ShadowsocksInboundConfig) *config.InboundDetourConfig {
+	in := &config.InboundDetourConfig{Protocol: ShadowsocksProtocolName, Tag: "ss-test", PortRange: 8388}
+	if err := setShadowsocksSettings(in, ssConfig); err != nil {
+		panic(err)
+	}
+	return in
+}
+
+func TestCompleteShadowsocksUser(t *testing.T) {
+	convey.Convey("AEAD加密方式", t, func() {
+		in := newTestShadowsocksInbound(&config.ShadowsocksInboundConfig{Method: "aes-256-gcm"})
+		user := &User{Email: "u1", UUID: "f0c2a7a4-2c1f-4c6e-9d83-7d4f0a0f1b11"}
+		convey.So(completeShadowsocksUser(user, in), convey.ShouldBeNil)
+		convey.So(user.Method, convey.ShouldEqual, "aes-256-gcm")
+		convey.So(user.UUID, convey.ShouldEqual, "f0c2a7a4-2c1f-4c6e-9d83-7d4f0a0f1b11")
+		convey.So(isShadowsocks2022Inbound(in), convey.ShouldBeFalse)
+	})
+
+	convey.Convey("未指定加密方式时沿用已有用户的加密方式", t, func() {
+		in := newTestShadowsocksInbound(&config.ShadowsocksInboundConfig{
+			Clients: []*config.ShadowsocksInboundUser{{Method: "aes-128-gcm", Password: "p", Email: "u0"}},
+		})
+		user := &User{Email: "u1", UUID: "id"}
+		convey.So(completeShadowsocksUser(user, in), convey.ShouldBeNil)
+		convey.So(user.Method, convey.ShouldEqual, "aes-128-gcm")
+
+		in = newTestShadowsocksInbound(&config.ShadowsocksInboundConfig{})
+		convey.So(completeShadowsocksUser(user, in), convey.ShouldBeNil)
+		convey.So(user.Method, convey.ShouldEqual, config.DefaultShadowsocksMethod)
+	})
+
+	convey.Convey("不支持多用户的加密方式", t, func() {
+		user := &User{Email: "u1", UUID: "id"}
+		in := newTestShadowsocksInbound(&config.ShadowsocksInboundConfig{Method: "aes-256-cfb"})
+		convey.So(completeShadowsocksUser(user, in), convey.ShouldNotBeNil)
+		in = newTestShadowsocksInbound(&config.ShadowsocksInboundConfig{Method: "2022-blake3-chacha20-poly1305"})
+		convey.So(completeShadowsocksUser(user, in), convey.ShouldNotBeNil)
+	})
+
+	convey.Convey("shadowsocks 2022由uuid派生用户密钥", t, func() {
+		for _, method := range []string{"2022-blake3-aes-128-gcm", "2022-blake3-aes-256-gcm"} {
+			in := newTestShadowsocksInbound(&config.ShadowsocksInboundConfig{Method: method})
+			convey.So(isShadowsocks2022Inbound(in), convey.ShouldBeTrue)
+
+			user := &User{Email: "u1", UUID: "f0c2a7a4-2c1f-4c6e-9d83-7d4f0a0f1b11"}
+			convey.So(completeShadowsocksUser(user, in), convey.ShouldBeNil)
+			convey.So(user.Method, convey.ShouldEqual, "")
+			convey.So(config.IsValidShadowsocks2022Key(method, user.UUID), convey.ShouldBeTrue)
+			// 各节点派生的密钥一致
+			convey.So(user.UUID, convey.ShouldEqual, config.DeriveShadowsocks2022Key(method, "f0c2a7a4-2c1f-4c6e-9d83-7d4f0a0f1b11"))
+
+			// 已经是合法密钥时不再派生
+			key := user.UUID
+			convey.So(completeShadowsocksUser(user, in), convey.ShouldBeNil)
+			convey.So(user.UUID, convey.ShouldEqual, key)
+		}
+	})
+}
+
+func TestShadowsocksUsers(t *testing.T) {
+	convey.Convey("添加, 查询与删除用户", t, func() {
+		in := newTestShadowsocksInbound(&config.ShadowsocksInboundConfig{Method: "aes-128-gcm"})
+		convey.So(addShadowsocksUser(in, &User{Email: "u1", UUID: "p1", Method: "aes-128-gcm"}), convey.ShouldBeNil)
+		convey.So(addShadowsocksUser(in, &User{Email: "u2", UUID: "p2", Method: "aes-128-gcm", Level: 1}), convey.ShouldBeNil)
+		convey.So(getShadowsocksUsers(in), convey.ShouldResemble, []string{"u1", "u2"})
+
+		password, err := getShadowsocksUserPassword(in, "u2")
+		convey.So(err, convey.ShouldBeNil)
+		convey.So(password, convey.ShouldEqual, "p2")
+		_, err = getShadowsocksUserPassword(in, "u3")
+		convey.So(err, convey.ShouldNotBeNil)
+
+		convey.So(removeShadowsocksUser(in, &User{Email: "u1"}), convey.ShouldBeNil)
+		convey.So(getShadowsocksUsers(in), convey.ShouldResemble, []string{"u2"})
+		convey.So(removeShadowsocksUser(in, &User{Email: "u1"}), convey.ShouldNotBeNil)
+	})
+
+	convey.Convey("重新加载时只保留指定的用户", t, func() {
+		in := newTestShadowsocksInbound(&config.ShadowsocksInboundConfig{
+			Method:   "2022-blake3-aes-128-gcm",
+			Password: config.DeriveShadowsocks2022Key("2022-blake3-aes-128-gcm", "server"),
+			Clients: []*config.ShadowsocksInboundUser{
+				{Password: "k1", Email: "u1"},
+				{Password: "k2", Email: "u2"},
+				{Password: "k3", Email: "u3"},
+			},
+		})
+		data, num, err := filterShadowsocksUsers(*in, func(email string) bool { return email != "u2" })
+		convey.So(err, convey.ShouldBeNil)
+		convey.So(num, convey.ShouldEqual, 2)
+
+		reloaded := &config.InboundDetourConfig{}
+		convey.So(json.Unmarshal(data, reloaded), convey.ShouldBeNil)
+		convey.So(reloaded.Tag, convey.ShouldEqual, in.Tag)
+		convey.So(getShadowsocksUsers(reloaded), convey.ShouldResemble, []string{"u1", "u3"})
+		// 原inbound不受影响
+		convey.So(getShadowsocksUsers(in), convey.ShouldResemble, []string{"u1", "u2", "u3"})
+	})
+}
@@ -29,6 +29,7 @@ type User struct {
 	UUID     string
 	Account  protoiface.MessageV1
 	Protocol string
+	Method   string // shadowsocks加密方式, shadowsocks 2022的用户为空
 }
 
 const (
@@ -97,6 +98,9 @@ func SetUserAccount(user *User) error {
 		user.Account = &trojan.Account{
 			Password: user.UUID,
 		}
+	case ShadowsocksProtocolName:
+		// v2ray的shadowsocks inbound只支持单用户
+		return fmt.Errorf("v2ray does not support shadowsocks multi-user")
 	default:
 		fmt.Errorf(fmt.Sprintf("Unsupport protocol %s", user.Protocol))
 	}
@@ -197,7 +201,7 @@ func addUserToRuntime(runtimeConfig *RuntimeConfig, user *User) error {
 		return err
 	}
 
-	logger.Debug("Add user to runtime: [Email] %s, [UUID/Password] %s to [Bound] %s", user.Email, user.UUID, user.Tag)
+	logger.Debug("Add user to runtime: [Email] %s to [Bound] %s", user.Email, user.Tag)
 	return nil
 }
 
@@ -332,6 +336,8 @@ func GetInboundUsers(in *config.InboundDetourConfig) []string {
 		return getVmessUsers(in)
 	case TrojanProtocolName:
 		return getTrojanUsers(in)
+	case ShadowsocksProtocolName:
+		return getShadowsocksUsers(in)
 	default:
 		return []string{}
 	}
@@ -386,6 +392,49 @@ func getTrojanUsers(in *config.InboundDetourConfig) []string {
 	return users
 }
 
+// 从inbound配置中获取用户的uuid/password
+func getInboundUserUUID(in *config.InboundDetourConfig, email string) (string, error) {
+	switch strings.ToLower(in.Protocol) {
+	case VlessProtocolName:
+		vlessConfig, err := NewVlessInboundConfig(in)
+		if err != nil {
+			return "", err
+		}
+		for _, client := range vlessConfig.Clients {
+			vUser := config.V2rayInboundUser{}
+			if err := json.Unmarshal(client, &vUser); err == nil && vUser.Email == email {
+				return vUser.ID, nil
+			}
+		}
+	case VmessProtocolName:
+		vmessConfig, err := NewVmessInboundConfig(in)
+		if err != nil {
+			return "", err
+		}
+		for _, user := range vmessConfig.Users {
+			vUser := config.V2rayInboundUser{}
+			if err := json.Unmarshal(user, &vUser); err == nil && vUser.Email == email {
+				return vUser.ID, nil
+			}
+		}
+	case TrojanProtocolName:
+		trojanConfig, err := NewTrojanInboundConfig(in)
+		if err != nil {
+			return "", err
+		}
+		for _, client := range trojanConfig.Clients {
+			if client.Email == email {
+				return client.Password, nil
+			}
+		}
+	case ShadowsocksProtocolName:
+		return getShadowsocksUserPassword(in, email)
+	default:
+		return "", fmt.Errorf("unsupport protocol %s", in.Protocol)
+	}
+	return "", fmt.Errorf("user[%s] is not exist in inbound[%s]", email, in.Tag)
+}
+
 func NewVlessInboundConfig(in *config.InboundDetourConfig) (*config.VLessInboundConfig, error) {
 	if strings.ToLower(in.Protocol) != VlessProtocolName {
 		return nil, fmt.Errorf("wrong protocol, need %s, but %s", VlessProtocolName, in.Protocol)
@@ -15,6 +15,7 @@ import (
 	"github.com/xtls/xray-core/common/protocol"
 	"github.com/xtls/xray-core/common/serial"
 	"github.com/xtls/xray-core/infra/conf"
+	"github.com/xtls/xray-core/proxy/shadowsocks"
 	"github.com/xtls/xray-core/proxy/trojan"
 	"github.com/xtls/xray-core/proxy/vless"
 	"github.com/xtls/xray-core/proxy/vmess"
@@ -29,6 +30,7 @@ type User struct {
 	UUID     string
 	Account  protoiface.MessageV1
 	Protocol string
+	Method   string // shadowsocks加密方式, shadowsocks 2022的用户为空
 	IsXtls   bool
 	Flow     string // for xtls
 }
@@ -111,12 +113,35 @@ func SetUserAccount(user *User) error {
 			trojanAccount.Flow = user.Flow
 		}
 		user.Account = trojanAccount
+	case ShadowsocksProtocolName:
+		// shadowsocks 2022通过重新加载inbound生效, 不需要account
+		if user.Method != "" {
+			user.Account = &shadowsocks.Account{
+				Password:   user.UUID,
+				CipherType: shadowsocksCipherType(user.Method),
+			}
+		}
 	default:
 		fmt.Errorf(fmt.Sprintf("Unsupport protocol %s", user.Protocol))
 	}
 	return nil
 }
 
+func shadowsocksCipherType(method string) shadowsocks.CipherType {
+	switch strings.ToLower(method) {
+	case "aes-128-gcm":
+		return shadowsocks.CipherType_AES_128_GCM
+	case "aes-256-gcm":
+		return shadowsocks.CipherType_AES_256_GCM
+	case "chacha20-poly1305", "chacha20-ietf-poly1305":
+		return shadowsocks.CipherType_CHACHA20_POLY1305
+	case "xchacha20-poly1305", "xchacha20-ietf-poly1305":
+		return shadowsocks.CipherType_XCHACHA20_POLY1305
+	default:
+		return shadowsocks.CipherType_UNKNOWN
+	}
+}
+
 func addUser(con command.HandlerServiceClient, user *User) error {
 	_, err := con.AlterInbound(context.Background(), &command.AlterInboundRequest{
 		Tag: user.Tag,
@@ -217,7 +242,7 @@ func addUserToRuntime(runtimeConfig *RuntimeConfig, user *User) error {
 		return err
 	}
 
-	logger.Debug("Add user to runtime, user: %v", user)
+	logger.Debug("Add user to runtime, user: %s, tag: %s", user.Email, user.Tag)
 	return nil
 }
 
@@ -340,7 +365,12 @@ func CompleteUserInformation(user *User, inbound *Inbound) error {
 	inbound.RWMutex.RLock()
 	defer inbound.RWMutex.RUnlock()
 	user.Protocol = inbound.Config.Protocol
-	user.IsXtls = inbound.Config.StreamSetting.Security == XTLSName
+	user.IsXtls = inbound.Config.StreamSetting != nil && inbound.Config.StreamSetting.Security == XTLSName
+	if strings.ToLower(user.Protocol) == ShadowsocksProtocolName {
+		if err := completeShadowsocksUser(user, &inbound.Config); err != nil {
+			return err
+		}
+	}
 	// 设置protocol后需要重新设置account
 	return SetUserAccount(user)
 }
@@ -353,6 +383,8 @@ func GetInboundUsers(in *config.InboundDetourConfig) []string {
 		return getVmessUsers(in)
 	case TrojanProtocolName:
 		return getTrojanUsers(in)
+	case ShadowsocksProtocolName:
+		return getShadowsocksUsers(in)
 	default:
 		return []string{}
 	}
@@ -407,6 +439,49 @@ func getTrojanUsers(in *config.InboundDetourConfig) []string {
 	return users
 }
 
+// 从inbound配置中获取用户的uuid/password
+func getInboundUserUUID(in *config.InboundDetourConfig, email string) (string, error) {
+	switch strings.ToLower(in.Protocol) {
+	case VlessProtocolName:
+		vlessConfig, err := NewVlessInboundConfig(in)
+		if err != nil {
+			return "", err
+		}
+		for _, client := range vlessConfig.Clients {
+			vUser := config.V2rayInboundUser{}
+			if err := json.Unmarshal(client, &vUser); err == nil && vUser.Email == email {
+				return vUser.ID, nil
+			}
+		}
+	case VmessProtocolName:
+		vmessConfig, err := NewVmessInboundConfig(in)
+		if err != nil {
+			return "", err
+		}
+		for _, user := range vmessConfig.Users {
+			vUser := config.V2rayInboundUser{}
+			if err := json.Unmarshal(user, &vUser); err == nil && vUser.Email == email {
+				return vUser.ID, nil
+			}
+		}
+	case TrojanProtocolName:
+		trojanConfig, err := NewTrojanInboundConfig(in)
+		if err != nil {
+			return "", err
+		}
+		for _, client := range trojanConfig.Clients {
+			if client.Email == email {
+				return client.Password, nil
+			}
+		}
+	case ShadowsocksProtocolName:
+		return getShadowsocksUserPassword(in, email)
+	default:
+		return "", fmt.Errorf("unsupport protocol %s", in.Protocol)
+	}
+	return "", fmt.Errorf("user[%s] is not exist in inbound[%s]", email, in.Tag)
+}
+
 func NewVlessInboundConfig(in *config.InboundDetourConfig) (*config.VLessInboundConfig, error) {
 	if strings.ToLower(in.Protocol) != VlessProtocolName {
 		return nil, fmt.Errorf("wrong protocol, need %s, but %s", VlessProtocolName, in.Protocol)
@@ -221,6 +221,8 @@ func getClashHysteriaUri(parsedUri *url.URL) *ClashProxy {
 	if parsedUri.Query().Get("sni") != "" {
 		clashProxy.SNI = parsedUri.Query().Get("sni")
 	}
+	clashProxy.Up = parsedUri.Query().Get("upmbps")
+	clashProxy.Down = parsedUri.Query().Get("downmbps")
 	return clashProxy
 }
 
@@ -304,7 +306,9 @@ type ClashProxy struct {
 	SkipCertVerify bool        `yaml:"skip-cert-verify,omitempty"`
 	Servername     string      `yaml:"servername,omitempty"`
 	Network        string      `yaml:"network,omitempty"`
-	SNI            string      `yaml:"sni,omitempty"` // trojan
+	SNI            string      `yaml:"sni,omitempty"`  // trojan
+	Up             string      `yaml:"up,omitempty"`   // hysteria, 单位为Mbps
+	Down           string      `yaml:"down,omitempty"` // hysteria, 单位为Mbps
 	PluginOpts     *PluginOpts `yaml:"plugin-opts,omitempty"`
 	WSOpts         *WSOpts     `yaml:"ws-opts,omitempty"`
 	H2Opts         *H2Opts     `yaml:"h2-opts,omitempty"`
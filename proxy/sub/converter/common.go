@@ -68,27 +68,34 @@ func decodeShadowsocksUrl(parsedUri *url.URL) (method, port, password, server st
 	err = nil
 	// ss订阅编码前格式为 ss://method:password@server:port
 	rawData, err := base64.RawStdEncoding.DecodeString(parsedUri.Host)
+	if err != nil {
+		// v2raymg生成的ss订阅使用url safe的base64编码
+		rawData, err = base64.RawURLEncoding.DecodeString(parsedUri.Host)
+	}
 	if err != nil {
 		logger.Error("decode ss url[%s] > err: %v", parsedUri.String(), err)
 		err = fmt.Errorf("decode ss url[%s] > err: %v", parsedUri.String(), err)
 		return
 	}
-	uriParts := strings.Split(string(rawData), ":")
-
-	if len(uriParts) != 3 {
-		logger.Error("ss url[%s] is not standard", string(rawData))
-		err = fmt.Errorf("ss url[%s] is not standard", string(rawData))
+	// shadowsocks 2022多用户模式的password中包含":", 按第一个":"拆分method, 最后一个"@"拆分server
+	data := string(rawData)
+	methodIndex := strings.Index(data, ":")
+	serverIndex := strings.LastIndex(data, "@")
+	if methodIndex < 0 || serverIndex < methodIndex {
+		logger.Error("ss url[%s] is not standard", data)
+		err = fmt.Errorf("ss url[%s] is not standard", data)
 		return
 	}
-	method = uriParts[0]
-	port = uriParts[2]
-	passwordAndServer := strings.Split(uriParts[1], "@")
-	if len(passwordAndServer) != 2 {
-		logger.Error("ss password and server part is not standard: %s", uriParts[1])
-		err = fmt.Errorf("ss password and server part is not standard: %s", uriParts[1])
+	method = data[:methodIndex]
+	password = data[methodIndex+1 : serverIndex]
+	serverAndPort := data[serverIndex+1:]
+	portIndex := strings.LastIndex(serverAndPort, ":")
+	if portIndex < 0 {
+		logger.Error("ss server and port part is not standard: %s", serverAndPort)
+		err = fmt.Errorf("ss server and port part is not standard: %s", serverAndPort)
 		return
 	}
-	password = passwordAndServer[0]
-	server = passwordAndServer[1]
+	server = serverAndPort[:portIndex]
+	port = serverAndPort[portIndex+1:]
 	return
 }
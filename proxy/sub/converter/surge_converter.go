@@ -134,9 +134,14 @@ func getSurgeHysteriaUri(parsedUri *url.URL) string {
 		parsedUri.Hostname(),
 		parsedUri.Port(),
 		fmt.Sprintf("password=%s", parsedUri.User.Username()),
-		"download-bandwidth=1000",
-		"ecn=true",
 	}
+	// 订阅中指定了用户档位的下行带宽时使用档位带宽
+	if downMbps := parsedUri.Query().Get("downmbps"); downMbps != "" {
+		surgeHysteriaUriParts = append(surgeHysteriaUriParts, fmt.Sprintf("download-bandwidth=%s", downMbps))
+	} else {
+		surgeHysteriaUriParts = append(surgeHysteriaUriParts, "download-bandwidth=1000")
+	}
+	surgeHysteriaUriParts = append(surgeHysteriaUriParts, "ecn=true")
 	return strings.Join(surgeHysteriaUriParts, ", ")
 }
 
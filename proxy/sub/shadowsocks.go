@@ -0,0 +1,72 @@
+package sub
+
+import (
+	"encoding/base64"
+	"fmt"
+	"net/url"
+
+	"github.com/lureiny/v2raymg/proxy/config"
+	"github.com/lureiny/v2raymg/proxy/manager"
+)
+
+type ShadowsocksShareConfig struct {
+	Method     string
+	Password   string // shadowsocks 2022多用户模式为{服务端密钥}:{用户密钥}
+	RemoteHost string
+	RemotePort uint32
+	NodeName   string
+}
+
+// Build 生成ss://base64(method:password@host:port)#name, 与converter中的解析方式保持一致
+// 使用url safe的base64编码, 避免编码结果中的"/"被解析为path
+func (c *ShadowsocksShareConfig) Build() string {
+	userInfo := fmt.Sprintf("%s:%s@%s:%d", c.Method, c.Password, c.RemoteHost, c.RemotePort)
+	return fmt.Sprintf("%s#%s", base64.RawURLEncoding.EncodeToString([]byte(userInfo)), url.QueryEscape(c.NodeName))
+}
+
+func NewShadowsocksShareConfig(in *config.InboundDetourConfig, email string, host string, port uint32) (*ShadowsocksShareConfig, error) {
+	ssConfig, err := manager.NewShadowsocksInboundConfig(in)
+	if err != nil {
+		return nil, err
+	}
+	sharedConfig := &ShadowsocksShareConfig{}
+	for _, client := range ssConfig.Clients {
+		if client.Email != email {
+			continue
+		}
+		sharedConfig.Method = client.Method
+		sharedConfig.Password = client.Password
+		if sharedConfig.Method == "" {
+			sharedConfig.Method = ssConfig.Method
+		}
+		if config.IsShadowsocks2022Method(ssConfig.Method) {
+			sharedConfig.Method = ssConfig.Method
+			sharedConfig.Password = ssConfig.Password + ":" + client.Password
+		}
+		break
+	}
+	if sharedConfig.Password == "" {
+		return nil, fmt.Errorf("%s not in %s", email, in.Tag)
+	}
+
+	// 外部host为空， 则使用监听地址
+	if host == "" {
+		host = in.ListenOn
+	}
+	// 外部传过来的port为0的话, 则使用当前监听的端口
+	if port == 0 {
+		port = in.PortRange
+	}
+	sharedConfig.RemoteHost = host
+	sharedConfig.RemotePort = port
+	return sharedConfig, nil
+}
+
+func GetShadowsocksSub(in *config.InboundDetourConfig, user, host, nodeName string, port uint32) (string, error) {
+	s, err := NewShadowsocksShareConfig(in, user, host, port)
+	if err != nil {
+		return "", err
+	}
+	s.NodeName = nodeName
+	return fmt.Sprintf("ss://%s", s.Build()), nil
+}
@@ -0,0 +1,63 @@
+package sub
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/lureiny/v2raymg/proxy/config"
+	"github.com/smartystreets/goconvey/convey"
+)
+
+func newTestShadowsocksInbound(ssConfig *config.ShadowsocksInboundConfig) *config.InboundDetourConfig {
+	data, _ := json.Marshal(ssConfig)
+	return &config.InboundDetourConfig{
+		Protocol:  "shadowsocks",
+		Tag:       "ss-test",
+		ListenOn:  "127.0.0.1",
+		PortRange: 8388,
+		Settings:  (*json.RawMessage)(&data),
+	}
+}
+
+// 解析ss://base64(method:password@host:port)#name中的userinfo部分
+func decodeTestShadowsocksSub(sub string) string {
+	encoded := strings.SplitN(strings.TrimPrefix(sub, "ss://"), "#", 2)[0]
+	data, err := base64.RawURLEncoding.DecodeString(encoded)
+	if err != nil {
+		panic(err)
+	}
+	return string(data)
+}
+
+func TestGetShadowsocksSub(t *testing.T) {
+	convey.Convey("AEAD多用户", t, func() {
+		in := newTestShadowsocksInbound(&config.ShadowsocksInboundConfig{
+			Method:  "aes-128-gcm",
+			Clients: []*config.ShadowsocksInboundUser{{Method: "aes-128-gcm", Password: "p/1+", Email: "u1"}},
+		})
+		sub, err := GetShadowsocksSub(in, "u1", "", "node 1", 0)
+		convey.So(err, convey.ShouldBeNil)
+		convey.So(strings.HasSuffix(sub, "#node+1"), convey.ShouldBeTrue)
+		convey.So(decodeTestShadowsocksSub(sub), convey.ShouldEqual, "aes-128-gcm:p/1+@127.0.0.1:8388")
+
+		sub, err = GetShadowsocksSub(in, "u1", "example.com", "node", 443)
+		convey.So(err, convey.ShouldBeNil)
+		convey.So(decodeTestShadowsocksSub(sub), convey.ShouldEqual, "aes-128-gcm:p/1+@example.com:443")
+
+		_, err = GetShadowsocksSub(in, "u2", "", "node", 0)
+		convey.So(err, convey.ShouldNotBeNil)
+	})
+
+	convey.Convey("shadowsocks 2022多用户使用服务端密钥:用户密钥", t, func() {
+		in := newTestShadowsocksInbound(&config.ShadowsocksInboundConfig{
+			Method:   "2022-blake3-aes-128-gcm",
+			Password: "server_key",
+			Clients:  []*config.ShadowsocksInboundUser{{Password: "user_key", Email: "u1"}},
+		})
+		sub, err := GetShadowsocksSub(in, "u1", "", "node", 0)
+		convey.So(err, convey.ShouldBeNil)
+		convey.So(decodeTestShadowsocksSub(sub), convey.ShouldEqual, "2022-blake3-aes-128-gcm:server_key:user_key@127.0.0.1:8388")
+	})
+}
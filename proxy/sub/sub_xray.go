@@ -30,6 +30,8 @@ func GetUserSubUri(user, tag, host, nodeName string, port uint32, useSNI bool) (
 		return GetVmessSub(&inbound.Config, user, host, nodeName, port, useSNI)
 	case manager.TrojanProtocolName:
 		return GetTrojanSub(&inbound.Config, user, host, nodeName, port, useSNI)
+	case manager.ShadowsocksProtocolName:
+		return GetShadowsocksSub(&inbound.Config, user, host, nodeName, port)
 	default:
 		return "", fmt.Errorf("not support protocol: %s", inbound.Config.Protocol)
 	}
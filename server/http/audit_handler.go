@@ -0,0 +1,211 @@
+package http
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	client "github.com/lureiny/v2raymg/client/rpc"
+	"github.com/lureiny/v2raymg/common/audit"
+	"github.com/lureiny/v2raymg/common/log/logger"
+	globalAudit "github.com/lureiny/v2raymg/global/audit"
+	globalCluster "github.com/lureiny/v2raymg/global/cluster"
+	"github.com/lureiny/v2raymg/server/rpc/proto"
+)
+
+// 不记录审计日志的只读接口
+var auditReadOnlyPaths = map[string]bool{
+	"/node":    true,
+	"/tag":     true,
+	"/stat":    true,
+	"/metrics": true,
+	"/report":  true,
+	"/audit":   true,
+}
+
+// 返回结果的最大记录长度
+const auditMsgMaxLen = 256
+
+func isAuditReadOnly(c *gin.Context) bool {
+	path := c.FullPath()
+	if auditReadOnlyPaths[path] {
+		return true
+	}
+	switch path {
+	case "/user":
+		return c.Query("type") == "5"
+	case "/plan":
+		t := c.DefaultQuery("type", "list")
+		return t == "list" || t == "get"
+	}
+	return false
+}
+
+// 记录返回结果的开头部分, 只记录纯文本的结果, 避免记录导出的用户数据
+type auditWriter struct {
+	gin.ResponseWriter
+	body []byte
+}
+
+func newAuditWriter(c *gin.Context) *auditWriter {
+	w := &auditWriter{ResponseWriter: c.Writer}
+	c.Writer = w
+	return w
+}
+
+func (w *auditWriter) Write(data []byte) (int, error) {
+	if len(w.body) < auditMsgMaxLen &&
+		strings.HasPrefix(w.Header().Get("Content-Type"), "text/plain") {
+		w.body = append(w.body, data...)
+	}
+	return w.ResponseWriter.Write(data)
+}
+
+func (w *auditWriter) WriteString(s string) (int, error) {
+	return w.Write([]byte(s))
+}
+
+// writer为nil表示token校验失败
+func recordHttpAudit(c *gin.Context, s *HttpServer, writer *auditWriter) {
+	params := map[string]string{}
+	for k, v := range c.Request.URL.Query() {
+		params[k] = strings.Join(v, ",")
+	}
+	entry := &proto.AuditEntry{
+		Source:    audit.SourceHttp,
+		Caller:    c.ClientIP(),
+		Operation: c.FullPath(),
+		Target:    c.DefaultQuery("target", s.Name),
+		Params:    audit.RedactParams(params),
+	}
+	if writer == nil {
+		entry.Msg = "invalid token"
+	} else {
+		entry.Success = c.Writer.Status() < 400
+		entry.Msg = string(writer.body)
+	}
+	globalAudit.Record(entry)
+}
+
+type AuditHandler struct{ HttpHandlerImp }
+
+type auditReport struct {
+	Entries []*proto.AuditEntry `json:"entries"`
+	Failed  map[string]string   `json:"failed,omitempty"`
+}
+
+func (handler *AuditHandler) parseParam(c *gin.Context) map[string]string {
+	parasMap := map[string]string{}
+
+	parasMap["target"] = c.DefaultQuery("target", "all")
+	parasMap["caller"] = c.DefaultQuery("caller", "")
+	parasMap["operation"] = c.DefaultQuery("operation", "")
+	parasMap["start"] = c.DefaultQuery("start", "0")
+	parasMap["end"] = c.DefaultQuery("end", "0")
+	parasMap["last"] = c.DefaultQuery("last", "168h")
+	parasMap["limit"] = c.DefaultQuery("limit", strconv.Itoa(audit.DefaultQueryLimit))
+	return parasMap
+}
+
+// 合并各节点的记录, 按时间倒序取最近的limit条
+func mergeAuditEntries(nodes map[string][]*proto.AuditEntry, limit int) []*proto.AuditEntry {
+	entries := []*proto.AuditEntry{}
+	for _, nodeEntries := range nodes {
+		entries = append(entries, nodeEntries...)
+	}
+	sort.SliceStable(entries, func(i, j int) bool {
+		return entries[i].Time > entries[j].Time
+	})
+	if len(entries) > limit {
+		entries = entries[:limit]
+	}
+	return entries
+}
+
+func (handler *AuditHandler) handlerFunc(c *gin.Context) {
+	parasMap := handler.parseParam(c)
+
+	start, end, err := getReportTimeRange(parasMap, time.Now())
+	if err != nil {
+		c.String(200, fmt.Sprintf("illegal time range > %v", err))
+		return
+	}
+	limit, err := strconv.Atoi(parasMap["limit"])
+	if err != nil || limit <= 0 || limit > audit.MaxQueryLimit {
+		c.String(200, fmt.Sprintf("invalid limit param, must be in (0, %d]", audit.MaxQueryLimit))
+		return
+	}
+
+	nodes := handler.getHttpServer().GetTargetNodes(parasMap["target"])
+	if len(nodes) == 0 {
+		c.String(200, "no avaliable node")
+		return
+	}
+
+	rpcClient := client.NewEndNodeClient(nodes, nil)
+	succList, failedList, _ := rpcClient.ReqToMultiEndNodeServer(c.Request.Context(),
+		client.GetAuditLogReqType,
+		&proto.GetAuditLogReq{
+			Start:     start,
+			End:       end,
+			Caller:    parasMap["caller"],
+			Operation: parasMap["operation"],
+			Limit:     int32(limit),
+		},
+		globalCluster.GetClusterToken(),
+	)
+	if len(failedList) != 0 {
+		logger.Error(
+			"Err=%s|Caller=%s|Operation=%s|Target=%s",
+			joinFailedList(failedList),
+			parasMap["caller"],
+			parasMap["operation"],
+			parasMap["target"],
+		)
+	}
+	if len(succList) == 0 {
+		c.String(200, joinFailedList(failedList))
+		return
+	}
+
+	nodeEntries := map[string][]*proto.AuditEntry{}
+	for node, rsp := range succList {
+		nodeEntries[node] = rsp.([]*proto.AuditEntry)
+	}
+	c.JSON(200, &auditReport{
+		Entries: mergeAuditEntries(nodeEntries, limit),
+		Failed:  failedList,
+	})
+}
+
+func (handler *AuditHandler) getHandlers() []gin.HandlerFunc {
+	return []gin.HandlerFunc{
+		getAuthHandlerFunc(handler.httpServer),
+		handler.handlerFunc,
+	}
+}
+
+func (handler *AuditHandler) getRelativePath() string {
+	return "/audit"
+}
+
+func (handler *AuditHandler) help() string {
+	usage := `/audit
+	查询管理操作的审计日志, 合并各节点的记录后按时间倒序返回, 密码, token, uuid等参数会脱敏
+	http接口的操作记录在入口节点上, source为http, caller为请求来源ip; 转发到各节点的操作记录在执行的节点上, source为rpc, caller为发起请求的节点名称
+	只读的查询接口不记录, token校验失败的请求会记录
+	请求示例: /audit?target=all&operation=/user&last=24h&token={token}
+	参数列表:
+	token: 用于验证操作权限
+	target: 目标node的名称, 默认值为all
+	caller: 只返回指定来源的记录, 为空时不过滤
+	operation: 只返回指定操作的记录, http接口为path, 例如/user, rpc接口为方法名, 例如AddUsers
+	start/end: 查询的时间范围, 时间戳, end默认为当前时间
+	last: start为0时查询end之前的时长, 单位为秒或者时长, 默认值为168h
+	limit: 返回的最大记录数, 默认值为100, 最大为1000
+	`
+	return usage
+}
@@ -1,7 +1,7 @@
 package http
 
 import (
-	"encoding/base64"
+	"strconv"
 
 	"github.com/gin-gonic/gin"
 	"github.com/lureiny/v2raymg/common/log/logger"
@@ -11,7 +11,7 @@ import (
 type AuthHysteria2Data struct {
 	Addr string `json:"addr"`
 	Auth string `json:"auth"`
-	TX   int64  `json:"tx`
+	TX   int64  `json:"tx"`
 }
 
 type AuthHysteria2 struct{ HttpHandlerImp }
@@ -28,7 +28,7 @@ func (handler *AuthHysteria2) parseParam(c *gin.Context) map[string]string {
 
 	parasMap["addr"] = req.Addr
 	parasMap["auth"] = req.Auth
-	parasMap["tx"] = string(req.TX)
+	parasMap["tx"] = strconv.FormatInt(req.TX, 10)
 
 	return parasMap
 }
@@ -36,18 +36,21 @@ func (handler *AuthHysteria2) parseParam(c *gin.Context) map[string]string {
 func (handler *AuthHysteria2) handlerFunc(c *gin.Context) {
 	parasMap := handler.parseParam(c)
 
-	userMpa := user.GetUserList()
-	for _, u := range userMpa {
-		if u.Passwd == parasMap["auth"] ||
-			base64.RawStdEncoding.EncodeToString([]byte(u.Passwd)) == parasMap["auth"] {
-			c.JSON(200, map[string]interface{}{
-				"ok": true,
-				"id": u.Name,
-			})
-			return
-		}
+	// 与订阅使用相同的检查: 过期, 暂停, 超出配额以及是否有可用的tag
+	u, err := user.AuthUser(parasMap["auth"], parasMap["addr"])
+	if err != nil {
+		logger.Error(
+			"Err=hysteria auth fail > %v|Addr=%s",
+			err,
+			parasMap["addr"],
+		)
+		c.String(403, "")
+		return
 	}
-	c.String(403, "")
+	c.JSON(200, map[string]interface{}{
+		"ok": true,
+		"id": u.Name,
+	})
 }
 
 func (handler *AuthHysteria2) getHandlers() []gin.HandlerFunc {
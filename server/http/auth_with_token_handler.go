@@ -12,8 +12,15 @@ func getAuthHandlerFunc(httpServer *HttpServer) gin.HandlerFunc {
 			logger.Error("Err=invalid token|HttpPath=%s", c.FullPath())
 			c.String(401, "invalide token")
 			c.Abort()
+			recordHttpAudit(c, httpServer, nil)
 			return
 		}
+		if isAuditReadOnly(c) {
+			c.Next()
+			return
+		}
+		writer := newAuditWriter(c)
 		c.Next()
+		recordHttpAudit(c, httpServer, writer)
 	}
 }
@@ -29,10 +29,11 @@ func (handler *CopyUserBetweenNodesHandler) handlerFunc(c *gin.Context) {
 	}
 
 	srcNodes := handler.getHttpServer().GetTargetNodes(parasMap["srcNode"])
-	if len(srcNodes) == 0 {
-		c.String(200, "no avaliable src node")
+	if len(srcNodes) != 1 {
+		c.String(200, "src node must select exactly one avaliable node")
 		return
 	}
+	srcNodeName := srcNodes[0].Name
 
 	dstNodes := handler.getHttpServer().GetTargetNodes(parasMap["dstNode"])
 	if len(dstNodes) == 0 {
@@ -46,7 +47,7 @@ func (handler *CopyUserBetweenNodesHandler) handlerFunc(c *gin.Context) {
 	succList, failedList, _ := srcNodeRpcClient.ReqToMultiEndNodeServer(
 		c.Request.Context(), client.GetUsersReqType, &proto.GetUsersReq{}, globalCluster.GetClusterToken())
 	if len(failedList) > 0 {
-		errMsg := fmt.Sprintf("get src node user list err > %v", failedList[parasMap["srcNode"]])
+		errMsg := fmt.Sprintf("get src node user list err > %v", failedList[srcNodeName])
 		logger.Error(
 			"Err=%s|SrcNode=%s",
 			errMsg,
@@ -56,7 +57,7 @@ func (handler *CopyUserBetweenNodesHandler) handlerFunc(c *gin.Context) {
 		return
 	}
 
-	users := succList[parasMap["srcNode"]]
+	users := succList[srcNodeName]
 	for _, u := range users.([]*proto.User) {
 		u.Tags = []string{}
 		u.Downlink = 0
@@ -35,6 +35,10 @@ func getBuilderType(key string) proto.BuilderType {
 		return proto.BuilderType_VMESSSettingBuilderType
 	case "trojan":
 		return proto.BuilderType_TrojanSettingBuilderType
+	case "shadowsocks":
+		return proto.BuilderType_ShadowsocksSettingBuilderType
+	case "shadowsocks-2022":
+		return proto.BuilderType_Shadowsocks2022SettingBuilderType
 	case "tcp":
 		return proto.BuilderType_TCPBuilderType
 	case "ws":
@@ -123,7 +127,7 @@ func (handler *FastAddInboundHandler) help() string {
 	token: 用于验证操作权限
 	target: 目标节点名称
 	tag: inbound tag, 不可以和已有节点重复
-	protocol: 协议类型, 默认为vless, 目前只支持vless, vmess, trojan
+	protocol: 协议类型, 默认为vless, 目前只支持vless, vmess, trojan, shadowsocks, shadowsocks-2022, shadowsocks不使用tls, 忽略stream, isXtls与domain
 	port: inbound port
 	stream: 传输层协议, 默认为tcp
 	isXtls: true/false, 是否使用xtls, 默认使用tls
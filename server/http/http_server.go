@@ -30,6 +30,7 @@ func (s *HttpServer) Init(certManager *lego.CertManager) {
 	s.Port = config.GetInt(common.ConfigServerHttpPort)
 	s.token = config.GetString(common.ConfigServerHttpToken)
 	s.Name = config.GetString(common.ConfigServerName)
+	s.startReconcileJob()
 }
 
 func (s *HttpServer) SetName(name string) {
@@ -45,23 +46,20 @@ func (s *HttpServer) Start() {
 	s.RestfulServer.Run(fmt.Sprintf("%s:%d", s.Host, s.Port))
 }
 
-// 根据target查找路由的节点
+// 根据target查找路由的节点, target支持all, 逗号分隔的节点名称以及标签选择器(如region=jp,tier!=free,status=healthy)
 func (s *HttpServer) GetTargetNodes(target string) []*cluster.Node {
 	if target == "" {
 		target = s.Name
 	}
-	if target == "all" {
-		filter := func(n *cluster.Node) bool {
-			return n.Name == s.Name || n.IsValid()
-		}
-		nodes := globalCluster.GetNodesWithFilter(filter)
-		return nodes
-	} else {
-		filter := func(n *cluster.Node) bool {
-			return n.IsValid() && n.Name == target
-		}
-		return globalCluster.GetNodesWithFilter(filter)
+	selector, err := cluster.ParseNodeSelector(target)
+	if err != nil {
+		logger.Error("Err=parse target fail > %v|Target=%s", err, target)
+		return []*cluster.Node{}
 	}
+	filter := func(n *cluster.Node) bool {
+		return (n.Name == s.Name || n.IsValid()) && selector.Match(n)
+	}
+	return globalCluster.GetNodesWithFilter(filter)
 }
 
 func (s *HttpServer) RegisterHandler(handler HttpHandlerInterface, method string) {
@@ -18,6 +18,10 @@ func init() {
 	GlobalHttpServer.RegisterHandler(&TagHandler{}, "GET")
 	GlobalHttpServer.RegisterHandler(&UpdateHandler{}, "GET")
 	GlobalHttpServer.RegisterHandler(&UserHandler{}, "GET")
+	GlobalHttpServer.RegisterHandler(&TrialHandler{}, "GET")
+	GlobalHttpServer.RegisterHandler(&PlanHandler{}, "GET")
+	GlobalHttpServer.RegisterHandler(&ReportHandler{}, "GET")
+	GlobalHttpServer.RegisterHandler(&AuditHandler{}, "GET")
 	GlobalHttpServer.RegisterHandler(&GatewayHandler{}, "GET")
 	GlobalHttpServer.RegisterHandler(&CertHandler{}, "GET")
 	GlobalHttpServer.RegisterHandler(&FastAddInboundHandler{}, "GET")
@@ -25,6 +29,11 @@ func init() {
 	GlobalHttpServer.RegisterHandler(&GetCertsHandler{}, "GET")
 	GlobalHttpServer.RegisterHandler(&ClearUserHandler{}, "GET")
 	GlobalHttpServer.RegisterHandler(&CopyUserBetweenNodesHandler{}, "GET")
+	GlobalHttpServer.RegisterHandler(&ExportUsersHandler{}, "GET")
+	GlobalHttpServer.RegisterHandler(&ImportUsersHandler{}, "POST")
+	// source节点为期望状态时使用GET, 导出的用户数据为期望状态时使用POST
+	GlobalHttpServer.RegisterHandler(&ReconcileHandler{}, "GET")
+	GlobalHttpServer.RegisterHandler(&ReconcileHandler{}, "POST")
 	// hysteria2 post方式进行auth 将auth外置
 	GlobalHttpServer.RegisterHandler(&AuthHysteria2{}, "POST")
 }
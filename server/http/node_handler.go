@@ -2,13 +2,24 @@ package http
 
 import (
 	"github.com/gin-gonic/gin"
-	"github.com/lureiny/v2raymg/global/cluster"
+	"github.com/lureiny/v2raymg/cluster"
+	globalCluster "github.com/lureiny/v2raymg/global/cluster"
 )
 
 type NodeHandler struct{ HttpHandlerImp }
 
 func (handler *NodeHandler) handlerFunc(c *gin.Context) {
-	nodeList := cluster.GetAllNode()
+	target := c.DefaultQuery("target", "")
+	if target == "" {
+		nodeList := globalCluster.GetAllNode()
+		c.JSON(200, nodeList)
+		return
+	}
+	// 按照target筛选节点, 用于确认选择器实际命中的节点
+	nodeList := map[string]*cluster.Node{}
+	for _, n := range handler.getHttpServer().GetTargetNodes(target) {
+		nodeList[n.Name] = n
+	}
 	c.JSON(200, nodeList)
 }
 
@@ -25,10 +36,12 @@ func (handler *NodeHandler) getRelativePath() string {
 
 func (handler *NodeHandler) help() string {
 	usage := `/node
-	/node?token={token}
-	获取当前集群内的全部节点
+	/node?token={token}&target={target}
+	获取当前集群内的全部节点, 返回结果中包含节点标签(labels), 状态(status)与最近一次心跳上报的健康信息(Health)
+	status: healthy, degraded, down, 未上报健康信息的节点为unknown
 	参数列表:
 	token: 用于验证操作权限
+	target: 可选, 仅返回target选中的有效节点, 支持节点名称与标签选择器, 如region=jp,tier!=free,status=healthy
 	`
 	return usage
 }
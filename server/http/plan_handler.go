@@ -0,0 +1,156 @@
+package http
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+	client "github.com/lureiny/v2raymg/client/rpc"
+	"github.com/lureiny/v2raymg/common/log/logger"
+	"github.com/lureiny/v2raymg/common/util"
+	globalCluster "github.com/lureiny/v2raymg/global/cluster"
+	"github.com/lureiny/v2raymg/server/rpc/proto"
+)
+
+type PlanHandler struct{ HttpHandlerImp }
+
+func (handler *PlanHandler) parseParam(c *gin.Context) map[string]string {
+	parasMap := map[string]string{}
+
+	parasMap["type"] = c.DefaultQuery("type", "list")
+	parasMap["target"] = c.DefaultQuery("target", handler.getHttpServer().Name)
+	parasMap["name"] = c.DefaultQuery("name", "")
+	parasMap["tags"] = c.DefaultQuery("tags", "")
+	parasMap["duration"] = c.DefaultQuery("duration", "0")
+	parasMap["quota"] = c.DefaultQuery("quota", "0")
+	parasMap["uplink_quota"] = c.DefaultQuery("uplink_quota", "0")
+	parasMap["downlink_quota"] = c.DefaultQuery("downlink_quota", "0")
+	parasMap["level"] = c.DefaultQuery("level", "0")
+	parasMap["reset_period"] = c.DefaultQuery("reset_period", "0")
+	parasMap["anchor_day"] = c.DefaultQuery("anchor_day", "0")
+	parasMap["reapply"] = c.DefaultQuery("reapply", "0")
+	parasMap["node_selector"] = c.DefaultQuery("node_selector", "")
+	return parasMap
+}
+
+func getPlan(parmas map[string]string) (*proto.Plan, error) {
+	tagList := util.StringList{}
+	tagList = strings.Split(parmas["tags"], ",")
+	plan := &proto.Plan{
+		Name:         parmas["name"],
+		Tags:         tagList.Filter(func(t string) bool { return len(t) > 0 }),
+		NodeSelector: parmas["node_selector"],
+	}
+	var err error = nil
+	if plan.Duration, err = util.ParseSeconds(parmas["duration"]); err != nil {
+		return nil, fmt.Errorf("invalid duration param > %v", err)
+	}
+	if plan.Quota, plan.UplinkQuota, plan.DownlinkQuota, err = getQuota(parmas); err != nil {
+		return nil, err
+	}
+	level, err := strconv.ParseUint(parmas["level"], 10, 32)
+	if err != nil {
+		return nil, fmt.Errorf("invalid level param > %v", err)
+	}
+	plan.Level = uint32(level)
+	if plan.ResetPeriod, plan.BillingAnchorDay, err = getBilling(parmas); err != nil {
+		return nil, err
+	}
+	return plan, nil
+}
+
+func (handler *PlanHandler) handlerFunc(c *gin.Context) {
+	parasMap := handler.parseParam(c)
+
+	nodes := handler.getHttpServer().GetTargetNodes(parasMap["target"])
+	if len(nodes) == 0 {
+		c.String(200, "no avaliable node")
+		return
+	}
+	rpcClient := client.NewEndNodeClient(nodes, nil)
+
+	var req interface{} = nil
+	var reqType client.ReqToEndNodeType = -1
+	switch strings.ToLower(parasMap["type"]) {
+	case "set":
+		plan, err := getPlan(parasMap)
+		if err != nil {
+			errMsg := fmt.Sprintf("illegal plan > %v", err)
+			logger.Error(
+				"Err=%s|Plan=%s|Type=%s|Target=%s",
+				errMsg,
+				parasMap["name"],
+				parasMap["type"],
+				parasMap["target"],
+			)
+			c.String(200, errMsg)
+			return
+		}
+		req = &proto.PlanOpReq{
+			Plans:   []*proto.Plan{plan},
+			Reapply: parasMap["reapply"] == "1",
+		}
+		reqType = client.SetPlansReqType
+	case "del":
+		req = &proto.PlanOpReq{
+			Plans: []*proto.Plan{{Name: parasMap["name"]}},
+		}
+		reqType = client.DeletePlansReqType
+	default:
+		succList, _, _ := rpcClient.ReqToMultiEndNodeServer(c.Request.Context(),
+			client.GetPlansReqType,
+			&proto.GetPlansReq{},
+			globalCluster.GetClusterToken(),
+		)
+		c.JSON(200, succList)
+		return
+	}
+
+	_, failedList, _ := rpcClient.ReqToMultiEndNodeServer(c.Request.Context(), reqType, req, globalCluster.GetClusterToken())
+	if len(failedList) != 0 {
+		errMsg := joinFailedList(failedList)
+		logger.Error(
+			"Err=%s|Plan=%s|Type=%s|Target=%s",
+			errMsg,
+			parasMap["name"],
+			parasMap["type"],
+			parasMap["target"],
+		)
+		c.String(200, errMsg)
+		return
+	}
+	c.String(200, "Succ")
+}
+
+func (handler *PlanHandler) getHandlers() []gin.HandlerFunc {
+	return []gin.HandlerFunc{
+		getAuthHandlerFunc(handler.httpServer),
+		handler.handlerFunc,
+	}
+}
+
+func (handler *PlanHandler) getRelativePath() string {
+	return "/plan"
+}
+
+func (handler *PlanHandler) help() string {
+	usage := `/plan
+	用户套餐操作接口, 添加用户时通过plan={name}指定套餐, 未指定的tag, 过期时间, 配额, level与流量重置周期由套餐填充
+	请求示例: /plan?type=set&target=target1&name=basic&tags=tag1,tag2&duration=720h&quota=100GB&token={token}
+	参数列表:
+	token: 用于验证操作权限
+	type: 操作类型, 可选值为set, del, list, 默认值为list
+	target: 目标node的名称
+	name: 套餐名称
+	tags: 套餐使用的inbound tag, 使用","分割, 为空时使用默认tag
+	duration: 有效期, 单位为秒或者时长, 例如duration=2592000或duration=720h, 为0时表示不过期
+	quota/uplink_quota/downlink_quota: 流量配额, 支持KB/MB/GB/TB等单位, 为0时表示不限制
+	level: xray/v2ray用户level
+	reset_period: 流量重置周期, 单位为月, 为0时表示不重置
+	anchor_day: 每月的重置日, 取值1-31, 为0时使用用户的创建日
+	node_selector: 套餐适用的节点, 格式与target参数相同, 例如region=jp,tier!=free, 使用套餐添加用户时只添加到满足条件的节点, 为空时不限制
+	reapply: 为1时将修改后的套餐重新应用到使用该套餐的用户, 更新tag, 配额, level与流量重置周期, 不修改过期时间
+	`
+	return usage
+}
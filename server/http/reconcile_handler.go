@@ -0,0 +1,253 @@
+package http
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strconv"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	client "github.com/lureiny/v2raymg/client/rpc"
+	"github.com/lureiny/v2raymg/cluster"
+	"github.com/lureiny/v2raymg/common"
+	"github.com/lureiny/v2raymg/common/log/logger"
+	globalCluster "github.com/lureiny/v2raymg/global/cluster"
+	"github.com/lureiny/v2raymg/global/config"
+	"github.com/lureiny/v2raymg/server/rpc/proto"
+)
+
+// 定期检查的超时时间
+const reconcileJobTimeout = time.Minute
+
+type ReconcileHandler struct{ HttpHandlerImp }
+
+func (handler *ReconcileHandler) parseParam(c *gin.Context) map[string]string {
+	parasMap := map[string]string{}
+
+	parasMap["source"] = c.DefaultQuery("source", "")
+	parasMap["target"] = c.DefaultQuery("target", "all")
+	parasMap["repair"] = c.DefaultQuery("repair", "false")
+	parasMap["prune"] = c.DefaultQuery("prune", "false")
+	parasMap["repair_uuid"] = c.DefaultQuery("repair_uuid", "false")
+	parasMap["format"] = c.DefaultQuery("format", "json")
+	return parasMap
+}
+
+// 从source节点获取期望状态
+func getSourceUsers(ctx context.Context, source string) ([]*proto.User, error) {
+	nodes := GlobalHttpServer.GetTargetNodes(source)
+	if source == "" || len(nodes) != 1 {
+		return nil, fmt.Errorf("source node[%s] is not avaliable, it must select exactly one node", source)
+	}
+	rpcClient := client.NewEndNodeClient(nodes, nil)
+	succList, failedList, _ := rpcClient.ReqToMultiEndNodeServer(ctx,
+		client.ExportUsersReqType, &proto.ExportUsersReq{}, globalCluster.GetClusterToken())
+	if len(failedList) != 0 {
+		return nil, fmt.Errorf("get users from source node fail > %s", joinFailedList(failedList))
+	}
+	return succList[nodes[0].Name].([]*proto.User), nil
+}
+
+// 从导出的用户数据中获取期望状态
+func parseStateUsers(data []byte, format, key string) ([]*proto.User, error) {
+	data, err := decryptTransferData(data, key)
+	if err != nil {
+		return nil, err
+	}
+	transferUsers, err := decodeTransferUsers(data, format)
+	if err != nil {
+		return nil, err
+	}
+	users := []*proto.User{}
+	for _, u := range transferUsers {
+		users = append(users, u.toUser())
+	}
+	return users, nil
+}
+
+// 在target节点上对比并修复用户, source节点不参与对比, 返回各节点的差异或错误信息
+func reconcileNodes(ctx context.Context, source string, users []*proto.User, target string, repair, prune, repairUuid bool) (map[string]interface{}, error) {
+	nodes := []*cluster.Node{}
+	for _, node := range GlobalHttpServer.GetTargetNodes(target) {
+		if node.Name != source {
+			nodes = append(nodes, node)
+		}
+	}
+	if len(nodes) == 0 {
+		return nil, fmt.Errorf("no avaliable node")
+	}
+
+	rpcClient := client.NewEndNodeClient(nodes, nil)
+	succList, failedList, _ := rpcClient.ReqToMultiEndNodeServer(ctx,
+		client.ReconcileUsersReqType,
+		&proto.ReconcileUsersReq{
+			Users:      users,
+			Repair:     repair,
+			Prune:      prune,
+			RepairUuid: repairUuid,
+		},
+		globalCluster.GetClusterToken(),
+	)
+	report := map[string]interface{}{}
+	for node, rsp := range succList {
+		report[node] = rsp.([]*proto.UserDrift)
+	}
+	for node, errMsg := range failedList {
+		report[node] = errMsg
+	}
+	return report, nil
+}
+
+func (handler *ReconcileHandler) handlerFunc(c *gin.Context) {
+	parasMap := handler.parseParam(c)
+
+	repair, err := strconv.ParseBool(parasMap["repair"])
+	if err != nil {
+		c.String(200, fmt.Sprintf("invalid repair param > %v", err))
+		return
+	}
+	prune, err := strconv.ParseBool(parasMap["prune"])
+	if err != nil {
+		c.String(200, fmt.Sprintf("invalid prune param > %v", err))
+		return
+	}
+	repairUuid, err := strconv.ParseBool(parasMap["repair_uuid"])
+	if err != nil {
+		c.String(200, fmt.Sprintf("invalid repair_uuid param > %v", err))
+		return
+	}
+
+	// POST请求使用body中的用户作为期望状态, 否则使用source节点上的用户
+	var users []*proto.User = nil
+	if c.Request.Method == "POST" {
+		key, err := getTransferKey(c)
+		var data []byte = nil
+		if err == nil {
+			data, err = c.GetRawData()
+		}
+		if err == nil {
+			users, err = parseStateUsers(data, parasMap["format"], key)
+		}
+		if err != nil {
+			err = fmt.Errorf("parse desired state fail > %v", err)
+		}
+	} else {
+		users, err = getSourceUsers(c.Request.Context(), parasMap["source"])
+	}
+	if err != nil {
+		logger.Error(
+			"Err=%s|Source=%s|Target=%s",
+			err.Error(),
+			parasMap["source"],
+			parasMap["target"],
+		)
+		c.String(200, err.Error())
+		return
+	}
+
+	report, err := reconcileNodes(c.Request.Context(), parasMap["source"], users, parasMap["target"], repair, prune, repairUuid)
+	if err != nil {
+		c.String(200, err.Error())
+		return
+	}
+	c.JSON(200, report)
+}
+
+func (handler *ReconcileHandler) getHandlers() []gin.HandlerFunc {
+	return []gin.HandlerFunc{
+		getAuthHandlerFunc(handler.httpServer),
+		handler.handlerFunc,
+	}
+}
+
+func (handler *ReconcileHandler) getRelativePath() string {
+	return "/reconcile"
+}
+
+func (handler *ReconcileHandler) help() string {
+	usage := `/reconcile
+	以source节点或者导出的用户数据为期望状态, 对比target节点上的用户, 返回各节点的差异, 可以选择修复
+	差异类型: missing: 节点上缺失, extra: 节点上多余, mismatch: tags, expire_time, quota, suspended或uuid不一致, 只比较节点上存在的inbound tag, 流量不参与对比
+	请求示例: /reconcile?source={source}&target={target}&repair={repair}&prune={prune}&token={token}
+	POST请求示例: curl -X POST --data-binary @users.json "/reconcile?target={target}&format=json&repair=true&token={token}"
+	参数列表:
+	token: 用于验证操作权限
+	source: 作为期望状态的节点名称, POST请求时使用body中/exportUsers导出的数据作为期望状态, 不需要source
+	target: 需要对比的节点名称, 默认值为all, source节点不参与对比
+	repair: 为true时补上缺失的用户并修复不一致的字段, 默认值为false
+	prune: 为true且repair为true时删除期望状态中不存在的用户, 默认值为false
+	repair_uuid: 为true时即使repair为false也会把uuid不一致的用户统一为期望状态中的uuid, 默认值为false
+	format: POST数据的格式, 可选值为json, csv, 默认值为json
+	X-Transfer-Key: 请求头, POST数据的加密口令, 与导出时的口令一致; 不支持通过key参数传递
+	`
+	return usage
+}
+
+// 定期对比各节点的用户, 只记录日志, 配置repair后自动修复
+func (s *HttpServer) startReconcileJob() {
+	interval := config.GetInt(common.ConfigReconcileInterval)
+	if interval <= 0 {
+		return
+	}
+	source := config.GetString(common.ConfigReconcileSource)
+	stateFile := config.GetString(common.ConfigReconcileStateFile)
+	if source == "" && stateFile == "" {
+		logger.Error("Err=reconcile source and state file are both empty")
+		return
+	}
+	go func() {
+		ticker := time.NewTicker(time.Second * time.Duration(interval))
+		defer ticker.Stop()
+		for range ticker.C {
+			runReconcileJob(source, stateFile)
+		}
+	}()
+}
+
+func runReconcileJob(source, stateFile string) {
+	ctx, cancel := context.WithTimeout(context.Background(), reconcileJobTimeout)
+	defer cancel()
+
+	var users []*proto.User = nil
+	var err error = nil
+	if stateFile != "" {
+		// 使用文件作为期望状态时全部节点都参与对比
+		source = ""
+		var data []byte = nil
+		if data, err = os.ReadFile(stateFile); err == nil {
+			users, err = parseStateUsers(data, "json", "")
+		}
+	} else {
+		users, err = getSourceUsers(ctx, source)
+	}
+	if err != nil {
+		logger.Error("Err=load reconcile desired state fail > %v|Source=%s|StateFile=%s", err, source, stateFile)
+		return
+	}
+
+	repair := config.GetBool(common.ConfigReconcileRepair)
+	report, err := reconcileNodes(ctx, source, users, "all", repair, config.GetBool(common.ConfigReconcilePrune), repair)
+	if err != nil {
+		logger.Error("Err=reconcile users fail > %v|Source=%s", err, source)
+		return
+	}
+	for node, result := range report {
+		drifts, ok := result.([]*proto.UserDrift)
+		if !ok {
+			logger.Error("Err=reconcile users fail > %v|Node=%s", result, node)
+			continue
+		}
+		for _, drift := range drifts {
+			logger.Warn(
+				"Msg=user drift|Node=%s|User=%s|Type=%s|Fields=%v|Repaired=%v|Err=%s",
+				node,
+				drift.Name,
+				drift.Type,
+				drift.Fields,
+				drift.Repaired,
+				drift.Msg,
+			)
+		}
+	}
+}
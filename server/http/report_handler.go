@@ -0,0 +1,197 @@
+package http
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	client "github.com/lureiny/v2raymg/client/rpc"
+	"github.com/lureiny/v2raymg/common/log/logger"
+	"github.com/lureiny/v2raymg/common/traffic"
+	"github.com/lureiny/v2raymg/common/util"
+	globalCluster "github.com/lureiny/v2raymg/global/cluster"
+	"github.com/lureiny/v2raymg/server/rpc/proto"
+)
+
+type ReportHandler struct{ HttpHandlerImp }
+
+type trafficReport struct {
+	Nodes  map[string][]*proto.TrafficSeries `json:"nodes"`
+	Merged []*proto.TrafficSeries            `json:"merged"`
+}
+
+func (handler *ReportHandler) parseParam(c *gin.Context) map[string]string {
+	parasMap := map[string]string{}
+
+	parasMap["target"] = c.DefaultQuery("target", handler.getHttpServer().Name)
+	parasMap["type"] = c.DefaultQuery("type", "user")
+	parasMap["name"] = c.DefaultQuery("name", "")
+	parasMap["granularity"] = c.DefaultQuery("granularity", traffic.GranularityDay)
+	parasMap["start"] = c.DefaultQuery("start", "0")
+	parasMap["end"] = c.DefaultQuery("end", "0")
+	parasMap["last"] = c.DefaultQuery("last", "720h")
+	parasMap["month"] = c.DefaultQuery("month", "")
+	return parasMap
+}
+
+// 查询的时间范围, month优先, 其次为start/end, start为0时使用last计算
+func getReportTimeRange(parmas map[string]string, now time.Time) (start, end int64, err error) {
+	if parmas["month"] != "" {
+		month, err := time.ParseInLocation("2006-01", parmas["month"], time.Local)
+		if err != nil {
+			return 0, 0, fmt.Errorf("invalid month param > %v", err)
+		}
+		return month.Unix(), month.AddDate(0, 1, 0).Unix(), nil
+	}
+	if start, err = strconv.ParseInt(parmas["start"], 10, 64); err != nil {
+		return 0, 0, fmt.Errorf("invalid start param > %v", err)
+	}
+	if end, err = strconv.ParseInt(parmas["end"], 10, 64); err != nil {
+		return 0, 0, fmt.Errorf("invalid end param > %v", err)
+	}
+	if end <= 0 {
+		end = now.Unix()
+	}
+	if start <= 0 {
+		last, err := util.ParseSeconds(parmas["last"])
+		if err != nil {
+			return 0, 0, fmt.Errorf("invalid last param > %v", err)
+		}
+		start = end - last
+	}
+	if start >= end {
+		return 0, 0, fmt.Errorf("start must be earlier than end")
+	}
+	return start, end, nil
+}
+
+// 合并各节点中同名的数据, 相同时间段的流量相加
+func mergeTrafficSeries(nodes map[string][]*proto.TrafficSeries) []*proto.TrafficSeries {
+	seriesMap := map[string]*proto.TrafficSeries{}
+	pointsMap := map[string]map[int64]*proto.TrafficPoint{}
+	for _, seriesList := range nodes {
+		for _, series := range seriesList {
+			merged, ok := seriesMap[series.Name]
+			if !ok {
+				merged = &proto.TrafficSeries{Name: series.Name, Type: series.Type}
+				seriesMap[series.Name] = merged
+				pointsMap[series.Name] = map[int64]*proto.TrafficPoint{}
+			}
+			merged.Uplink += series.Uplink
+			merged.Downlink += series.Downlink
+			for _, point := range series.Points {
+				if p, ok := pointsMap[series.Name][point.Timestamp]; ok {
+					p.Uplink += point.Uplink
+					p.Downlink += point.Downlink
+					continue
+				}
+				pointsMap[series.Name][point.Timestamp] = &proto.TrafficPoint{
+					Timestamp: point.Timestamp,
+					Uplink:    point.Uplink,
+					Downlink:  point.Downlink,
+				}
+			}
+		}
+	}
+	result := []*proto.TrafficSeries{}
+	for name, merged := range seriesMap {
+		for _, point := range pointsMap[name] {
+			merged.Points = append(merged.Points, point)
+		}
+		sort.Slice(merged.Points, func(i, j int) bool {
+			return merged.Points[i].Timestamp < merged.Points[j].Timestamp
+		})
+		result = append(result, merged)
+	}
+	sort.Slice(result, func(i, j int) bool {
+		return result[i].Name < result[j].Name
+	})
+	return result
+}
+
+func (handler *ReportHandler) handlerFunc(c *gin.Context) {
+	parasMap := handler.parseParam(c)
+
+	start, end, err := getReportTimeRange(parasMap, time.Now())
+	if err != nil {
+		errMsg := fmt.Sprintf("illegal time range > %v", err)
+		logger.Error(
+			"Err=%s|Type=%s|Name=%s|Target=%s",
+			errMsg,
+			parasMap["type"],
+			parasMap["name"],
+			parasMap["target"],
+		)
+		c.String(200, errMsg)
+		return
+	}
+
+	nodes := handler.getHttpServer().GetTargetNodes(parasMap["target"])
+	if len(nodes) == 0 {
+		c.String(200, "no avaliable node")
+		return
+	}
+
+	rpcClient := client.NewEndNodeClient(nodes, nil)
+	succList, failedList, _ := rpcClient.ReqToMultiEndNodeServer(c.Request.Context(),
+		client.GetTrafficReportReqType,
+		&proto.GetTrafficReportReq{
+			Type:        parasMap["type"],
+			Name:        parasMap["name"],
+			Granularity: parasMap["granularity"],
+			Start:       start,
+			End:         end,
+		},
+		globalCluster.GetClusterToken(),
+	)
+	if len(failedList) != 0 {
+		logger.Error(
+			"Err=%s|Type=%s|Name=%s|Target=%s",
+			joinFailedList(failedList),
+			parasMap["type"],
+			parasMap["name"],
+			parasMap["target"],
+		)
+	}
+	if len(succList) == 0 {
+		c.String(200, joinFailedList(failedList))
+		return
+	}
+
+	report := &trafficReport{Nodes: map[string][]*proto.TrafficSeries{}}
+	for node, rsp := range succList {
+		report.Nodes[node] = rsp.([]*proto.TrafficSeries)
+	}
+	report.Merged = mergeTrafficSeries(report.Nodes)
+	c.JSON(200, report)
+}
+
+func (handler *ReportHandler) getHandlers() []gin.HandlerFunc {
+	return []gin.HandlerFunc{
+		getAuthHandlerFunc(handler.httpServer),
+		handler.handlerFunc,
+	}
+}
+
+func (handler *ReportHandler) getRelativePath() string {
+	return "/report"
+}
+
+func (handler *ReportHandler) help() string {
+	usage := `/report
+	获取持久化的流量报表, 流量按小时与天聚合, 节点重启后不会丢失, 返回结果中nodes为各节点的数据, merged为合并各节点后的数据
+	请求示例: /report?target=all&type=user&name=user1&month=2022-11&token={token}
+	参数列表:
+	token: 用于验证操作权限
+	target: 目标node的名称, 为all时合并全部节点的数据
+	type: 流量类型, 可选值为user, inbound, outbound, node, 默认值为user, node为节点的总流量
+	name: 用户名/inbound tag/节点名称, 为空时返回该类型的全部数据
+	granularity: 聚合粒度, 可选值为hour, day, 默认值为day
+	month: 查询指定月份的流量, 格式为2022-11, 指定时忽略start, end与last
+	start/end: 查询的时间范围, 时间戳, end默认为当前时间
+	last: start为0时查询end之前的时长, 单位为秒或者时长, 默认值为720h
+	`
+	return usage
+}
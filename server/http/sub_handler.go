@@ -1,6 +1,7 @@
 package http
 
 import (
+	"fmt"
 	"sort"
 	"strings"
 
@@ -21,6 +22,7 @@ func (handler *SubHandler) parseParam(c *gin.Context) map[string]string {
 	// sub  这里需要变更下token的问题
 	parasMap["user"] = c.Query("user")
 	parasMap["pwd"] = c.Query("pwd")
+	parasMap["subToken"] = c.Query("sub_token")
 	parasMap["tags"] = c.DefaultQuery("tags", "")                          // 按照","分隔
 	parasMap["excludeProtocols"] = c.DefaultQuery("exclude_protocols", "") // 按照","分隔
 	parasMap["target"] = c.DefaultQuery("target", handler.getHttpServer().Name)
@@ -38,17 +40,17 @@ func (handler *SubHandler) handlerFunc(c *gin.Context) {
 	excludeProtocols = strings.Split(parasMap["excludeProtocols"], ",")
 	// 需要根据target做路由
 	userPoint := &proto.User{
-		Name:   parasMap["user"],
-		Passwd: parasMap["pwd"],
-		Tags:   tagList.Filter(func(t string) bool { return len(t) > 0 }),
+		Name:     parasMap["user"],
+		Passwd:   parasMap["pwd"],
+		SubToken: parasMap["subToken"],
+		Tags:     tagList.Filter(func(t string) bool { return len(t) > 0 }),
 	}
 
 	if !cluster.IsUserComplete(userPoint, true) {
 		logger.Error(
-			"Err=%s|User=%s|Passwd=%s|Target=%s",
+			"Err=%s|User=%s|Target=%s",
 			"invalid user",
 			parasMap["user"],
-			parasMap["pwd"],
 			parasMap["target"],
 		)
 		c.String(200, "invalid user")
@@ -75,23 +77,30 @@ func (handler *SubHandler) handlerFunc(c *gin.Context) {
 	if len(failedList) != 0 {
 		errMsg := joinFailedList(failedList)
 		logger.Error(
-			"Err=%s|User=%s|Passwd=%s|Target=%s|ExincludeProtocols=%s",
+			"Err=%s|User=%s|Target=%s|ExincludeProtocols=%s",
 			errMsg,
 			parasMap["user"],
-			parasMap["pwd"],
 			parasMap["target"],
 			parasMap["excludeProtocols"],
 		)
 	}
 	uris := []string{}
 	succNodes := []string{}
+	usages := []*proto.User{}
 	for node := range succList {
 		succNodes = append(succNodes, node)
 	}
 
 	sort.Strings(succNodes)
 	for _, n := range succNodes {
-		uris = append(uris, succList[n].([]string)...)
+		rsp := succList[n].(*proto.GetSubRsp)
+		uris = append(uris, rsp.GetUris()...)
+		if rsp.GetUsage() != nil {
+			usages = append(usages, rsp.GetUsage())
+		}
+	}
+	if userInfo := getSubscriptionUserinfo(usages); userInfo != "" {
+		c.Header("Subscription-Userinfo", userInfo)
 	}
 
 	uri, err := converter.ConvertSubUri(strings.ToLower(userAgent), uris)
@@ -101,6 +110,35 @@ func (handler *SubHandler) handlerFunc(c *gin.Context) {
 	c.String(200, uri)
 }
 
+// 订阅客户端通用的流量信息格式, 流量为各节点当前周期的已使用流量之和, 配额按节点分别计算, 总量为各节点配额之和
+// 任一节点不限制配额时不返回total, 过期时间取最早的一个
+func getSubscriptionUserinfo(usages []*proto.User) string {
+	if len(usages) == 0 {
+		return ""
+	}
+	var upload, download, total, expire int64 = 0, 0, 0, 0
+	unlimited := false
+	for _, u := range usages {
+		upload += u.UsedUplink
+		download += u.UsedDownlink
+		if u.Quota <= 0 {
+			unlimited = true
+		}
+		total += u.Quota
+		if u.ExpireTime > 0 && (expire == 0 || u.ExpireTime < expire) {
+			expire = u.ExpireTime
+		}
+	}
+	userInfo := fmt.Sprintf("upload=%d; download=%d", upload, download)
+	if !unlimited {
+		userInfo += fmt.Sprintf("; total=%d", total)
+	}
+	if expire > 0 {
+		userInfo += fmt.Sprintf("; expire=%d", expire)
+	}
+	return userInfo
+}
+
 func (handler *SubHandler) getHandlers() []gin.HandlerFunc {
 	return []gin.HandlerFunc{
 		handler.handlerFunc,
@@ -114,13 +152,15 @@ func (handler *SubHandler) getRelativePath() string {
 func (handler *SubHandler) help() string {
 	usage := `/sub
 	获取订阅
-	/sub?target={target}&user={user}&pwd={pwd}&tags={tags}&exclude_protocols={exclude_protocols}&use_sni={use_sni}
+	/sub?target={target}&user={user}&sub_token={sub_token}&tags={tags}&exclude_protocols={exclude_protocols}&use_sni={use_sni}
 	target: 目标节点
 	user: user name
-	pwd: password
+	sub_token: 订阅token, 添加用户时随机生成, 可以在/user中单独重置, 建议使用sub_token代替pwd
+	pwd: password, 未指定sub_token时使用密码校验
 	tags: inbound的tag列表, 使用","分隔
 	exclude_protocols: 过滤掉的协议订阅, 使用","分隔
 	use_sni: 是否包含sni信息, 解决sni封锁问题
+	响应头Subscription-Userinfo中包含当前流量统计周期的已使用流量, 配额与过期时间
 	`
 	return usage
 }
@@ -0,0 +1,311 @@
+package http
+
+import (
+	"fmt"
+	"net"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	client "github.com/lureiny/v2raymg/client/rpc"
+	"github.com/lureiny/v2raymg/cluster"
+	"github.com/lureiny/v2raymg/common"
+	"github.com/lureiny/v2raymg/common/log/logger"
+	"github.com/lureiny/v2raymg/common/util"
+	globalCluster "github.com/lureiny/v2raymg/global/cluster"
+	"github.com/lureiny/v2raymg/global/config"
+	"github.com/lureiny/v2raymg/server/rpc/proto"
+)
+
+const (
+	defaultTrialNamePrefix = "trial_"
+	defaultTrialDuration   = "24h"
+	defaultTrialQuota      = "1GB"
+	defaultTrialRateLimit  = 5
+	defaultTrialRateWindow = "1h"
+
+	trialNameSize   = 4 // 用户名随机部分的字节数
+	trialPasswdSize = 8
+	trialNameRetry  = 5
+)
+
+var (
+	trialLimiter     *util.RateLimiter = nil
+	trialLimiterOnce sync.Once
+)
+
+// 创建成功后返回给调用方的试用用户信息
+type trialUser struct {
+	Name       string   `json:"name"`
+	Passwd     string   `json:"pwd"`
+	SubToken   string   `json:"sub_token"`
+	Uuid       string   `json:"uuid"`
+	Tags       []string `json:"tags"`
+	ExpireTime int64    `json:"expire_time"`
+	Quota      int64    `json:"quota"`
+	Target     string   `json:"target"`
+	SubUrl     string   `json:"sub_url"`
+}
+
+type TrialHandler struct{ HttpHandlerImp }
+
+func (handler *TrialHandler) parseParam(c *gin.Context) map[string]string {
+	parasMap := map[string]string{}
+
+	parasMap["target"] = c.DefaultQuery("target", "all")
+	return parasMap
+}
+
+func getConfigOrDefault(key, defaultValue string) string {
+	if value := config.GetString(key); value != "" {
+		return value
+	}
+	return defaultValue
+}
+
+// 限流配置在第一次创建试用用户时读取
+func getTrialLimiter() *util.RateLimiter {
+	trialLimiterOnce.Do(func() {
+		limit := defaultTrialRateLimit
+		if config.GetString(common.ConfigTrialRateLimit) != "" {
+			limit = config.GetInt(common.ConfigTrialRateLimit)
+		}
+		window, err := util.ParseSeconds(getConfigOrDefault(common.ConfigTrialRateWindow, defaultTrialRateWindow))
+		if err != nil || window <= 0 {
+			logger.Error("Err=invalid trial rate window > %v|Default=%s", err, defaultTrialRateWindow)
+			window, _ = util.ParseSeconds(defaultTrialRateWindow)
+		}
+		trialLimiter = util.NewRateLimiter(limit, time.Duration(window)*time.Second)
+	})
+	return trialLimiter
+}
+
+// 获取目标节点上已有的用户名, 避免随机生成的用户名与已有用户重复
+func getExistUserNames(c *gin.Context, rpcClient *client.EndNodeClient) (map[string]bool, error) {
+	succList, failedList, _ := rpcClient.ReqToMultiEndNodeServer(c.Request.Context(),
+		client.GetUsersReqType,
+		&proto.GetUsersReq{},
+		globalCluster.GetClusterToken(),
+	)
+	if len(failedList) != 0 {
+		return nil, fmt.Errorf("get users fail > %s", joinFailedList(failedList))
+	}
+	names := map[string]bool{}
+	for _, users := range succList {
+		for _, u := range users.([]*proto.User) {
+			names[u.Name] = true
+		}
+	}
+	return names, nil
+}
+
+// 按照trial配置生成用户, 用户名, 密码, 订阅token与uuid均随机生成
+func newTrialUser(existNames map[string]bool) (*proto.User, error) {
+	duration, err := util.ParseSeconds(getConfigOrDefault(common.ConfigTrialDuration, defaultTrialDuration))
+	if err != nil || duration <= 0 {
+		return nil, fmt.Errorf("invalid trial duration > %v", err)
+	}
+	quota, err := util.ParseByteSize(getConfigOrDefault(common.ConfigTrialQuota, defaultTrialQuota))
+	if err != nil {
+		return nil, fmt.Errorf("invalid trial quota > %v", err)
+	}
+
+	user := &proto.User{
+		ExpireTime: time.Now().Unix() + duration,
+		Quota:      quota,
+		Tags:       config.GetStringSlice(common.ConfigTrialTags),
+		Uuid:       cluster.NewUserUUID(),
+	}
+	prefix := getConfigOrDefault(common.ConfigTrialNamePrefix, defaultTrialNamePrefix)
+	for i := 0; i < trialNameRetry && user.Name == ""; i++ {
+		suffix, err := util.RandomToken(trialNameSize)
+		if err != nil {
+			return nil, fmt.Errorf("generate trial user name fail > %v", err)
+		}
+		if !existNames[prefix+suffix] {
+			user.Name = prefix + suffix
+		}
+	}
+	if user.Name == "" {
+		return nil, fmt.Errorf("generate unique trial user name fail")
+	}
+	if user.Passwd, err = util.RandomToken(trialPasswdSize); err != nil {
+		return nil, fmt.Errorf("generate trial user passwd fail > %v", err)
+	}
+	if user.SubToken, err = cluster.NewSubToken(); err != nil {
+		return nil, err
+	}
+	return user, nil
+}
+
+// 订阅地址优先使用配置的地址, 否则使用本次请求的地址
+func getTrialSubUrl(c *gin.Context, user *proto.User, target string) string {
+	base := config.GetString(common.ConfigTrialSubUrl)
+	if base == "" {
+		scheme := "http"
+		if c.Request.TLS != nil {
+			scheme = "https"
+		}
+		if forwardedProto := c.GetHeader("X-Forwarded-Proto"); forwardedProto != "" {
+			scheme = forwardedProto
+		}
+		base = scheme + "://" + c.Request.Host
+	}
+	query := url.Values{}
+	query.Set("target", target)
+	query.Set("user", user.Name)
+	query.Set("sub_token", user.SubToken)
+	return strings.TrimSuffix(base, "/") + "/sub?" + query.Encode()
+}
+
+var defaultTrialTrustedProxies = []string{"127.0.0.1", "::1"}
+
+// 来源地址是否属于信任的反向代理
+func isTrustedProxy(host string) bool {
+	ip := net.ParseIP(host)
+	if ip == nil {
+		return false
+	}
+	proxies := config.GetStringSlice(common.ConfigTrialTrustedProxies)
+	if len(proxies) == 0 {
+		proxies = defaultTrialTrustedProxies
+	}
+	for _, proxy := range proxies {
+		if _, ipNet, err := net.ParseCIDR(proxy); err == nil {
+			if ipNet.Contains(ip) {
+				return true
+			}
+		} else if proxyIP := net.ParseIP(proxy); proxyIP != nil && proxyIP.Equal(ip) {
+			return true
+		}
+	}
+	return false
+}
+
+// 限流使用通过认证的调用方地址, 只有配置了trusted_proxy_header且请求来自信任的反向代理时才使用请求头中的地址,
+// 否则使用tcp连接的来源地址, 不信任X-Forwarded-For等可以伪造的请求头
+func getTrialCaller(c *gin.Context) string {
+	host, _, err := net.SplitHostPort(c.Request.RemoteAddr)
+	if err != nil {
+		host = c.Request.RemoteAddr
+	}
+	header := config.GetString(common.ConfigTrialTrustedProxyHeader)
+	if header == "" || !isTrustedProxy(host) {
+		return host
+	}
+	// 多级代理时取最后一个代理写入的地址
+	values := strings.Split(c.GetHeader(header), ",")
+	if caller := strings.TrimSpace(values[len(values)-1]); caller != "" {
+		return caller
+	}
+	return host
+}
+
+func (handler *TrialHandler) handlerFunc(c *gin.Context) {
+	parasMap := handler.parseParam(c)
+
+	caller := getTrialCaller(c)
+	// 只有创建成功时才计数, 避免节点故障等原因导致的失败占用配额
+	if getTrialLimiter().Limited(caller) {
+		logger.Error("Err=create trial user too frequently|Caller=%s|Target=%s", caller, parasMap["target"])
+		c.String(429, "create trial user too frequently, please try again later")
+		return
+	}
+
+	nodes := handler.getHttpServer().GetTargetNodes(parasMap["target"])
+	if len(nodes) == 0 {
+		c.String(200, "no avaliable node")
+		return
+	}
+	rpcClient := client.NewEndNodeClient(nodes, nil)
+
+	existNames, err := getExistUserNames(c, rpcClient)
+	var user *proto.User = nil
+	if err == nil {
+		user, err = newTrialUser(existNames)
+	}
+	if err != nil {
+		logger.Error("Err=%s|Caller=%s|Target=%s", err.Error(), caller, parasMap["target"])
+		c.String(200, err.Error())
+		return
+	}
+
+	succList, failedList, _ := rpcClient.ReqToMultiEndNodeServer(c.Request.Context(),
+		client.AddUsersReqType,
+		&proto.UserOpReq{Users: []*proto.User{user}},
+		globalCluster.GetClusterToken(),
+	)
+	if len(failedList) != 0 {
+		errMsg := joinFailedList(failedList)
+		logger.Error(
+			"Err=%s|User=%s|Caller=%s|Target=%s",
+			errMsg,
+			user.Name,
+			caller,
+			parasMap["target"],
+		)
+		// 部分节点添加失败时删除已经添加的用户, 避免留下不完整的试用用户
+		if len(succList) != 0 {
+			succNodes := []*cluster.Node{}
+			for _, node := range nodes {
+				if _, ok := succList[node.Name]; ok {
+					succNodes = append(succNodes, node)
+				}
+			}
+			client.NewEndNodeClient(succNodes, nil).ReqToMultiEndNodeServer(c.Request.Context(),
+				client.DeleteUsersReqType,
+				&proto.UserOpReq{Users: []*proto.User{{Name: user.Name}}},
+				globalCluster.GetClusterToken(),
+			)
+		}
+		c.String(200, errMsg)
+		return
+	}
+
+	getTrialLimiter().Allow(caller)
+	logger.Info(
+		"Msg=create trial user|User=%s|Caller=%s|Target=%s|ExpireTime=%d|Quota=%d",
+		user.Name,
+		caller,
+		parasMap["target"],
+		user.ExpireTime,
+		user.Quota,
+	)
+	c.JSON(200, &trialUser{
+		Name:       user.Name,
+		Passwd:     user.Passwd,
+		SubToken:   user.SubToken,
+		Uuid:       user.Uuid,
+		Tags:       user.Tags,
+		ExpireTime: user.ExpireTime,
+		Quota:      user.Quota,
+		Target:     parasMap["target"],
+		SubUrl:     getTrialSubUrl(c, user, parasMap["target"]),
+	})
+}
+
+func (handler *TrialHandler) getHandlers() []gin.HandlerFunc {
+	return []gin.HandlerFunc{
+		getAuthHandlerFunc(handler.httpServer),
+		handler.handlerFunc,
+	}
+}
+
+func (handler *TrialHandler) getRelativePath() string {
+	return "/trial"
+}
+
+func (handler *TrialHandler) help() string {
+	usage := `/trial
+	创建试用用户, 用户名, 密码, 订阅token与uuid随机生成, 过期时间, 流量配额与inbound tag使用配置文件中的trial配置, 返回用户信息与订阅地址
+	同一个调用方在rate_window内最多成功创建rate_limit个试用用户, 超出时返回429, 创建失败不计数
+	调用方默认为tcp连接的来源ip, 配置了trial.trusted_proxy_header时, 来自trial.trusted_proxies的请求使用该请求头中的ip
+	请求示例: /trial?target={target}&token={token}
+	参数列表:
+	token: 用于验证操作权限
+	target: 添加用户的节点名称, 默认值为all, 部分节点添加失败时会删除已添加的用户
+	`
+	return usage
+}
@@ -0,0 +1,49 @@
+package http
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/lureiny/v2raymg/common"
+	"github.com/lureiny/v2raymg/global/config"
+	"github.com/smartystreets/goconvey/convey"
+)
+
+func TestGetTrialCaller(t *testing.T) {
+	convey.Convey("trial caller ignores forwarded headers", t, func() {
+		newContext := func(remoteAddr, forwardedFor string) *gin.Context {
+			c, _ := gin.CreateTestContext(httptest.NewRecorder())
+			c.Request, _ = http.NewRequest("GET", "/trial", nil)
+			c.Request.RemoteAddr = remoteAddr
+			if forwardedFor != "" {
+				c.Request.Header.Set("X-Forwarded-For", forwardedFor)
+				c.Request.Header.Set("X-Real-Ip", forwardedFor)
+			}
+			return c
+		}
+		configFile := filepath.Join(t.TempDir(), "config.yaml")
+		convey.So(os.WriteFile(configFile, []byte("trial:\n  rate_limit: 5\n"), 0644), convey.ShouldBeNil)
+		convey.So(config.InitGlobalConfig(configFile), convey.ShouldBeNil)
+		convey.So(getTrialCaller(newContext("10.0.0.1:34567", "")), convey.ShouldEqual, "10.0.0.1")
+		convey.So(getTrialCaller(newContext("10.0.0.1:34567", "1.2.3.4")), convey.ShouldEqual, "10.0.0.1")
+		convey.So(getTrialCaller(newContext("10.0.0.1:45678", "5.6.7.8")), convey.ShouldEqual, "10.0.0.1")
+		convey.So(getTrialCaller(newContext("[::1]:34567", "")), convey.ShouldEqual, "::1")
+
+		convey.Convey("use trusted proxy header", func() {
+			config.Set(common.ConfigTrialTrustedProxyHeader, "X-Forwarded-For")
+			config.Set(common.ConfigTrialTrustedProxies, []string{"10.0.0.0/24"})
+			defer config.Set(common.ConfigTrialTrustedProxyHeader, "")
+			defer config.Set(common.ConfigTrialTrustedProxies, []string{})
+			convey.So(getTrialCaller(newContext("10.0.0.1:34567", "1.2.3.4")), convey.ShouldEqual, "1.2.3.4")
+			convey.So(getTrialCaller(newContext("10.0.0.1:34567", "9.9.9.9, 1.2.3.4")), convey.ShouldEqual, "1.2.3.4")
+			convey.So(getTrialCaller(newContext("10.0.0.1:34567", "")), convey.ShouldEqual, "10.0.0.1")
+			// 不是信任的反向代理时忽略请求头
+			convey.So(getTrialCaller(newContext("10.0.1.1:34567", "1.2.3.4")), convey.ShouldEqual, "10.0.1.1")
+			convey.So(getTrialCaller(newContext("127.0.0.1:34567", "1.2.3.4")), convey.ShouldEqual, "127.0.0.1")
+		})
+	})
+}
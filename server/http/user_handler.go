@@ -7,10 +7,13 @@ import (
 	"time"
 
 	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
 	client "github.com/lureiny/v2raymg/client/rpc"
+	"github.com/lureiny/v2raymg/cluster"
 	"github.com/lureiny/v2raymg/common/log/logger"
 	"github.com/lureiny/v2raymg/common/util"
 	globalCluster "github.com/lureiny/v2raymg/global/cluster"
+	globalUser "github.com/lureiny/v2raymg/global/user"
 	"github.com/lureiny/v2raymg/server/rpc/proto"
 )
 
@@ -18,6 +21,7 @@ type UserHandler struct{ HttpHandlerImp }
 
 var userOpMap = map[string]string{
 	"1": "AddUsers", "2": "UpdateUsers", "3": "DeleteUsers", "4": "ResetUser",
+	"6": "SuspendUsers", "7": "ResumeUsers",
 }
 
 func getExpireTime(parmas map[string]string) (int64, error) {
@@ -45,18 +49,159 @@ func getExpireTime(parmas map[string]string) (int64, error) {
 	}
 }
 
+// 流量配额支持带单位, 例如100GB, 为0时表示不限制(更新时表示不修改), 小于0时表示取消限制
+func getQuota(parmas map[string]string) (quota, uplinkQuota, downlinkQuota int64, err error) {
+	if quota, err = util.ParseByteSize(parmas["quota"]); err != nil {
+		return 0, 0, 0, fmt.Errorf("invalid quota param > %v", err)
+	}
+	if uplinkQuota, err = util.ParseByteSize(parmas["uplink_quota"]); err != nil {
+		return 0, 0, 0, fmt.Errorf("invalid uplink_quota param > %v", err)
+	}
+	if downlinkQuota, err = util.ParseByteSize(parmas["downlink_quota"]); err != nil {
+		return 0, 0, 0, fmt.Errorf("invalid downlink_quota param > %v", err)
+	}
+	return
+}
+
+// 流量重置周期(月)与重置日, 重置周期为0时表示不重置
+func getBilling(parmas map[string]string) (resetPeriod, anchorDay uint32, err error) {
+	period, err := strconv.ParseUint(parmas["reset_period"], 10, 32)
+	if err != nil {
+		return 0, 0, fmt.Errorf("invalid reset_period param > %v", err)
+	}
+	day, err := strconv.ParseUint(parmas["anchor_day"], 10, 32)
+	if err != nil {
+		return 0, 0, fmt.Errorf("invalid anchor_day param > %v", err)
+	}
+	if day > 31 {
+		return 0, 0, fmt.Errorf("invalid anchor_day param > must be in [1, 31]")
+	}
+	return uint32(period), uint32(day), nil
+}
+
+// 部分更新时需要修改的字段, fields可选pwd,expire,quota,billing,sub_token,speed_tier, extend/add_tags/remove_tags不为空时同样生效
+// 均未指定时返回nil, 即全量更新passwd, expire与quota
+func getUpdateMask(parmas map[string]string) (*proto.UserUpdateMask, error) {
+	mask := &proto.UserUpdateMask{}
+	masked := false
+	for _, field := range strings.Split(parmas["fields"], ",") {
+		switch field {
+		case "":
+			continue
+		case "pwd":
+			mask.Passwd = true
+		case "expire":
+			mask.ExpireTime = true
+		case "quota":
+			mask.Quota = true
+		case "billing":
+			mask.Billing = true
+		case "sub_token":
+			mask.SubToken = true
+		case "speed_tier":
+			mask.SpeedTier = true
+		default:
+			return nil, fmt.Errorf("unsupport update field %s", field)
+		}
+		masked = true
+	}
+	if extend := parmas["extend"]; extend != "" && extend != "0" {
+		// 支持秒数或者时长, 例如2592000或720h
+		seconds, err := util.ParseSeconds(extend)
+		if err != nil {
+			return nil, fmt.Errorf("invalid extend param > %v", err)
+		}
+		if seconds <= 0 {
+			return nil, fmt.Errorf("invalid extend param > must be positive")
+		}
+		mask.ExtendExpire = seconds
+		masked = true
+	}
+	splitTags := func(tags string) []string {
+		tagList := util.StringList(strings.Split(tags, ","))
+		return tagList.Filter(func(t string) bool { return len(t) > 0 })
+	}
+	if mask.AddTags = splitTags(parmas["add_tags"]); len(mask.AddTags) > 0 {
+		masked = true
+	}
+	if mask.RemoveTags = splitTags(parmas["remove_tags"]); len(mask.RemoveTags) > 0 {
+		masked = true
+	}
+	if !masked {
+		return nil, nil
+	}
+	return mask, nil
+}
+
+// 指定sub_token时使用指定的token, 用于同步各节点的token, 否则随机生成
+func getSubToken(parmas map[string]string) (string, error) {
+	if token := parmas["sub_token"]; token != "" {
+		if len(token) < 16 {
+			return "", fmt.Errorf("sub_token is too short, at least 16 characters")
+		}
+		return token, nil
+	}
+	return cluster.NewSubToken()
+}
+
+// 指定uuid时使用指定的uuid, 否则随机生成, 各节点使用同一个uuid
+func getUserUUID(parmas map[string]string) (string, error) {
+	if id := parmas["uuid"]; id != "" {
+		if _, err := uuid.Parse(id); err != nil {
+			return "", fmt.Errorf("invalid uuid > %v", err)
+		}
+		return id, nil
+	}
+	return cluster.NewUserUUID(), nil
+}
+
 func (handler *UserHandler) parseParam(c *gin.Context) map[string]string {
 	parasMap := map[string]string{}
 	parasMap["user"] = c.Query("user")
 	parasMap["pwd"] = c.Query("pwd")
+	parasMap["sub_token"] = c.DefaultQuery("sub_token", "")
+	parasMap["uuid"] = c.DefaultQuery("uuid", "")
 	parasMap["type"] = c.DefaultQuery("type", "")
 	parasMap["target"] = c.DefaultQuery("target", handler.getHttpServer().Name)
 	parasMap["tags"] = c.DefaultQuery("tags", "")
 	parasMap["ttl"] = c.DefaultQuery("ttl", "0")
 	parasMap["expire"] = c.DefaultQuery("expire", "0")
+	parasMap["quota"] = c.DefaultQuery("quota", "0")
+	parasMap["uplink_quota"] = c.DefaultQuery("uplink_quota", "0")
+	parasMap["downlink_quota"] = c.DefaultQuery("downlink_quota", "0")
+	parasMap["plan"] = c.DefaultQuery("plan", "")
+	parasMap["speed_tier"] = c.DefaultQuery("speed_tier", "")
+	parasMap["reset_period"] = c.DefaultQuery("reset_period", "0")
+	parasMap["anchor_day"] = c.DefaultQuery("anchor_day", "0")
+	parasMap["fields"] = c.DefaultQuery("fields", "")
+	parasMap["extend"] = c.DefaultQuery("extend", "")
+	parasMap["add_tags"] = c.DefaultQuery("add_tags", "")
+	parasMap["remove_tags"] = c.DefaultQuery("remove_tags", "")
 	return parasMap
 }
 
+// 按照套餐的节点条件过滤目标节点, 入口节点上不存在该套餐时不过滤, 由目标节点校验套餐是否存在
+func (handler *UserHandler) filterPlanNodes(planName string, nodes []*cluster.Node, targetSet bool) ([]*cluster.Node, error) {
+	plan := globalUser.GetPlanManager().Get(planName)
+	if plan == nil || plan.NodeSelector == "" {
+		return nodes, nil
+	}
+	selector, err := cluster.ParseNodeSelector(plan.NodeSelector)
+	if err != nil {
+		return nil, fmt.Errorf("invalid plan[%s] node selector > %v", planName, err)
+	}
+	if !targetSet {
+		nodes = handler.getHttpServer().GetTargetNodes(plan.NodeSelector)
+	}
+	matched := []*cluster.Node{}
+	for _, n := range nodes {
+		if selector.Match(n) {
+			matched = append(matched, n)
+		}
+	}
+	return matched, nil
+}
+
 func (handler *UserHandler) handlerFunc(c *gin.Context) {
 	parasMap := handler.parseParam(c)
 	var err error = nil
@@ -65,10 +210,37 @@ func (handler *UserHandler) handlerFunc(c *gin.Context) {
 	if err != nil {
 		errMsg := fmt.Sprintf("illegal expire time > %v", err)
 		logger.Error(
-			"Err=%s|User=%s|Passwd=%s|OpType=%s|Target=%s",
+			"Err=%s|User=%s|OpType=%s|Target=%s",
+			errMsg,
+			parasMap["user"],
+			parasMap["type"],
+			parasMap["target"],
+		)
+		c.String(200, errMsg)
+		return
+	}
+
+	quota, uplinkQuota, downlinkQuota, err := getQuota(parasMap)
+	if err != nil {
+		errMsg := fmt.Sprintf("illegal quota > %v", err)
+		logger.Error(
+			"Err=%s|User=%s|OpType=%s|Target=%s",
+			errMsg,
+			parasMap["user"],
+			parasMap["type"],
+			parasMap["target"],
+		)
+		c.String(200, errMsg)
+		return
+	}
+
+	resetPeriod, anchorDay, err := getBilling(parasMap)
+	if err != nil {
+		errMsg := fmt.Sprintf("illegal billing period > %v", err)
+		logger.Error(
+			"Err=%s|User=%s|OpType=%s|Target=%s",
 			errMsg,
 			parasMap["user"],
-			parasMap["pwd"],
 			parasMap["type"],
 			parasMap["target"],
 		)
@@ -79,13 +251,35 @@ func (handler *UserHandler) handlerFunc(c *gin.Context) {
 	tagList := util.StringList{}
 	tagList = strings.Split(parasMap["tags"], ",")
 	userPoint := &proto.User{
-		Name:       parasMap["user"],
-		Passwd:     parasMap["pwd"],
-		ExpireTime: expire,
-		Tags:       tagList.Filter(func(t string) bool { return len(t) > 0 }),
+		Name:             parasMap["user"],
+		Passwd:           parasMap["pwd"],
+		ExpireTime:       expire,
+		Tags:             tagList.Filter(func(t string) bool { return len(t) > 0 }),
+		Quota:            quota,
+		UplinkQuota:      uplinkQuota,
+		DownlinkQuota:    downlinkQuota,
+		Plan:             parasMap["plan"],
+		ResetPeriod:      resetPeriod,
+		BillingAnchorDay: anchorDay,
+		SpeedTier:        parasMap["speed_tier"],
 	}
 
 	nodes := handler.getHttpServer().GetTargetNodes(parasMap["target"])
+	// 使用套餐添加用户时只添加到满足套餐节点条件的节点, 未指定target时由套餐选择节点
+	if userOpMap[parasMap["type"]] == "AddUsers" && userPoint.Plan != "" {
+		_, targetSet := c.GetQuery("target")
+		if nodes, err = handler.filterPlanNodes(userPoint.Plan, nodes, targetSet); err != nil {
+			logger.Error(
+				"Err=%s|User=%s|OpType=%s|Target=%s",
+				err.Error(),
+				parasMap["user"],
+				parasMap["type"],
+				parasMap["target"],
+			)
+			c.String(200, err.Error())
+			return
+		}
+	}
 	if len(nodes) == 0 {
 		c.String(200, "no avaliable node")
 		return
@@ -97,6 +291,48 @@ func (handler *UserHandler) handlerFunc(c *gin.Context) {
 		req := &proto.UserOpReq{
 			Users: []*proto.User{userPoint},
 		}
+		if opName == "UpdateUsers" {
+			if req.UpdateMask, err = getUpdateMask(parasMap); err != nil {
+				errMsg := fmt.Sprintf("illegal update fields > %v", err)
+				logger.Error(
+					"Err=%s|User=%s|OpType=%s|Target=%s",
+					errMsg,
+					parasMap["user"],
+					parasMap["type"],
+					parasMap["target"],
+				)
+				c.String(200, errMsg)
+				return
+			}
+		}
+		// 订阅token在请求入口生成, 保证各个节点上同一个用户的token一致
+		if opName == "AddUsers" || (opName == "UpdateUsers" && req.UpdateMask.GetSubToken()) {
+			if userPoint.SubToken, err = getSubToken(parasMap); err != nil {
+				logger.Error(
+					"Err=%s|User=%s|OpType=%s|Target=%s",
+					err.Error(),
+					parasMap["user"],
+					parasMap["type"],
+					parasMap["target"],
+				)
+				c.String(200, err.Error())
+				return
+			}
+		}
+		// uuid同样在请求入口生成, 添加与重置后各个节点上的uuid一致
+		if opName == "AddUsers" || opName == "ResetUser" {
+			if userPoint.Uuid, err = getUserUUID(parasMap); err != nil {
+				logger.Error(
+					"Err=%s|User=%s|OpType=%s|Target=%s",
+					err.Error(),
+					parasMap["user"],
+					parasMap["type"],
+					parasMap["target"],
+				)
+				c.String(200, err.Error())
+				return
+			}
+		}
 		var reqType client.ReqToEndNodeType = -1
 		switch opName {
 		case "AddUsers":
@@ -107,15 +343,18 @@ func (handler *UserHandler) handlerFunc(c *gin.Context) {
 			reqType = client.DeleteUsersReqType
 		case "ResetUser":
 			reqType = client.ResetUserReqType
+		case "SuspendUsers":
+			reqType = client.SuspendUsersReqType
+		case "ResumeUsers":
+			reqType = client.ResumeUsersReqType
 		}
 		_, failedList, _ := rpcClient.ReqToMultiEndNodeServer(c.Request.Context(), reqType, req, globalCluster.GetClusterToken())
 		if len(failedList) != 0 {
 			errMsg := joinFailedList(failedList)
 			logger.Error(
-				"Err=%s|User=%s|Passwd=%s|OpType=%s|Target=%s",
+				"Err=%s|User=%s|OpType=%s|Target=%s",
 				errMsg,
 				parasMap["user"],
-				parasMap["pwd"],
 				parasMap["type"],
 				parasMap["target"],
 			)
@@ -129,15 +368,20 @@ func (handler *UserHandler) handlerFunc(c *gin.Context) {
 			&proto.GetUsersReq{},
 			globalCluster.GetClusterToken(),
 		)
+		// 密码哈希仅用于节点间复制用户, 不对外返回
+		for _, users := range succList {
+			for _, u := range users.([]*proto.User) {
+				u.PasswdHash = ""
+			}
+		}
 		c.JSON(200, succList)
 		return
 	} else {
 		err = fmt.Errorf("unsupport operation type %s", parasMap["type"])
 		logger.Error(
-			"Err=%s|User=%s|Passwd=%s|OpType=%s|Target=%s",
+			"Err=%s|User=%s|OpType=%s|Target=%s",
 			err.Error(),
 			parasMap["user"],
-			parasMap["pwd"],
 			parasMap["type"],
 			parasMap["target"],
 		)
@@ -160,7 +404,8 @@ func (handler *UserHandler) getRelativePath() string {
 
 func (handler *UserHandler) help() string {
 	usage := `/user
-	user操作接口, 支持添加, 删除, 更新user信息, 重置用户proxy的密钥信息, 获取用户列表
+	user操作接口, 支持添加, 删除, 更新user信息, 重置用户proxy的密钥信息, 获取用户列表, 暂停与恢复用户
+	用户超出流量配额后会被停用, 提高配额后自动恢复
 	通用参数列表:
 	target: 目标node的名称
 	tags: 操作的inbound的tag, 使用","分隔
@@ -168,26 +413,52 @@ func (handler *UserHandler) help() string {
 	token: 用于验证操作权限
 	各个接口参数说明:
 	1. 添加用户
-	/user?type=1&user={user}&pwd={pwd}&expire={expire}&target={target}&token={token}&ttl={ttl}&tags={tags}
+	/user?type=1&user={user}&pwd={pwd}&expire={expire}&target={target}&token={token}&ttl={ttl}&tags={tags}&quota={quota}&plan={plan}&reset_period={reset_period}&anchor_day={anchor_day}&sub_token={sub_token}&uuid={uuid}&speed_tier={speed_tier}
 	user: 用户名
-	pwd: password
+	pwd: password, 只保存加盐后的哈希, 用于获取订阅
+	sub_token: 订阅token, 用于/sub与hysteria认证, 默认随机生成, 可以通过type=5查看
+	uuid: xray/v2ray中使用的uuid/password, 默认随机生成, target=all时各节点使用同一个uuid; 已存在的用户沿用原有的uuid
 	expire: 过期时间, 过期时间的时间戳, 例如2022-11-27 12:00:00过期, 则expire=1669521600, 与下述ttl参数同时存在时, 优先使用ttl设置过期时间
 	ttl: 存活时间, 从添加时开始的有效存活时间, 单位为秒, 例如1个小时内有效, ttl=3600
 	tags: 添加inbound的tag列表, 以逗号分隔
+	quota: 总流量配额, 单位为byte, 支持KB/MB/GB/TB等单位, 例如quota=100GB, 默认为0表示不限制
+	uplink_quota: 上行流量配额, 格式同quota
+	downlink_quota: 下行流量配额, 格式同quota
+	plan: 套餐名称, 参见/plan, 未指定的tags, 过期时间, 配额, level与流量重置周期由套餐填充; 套餐设置了node_selector时只添加到满足条件的节点, 此时未指定target则添加到全部满足条件的节点, 按照入口节点上的套餐判断
+	reset_period: 流量重置周期, 单位为月, 到达重置日时清零已使用流量并恢复因超出配额停用的用户, 默认为0表示不重置
+	anchor_day: 每月的重置日, 取值1-31, 超过当月天数时在当月最后一天重置, 默认为用户的创建日
+	speed_tier: 速度档位, 参见配置中的speed_tiers, 指定后使用档位对应的level, 优先于套餐中的level
 	2. 更新用户信息
-	/user?type=2&user={user}&pwd={pwd}&expire={expire}&target={target}&token={token}&ttl={ttl}
+	/user?type=2&user={user}&pwd={pwd}&expire={expire}&target={target}&token={token}&ttl={ttl}&quota={quota}&fields={fields}&extend={extend}&add_tags={add_tags}&remove_tags={remove_tags}&reset_period={reset_period}&anchor_day={anchor_day}&sub_token={sub_token}&speed_tier={speed_tier}
 	user: 用户名
 	pwd: password
+	sub_token: 仅在fields包含sub_token时重置订阅token, 为空时随机生成, 重置后旧的订阅链接与hysteria认证失效
 	expire: 过期时间, 过期时间的时间戳, 例如2022-11-27 12:00:00过期, 则expire=1669521600, 与下述ttl参数同时存在时, 优先使用ttl设置过期时间
 	ttl: 存活时间, 从添加时开始的有效存活时间, 单位为秒, 例如1个小时内有效, ttl=3600
+	quota/uplink_quota/downlink_quota: 流量配额, 为0或不填时不修改, 小于0时取消限制
+	reset_period/anchor_day: 流量重置周期与重置日, 仅在fields包含billing时修改, 修改后已使用流量保留
+	speed_tier: 速度档位, 仅在fields包含speed_tier时修改, 为空时恢复为level 0, 修改后无需重启proxy
+	fields: 部分更新时需要修改的字段, 可选pwd,expire,quota,billing,sub_token,speed_tier, 以逗号分隔, 未指定的字段不会修改, 此时不需要传递pwd
+	extend: 在当前过期时间的基础上延长, 单位为秒或者时长, 例如extend=2592000或extend=720h, 已过期的用户从当前时间开始计算, 不过期的用户不变
+	add_tags: 将用户添加到指定的inbound, 以逗号分隔
+	remove_tags: 将用户从指定的inbound中删除, 以逗号分隔, 删除全部tag的用户会被清除
+	fields, extend, add_tags, remove_tags均未指定时, 全量更新pwd, expire与quota, 此时pwd不能为空
 	3. 删除用户
 	/user?type=3&target={target}&user={user}&token={token}&tags={tags}
 	user: 用户名
 	4. 重置用户
-	/user?target={target}&type=4&user={user}&token={token}
+	/user?target={target}&type=4&user={user}&token={token}&uuid={uuid}
 	user: 用户名
+	uuid: 重置后的uuid, 默认随机生成, 各目标节点同时重置为同一个uuid, 用户所在的全部inbound均会重置
 	5. 获取用户列表
 	/user?type=5&target={target}&token={token}
+	返回结果中used_uplink/used_downlink为已使用流量, remaining_quota为剩余配额(-1表示不限制), over_quota表示是否因超出配额被停用, suspended表示是否被暂停, period_start为当前流量统计周期的开始时间, sub_token为订阅token, uuid为xray/v2ray中使用的uuid/password
+	6. 暂停用户
+	/user?type=6&target={target}&user={user}&token={token}
+	user: 用户名, 暂停后用户会从全部inbound中停用, 订阅与hysteria认证均会被拒绝, 用户信息与tag保留
+	7. 恢复用户
+	/user?type=7&target={target}&user={user}&token={token}
+	user: 用户名, 恢复后沿用暂停前的tag与uuid/password
 	`
 	return usage
 }
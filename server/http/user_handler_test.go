@@ -126,6 +126,43 @@ func TestGetExpireTime(t *testing.T) {
 	})
 }
 
+func TestGetUpdateMask(t *testing.T) {
+	convey.Convey("get update mask", t, func() {
+		convey.Convey("full update with out mask params", func() {
+			mask, err := getUpdateMask(map[string]string{"extend": "0"})
+			convey.So(err, convey.ShouldBeNil)
+			convey.So(mask, convey.ShouldBeNil)
+		})
+		convey.Convey("update fields", func() {
+			mask, err := getUpdateMask(map[string]string{"fields": "expire,quota"})
+			convey.So(err, convey.ShouldBeNil)
+			convey.So(mask.Passwd, convey.ShouldBeFalse)
+			convey.So(mask.ExpireTime, convey.ShouldBeTrue)
+			convey.So(mask.Quota, convey.ShouldBeTrue)
+		})
+		convey.Convey("extend by seconds and duration", func() {
+			mask, err := getUpdateMask(map[string]string{"extend": "3600"})
+			convey.So(err, convey.ShouldBeNil)
+			convey.So(mask.ExtendExpire, convey.ShouldEqual, 3600)
+			mask, err = getUpdateMask(map[string]string{"extend": "720h"})
+			convey.So(err, convey.ShouldBeNil)
+			convey.So(mask.ExtendExpire, convey.ShouldEqual, 720*3600)
+		})
+		convey.Convey("add and remove tags", func() {
+			mask, err := getUpdateMask(map[string]string{"add_tags": "a,,b", "remove_tags": "c"})
+			convey.So(err, convey.ShouldBeNil)
+			convey.So(mask.AddTags, convey.ShouldResemble, []string{"a", "b"})
+			convey.So(mask.RemoveTags, convey.ShouldResemble, []string{"c"})
+		})
+		convey.Convey("invalid params", func() {
+			_, err := getUpdateMask(map[string]string{"fields": "name"})
+			convey.So(err, convey.ShouldNotBeNil)
+			_, err = getUpdateMask(map[string]string{"extend": "-1h"})
+			convey.So(err, convey.ShouldNotBeNil)
+		})
+	})
+}
+
 func getResponse(resp *http.Response) []byte {
 	d, _ := ioutil.ReadAll(resp.Body)
 	return d
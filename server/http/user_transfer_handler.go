@@ -0,0 +1,520 @@
+package http
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+	client "github.com/lureiny/v2raymg/client/rpc"
+	"github.com/lureiny/v2raymg/cluster"
+	"github.com/lureiny/v2raymg/common/log/logger"
+	"github.com/lureiny/v2raymg/common/util"
+	globalCluster "github.com/lureiny/v2raymg/global/cluster"
+	"github.com/lureiny/v2raymg/server/rpc/proto"
+)
+
+// 导入导出使用的用户格式, 导入时passwd与passwd_hash至少需要指定一个
+type transferUser struct {
+	Name             string   `json:"name"`
+	Tags             []string `json:"tags"`
+	ExpireTime       int64    `json:"expire_time"`
+	Quota            int64    `json:"quota"`
+	UplinkQuota      int64    `json:"uplink_quota"`
+	DownlinkQuota    int64    `json:"downlink_quota"`
+	UsedUplink       int64    `json:"used_uplink"`
+	UsedDownlink     int64    `json:"used_downlink"`
+	Passwd           string   `json:"passwd,omitempty"`
+	PasswdHash       string   `json:"passwd_hash"`
+	SubToken         string   `json:"sub_token"`
+	Uuid             string   `json:"uuid"`
+	Plan             string   `json:"plan"`
+	Level            uint32   `json:"level"`
+	SpeedTier        string   `json:"speed_tier"`
+	ResetPeriod      uint32   `json:"reset_period"`
+	BillingAnchorDay uint32   `json:"billing_anchor_day"`
+	Suspended        bool     `json:"suspended"`
+	Nodes            []string `json:"nodes,omitempty"` // 导出时用户所在的节点
+}
+
+var transferUserCsvHeader = []string{
+	"name", "tags", "expire_time", "quota", "uplink_quota", "downlink_quota",
+	"used_uplink", "used_downlink", "passwd", "passwd_hash", "sub_token",
+	"uuid", "plan", "level", "speed_tier", "reset_period", "billing_anchor_day", "suspended", "nodes",
+}
+
+func newTransferUser(user *proto.User) *transferUser {
+	return &transferUser{
+		Name:             user.Name,
+		Tags:             append([]string{}, user.Tags...),
+		ExpireTime:       user.ExpireTime,
+		Quota:            user.Quota,
+		UplinkQuota:      user.UplinkQuota,
+		DownlinkQuota:    user.DownlinkQuota,
+		UsedUplink:       user.UsedUplink,
+		UsedDownlink:     user.UsedDownlink,
+		PasswdHash:       user.PasswdHash,
+		SubToken:         user.SubToken,
+		Uuid:             user.Uuid,
+		Plan:             user.Plan,
+		Level:            user.Level,
+		SpeedTier:        user.SpeedTier,
+		ResetPeriod:      user.ResetPeriod,
+		BillingAnchorDay: user.BillingAnchorDay,
+		Suspended:        user.Suspended,
+	}
+}
+
+func (u *transferUser) toUser() *proto.User {
+	return &proto.User{
+		Name:             u.Name,
+		Tags:             u.Tags,
+		ExpireTime:       u.ExpireTime,
+		Quota:            u.Quota,
+		UplinkQuota:      u.UplinkQuota,
+		DownlinkQuota:    u.DownlinkQuota,
+		UsedUplink:       u.UsedUplink,
+		UsedDownlink:     u.UsedDownlink,
+		Passwd:           u.Passwd,
+		PasswdHash:       u.PasswdHash,
+		SubToken:         u.SubToken,
+		Uuid:             u.Uuid,
+		Plan:             u.Plan,
+		Level:            u.Level,
+		SpeedTier:        u.SpeedTier,
+		ResetPeriod:      u.ResetPeriod,
+		BillingAnchorDay: u.BillingAnchorDay,
+		Suspended:        u.Suspended,
+	}
+}
+
+// 合并各节点中的同名用户, tag与节点取并集, 流量相加, 其余字段使用按名称排序后第一个节点上的数据
+func mergeTransferUsers(nodeUsers map[string][]*proto.User) []*transferUser {
+	nodes := []string{}
+	for node := range nodeUsers {
+		nodes = append(nodes, node)
+	}
+	sort.Strings(nodes)
+	usersMap := map[string]*transferUser{}
+	for _, node := range nodes {
+		for _, user := range nodeUsers[node] {
+			u, ok := usersMap[user.Name]
+			if !ok {
+				u = newTransferUser(user)
+				u.Nodes = []string{node}
+				usersMap[user.Name] = u
+				continue
+			}
+			u.UsedUplink += user.UsedUplink
+			u.UsedDownlink += user.UsedDownlink
+			u.Tags = append(u.Tags, diffStrings(user.Tags, u.Tags)...)
+			u.Nodes = append(u.Nodes, node)
+		}
+	}
+	users := []*transferUser{}
+	for _, u := range usersMap {
+		users = append(users, u)
+	}
+	sort.Slice(users, func(i, j int) bool {
+		return users[i].Name < users[j].Name
+	})
+	return users
+}
+
+// 在src中但不在dst中的元素
+func diffStrings(src, dst []string) []string {
+	dstList := util.StringList(dst)
+	result := []string{}
+	for _, s := range src {
+		if !dstList.Contains(s) {
+			result = append(result, s)
+		}
+	}
+	return result
+}
+
+func encodeTransferUsers(users []*transferUser, format string) ([]byte, error) {
+	switch format {
+	case "json":
+		return json.MarshalIndent(users, "", "  ")
+	case "csv":
+		buf := &bytes.Buffer{}
+		w := csv.NewWriter(buf)
+		if err := w.Write(transferUserCsvHeader); err != nil {
+			return nil, err
+		}
+		for _, u := range users {
+			record := []string{
+				u.Name,
+				strings.Join(u.Tags, ","),
+				strconv.FormatInt(u.ExpireTime, 10),
+				strconv.FormatInt(u.Quota, 10),
+				strconv.FormatInt(u.UplinkQuota, 10),
+				strconv.FormatInt(u.DownlinkQuota, 10),
+				strconv.FormatInt(u.UsedUplink, 10),
+				strconv.FormatInt(u.UsedDownlink, 10),
+				u.Passwd,
+				u.PasswdHash,
+				u.SubToken,
+				u.Uuid,
+				u.Plan,
+				strconv.FormatUint(uint64(u.Level), 10),
+				u.SpeedTier,
+				strconv.FormatUint(uint64(u.ResetPeriod), 10),
+				strconv.FormatUint(uint64(u.BillingAnchorDay), 10),
+				strconv.FormatBool(u.Suspended),
+				strings.Join(u.Nodes, ","),
+			}
+			if err := w.Write(record); err != nil {
+				return nil, err
+			}
+		}
+		w.Flush()
+		return buf.Bytes(), w.Error()
+	}
+	return nil, fmt.Errorf("unsupport format %s", format)
+}
+
+// csv按表头匹配列, 缺少的列使用默认值
+func decodeTransferUsersFromCsv(data []byte) ([]*transferUser, error) {
+	r := csv.NewReader(bytes.NewReader(data))
+	r.FieldsPerRecord = -1
+	header, err := r.Read()
+	if err != nil {
+		return nil, fmt.Errorf("read csv header fail > %v", err)
+	}
+	columns := map[string]int{}
+	for i, name := range header {
+		columns[strings.TrimSpace(name)] = i
+	}
+	if _, ok := columns["name"]; !ok {
+		return nil, fmt.Errorf("csv header must contain name")
+	}
+
+	users := []*transferUser{}
+	for line := 2; ; line++ {
+		record, err := r.Read()
+		if err == io.EOF {
+			break
+		} else if err != nil {
+			return nil, fmt.Errorf("read csv line %d fail > %v", line, err)
+		}
+		get := func(column string) string {
+			if i, ok := columns[column]; ok && i < len(record) {
+				return strings.TrimSpace(record[i])
+			}
+			return ""
+		}
+		var parseErr error = nil
+		parseInt := func(column string) int64 {
+			v := get(column)
+			if v == "" || parseErr != nil {
+				return 0
+			}
+			n, err := strconv.ParseInt(v, 10, 64)
+			if err != nil {
+				parseErr = fmt.Errorf("invalid %s > %v", column, err)
+			}
+			return n
+		}
+		u := &transferUser{
+			Name:             get("name"),
+			ExpireTime:       parseInt("expire_time"),
+			Quota:            parseInt("quota"),
+			UplinkQuota:      parseInt("uplink_quota"),
+			DownlinkQuota:    parseInt("downlink_quota"),
+			UsedUplink:       parseInt("used_uplink"),
+			UsedDownlink:     parseInt("used_downlink"),
+			Passwd:           get("passwd"),
+			PasswdHash:       get("passwd_hash"),
+			SubToken:         get("sub_token"),
+			Uuid:             get("uuid"),
+			Plan:             get("plan"),
+			Level:            uint32(parseInt("level")),
+			SpeedTier:        get("speed_tier"),
+			ResetPeriod:      uint32(parseInt("reset_period")),
+			BillingAnchorDay: uint32(parseInt("billing_anchor_day")),
+		}
+		if tags := get("tags"); tags != "" {
+			u.Tags = strings.Split(tags, ",")
+		}
+		if suspended := get("suspended"); suspended != "" {
+			if u.Suspended, err = strconv.ParseBool(suspended); err != nil {
+				parseErr = fmt.Errorf("invalid suspended > %v", err)
+			}
+		}
+		if parseErr != nil {
+			return nil, fmt.Errorf("parse csv line %d fail > %v", line, parseErr)
+		}
+		users = append(users, u)
+	}
+	return users, nil
+}
+
+func decodeTransferUsers(data []byte, format string) ([]*transferUser, error) {
+	switch format {
+	case "json":
+		users := []*transferUser{}
+		if err := json.Unmarshal(data, &users); err != nil {
+			return nil, fmt.Errorf("parse json fail > %v", err)
+		}
+		return users, nil
+	case "csv":
+		return decodeTransferUsersFromCsv(data)
+	}
+	return nil, fmt.Errorf("unsupport format %s", format)
+}
+
+// 加密口令通过请求头传递, 避免出现在访问日志, 代理日志与shell历史中
+const transferKeyHeader = "X-Transfer-Key"
+
+// 获取加密口令, query中的key会被拒绝, 避免口令泄露并且避免误以为数据已加密
+func getTransferKey(c *gin.Context) (string, error) {
+	if _, ok := c.GetQuery("key"); ok {
+		return "", fmt.Errorf("key param is not supported, please use %s header", transferKeyHeader)
+	}
+	return c.GetHeader(transferKeyHeader), nil
+}
+
+// 指定key时使用key加密导出的数据, 结果为base64编码
+func encryptTransferData(data []byte, key string) ([]byte, error) {
+	if key == "" {
+		return data, nil
+	}
+	encryptedData, err := util.EncryptWithPassphrase(data, key)
+	if err != nil {
+		return nil, err
+	}
+	return []byte(base64.StdEncoding.EncodeToString(encryptedData)), nil
+}
+
+func decryptTransferData(data []byte, key string) ([]byte, error) {
+	if key == "" {
+		return data, nil
+	}
+	encryptedData, err := base64.StdEncoding.DecodeString(strings.TrimSpace(string(data)))
+	if err != nil {
+		return nil, fmt.Errorf("invalid base64 data > %v", err)
+	}
+	return util.DecryptWithPassphrase(encryptedData, key)
+}
+
+type ExportUsersHandler struct{ HttpHandlerImp }
+
+func (handler *ExportUsersHandler) parseParam(c *gin.Context) map[string]string {
+	parasMap := map[string]string{}
+
+	parasMap["target"] = c.DefaultQuery("target", handler.getHttpServer().Name)
+	parasMap["format"] = c.DefaultQuery("format", "json")
+	return parasMap
+}
+
+func (handler *ExportUsersHandler) handlerFunc(c *gin.Context) {
+	parasMap := handler.parseParam(c)
+	key, err := getTransferKey(c)
+	if err != nil {
+		c.String(200, err.Error())
+		return
+	}
+
+	nodes := handler.getHttpServer().GetTargetNodes(parasMap["target"])
+	if len(nodes) == 0 {
+		c.String(200, "no avaliable node")
+		return
+	}
+
+	rpcClient := client.NewEndNodeClient(nodes, nil)
+	succList, failedList, _ := rpcClient.ReqToMultiEndNodeServer(c.Request.Context(),
+		client.ExportUsersReqType, &proto.ExportUsersReq{}, globalCluster.GetClusterToken())
+	// 部分节点失败时导出的数据不完整, 直接返回错误
+	if len(failedList) != 0 {
+		errMsg := joinFailedList(failedList)
+		logger.Error(
+			"Err=%s|Target=%s",
+			errMsg,
+			parasMap["target"],
+		)
+		c.String(200, errMsg)
+		return
+	}
+
+	nodeUsers := map[string][]*proto.User{}
+	for node, rsp := range succList {
+		nodeUsers[node] = rsp.([]*proto.User)
+	}
+	data, err := encodeTransferUsers(mergeTransferUsers(nodeUsers), parasMap["format"])
+	if err == nil {
+		data, err = encryptTransferData(data, key)
+	}
+	if err != nil {
+		errMsg := fmt.Sprintf("export users fail > %v", err)
+		logger.Error(
+			"Err=%s|Target=%s|Format=%s",
+			errMsg,
+			parasMap["target"],
+			parasMap["format"],
+		)
+		c.String(200, errMsg)
+		return
+	}
+
+	contentType := "application/json"
+	if key != "" {
+		contentType = "text/plain"
+	} else if parasMap["format"] == "csv" {
+		contentType = "text/csv"
+	}
+	c.Data(200, contentType, data)
+}
+
+func (handler *ExportUsersHandler) getHandlers() []gin.HandlerFunc {
+	return []gin.HandlerFunc{
+		getAuthHandlerFunc(handler.httpServer),
+		handler.handlerFunc,
+	}
+}
+
+func (handler *ExportUsersHandler) getRelativePath() string {
+	return "/exportUsers"
+}
+
+func (handler *ExportUsersHandler) help() string {
+	usage := `/exportUsers
+	导出全部用户, 包含名称, tag, 过期时间, 配额, 已使用流量与凭证(密码哈希, 订阅token与uuid), target为all时合并各节点中的同名用户, 流量相加
+	请求示例: curl -H "X-Transfer-Key: {key}" "/exportUsers?target={target}&format=csv&token={token}"
+	参数列表:
+	token: 用于验证操作权限
+	target: 目标node的名称, 为all时导出全部节点的用户
+	format: 导出格式, 可选值为json, csv, 默认值为json
+	X-Transfer-Key: 请求头, 加密导出数据的口令, 为空时不加密, 加密后的数据为base64编码; 为避免口令出现在日志中, 不支持通过key参数传递
+	`
+	return usage
+}
+
+type ImportUsersHandler struct{ HttpHandlerImp }
+
+func (handler *ImportUsersHandler) parseParam(c *gin.Context) map[string]string {
+	parasMap := map[string]string{}
+
+	parasMap["target"] = c.DefaultQuery("target", handler.getHttpServer().Name)
+	parasMap["format"] = c.DefaultQuery("format", "json")
+	parasMap["policy"] = c.DefaultQuery("policy", "skip")
+	parasMap["dry_run"] = c.DefaultQuery("dry_run", "false")
+	return parasMap
+}
+
+func (handler *ImportUsersHandler) handlerFunc(c *gin.Context) {
+	parasMap := handler.parseParam(c)
+
+	dryRun, err := strconv.ParseBool(parasMap["dry_run"])
+	if err != nil {
+		c.String(200, fmt.Sprintf("invalid dry_run param > %v", err))
+		return
+	}
+	key, err := getTransferKey(c)
+	if err != nil {
+		c.String(200, err.Error())
+		return
+	}
+
+	data, err := c.GetRawData()
+	if err == nil {
+		data, err = decryptTransferData(data, key)
+	}
+	var transferUsers []*transferUser = nil
+	if err == nil {
+		transferUsers, err = decodeTransferUsers(data, parasMap["format"])
+	}
+	if err != nil {
+		errMsg := fmt.Sprintf("parse import data fail > %v", err)
+		logger.Error(
+			"Err=%s|Target=%s|Format=%s",
+			errMsg,
+			parasMap["target"],
+			parasMap["format"],
+		)
+		c.String(200, errMsg)
+		return
+	}
+	users := []*proto.User{}
+	for _, u := range transferUsers {
+		user := u.toUser()
+		// 导入数据中没有uuid时在入口生成, 保证各节点上新添加的用户uuid一致
+		if user.Uuid == "" {
+			user.Uuid = cluster.NewUserUUID()
+		}
+		users = append(users, user)
+	}
+
+	nodes := handler.getHttpServer().GetTargetNodes(parasMap["target"])
+	if len(nodes) == 0 {
+		c.String(200, "no avaliable node")
+		return
+	}
+
+	rpcClient := client.NewEndNodeClient(nodes, nil)
+	succList, failedList, _ := rpcClient.ReqToMultiEndNodeServer(c.Request.Context(),
+		client.ImportUsersReqType,
+		&proto.ImportUsersReq{
+			Users:          users,
+			ConflictPolicy: parasMap["policy"],
+			DryRun:         dryRun,
+		},
+		globalCluster.GetClusterToken(),
+	)
+	if len(failedList) != 0 {
+		logger.Error(
+			"Err=%s|Target=%s|Policy=%s|DryRun=%v",
+			joinFailedList(failedList),
+			parasMap["target"],
+			parasMap["policy"],
+			dryRun,
+		)
+	}
+	if len(succList) == 0 {
+		c.String(200, joinFailedList(failedList))
+		return
+	}
+
+	report := map[string]interface{}{}
+	for node, rsp := range succList {
+		report[node] = rsp.([]*proto.ImportUserResult)
+	}
+	for node, errMsg := range failedList {
+		report[node] = errMsg
+	}
+	c.JSON(200, report)
+}
+
+func (handler *ImportUsersHandler) getHandlers() []gin.HandlerFunc {
+	return []gin.HandlerFunc{
+		getAuthHandlerFunc(handler.httpServer),
+		handler.handlerFunc,
+	}
+}
+
+func (handler *ImportUsersHandler) getRelativePath() string {
+	return "/importUsers"
+}
+
+func (handler *ImportUsersHandler) help() string {
+	usage := `/importUsers
+	批量导入用户, POST请求, 请求body为/exportUsers导出的json或csv数据, 返回每个节点中各用户的处理结果(add/skip/overwrite/merge/fail)
+	请求示例: curl -X POST --data-binary @users.csv "/importUsers?target={target}&format=csv&policy=merge&dry_run=true&token={token}"
+	参数列表:
+	token: 用于验证操作权限
+	target: 目标node的名称, 为all时导入到全部节点
+	format: 数据格式, 可选值为json, csv, 默认值为json, csv需要包含表头, 至少包含name列
+	policy: 用户已存在时的处理方式, skip: 跳过, overwrite: 覆盖过期时间, 配额, 流量重置周期, 凭证与tag, merge: 只添加新的tag, 默认值为skip
+	dry_run: 为true时只返回处理结果, 不修改用户
+	X-Transfer-Key: 请求头, 数据的加密口令, 与导出时的口令一致; 不支持通过key参数传递
+	导入的新用户需要passwd(明文)或者passwd_hash, 导入前已使用的流量计入当前统计周期, 没有uuid时随机生成, 导入到多个节点时使用同一个uuid
+	`
+	return usage
+}
@@ -0,0 +1,101 @@
+package http
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/lureiny/v2raymg/server/rpc/proto"
+	"github.com/smartystreets/goconvey/convey"
+)
+
+func TestTransferUsers(t *testing.T) {
+	users := []*transferUser{
+		{
+			Name:             "u1",
+			Tags:             []string{"vless", "vmess"},
+			ExpireTime:       1700000000,
+			Quota:            1000,
+			UsedUplink:       10,
+			UsedDownlink:     20,
+			PasswdHash:       "$2a$10$hash",
+			SubToken:         "token",
+			Uuid:             "e2b6b8a4-0b5f-4a0c-9a3f-2f7c1b0d6a11",
+			Level:            1,
+			ResetPeriod:      1,
+			BillingAnchorDay: 31,
+			Suspended:        true,
+			Nodes:            []string{"node1"},
+		},
+		{Name: "u2", Passwd: "passwd"},
+	}
+
+	convey.Convey("encode and decode", t, func() {
+		for _, format := range []string{"json", "csv"} {
+			data, err := encodeTransferUsers(users, format)
+			convey.So(err, convey.ShouldBeNil)
+			decoded, err := decodeTransferUsers(data, format)
+			convey.So(err, convey.ShouldBeNil)
+			convey.So(len(decoded), convey.ShouldEqual, 2)
+			convey.So(decoded[0].toUser(), convey.ShouldResemble, users[0].toUser())
+			convey.So(decoded[1].Passwd, convey.ShouldEqual, "passwd")
+		}
+		_, err := encodeTransferUsers(users, "xml")
+		convey.So(err, convey.ShouldNotBeNil)
+	})
+
+	convey.Convey("decode csv with partial columns", t, func() {
+		decoded, err := decodeTransferUsers([]byte("name,passwd,tags\nu1,p1,\"a,b\"\n"), "csv")
+		convey.So(err, convey.ShouldBeNil)
+		convey.So(decoded[0].Tags, convey.ShouldResemble, []string{"a", "b"})
+		_, err = decodeTransferUsers([]byte("passwd\np1\n"), "csv")
+		convey.So(err, convey.ShouldNotBeNil)
+		_, err = decodeTransferUsers([]byte("name,quota\nu1,abc\n"), "csv")
+		convey.So(err, convey.ShouldNotBeNil)
+	})
+
+	convey.Convey("encrypt transfer data", t, func() {
+		data, err := encodeTransferUsers(users, "json")
+		convey.So(err, convey.ShouldBeNil)
+		encrypted, err := encryptTransferData(data, "secret")
+		convey.So(err, convey.ShouldBeNil)
+		decrypted, err := decryptTransferData(encrypted, "secret")
+		convey.So(err, convey.ShouldBeNil)
+		convey.So(decrypted, convey.ShouldResemble, data)
+		_, err = decryptTransferData(encrypted, "wrong")
+		convey.So(err, convey.ShouldNotBeNil)
+		// 没有口令时不加密
+		plain, err := encryptTransferData(data, "")
+		convey.So(err, convey.ShouldBeNil)
+		convey.So(plain, convey.ShouldResemble, data)
+	})
+
+	convey.Convey("merge users from nodes", t, func() {
+		merged := mergeTransferUsers(map[string][]*proto.User{
+			"node2": {{Name: "u1", Tags: []string{"b"}, UsedUplink: 5}},
+			"node1": {{Name: "u1", Tags: []string{"a"}, UsedUplink: 10, Quota: 100}, {Name: "u2"}},
+		})
+		convey.So(len(merged), convey.ShouldEqual, 2)
+		convey.So(merged[0].Tags, convey.ShouldResemble, []string{"a", "b"})
+		convey.So(merged[0].UsedUplink, convey.ShouldEqual, 15)
+		convey.So(merged[0].Quota, convey.ShouldEqual, 100)
+		convey.So(merged[0].Nodes, convey.ShouldResemble, []string{"node1", "node2"})
+	})
+
+	convey.Convey("transfer key only from header", t, func() {
+		newContext := func(url, key string) *gin.Context {
+			c, _ := gin.CreateTestContext(httptest.NewRecorder())
+			c.Request, _ = http.NewRequest("GET", url, nil)
+			if key != "" {
+				c.Request.Header.Set(transferKeyHeader, key)
+			}
+			return c
+		}
+		key, err := getTransferKey(newContext("/exportUsers", "secret"))
+		convey.So(err, convey.ShouldBeNil)
+		convey.So(key, convey.ShouldEqual, "secret")
+		_, err = getTransferKey(newContext("/exportUsers?key=secret", ""))
+		convey.So(err, convey.ShouldNotBeNil)
+	})
+}
@@ -2,6 +2,7 @@ package rpc
 
 import (
 	context "context"
+	"crypto/subtle"
 	"fmt"
 	"net"
 	"time"
@@ -21,9 +22,16 @@ type NodeMap map[string]*proto.Node
 type CenterNodeServer struct {
 	proto.UnimplementedCenterNodeAccessServer
 	clusters c.CenterClusterManager
+	peers    []*c.Node // 其他中心节点, 用于同步集群成员
 	server.ServerConfig
 }
 
+const (
+	// 中心节点之间同步成员的周期
+	centerSyncInterval = 10 * time.Second
+	centerSyncTimeout  = 5 * time.Second
+)
+
 func (s *CenterNodeServer) HeartBeat(ctx context.Context, heartBeatReq *proto.HeartBeatReq) (*proto.HeartBeatRsp, error) {
 	heartBeatRsp := &proto.HeartBeatRsp{}
 	node := &c.Node{
@@ -31,6 +39,7 @@ func (s *CenterNodeServer) HeartBeat(ctx context.Context, heartBeatReq *proto.He
 		CreateTime:       time.Now().Unix(),
 		GetHeartBeatTime: time.Now().Unix(),
 	}
+	node.SetHealth(heartBeatReq.GetHealth())
 	if !node.IsComplete() {
 		logger.Error(
 			"Err=%s|Src=%s:%d|SrcName=%s|Cluster=%s",
@@ -56,8 +65,10 @@ func (s *CenterNodeServer) HeartBeat(ctx context.Context, heartBeatReq *proto.He
 	if cluster := s.clusters.GetCluster(clusterName); cluster != nil {
 		// 集群已经存在
 		if n := cluster.Get(nodeName); n != nil {
-			// 存在该节点, 更新探活时间
+			// 存在该节点, 更新探活时间与标签
 			n.GetHeartBeatTime = time.Now().Unix()
+			cluster.UpdateNodeLabels(nodeName, node.GetLabels())
+			cluster.UpdateNodeHealth(nodeName, node.GetHealth())
 		} else {
 			logger.Error(
 				"Msg=%s|Src=%s:%d|SrcName=%s|Cluster=%s",
@@ -91,6 +102,67 @@ func (s *CenterNodeServer) HeartBeat(ctx context.Context, heartBeatReq *proto.He
 	return heartBeatRsp, nil
 }
 
+// SyncMembership 校验center_token后合并其他中心节点的成员, 只返回发送方负责的集群的成员
+func (s *CenterNodeServer) SyncMembership(ctx context.Context, syncMembershipReq *proto.SyncMembershipReq) (*proto.SyncMembershipRsp, error) {
+	centerToken := gc.GetString(common.ConfigCenterToken)
+	if centerToken == "" ||
+		subtle.ConstantTimeCompare([]byte(centerToken), []byte(syncMembershipReq.GetToken())) != 1 {
+		errMsg := "invalid center token"
+		logger.Error("Err=%s|Peer=%s", errMsg, syncMembershipReq.GetCenterName())
+		return &proto.SyncMembershipRsp{Code: 401, Msg: errMsg}, nil
+	}
+	added := s.clusters.Merge(syncMembershipReq.GetMembers())
+	if added > 0 {
+		logger.Info(
+			"Msg=add node from center peer|Peer=%s|Count=%d",
+			syncMembershipReq.GetCenterName(),
+			added,
+		)
+	}
+	return &proto.SyncMembershipRsp{Members: s.clusters.ClusterMembers(syncMembershipReq.GetClusterNames())}, nil
+}
+
+// 与其他中心节点交换成员, 返回同步成功的中心节点数
+func (s *CenterNodeServer) syncWithPeers() int {
+	succ := 0
+	members := s.clusters.Members()
+	clusterNames := s.clusters.ClusterNames()
+	centerToken := gc.GetString(common.ConfigCenterToken)
+	for _, peer := range s.peers {
+		conn, err := peer.GetGrpcClientConn()
+		if err != nil {
+			logger.Error("Err=did not connect > %v|Peer=%s:%d", err, peer.Host, peer.Port)
+			continue
+		}
+		ctx, cancel := context.WithTimeout(context.Background(), centerSyncTimeout)
+		rsp, err := proto.NewCenterNodeAccessClient(conn).SyncMembership(ctx, &proto.SyncMembershipReq{
+			CenterName:   s.Name,
+			Members:      members,
+			Token:        centerToken,
+			ClusterNames: clusterNames,
+		})
+		cancel()
+		if err != nil || rsp.GetCode() != 0 {
+			logger.Error("Err=sync membership fail > %v|Code=%d|Peer=%s:%d", err, rsp.GetCode(), peer.Host, peer.Port)
+			continue
+		}
+		if added := s.clusters.Merge(rsp.GetMembers()); added > 0 {
+			logger.Info("Msg=add node from center peer|Peer=%s:%d|Count=%d", peer.Host, peer.Port, added)
+		}
+		succ++
+	}
+	return succ
+}
+
+// 定期与其他中心节点同步成员
+func (s *CenterNodeServer) replicate() {
+	ticker := time.NewTicker(centerSyncInterval)
+	for {
+		<-ticker.C
+		s.syncWithPeers()
+	}
+}
+
 // 过滤各个集群下的无效节点
 func (s *CenterNodeServer) filter() {
 	// 10s 过滤一次
@@ -117,6 +189,19 @@ func (s *CenterNodeServer) Init() {
 	logger.SetLogLevel(0)
 	logger.SetServerName(serverName)
 	logger.SetNodeType(s.Type)
+	// 中心节点不会向其他节点申请证书, 需要持有CA私钥或者提前放置节点证书
+	if err := c.InitClusterTLS(serverName); err != nil {
+		logger.Fatalf("Err=init cluster tls fail > %v", err)
+	}
+	peers, err := c.LoadCenterNodes(common.ConfigCenterPeers)
+	if err != nil {
+		logger.Fatalf("Err=load center peers fail > %v", err)
+	}
+	// 中心节点之间的同步使用center_token认证, 避免任意调用方获取全部集群的成员
+	if len(peers) != 0 && gc.GetString(common.ConfigCenterToken) == "" {
+		logger.Fatalf("Err=center token is empty, it is required when center peers are configured")
+	}
+	s.peers = peers
 }
 
 func (s *CenterNodeServer) Start() {
@@ -130,8 +215,22 @@ func (s *CenterNodeServer) Start() {
 			addr,
 		)
 	}
-	grpcServer := grpc.NewServer()
+	serverOptions, err := c.GetClusterTLS().ServerOptions()
+	if err != nil {
+		logger.Fatalf(
+			"Err=%v|Addr=%s",
+			err,
+			addr,
+		)
+	}
+	grpcServer := grpc.NewServer(serverOptions...)
 	proto.RegisterCenterNodeAccessServer(grpcServer, s)
+	// 重启后本地没有成员, 由其他中心节点在一个同步周期内推送恢复
+	if len(s.peers) != 0 {
+		succ := s.syncWithPeers()
+		logger.Info("Msg=rebuild membership from center peers|Succ=%d|Peers=%d", succ, len(s.peers))
+		go s.replicate()
+	}
 	go s.filter()
 	logger.Info("Msg=center node server listening at %v", lis.Addr())
 	if err := grpcServer.Serve(lis); err != nil {
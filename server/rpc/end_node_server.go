@@ -14,9 +14,11 @@ import (
 	rpcClient "github.com/lureiny/v2raymg/client/rpc"
 	"github.com/lureiny/v2raymg/cluster"
 	"github.com/lureiny/v2raymg/common"
+	"github.com/lureiny/v2raymg/common/audit"
 	"github.com/lureiny/v2raymg/common/log/logger"
 	"github.com/lureiny/v2raymg/common/rpc"
 	"github.com/lureiny/v2raymg/common/util"
+	globalAudit "github.com/lureiny/v2raymg/global/audit"
 	globalCluster "github.com/lureiny/v2raymg/global/cluster"
 	"github.com/lureiny/v2raymg/global/collecter"
 	globalConfig "github.com/lureiny/v2raymg/global/config"
@@ -31,6 +33,7 @@ import (
 
 	grpc "google.golang.org/grpc"
 	"google.golang.org/grpc/encoding"
+	"google.golang.org/grpc/metadata"
 )
 
 var RpcServerKey = []byte{}
@@ -40,8 +43,10 @@ var localNode = cluster.GetLocalNode()
 
 type EndNodeServer struct {
 	proto.UnimplementedEndNodeAccessServer
-	centerNode  *cluster.Node
+	centerNodes []*cluster.Node // 中心节点列表, 当前使用的节点不可用时依次切换
+	centerIndex int
 	certManager *lego.CertManager
+	localHealth *proto.NodeHealth // 本地节点最近一次采集的健康状态, 随心跳上报
 	server.ServerConfig
 }
 
@@ -57,12 +62,42 @@ func GetEndNodeServer() *EndNodeServer {
 var methodPrefixLen = len("/proto.EndNodeAccess/")
 
 // gateway模式下放行的接口列表
-var onlyGatewayMethods = "HeartBeat|RegisterNode|SetGatewayModel|GetPingMetric|GetBandWidthStats"
+var onlyGatewayMethods = "HeartBeat|RegisterNode|IssueNodeCert|GossipPing|SetGatewayModel|GetPingMetric|GetBandWidthStats"
 
 func isOnlyGatewayMethod(fullMethod string) bool {
 	return strings.Contains(onlyGatewayMethods, fullMethod[methodPrefixLen:])
 }
 
+// 需要记录审计日志的接口, 只记录修改类的操作与用户导出
+var auditMethods = map[string]bool{
+	"AddUsers":             true,
+	"DeleteUsers":          true,
+	"ClearUsers":           true,
+	"UpdateUsers":          true,
+	"ResetUser":            true,
+	"SuspendUsers":         true,
+	"ResumeUsers":          true,
+	"SetPlans":             true,
+	"DeletePlans":          true,
+	"ExportUsers":          true,
+	"ImportUsers":          true,
+	"ReconcileUsers":       true,
+	"AddInbound":           true,
+	"DeleteInbound":        true,
+	"TransferInbound":      true,
+	"CopyInbound":          true,
+	"CopyUser":             true,
+	"UpdateProxy":          true,
+	"AddAdaptiveConfig":    true,
+	"DeleteAdaptiveConfig": true,
+	"Adaptive":             true,
+	"FastAddInbound":       true,
+	"SetGatewayModel":      true,
+	"ObtainNewCert":        true,
+	"TransferCert":         true,
+	"IssueNodeCert":        true,
+}
+
 var methodRspMap = map[string]interface{}{
 	"GetUsers":             &proto.GetUsersRsp{},
 	"AddUsers":             &proto.UserOpRsp{},
@@ -70,10 +105,22 @@ var methodRspMap = map[string]interface{}{
 	"ClearUsers":           &proto.ClearUsersRsp{},
 	"UpdateUsers":          &proto.UserOpRsp{},
 	"ResetUser":            &proto.UserOpRsp{},
+	"SuspendUsers":         &proto.UserOpRsp{},
+	"ResumeUsers":          &proto.UserOpRsp{},
+	"SetPlans":             &proto.PlanOpRsp{},
+	"DeletePlans":          &proto.PlanOpRsp{},
+	"GetPlans":             &proto.GetPlansRsp{},
+	"GetTrafficReport":     &proto.GetTrafficReportRsp{},
+	"GetAuditLog":          &proto.GetAuditLogRsp{},
+	"ExportUsers":          &proto.ExportUsersRsp{},
+	"ImportUsers":          &proto.ImportUsersRsp{},
+	"ReconcileUsers":       &proto.ReconcileUsersRsp{},
 	"GetSub":               &proto.GetSubRsp{},
 	"GetBandWidthStats":    &proto.GetBandwidthStatsRsp{},
 	"HeartBeat":            &proto.HeartBeatRsp{},
 	"RegisterNode":         &proto.RegisterNodeRsp{},
+	"IssueNodeCert":        &proto.IssueNodeCertRsp{},
+	"GossipPing":           &proto.GossipPingRsp{},
 	"AddInbound":           &proto.InboundOpRsp{},
 	"DeleteInbound":        &proto.InboundOpRsp{},
 	"TransferInbound":      &proto.InboundOpRsp{},
@@ -100,7 +147,9 @@ func newEmptyRsp(fullMethod string) (interface{}, error) {
 func authRemoteNode(req interface{}, fullMethod string) (bool, interface{}, *proto.Node) {
 	reqValue := reflect.ValueOf(req)
 	nodeAuthInfo := reqValue.Elem().FieldByName("NodeAuthInfo").Elem().Interface().(proto.NodeAuthInfo)
-	if fullMethod[methodPrefixLen:] == "RegisterNode" {
+	// 注册与申请证书时对端还没有本地的token, gossip消息使用集群token, 均在接口中校验集群token
+	switch fullMethod[methodPrefixLen:] {
+	case "RegisterNode", "IssueNodeCert", "GossipPing":
 		return true, nil, nodeAuthInfo.Node
 	}
 	node := &cluster.Node{
@@ -125,7 +174,113 @@ func authRemoteNode(req interface{}, fullMethod string) (bool, interface{}, *pro
 	return true, nil, nodeAuthInfo.Node
 }
 
+// 记录其他节点转发的操作, code为0时表示成功
+func recordAudit(node *proto.Node, fullMethod string, req, rsp interface{}, err error) {
+	method := fullMethod[methodPrefixLen:]
+	if !auditMethods[method] {
+		return
+	}
+	entry := &proto.AuditEntry{
+		Source:    audit.SourceRpc,
+		Caller:    node.GetName(),
+		Operation: method,
+		Target:    globalConfig.GetString(common.ConfigServerName),
+	}
+	if m, ok := req.(pb.Message); ok {
+		entry.Params = audit.RedactMessage(m)
+	}
+	if err != nil {
+		entry.Msg = err.Error()
+	} else if m, ok := rsp.(pb.Message); ok {
+		fields := m.ProtoReflect().Descriptor().Fields()
+		if fd := fields.ByName("code"); fd != nil {
+			entry.Success = m.ProtoReflect().Get(fd).Int() == 0
+		}
+		if fd := fields.ByName("msg"); fd != nil {
+			entry.Msg = m.ProtoReflect().Get(fd).String()
+		}
+	}
+	globalAudit.Record(entry)
+}
+
+var replayGuard = rpc.NewReplayGuard(rpc.DefaultReplayWindow)
+
+// 检查请求的编码版本与重放, 未升级节点使用旧版本编码且不带时间戳, 仅在非严格模式下放行, 每次放行都记录日志与次数
+func checkReplay(ctx context.Context, req interface{}, fullMethod string) (bool, interface{}) {
+	legacy := false
+	if md, ok := metadata.FromIncomingContext(ctx); ok {
+		if values := md.Get("content-type"); len(values) > 0 {
+			legacy = rpc.IsLegacyContentType(values[0])
+		}
+	}
+	var authInfo *proto.NodeAuthInfo
+	if r, ok := req.(interface{ GetNodeAuthInfo() *proto.NodeAuthInfo }); ok {
+		authInfo = r.GetNodeAuthInfo()
+	}
+	var err error
+	if legacy && rpc.IsStrictCodec() {
+		err = fmt.Errorf("legacy codec is disabled")
+	} else if !legacy || authInfo.GetTimestamp() != 0 {
+		err = replayGuard.Check(authInfo.GetTimestamp(), authInfo.GetNonce())
+	} else {
+		logger.Warn(
+			"Msg=accept legacy request without timestamp, it can't be protected from replay|SrcName=%s|Api=%s|LegacyCount=%d",
+			authInfo.GetNode().GetName(),
+			fullMethod[methodPrefixLen:],
+			rpc.RecordLegacyRequest(),
+		)
+	}
+	if err == nil {
+		return true, nil
+	}
+	errMsg := fmt.Sprintf("reject request > %v", err)
+	logger.Error(
+		"Err=%s|SrcName=%s|Api=%s",
+		errMsg,
+		authInfo.GetNode().GetName(),
+		fullMethod[methodPrefixLen:],
+	)
+	rspValue := reflect.New(reflect.TypeOf(methodRspMap[fullMethod[methodPrefixLen:]]).Elem())
+	rspValue.Elem().FieldByName("Code").SetInt(401)
+	rspValue.Elem().FieldByName("Msg").SetString(errMsg)
+	return false, rspValue.Interface()
+}
+
+// tls连接时对端证书中的节点名称需要与请求中的节点名称一致, 避免持有证书的节点冒用其他节点
+func checkPeerCert(ctx context.Context, req interface{}, fullMethod string) (bool, interface{}) {
+	commonName, ok := rpc.PeerCommonName(ctx)
+	if !ok {
+		return true, nil
+	}
+	var nodeName string
+	if r, ok := req.(interface{ GetNodeAuthInfo() *proto.NodeAuthInfo }); ok {
+		nodeName = r.GetNodeAuthInfo().GetNode().GetName()
+	}
+	if commonName == nodeName {
+		return true, nil
+	}
+	errMsg := fmt.Sprintf("reject request > node name[%s] in peer cert mismatch with request node", commonName)
+	logger.Error(
+		"Err=%s|SrcName=%s|Api=%s",
+		errMsg,
+		nodeName,
+		fullMethod[methodPrefixLen:],
+	)
+	rspValue := reflect.New(reflect.TypeOf(methodRspMap[fullMethod[methodPrefixLen:]]).Elem())
+	rspValue.Elem().FieldByName("Code").SetInt(401)
+	rspValue.Elem().FieldByName("Msg").SetString(errMsg)
+	return false, rspValue.Interface()
+}
+
 func UnaryServerInterceptor(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, hander grpc.UnaryHandler) (interface{}, error) {
+	// 告知客户端本节点支持的编码版本
+	grpc.SetHeader(ctx, metadata.Pairs(rpc.CodecVersionHeader, strconv.Itoa(rpc.CodecVersionAEAD)))
+	if ok, rsp := checkReplay(ctx, req, info.FullMethod); !ok {
+		return rsp, nil
+	}
+	if ok, rsp := checkPeerCert(ctx, req, info.FullMethod); !ok {
+		return rsp, nil
+	}
 	// only gateway表示当前节点仅作为转发节点, 本身对外不提供代理服务
 	if globalConfig.GetBool(common.ConfigServerRpcOnlyGateway) &&
 		!isOnlyGatewayMethod(info.FullMethod) {
@@ -133,10 +288,12 @@ func UnaryServerInterceptor(ctx context.Context, req interface{}, info *grpc.Una
 	}
 	authOK, rsp, node := authRemoteNode(req, info.FullMethod)
 	if !authOK {
+		recordAudit(node, info.FullMethod, req, rsp, nil)
 		return rsp, nil
 	}
 	startPoint := time.Now().UnixMilli()
 	rsp, err := hander(ctx, req)
+	recordAudit(node, info.FullMethod, req, rsp, err)
 	logger.Info(
 		"Src=%s:%d|SrcName=%s|Api=%s|Delay=%dms",
 		node.Host,
@@ -158,17 +315,15 @@ func (s *EndNodeServer) Init(certManager *lego.CertManager) {
 	s.Name = serverName
 
 	// init center node
-	s.centerNode = &cluster.Node{
-		Node: &proto.Node{
-			Host: globalConfig.GetString(common.ConfigCenterNodeHost),
-			Port: int32(globalConfig.GetInt(common.ConfigCenterNodePort)),
-		},
-	}
+	s.initCenterNodes()
 
 	err := proxy.StartProxyServer()
 	if err != nil {
 		logger.Error("Err=Start proxy server err > %v", err)
+		return
 	}
+	// 档位的level修改后需要在proxy运行时更新用户
+	globalUserManager.SyncSpeedTierLevels()
 }
 
 func (s *EndNodeServer) RegisterNode(ctx context.Context, registerNodeReq *proto.RegisterNodeReq) (*proto.RegisterNodeRsp, error) {
@@ -292,6 +447,85 @@ func (s *EndNodeServer) RegisterNode(ctx context.Context, registerNodeReq *proto
 	return registerNodeRsp, nil
 }
 
+func (s *EndNodeServer) IssueNodeCert(ctx context.Context, issueNodeCertReq *proto.IssueNodeCertReq) (*proto.IssueNodeCertRsp, error) {
+	issueNodeCertRsp := &proto.IssueNodeCertRsp{}
+	node := issueNodeCertReq.GetNodeAuthInfo().GetNode()
+	if err := globalCluster.IsSameCluster(node.GetClusterName(), issueNodeCertReq.GetNodeAuthInfo().GetToken()); err != nil {
+		logger.Error(
+			"Err=%s|Src=%s:%d|SrcName=%s",
+			err.Error(),
+			node.GetHost(),
+			node.GetPort(),
+			node.GetName(),
+		)
+		issueNodeCertRsp.Code = 1200
+		issueNodeCertRsp.Msg = err.Error()
+		return issueNodeCertRsp, nil
+	}
+	// 只为请求节点自身签发证书, 避免持有集群token的节点冒用其他节点的名称
+	caCert, cert, err := cluster.GetClusterTLS().SignNodeCSR(issueNodeCertReq.GetCsr(), node.GetName())
+	if err != nil {
+		errMsg := fmt.Sprintf("issue node cert fail > %v", err)
+		logger.Error(
+			"Err=%s|SrcName=%s",
+			errMsg,
+			node.GetName(),
+		)
+		issueNodeCertRsp.Code = 1201
+		issueNodeCertRsp.Msg = errMsg
+		return issueNodeCertRsp, nil
+	}
+	logger.Info("Msg=issue node cert|SrcName=%s", node.GetName())
+	issueNodeCertRsp.Cert = cert
+	issueNodeCertRsp.CaCert = caCert
+	return issueNodeCertRsp, nil
+}
+
+// 开启mTLS且本地没有节点证书时, 向签发节点申请证书, 签发节点需要处于migrate模式
+func (s *EndNodeServer) requestNodeCert() error {
+	clusterTLS := cluster.GetClusterTLS()
+	if !clusterTLS.Enabled() || clusterTLS.Ready() {
+		return nil
+	}
+	issuer := globalConfig.GetString(common.ConfigClusterTLSIssuer)
+	if issuer == "" {
+		return fmt.Errorf("node cert is not exist and no issuer is configured")
+	}
+	host, port, err := net.SplitHostPort(issuer)
+	if err != nil {
+		return fmt.Errorf("invalid issuer %s > %v", issuer, err)
+	}
+	portNum, err := strconv.Atoi(port)
+	if err != nil {
+		return fmt.Errorf("invalid issuer port %s > %v", port, err)
+	}
+	issuerNode := &cluster.Node{Node: &proto.Node{Host: host, Port: int32(portNum)}}
+	conn, err := issuerNode.GetGrpcClientConn()
+	if err != nil {
+		return fmt.Errorf("connect to issuer fail > %v", err)
+	}
+	defer conn.Close()
+
+	csr, key, err := rpc.NewNodeCSR(s.Name, []string{localNode.Host, s.Host})
+	if err != nil {
+		return err
+	}
+	nodeAuthInfo := &proto.NodeAuthInfo{
+		Token: globalCluster.GetClusterToken(),
+		Node:  &localNode.Node,
+	}
+	reqData, _ := pb.Marshal(&proto.IssueNodeCertReq{Csr: csr})
+	rsp, err := rpcClient.ReqIssueNodeCert(rpcClient.NewContext(), reqData, proto.NewEndNodeAccessClient(conn), nodeAuthInfo, globalCluster.GetClusterToken())
+	if err != nil {
+		return fmt.Errorf("request node cert fail > %v", err)
+	}
+	issueRsp := rsp.(*proto.IssueNodeCertRsp)
+	if issueRsp.GetCode() != 0 {
+		return fmt.Errorf("request node cert fail > %s", issueRsp.GetMsg())
+	}
+	return clusterTLS.SaveNodeCert(issueRsp.GetCaCert(), issueRsp.GetCert(), key)
+}
+
 func (s *EndNodeServer) HeartBeat(ctx context.Context, heartBeatReq *proto.HeartBeatReq) (*proto.HeartBeatRsp, error) {
 	heartBeatRsp := &proto.HeartBeatRsp{}
 	node := globalCluster.Get(heartBeatReq.GetNodeAuthInfo().Node.GetName())
@@ -305,6 +539,9 @@ func (s *EndNodeServer) HeartBeat(ctx context.Context, heartBeatReq *proto.Heart
 		)
 		return heartBeatRsp, nil
 	}
+	// 节点标签可能在重启后变化, 每次心跳时更新
+	globalCluster.UpdateNodeLabels(node.Name, heartBeatReq.GetNodeAuthInfo().GetNode().GetLabels())
+	globalCluster.UpdateNodeHealth(node.Name, heartBeatReq.GetHealth())
 	heartBeatRsp.NodesMap = globalCluster.GetProtoNodesWithFilter(
 		func(node *cluster.Node) bool {
 			return node.Name != s.Name && node.IsValid()
@@ -326,6 +563,7 @@ func (s *EndNodeServer) GetUsers(ctx context.Context, getUsersReq *proto.GetUser
 			u.Downlink = stat.Downlink
 			u.Uplink = stat.Uplink
 		}
+		u.RemainingQuota = cluster.GetRemainingQuota(u)
 		getUsersRsp.Users = append(getUsersRsp.Users, u)
 	}
 	return getUsersRsp, nil
@@ -385,14 +623,19 @@ func (s *EndNodeServer) UpdateUsers(ctx context.Context, updateUsersReq *proto.U
 		Code: 0,
 	}
 
-	var err error = nil
-	userList := ""
 	for _, user := range updateUsersReq.GetUsers() {
-		userList = userList + ";" + user.Name
-		err = globalUserManager.Update(user)
+		err := globalUserManager.Update(user, updateUsersReq.GetUpdateMask())
+		if err != nil {
+			logger.Error(
+				"Err=%s|User=%s",
+				err.Error(),
+				user.Name,
+			)
+			updateUsersRsp.Msg += fmt.Sprintf("user: %s update failed, %s\n", user.Name, err.Error())
+		}
 	}
-	if err != nil {
-		updateUsersRsp.Msg = err.Error()
+	if len(updateUsersRsp.Msg) > 0 {
+		updateUsersRsp.Code = 205
 	}
 
 	return updateUsersRsp, nil
@@ -423,6 +666,52 @@ func (s *EndNodeServer) ResetUser(ctx context.Context, resetUserReq *proto.UserO
 	return resetUserRsp, nil
 }
 
+func (s *EndNodeServer) SuspendUsers(ctx context.Context, suspendUsersReq *proto.UserOpReq) (*proto.UserOpRsp, error) {
+	suspendUsersRsp := &proto.UserOpRsp{
+		Code: 0,
+	}
+
+	for _, user := range suspendUsersReq.GetUsers() {
+		err := globalUserManager.Suspend(user)
+		if err != nil {
+			logger.Error(
+				"Err=%s|User=%s",
+				err.Error(),
+				user.Name,
+			)
+			suspendUsersRsp.Msg += fmt.Sprintf("user: %s suspend failed, %s\n", user.Name, err.Error())
+		}
+	}
+	if len(suspendUsersRsp.Msg) > 0 {
+		suspendUsersRsp.Code = 203
+	}
+
+	return suspendUsersRsp, nil
+}
+
+func (s *EndNodeServer) ResumeUsers(ctx context.Context, resumeUsersReq *proto.UserOpReq) (*proto.UserOpRsp, error) {
+	resumeUsersRsp := &proto.UserOpRsp{
+		Code: 0,
+	}
+
+	for _, user := range resumeUsersReq.GetUsers() {
+		err := globalUserManager.Resume(user)
+		if err != nil {
+			logger.Error(
+				"Err=%s|User=%s",
+				err.Error(),
+				user.Name,
+			)
+			resumeUsersRsp.Msg += fmt.Sprintf("user: %s resume failed, %s\n", user.Name, err.Error())
+		}
+	}
+	if len(resumeUsersRsp.Msg) > 0 {
+		resumeUsersRsp.Code = 204
+	}
+
+	return resumeUsersRsp, nil
+}
+
 func (s *EndNodeServer) GetSub(ctx context.Context, getSubReq *proto.GetSubReq) (*proto.GetSubRsp, error) {
 	getSubRsp := &proto.GetSubRsp{
 		Code: 0,
@@ -436,10 +725,9 @@ func (s *EndNodeServer) GetSub(ctx context.Context, getSubReq *proto.GetSubReq)
 	if err != nil || len(uris) == 0 {
 		errMsg := fmt.Sprintf("get sub err > %v", err)
 		logger.Error(
-			"Err=%s|User=%s|Passwd=%s|URI=%s|Tags=%v",
+			"Err=%s|User=%s|Tags=%v",
 			errMsg,
 			user.Name,
-			user.Passwd,
 			user.Tags,
 		)
 		getSubRsp.Msg = errMsg
@@ -447,6 +735,20 @@ func (s *EndNodeServer) GetSub(ctx context.Context, getSubReq *proto.GetSubReq)
 		return getSubRsp, nil
 	}
 	getSubRsp.Uris = uris
+	if u := globalUserManager.Get(user.Name); u != nil {
+		getSubRsp.Usage = &proto.User{
+			Name:          u.Name,
+			ExpireTime:    u.ExpireTime,
+			Quota:         u.Quota,
+			UsedUplink:    u.UsedUplink,
+			UsedDownlink:  u.UsedDownlink,
+			PeriodStart:   u.PeriodStart,
+			ResetPeriod:   u.ResetPeriod,
+			OverQuota:     u.OverQuota,
+			UplinkQuota:   u.UplinkQuota,
+			DownlinkQuota: u.DownlinkQuota,
+		}
+	}
 
 	return getSubRsp, nil
 }
@@ -861,7 +1163,9 @@ func (s *EndNodeServer) FastAddInbound(ctx context.Context, fastAddInboundReq *p
 	fastAddInboundRsp := &proto.FastAddInboundRsp{
 		Code: 0,
 	}
-	if cert := s.certManager.GetCert(fastAddInboundReq.GetDomain()); cert == nil {
+	if isShadowsocksBuilder(fastAddInboundReq.GetInboundBuilderType()) {
+		// shadowsocks不使用tls, 不需要证书
+	} else if cert := s.certManager.GetCert(fastAddInboundReq.GetDomain()); cert == nil {
 		if err := s.certManager.ObtainNewCert(fastAddInboundReq.GetDomain()); err != nil {
 			fastAddInboundRsp.Code = 1022
 			fastAddInboundRsp.Msg = fmt.Sprintf("obtain new cert of domain[%s] fail > %v", fastAddInboundReq.GetDomain(), err)
@@ -904,7 +1208,36 @@ func (s *EndNodeServer) GetCerts(ctx context.Context, getCertsReq *proto.GetCert
 	return getCertsRsp, nil
 }
 
+func isShadowsocksBuilder(builderType proto.BuilderType) bool {
+	return builderType == proto.BuilderType_ShadowsocksSettingBuilderType ||
+		builderType == proto.BuilderType_Shadowsocks2022SettingBuilderType
+}
+
+// shadowsocks inbound只使用tcp/udp, 忽略stream与证书配置
+func newShadowsocksInbound(fastAddInboundReq *proto.FastAddInboundReq) (*manager.Inbound, error) {
+	inboundBuilder := config.GetInboundSettingBuilder(fastAddInboundReq.GetInboundBuilderType())
+	if inboundBuilder == nil {
+		return nil, fmt.Errorf("unsupport protocol")
+	}
+	inboundBuilder.Mutex.Lock()
+	defer inboundBuilder.Mutex.Unlock()
+	inboundConfig := config.InboundDetourConfig{}
+	inboundConfig.Protocol = inboundBuilder.GetProtocol()
+	inboundConfig.Settings = inboundBuilder.Build()
+	inboundConfig.ListenOn = "0.0.0.0"
+	inboundConfig.PortRange = uint32(fastAddInboundReq.GetPort())
+	inboundConfig.Tag = fastAddInboundReq.GetTag()
+	return &manager.Inbound{
+		Config:  inboundConfig,
+		Tag:     fastAddInboundReq.GetTag(),
+		RWMutex: sync.RWMutex{},
+	}, nil
+}
+
 func newInbound(fastAddInboundReq *proto.FastAddInboundReq, c *lego.CertManager) (*manager.Inbound, error) {
+	if isShadowsocksBuilder(fastAddInboundReq.GetInboundBuilderType()) {
+		return newShadowsocksInbound(fastAddInboundReq)
+	}
 	if c.GetCert(fastAddInboundReq.GetDomain()) == nil {
 		return nil, fmt.Errorf("not found domain's[%s] cert", fastAddInboundReq.GetDomain())
 	}
@@ -983,6 +1316,152 @@ func (s *EndNodeServer) GetPingMetric(ctx context.Context, in *proto.GetPingMetr
 	return getMetricRsp, nil
 }
 
+func (s *EndNodeServer) SetPlans(ctx context.Context, setPlansReq *proto.PlanOpReq) (*proto.PlanOpRsp, error) {
+	setPlansRsp := &proto.PlanOpRsp{
+		Code: 0,
+	}
+	if err := globalUserManager.SetPlans(setPlansReq.GetPlans()...); err != nil {
+		logger.Error("Err=%s", err.Error())
+		setPlansRsp.Code = 1100
+		setPlansRsp.Msg = fmt.Sprintf("set plans failed, %s", err.Error())
+		return setPlansRsp, nil
+	}
+	if !setPlansReq.GetReapply() {
+		return setPlansRsp, nil
+	}
+	for _, plan := range setPlansReq.GetPlans() {
+		if err := globalUserManager.ReapplyPlan(plan.Name); err != nil {
+			logger.Error(
+				"Err=%s|Plan=%s",
+				err.Error(),
+				plan.Name,
+			)
+			setPlansRsp.Msg += fmt.Sprintf("plan: %s reapply failed, %s\n", plan.Name, err.Error())
+		}
+	}
+	if len(setPlansRsp.Msg) > 0 {
+		setPlansRsp.Code = 1101
+	}
+	return setPlansRsp, nil
+}
+
+func (s *EndNodeServer) DeletePlans(ctx context.Context, deletePlansReq *proto.PlanOpReq) (*proto.PlanOpRsp, error) {
+	deletePlansRsp := &proto.PlanOpRsp{
+		Code: 0,
+	}
+	names := []string{}
+	for _, plan := range deletePlansReq.GetPlans() {
+		names = append(names, plan.Name)
+	}
+	if err := globalUserManager.DeletePlans(names...); err != nil {
+		logger.Error(
+			"Err=%s|Plans=%v",
+			err.Error(),
+			names,
+		)
+		deletePlansRsp.Code = 1102
+		deletePlansRsp.Msg = fmt.Sprintf("delete plans failed, %s", err.Error())
+	}
+	return deletePlansRsp, nil
+}
+
+func (s *EndNodeServer) GetPlans(ctx context.Context, getPlansReq *proto.GetPlansReq) (*proto.GetPlansRsp, error) {
+	return &proto.GetPlansRsp{
+		Code:  0,
+		Plans: globalUserManager.GetPlans(),
+	}, nil
+}
+
+func (s *EndNodeServer) GetTrafficReport(ctx context.Context, getTrafficReportReq *proto.GetTrafficReportReq) (*proto.GetTrafficReportRsp, error) {
+	getTrafficReportRsp := &proto.GetTrafficReportRsp{
+		Code: 0,
+	}
+	series, err := collecter.GetTrafficReport(getTrafficReportReq)
+	if err != nil {
+		logger.Error(
+			"Err=%s|Type=%s|Name=%s",
+			err.Error(),
+			getTrafficReportReq.GetType(),
+			getTrafficReportReq.GetName(),
+		)
+		getTrafficReportRsp.Code = 1103
+		getTrafficReportRsp.Msg = err.Error()
+		return getTrafficReportRsp, nil
+	}
+	getTrafficReportRsp.Series = series
+	return getTrafficReportRsp, nil
+}
+
+func (s *EndNodeServer) GetAuditLog(ctx context.Context, getAuditLogReq *proto.GetAuditLogReq) (*proto.GetAuditLogRsp, error) {
+	getAuditLogRsp := &proto.GetAuditLogRsp{
+		Code: 0,
+	}
+	entries, err := globalAudit.Query(getAuditLogReq)
+	if err != nil {
+		logger.Error(
+			"Err=%s|Caller=%s|Operation=%s",
+			err.Error(),
+			getAuditLogReq.GetCaller(),
+			getAuditLogReq.GetOperation(),
+		)
+		getAuditLogRsp.Code = 1105
+		getAuditLogRsp.Msg = err.Error()
+		return getAuditLogRsp, nil
+	}
+	getAuditLogRsp.Entries = entries
+	return getAuditLogRsp, nil
+}
+
+func (s *EndNodeServer) ExportUsers(ctx context.Context, exportUsersReq *proto.ExportUsersReq) (*proto.ExportUsersRsp, error) {
+	return &proto.ExportUsersRsp{
+		Code:  0,
+		Users: globalUserManager.ExportUsers(),
+	}, nil
+}
+
+func (s *EndNodeServer) ImportUsers(ctx context.Context, importUsersReq *proto.ImportUsersReq) (*proto.ImportUsersRsp, error) {
+	importUsersRsp := &proto.ImportUsersRsp{
+		Code: 0,
+	}
+	results, err := globalUserManager.ImportUsers(
+		importUsersReq.GetUsers(),
+		importUsersReq.GetConflictPolicy(),
+		importUsersReq.GetDryRun(),
+	)
+	if err != nil {
+		logger.Error(
+			"Err=%s|Policy=%s|DryRun=%v",
+			err.Error(),
+			importUsersReq.GetConflictPolicy(),
+			importUsersReq.GetDryRun(),
+		)
+		importUsersRsp.Code = 1104
+		importUsersRsp.Msg = err.Error()
+		return importUsersRsp, nil
+	}
+	importUsersRsp.Results = results
+	return importUsersRsp, nil
+}
+
+func (s *EndNodeServer) ReconcileUsers(ctx context.Context, reconcileUsersReq *proto.ReconcileUsersReq) (*proto.ReconcileUsersRsp, error) {
+	drifts := globalUserManager.ReconcileUsers(
+		reconcileUsersReq.GetUsers(),
+		reconcileUsersReq.GetRepair(),
+		reconcileUsersReq.GetPrune(),
+		reconcileUsersReq.GetRepairUuid(),
+	)
+	if len(drifts) > 0 {
+		logger.Info(
+			"Msg=reconcile users|DriftNum=%d|Repair=%v|Prune=%v|RepairUuid=%v",
+			len(drifts),
+			reconcileUsersReq.GetRepair(),
+			reconcileUsersReq.GetPrune(),
+			reconcileUsersReq.GetRepairUuid(),
+		)
+	}
+	return &proto.ReconcileUsersRsp{Code: 0, Drifts: drifts}, nil
+}
+
 func (s *EndNodeServer) registerToEndNode(node *cluster.Node, wg *sync.WaitGroup, ch chan struct{}) {
 	defer func() {
 		wg.Done()
@@ -1063,7 +1542,7 @@ func (s *EndNodeServer) heartbeatToEndNode(node *cluster.Node, wg *sync.WaitGrou
 		Token: node.OutToken,
 		Node:  &localNode.Node,
 	}
-	var heartBeatReq interface{} = &proto.HeartBeatReq{}
+	var heartBeatReq interface{} = &proto.HeartBeatReq{Health: s.localHealth}
 	reqData, _ := pb.Marshal(heartBeatReq.(pb.Message))
 	rsp, err := rpcClient.ReqHeartBeat(rpcClient.NewContext(), reqData, c, nodeAuthInfo, globalCluster.GetClusterToken())
 	heartBeatRsp := rsp.(*proto.HeartBeatRsp)
@@ -1094,6 +1573,7 @@ func (s *EndNodeServer) registerOrHeartBeatToEndNode() {
 	// 并发数 10
 	ch := make(chan struct{}, 10)
 	wg := sync.WaitGroup{}
+	gossipEnabled := globalCluster.GetGossip() != nil
 	for _, node := range globalCluster.GetAllNode() {
 		if node.Name == s.Name {
 			continue
@@ -1116,6 +1596,10 @@ func (s *EndNodeServer) registerOrHeartBeatToEndNode() {
 			return
 		}
 
+		// gossip模式下节点存活由探测维护, 只向未注册的节点注册, 不再发送心跳
+		if gossipEnabled && node.RegisteredRemote() {
+			continue
+		}
 		ch <- struct{}{}
 		wg.Add(1)
 		if !node.RegisteredRemote() {
@@ -1127,20 +1611,61 @@ func (s *EndNodeServer) registerOrHeartBeatToEndNode() {
 	wg.Wait()
 }
 
+// 兼容旧版本的单个中心节点配置, 与center_nodes合并
+func (s *EndNodeServer) initCenterNodes() {
+	centerNodes, err := cluster.LoadCenterNodes(common.ConfigCenterNodes)
+	if err != nil {
+		logger.Error("Err=load center nodes fail > %v", err)
+	}
+	legacyNode := &cluster.Node{
+		Node: &proto.Node{
+			Host: globalConfig.GetString(common.ConfigCenterNodeHost),
+			Port: int32(globalConfig.GetInt(common.ConfigCenterNodePort)),
+		},
+	}
+	if legacyNode.Host != "" && legacyNode.Port > 1000 {
+		exist := false
+		for _, n := range centerNodes {
+			exist = exist || (n.Host == legacyNode.Host && n.Port == legacyNode.Port)
+		}
+		if !exist {
+			centerNodes = append([]*cluster.Node{legacyNode}, centerNodes...)
+		}
+	}
+	s.centerNodes = centerNodes
+}
+
 func (s *EndNodeServer) heartbeatToCenterNode() {
-	// 发送心跳到center node
-	if s.centerNode.Host == "" || s.centerNode.Port <= 1000 {
+	// 发送心跳到center node, 失败时依次尝试其他中心节点
+	for i := 0; i < len(s.centerNodes); i++ {
+		index := (s.centerIndex + i) % len(s.centerNodes)
+		rsp, err := s.heartbeatToCenter(s.centerNodes[index])
+		if err != nil {
+			logger.Error(
+				"Err=%v|Center=%s:%d",
+				err,
+				s.centerNodes[index].Host,
+				s.centerNodes[index].Port,
+			)
+			continue
+		}
+		if index != s.centerIndex {
+			logger.Info(
+				"Msg=switch center node|Center=%s:%d",
+				s.centerNodes[index].Host,
+				s.centerNodes[index].Port,
+			)
+			s.centerIndex = index
+		}
+		addRemoteNode(rsp, s, "Center")
 		return
 	}
-	conn, err := s.centerNode.GetGrpcClientConn()
+}
+
+func (s *EndNodeServer) heartbeatToCenter(centerNode *cluster.Node) (*proto.HeartBeatRsp, error) {
+	conn, err := centerNode.GetGrpcClientConn()
 	if err != nil {
-		errMsg := fmt.Sprintf("did not connect > %v", err)
-		logger.Error(
-			"Err=%s|Center=%s",
-			errMsg,
-			s.centerNode.Host,
-		)
-		return
+		return nil, fmt.Errorf("did not connect > %v", err)
 	}
 	c := proto.NewCenterNodeAccessClient(conn)
 	heartBeatReq := &proto.HeartBeatReq{
@@ -1148,18 +1673,13 @@ func (s *EndNodeServer) heartbeatToCenterNode() {
 			Token: "",
 			Node:  &localNode.Node,
 		},
+		Health: s.localHealth,
 	}
 	rsp, err := c.HeartBeat(rpcClient.NewContext(), heartBeatReq)
 	if err != nil {
-		errMsg := fmt.Sprintf("heartbeat failed > %v", err)
-		logger.Error(
-			"Err=%s|Center=%s:%d",
-			errMsg,
-			s.centerNode.Host,
-		)
-	} else {
-		addRemoteNode(rsp, s, "Center")
+		return nil, fmt.Errorf("heartbeat failed > %v", err)
 	}
+	return rsp, nil
 }
 
 func addRemoteNode(rsp *proto.HeartBeatRsp, s *EndNodeServer, remoteServerType string) {
@@ -1203,6 +1723,7 @@ func (s *EndNodeServer) heartBeatAndRegisterToNodeOrCenterNode() {
 	defer logger.Info("heartbeat and register exit")
 	ticker := time.NewTicker(heartbeatInterval)
 	for {
+		s.refreshLocalHealth()
 		s.heartbeatToCenterNode()
 		s.registerOrHeartBeatToEndNode()
 		<-ticker.C
@@ -1219,6 +1740,8 @@ func (s *EndNodeServer) filter() {
 		globalCluster.Filter(func(n *cluster.Node) bool {
 			return n.IsValid() || n.IsLocal()
 		})
+		// 持久化当前已知的节点, 重启后用于重新注册
+		globalCluster.FlushNodes()
 		// 过滤无效用户
 		globalUserManager.ClearInvalideUser()
 	}
@@ -1243,9 +1766,27 @@ func (s *EndNodeServer) Start() {
 		)
 		return
 	}
+	if err := s.requestNodeCert(); err != nil {
+		logger.Error("Err=%v|Issuer=%s", err, globalConfig.GetString(common.ConfigClusterTLSIssuer))
+	}
+	serverOptions, err := cluster.GetClusterTLS().ServerOptions()
+	if err != nil {
+		logger.Error(
+			"Err=%v|Addr=%s:%d",
+			err,
+			s.Host,
+			s.Port,
+		)
+		return
+	}
+	// 旧版本编码用于兼容未升级的节点, 严格模式下请求会在拦截器中被拒绝
 	encoding.RegisterCodec(rpc.NewEncryptMessageCodec(globalCluster.GetClusterToken()))
-	grpcServer := grpc.NewServer(grpc.UnaryInterceptor(UnaryServerInterceptor))
+	encoding.RegisterCodec(rpc.NewAEADMessageCodec(globalCluster.GetClusterToken()))
+	grpcServer := grpc.NewServer(append(serverOptions, grpc.UnaryInterceptor(UnaryServerInterceptor))...)
 	proto.RegisterEndNodeAccessServer(grpcServer, s)
+	if gossip := globalCluster.GetGossip(); gossip != nil {
+		gossip.Start(&gossipTransport{})
+	}
 	go s.heartBeatAndRegisterToNodeOrCenterNode()
 	go s.filter()
 	logger.Info("Msg=server listening at %v", lis.Addr())
@@ -0,0 +1,80 @@
+package rpc
+
+import (
+	context "context"
+	"fmt"
+
+	rpcClient "github.com/lureiny/v2raymg/client/rpc"
+	"github.com/lureiny/v2raymg/cluster"
+	globalCluster "github.com/lureiny/v2raymg/global/cluster"
+	"github.com/lureiny/v2raymg/server/rpc/proto"
+	pb "google.golang.org/protobuf/proto"
+)
+
+// gossipTransport 通过节点间的grpc发送gossip消息, 使用集群token认证
+type gossipTransport struct{}
+
+func (t *gossipTransport) Ping(ctx context.Context, node *cluster.Node, members []*proto.GossipMember) ([]*proto.GossipMember, error) {
+	rsp, err := t.ping(ctx, node, "", members)
+	if err != nil {
+		return nil, err
+	}
+	return rsp.GetMembers(), nil
+}
+
+func (t *gossipTransport) PingReq(ctx context.Context, via *cluster.Node, target string, members []*proto.GossipMember) (bool, []*proto.GossipMember, error) {
+	rsp, err := t.ping(ctx, via, target, members)
+	if err != nil {
+		return false, nil, err
+	}
+	return rsp.GetAck(), rsp.GetMembers(), nil
+}
+
+func (t *gossipTransport) ping(ctx context.Context, node *cluster.Node, target string, members []*proto.GossipMember) (*proto.GossipPingRsp, error) {
+	conn, err := node.GetGrpcClientConn()
+	if err != nil {
+		return nil, fmt.Errorf("did not connect > %v", err)
+	}
+	token := globalCluster.GetClusterToken()
+	nodeAuthInfo := &proto.NodeAuthInfo{
+		Token: token,
+		Node:  &localNode.Node,
+	}
+	var gossipPingReq interface{} = &proto.GossipPingReq{
+		Target:  target,
+		Members: members,
+	}
+	reqData, _ := pb.Marshal(gossipPingReq.(pb.Message))
+	r, err := rpcClient.ReqGossipPing(ctx, reqData, proto.NewEndNodeAccessClient(conn), nodeAuthInfo, token)
+	if err != nil {
+		return nil, err
+	}
+	rsp := r.(*proto.GossipPingRsp)
+	if rsp.GetCode() != 0 {
+		return nil, fmt.Errorf("gossip ping fail > %s", rsp.GetMsg())
+	}
+	return rsp, nil
+}
+
+func (s *EndNodeServer) GossipPing(ctx context.Context, gossipPingReq *proto.GossipPingReq) (*proto.GossipPingRsp, error) {
+	gossipPingRsp := &proto.GossipPingRsp{}
+	node := gossipPingReq.GetNodeAuthInfo().GetNode()
+	if err := globalCluster.IsSameCluster(node.GetClusterName(), gossipPingReq.GetNodeAuthInfo().GetToken()); err != nil {
+		gossipPingRsp.Code = 1300
+		gossipPingRsp.Msg = err.Error()
+		return gossipPingRsp, nil
+	}
+	gossip := globalCluster.GetGossip()
+	if gossip == nil {
+		gossipPingRsp.Code = 1301
+		gossipPingRsp.Msg = "gossip membership is disabled"
+		return gossipPingRsp, nil
+	}
+	if gossipPingReq.GetTarget() == "" {
+		gossipPingRsp.Ack = true
+		gossipPingRsp.Members = gossip.HandlePing(gossipPingReq.GetMembers())
+		return gossipPingRsp, nil
+	}
+	gossipPingRsp.Ack, gossipPingRsp.Members = gossip.HandlePingReq(ctx, gossipPingReq.GetTarget(), gossipPingReq.GetMembers())
+	return gossipPingRsp, nil
+}
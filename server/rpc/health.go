@@ -0,0 +1,95 @@
+package rpc
+
+import (
+	"time"
+
+	"github.com/lureiny/v2raymg/cluster"
+	"github.com/lureiny/v2raymg/common"
+	"github.com/lureiny/v2raymg/common/log/logger"
+	"github.com/lureiny/v2raymg/common/sysinfo"
+	globalCluster "github.com/lureiny/v2raymg/global/cluster"
+	globalConfig "github.com/lureiny/v2raymg/global/config"
+	"github.com/lureiny/v2raymg/global/proxy"
+	globalUserManager "github.com/lureiny/v2raymg/global/user"
+	"github.com/lureiny/v2raymg/proxy/manager"
+	"github.com/lureiny/v2raymg/server/rpc/proto"
+)
+
+const (
+	// 证书剩余有效期低于该值时节点为degraded
+	certExpiryWarning = 7 * 24 * time.Hour
+	// 内存使用比例超过该值时节点为degraded
+	memUsageWarning = 0.9
+)
+
+var sysSampler = sysinfo.NewSampler()
+
+func toProcessHealth(state *manager.ProxyServerState) *proto.ProcessHealth {
+	if state == nil {
+		return nil
+	}
+	return &proto.ProcessHealth{
+		Running:   state.Running,
+		Version:   state.Version,
+		StartTime: state.StartTime,
+	}
+}
+
+// 采集本地节点的健康状态
+func (s *EndNodeServer) collectHealth() *proto.NodeHealth {
+	health := &proto.NodeHealth{
+		Uptime:     int64(sysinfo.Uptime().Seconds()),
+		ReportTime: time.Now().Unix(),
+	}
+	// gateway模式下不运行proxy
+	if !globalConfig.GetBool(common.ConfigServerRpcOnlyGateway) {
+		health.Proxy = toProcessHealth(proxy.GetProxyServerState())
+		health.Hysteria = toProcessHealth(proxy.GetHysteriaServerState())
+		health.UserCount = int32(globalUserManager.Count())
+		health.InboundTags = proxy.GetTags()
+	}
+	if s.certManager != nil {
+		health.CertExpiries = s.certManager.GetCertExpiries()
+	}
+	if stats, err := sysSampler.Sample(); err != nil {
+		logger.Debug("Err=sample system stats fail > %v", err)
+	} else {
+		health.CpuPercent = stats.CPUPercent
+		health.MemTotal = stats.MemTotal
+		health.MemUsed = stats.MemUsed
+		health.NetRxRate = stats.NetRxRate
+		health.NetTxRate = stats.NetTxRate
+		health.Load1 = stats.Load1
+	}
+	health.Status = evalHealthStatus(health)
+	return health
+}
+
+// proxy未运行时为down, hysteria未运行, 证书即将过期或内存不足时为degraded
+func evalHealthStatus(health *proto.NodeHealth) string {
+	if health.Proxy != nil && !health.Proxy.Running {
+		return cluster.NodeStatusDown
+	}
+	if health.Hysteria != nil && !health.Hysteria.Running {
+		return cluster.NodeStatusDegraded
+	}
+	expiryLimit := time.Now().Add(certExpiryWarning).Unix()
+	for _, expireTime := range health.CertExpiries {
+		if expireTime < expiryLimit {
+			return cluster.NodeStatusDegraded
+		}
+	}
+	if health.MemTotal > 0 && float64(health.MemUsed)/float64(health.MemTotal) > memUsageWarning {
+		return cluster.NodeStatusDegraded
+	}
+	return cluster.NodeStatusHealthy
+}
+
+// 更新本地节点的健康状态, 用于心跳上报与/node展示
+func (s *EndNodeServer) refreshLocalHealth() {
+	s.localHealth = s.collectHealth()
+	globalCluster.UpdateNodeHealth(s.Name, s.localHealth)
+	if gossip := globalCluster.GetGossip(); gossip != nil {
+		gossip.SetLocalHealth(s.localHealth)
+	}
+}
@@ -23,16 +23,18 @@ const (
 type BuilderType int32
 
 const (
-	BuilderType_UnknowBuilderType        BuilderType = 0
-	BuilderType_VLESSSettingBuilderType  BuilderType = 10
-	BuilderType_VMESSSettingBuilderType  BuilderType = 11
-	BuilderType_TrojanSettingBuilderType BuilderType = 12
-	BuilderType_TCPBuilderType           BuilderType = 20
-	BuilderType_WSBuilderType            BuilderType = 21
-	BuilderType_QuicBuilderType          BuilderType = 22
-	BuilderType_MkcpBuilderType          BuilderType = 23
-	BuilderType_GrpcBuilderType          BuilderType = 24
-	BuilderType_HttpBuilderType          BuilderType = 25
+	BuilderType_UnknowBuilderType                 BuilderType = 0
+	BuilderType_VLESSSettingBuilderType           BuilderType = 10
+	BuilderType_VMESSSettingBuilderType           BuilderType = 11
+	BuilderType_TrojanSettingBuilderType          BuilderType = 12
+	BuilderType_ShadowsocksSettingBuilderType     BuilderType = 13
+	BuilderType_Shadowsocks2022SettingBuilderType BuilderType = 14
+	BuilderType_TCPBuilderType                    BuilderType = 20
+	BuilderType_WSBuilderType                     BuilderType = 21
+	BuilderType_QuicBuilderType                   BuilderType = 22
+	BuilderType_MkcpBuilderType                   BuilderType = 23
+	BuilderType_GrpcBuilderType                   BuilderType = 24
+	BuilderType_HttpBuilderType                   BuilderType = 25
 )
 
 // Enum value maps for BuilderType.
@@ -42,6 +44,8 @@ var (
 		10: "VLESSSettingBuilderType",
 		11: "VMESSSettingBuilderType",
 		12: "TrojanSettingBuilderType",
+		13: "ShadowsocksSettingBuilderType",
+		14: "Shadowsocks2022SettingBuilderType",
 		20: "TCPBuilderType",
 		21: "WSBuilderType",
 		22: "QuicBuilderType",
@@ -50,16 +54,18 @@ var (
 		25: "HttpBuilderType",
 	}
 	BuilderType_value = map[string]int32{
-		"UnknowBuilderType":        0,
-		"VLESSSettingBuilderType":  10,
-		"VMESSSettingBuilderType":  11,
-		"TrojanSettingBuilderType": 12,
-		"TCPBuilderType":           20,
-		"WSBuilderType":            21,
-		"QuicBuilderType":          22,
-		"MkcpBuilderType":          23,
-		"GrpcBuilderType":          24,
-		"HttpBuilderType":          25,
+		"UnknowBuilderType":                 0,
+		"VLESSSettingBuilderType":           10,
+		"VMESSSettingBuilderType":           11,
+		"TrojanSettingBuilderType":          12,
+		"ShadowsocksSettingBuilderType":     13,
+		"Shadowsocks2022SettingBuilderType": 14,
+		"TCPBuilderType":                    20,
+		"WSBuilderType":                     21,
+		"QuicBuilderType":                   22,
+		"MkcpBuilderType":                   23,
+		"GrpcBuilderType":                   24,
+		"HttpBuilderType":                   25,
 	}
 )
 
@@ -95,12 +101,32 @@ type User struct {
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Name       string   `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	Passwd     string   `protobuf:"bytes,2,opt,name=passwd,proto3" json:"passwd,omitempty"`
-	ExpireTime int64    `protobuf:"varint,3,opt,name=expire_time,json=expireTime,proto3" json:"expire_time,omitempty"`
-	Tags       []string `protobuf:"bytes,4,rep,name=tags,proto3" json:"tags,omitempty"`
-	Downlink   int64    `protobuf:"varint,5,opt,name=downlink,proto3" json:"downlink,omitempty"`
-	Uplink     int64    `protobuf:"varint,6,opt,name=uplink,proto3" json:"uplink,omitempty"`
+	Name             string   `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Passwd           string   `protobuf:"bytes,2,opt,name=passwd,proto3" json:"passwd,omitempty"` // 仅在请求中传递明文密码, 存储时只保存passwd_hash
+	ExpireTime       int64    `protobuf:"varint,3,opt,name=expire_time,json=expireTime,proto3" json:"expire_time,omitempty"`
+	Tags             []string `protobuf:"bytes,4,rep,name=tags,proto3" json:"tags,omitempty"`
+	Downlink         int64    `protobuf:"varint,5,opt,name=downlink,proto3" json:"downlink,omitempty"`
+	Uplink           int64    `protobuf:"varint,6,opt,name=uplink,proto3" json:"uplink,omitempty"`
+	Quota            int64    `protobuf:"varint,7,opt,name=quota,proto3" json:"quota,omitempty"`                                          // 总流量配额, 单位byte, 0表示不限制
+	UplinkQuota      int64    `protobuf:"varint,8,opt,name=uplink_quota,json=uplinkQuota,proto3" json:"uplink_quota,omitempty"`           // 上行流量配额, 0表示不限制
+	DownlinkQuota    int64    `protobuf:"varint,9,opt,name=downlink_quota,json=downlinkQuota,proto3" json:"downlink_quota,omitempty"`     // 下行流量配额, 0表示不限制
+	UsedUplink       int64    `protobuf:"varint,10,opt,name=used_uplink,json=usedUplink,proto3" json:"used_uplink,omitempty"`             // 当前统计周期内已使用的上行流量
+	UsedDownlink     int64    `protobuf:"varint,11,opt,name=used_downlink,json=usedDownlink,proto3" json:"used_downlink,omitempty"`       // 当前统计周期内已使用的下行流量
+	RemainingQuota   int64    `protobuf:"varint,12,opt,name=remaining_quota,json=remainingQuota,proto3" json:"remaining_quota,omitempty"` // 剩余流量配额, 仅查询时计算, -1表示不限制
+	OverQuota        bool     `protobuf:"varint,13,opt,name=over_quota,json=overQuota,proto3" json:"over_quota,omitempty"`                // 超出配额的用户会从proxy中停用
+	CreateTime       int64    `protobuf:"varint,14,opt,name=create_time,json=createTime,proto3" json:"create_time,omitempty"`
+	Suspended        bool     `protobuf:"varint,15,opt,name=suspended,proto3" json:"suspended,omitempty"`                               // 暂停的用户会从proxy中停用, 保留用户信息与tag
+	LastLoginAddr    string   `protobuf:"bytes,16,opt,name=last_login_addr,json=lastLoginAddr,proto3" json:"last_login_addr,omitempty"` // 最近一次hysteria认证的客户端地址
+	LastLoginTime    int64    `protobuf:"varint,17,opt,name=last_login_time,json=lastLoginTime,proto3" json:"last_login_time,omitempty"`
+	Plan             string   `protobuf:"bytes,18,opt,name=plan,proto3" json:"plan,omitempty"`                                                    // 用户使用的套餐
+	Level            uint32   `protobuf:"varint,19,opt,name=level,proto3" json:"level,omitempty"`                                                 // xray/v2ray用户level
+	BillingAnchorDay uint32   `protobuf:"varint,20,opt,name=billing_anchor_day,json=billingAnchorDay,proto3" json:"billing_anchor_day,omitempty"` // 流量重置日, 1-31, 超过当月天数时取当月最后一天
+	ResetPeriod      uint32   `protobuf:"varint,21,opt,name=reset_period,json=resetPeriod,proto3" json:"reset_period,omitempty"`                  // 流量重置周期, 单位月, 0表示不重置
+	PeriodStart      int64    `protobuf:"varint,22,opt,name=period_start,json=periodStart,proto3" json:"period_start,omitempty"`                  // 当前流量统计周期的开始时间
+	PasswdHash       string   `protobuf:"bytes,23,opt,name=passwd_hash,json=passwdHash,proto3" json:"passwd_hash,omitempty"`                      // 加盐后的密码哈希(bcrypt)
+	SubToken         string   `protobuf:"bytes,24,opt,name=sub_token,json=subToken,proto3" json:"sub_token,omitempty"`                            // 订阅token, 用于/sub与hysteria认证, 可以单独重置
+	Uuid             string   `protobuf:"bytes,25,opt,name=uuid,proto3" json:"uuid,omitempty"`                                                    // xray/v2ray中使用的uuid/password, 集群内各节点一致, 重置时同时更新
+	SpeedTier        string   `protobuf:"bytes,26,opt,name=speed_tier,json=speedTier,proto3" json:"speed_tier,omitempty"`                         // 用户的速度档位, 对应配置中speed_tiers的xray/v2ray policy level, 为空时使用level
 }
 
 func (x *User) Reset() {
@@ -177,135 +203,176 @@ func (x *User) GetUplink() int64 {
 	return 0
 }
 
-type NodeAuthInfo struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
+func (x *User) GetQuota() int64 {
+	if x != nil {
+		return x.Quota
+	}
+	return 0
+}
 
-	Token string `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
-	Node  *Node  `protobuf:"bytes,2,opt,name=node,proto3" json:"node,omitempty"`
+func (x *User) GetUplinkQuota() int64 {
+	if x != nil {
+		return x.UplinkQuota
+	}
+	return 0
 }
 
-func (x *NodeAuthInfo) Reset() {
-	*x = NodeAuthInfo{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_rpc_server_proto_msgTypes[1]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+func (x *User) GetDownlinkQuota() int64 {
+	if x != nil {
+		return x.DownlinkQuota
 	}
+	return 0
 }
 
-func (x *NodeAuthInfo) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *User) GetUsedUplink() int64 {
+	if x != nil {
+		return x.UsedUplink
+	}
+	return 0
 }
 
-func (*NodeAuthInfo) ProtoMessage() {}
+func (x *User) GetUsedDownlink() int64 {
+	if x != nil {
+		return x.UsedDownlink
+	}
+	return 0
+}
 
-func (x *NodeAuthInfo) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_server_proto_msgTypes[1]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+func (x *User) GetRemainingQuota() int64 {
+	if x != nil {
+		return x.RemainingQuota
 	}
-	return mi.MessageOf(x)
+	return 0
 }
 
-// Deprecated: Use NodeAuthInfo.ProtoReflect.Descriptor instead.
-func (*NodeAuthInfo) Descriptor() ([]byte, []int) {
-	return file_rpc_server_proto_rawDescGZIP(), []int{1}
+func (x *User) GetOverQuota() bool {
+	if x != nil {
+		return x.OverQuota
+	}
+	return false
 }
 
-func (x *NodeAuthInfo) GetToken() string {
+func (x *User) GetCreateTime() int64 {
 	if x != nil {
-		return x.Token
+		return x.CreateTime
+	}
+	return 0
+}
+
+func (x *User) GetSuspended() bool {
+	if x != nil {
+		return x.Suspended
+	}
+	return false
+}
+
+func (x *User) GetLastLoginAddr() string {
+	if x != nil {
+		return x.LastLoginAddr
 	}
 	return ""
 }
 
-func (x *NodeAuthInfo) GetNode() *Node {
+func (x *User) GetLastLoginTime() int64 {
 	if x != nil {
-		return x.Node
+		return x.LastLoginTime
 	}
-	return nil
+	return 0
 }
 
-type GetUsersReq struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
+func (x *User) GetPlan() string {
+	if x != nil {
+		return x.Plan
+	}
+	return ""
+}
 
-	NodeAuthInfo *NodeAuthInfo `protobuf:"bytes,1,opt,name=node_auth_info,json=nodeAuthInfo,proto3" json:"node_auth_info,omitempty"`
+func (x *User) GetLevel() uint32 {
+	if x != nil {
+		return x.Level
+	}
+	return 0
 }
 
-func (x *GetUsersReq) Reset() {
-	*x = GetUsersReq{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_rpc_server_proto_msgTypes[2]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+func (x *User) GetBillingAnchorDay() uint32 {
+	if x != nil {
+		return x.BillingAnchorDay
 	}
+	return 0
 }
 
-func (x *GetUsersReq) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *User) GetResetPeriod() uint32 {
+	if x != nil {
+		return x.ResetPeriod
+	}
+	return 0
 }
 
-func (*GetUsersReq) ProtoMessage() {}
+func (x *User) GetPeriodStart() int64 {
+	if x != nil {
+		return x.PeriodStart
+	}
+	return 0
+}
 
-func (x *GetUsersReq) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_server_proto_msgTypes[2]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+func (x *User) GetPasswdHash() string {
+	if x != nil {
+		return x.PasswdHash
 	}
-	return mi.MessageOf(x)
+	return ""
 }
 
-// Deprecated: Use GetUsersReq.ProtoReflect.Descriptor instead.
-func (*GetUsersReq) Descriptor() ([]byte, []int) {
-	return file_rpc_server_proto_rawDescGZIP(), []int{2}
+func (x *User) GetSubToken() string {
+	if x != nil {
+		return x.SubToken
+	}
+	return ""
 }
 
-func (x *GetUsersReq) GetNodeAuthInfo() *NodeAuthInfo {
+func (x *User) GetUuid() string {
 	if x != nil {
-		return x.NodeAuthInfo
+		return x.Uuid
 	}
-	return nil
+	return ""
 }
 
-type GetUsersRsp struct {
+func (x *User) GetSpeedTier() string {
+	if x != nil {
+		return x.SpeedTier
+	}
+	return ""
+}
+
+// 用户一个统计周期内的流量
+type UserUsage struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Code  int32   `protobuf:"varint,1,opt,name=code,proto3" json:"code,omitempty"`
-	Msg   string  `protobuf:"bytes,2,opt,name=msg,proto3" json:"msg,omitempty"`
-	Users []*User `protobuf:"bytes,3,rep,name=users,proto3" json:"users,omitempty"`
+	Name     string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Start    int64  `protobuf:"varint,2,opt,name=start,proto3" json:"start,omitempty"`
+	End      int64  `protobuf:"varint,3,opt,name=end,proto3" json:"end,omitempty"`
+	Uplink   int64  `protobuf:"varint,4,opt,name=uplink,proto3" json:"uplink,omitempty"`
+	Downlink int64  `protobuf:"varint,5,opt,name=downlink,proto3" json:"downlink,omitempty"`
 }
 
-func (x *GetUsersRsp) Reset() {
-	*x = GetUsersRsp{}
+func (x *UserUsage) Reset() {
+	*x = UserUsage{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_rpc_server_proto_msgTypes[3]
+		mi := &file_rpc_server_proto_msgTypes[1]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *GetUsersRsp) String() string {
+func (x *UserUsage) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetUsersRsp) ProtoMessage() {}
+func (*UserUsage) ProtoMessage() {}
 
-func (x *GetUsersRsp) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_server_proto_msgTypes[3]
+func (x *UserUsage) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_server_proto_msgTypes[1]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -316,58 +383,81 @@ func (x *GetUsersRsp) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetUsersRsp.ProtoReflect.Descriptor instead.
-func (*GetUsersRsp) Descriptor() ([]byte, []int) {
-	return file_rpc_server_proto_rawDescGZIP(), []int{3}
+// Deprecated: Use UserUsage.ProtoReflect.Descriptor instead.
+func (*UserUsage) Descriptor() ([]byte, []int) {
+	return file_rpc_server_proto_rawDescGZIP(), []int{1}
 }
 
-func (x *GetUsersRsp) GetCode() int32 {
+func (x *UserUsage) GetName() string {
 	if x != nil {
-		return x.Code
+		return x.Name
+	}
+	return ""
+}
+
+func (x *UserUsage) GetStart() int64 {
+	if x != nil {
+		return x.Start
 	}
 	return 0
 }
 
-func (x *GetUsersRsp) GetMsg() string {
+func (x *UserUsage) GetEnd() int64 {
 	if x != nil {
-		return x.Msg
+		return x.End
 	}
-	return ""
+	return 0
 }
 
-func (x *GetUsersRsp) GetUsers() []*User {
+func (x *UserUsage) GetUplink() int64 {
 	if x != nil {
-		return x.Users
+		return x.Uplink
 	}
-	return nil
+	return 0
 }
 
-type UserOpReq struct {
+func (x *UserUsage) GetDownlink() int64 {
+	if x != nil {
+		return x.Downlink
+	}
+	return 0
+}
+
+// 用户套餐模板, 添加用户时可以通过套餐填充tag, 有效期, 配额与level
+type Plan struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	NodeAuthInfo *NodeAuthInfo `protobuf:"bytes,1,opt,name=node_auth_info,json=nodeAuthInfo,proto3" json:"node_auth_info,omitempty"`
-	Users        []*User       `protobuf:"bytes,2,rep,name=users,proto3" json:"users,omitempty"`
+	Name             string   `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Tags             []string `protobuf:"bytes,2,rep,name=tags,proto3" json:"tags,omitempty"`          // 为空时使用默认tag
+	Duration         int64    `protobuf:"varint,3,opt,name=duration,proto3" json:"duration,omitempty"` // 有效期, 单位秒, 0表示不过期
+	Quota            int64    `protobuf:"varint,4,opt,name=quota,proto3" json:"quota,omitempty"`       // 流量配额, 0表示不限制
+	UplinkQuota      int64    `protobuf:"varint,5,opt,name=uplink_quota,json=uplinkQuota,proto3" json:"uplink_quota,omitempty"`
+	DownlinkQuota    int64    `protobuf:"varint,6,opt,name=downlink_quota,json=downlinkQuota,proto3" json:"downlink_quota,omitempty"`
+	Level            uint32   `protobuf:"varint,7,opt,name=level,proto3" json:"level,omitempty"`
+	ResetPeriod      uint32   `protobuf:"varint,8,opt,name=reset_period,json=resetPeriod,proto3" json:"reset_period,omitempty"`                  // 流量重置周期, 单位月, 0表示不重置
+	BillingAnchorDay uint32   `protobuf:"varint,9,opt,name=billing_anchor_day,json=billingAnchorDay,proto3" json:"billing_anchor_day,omitempty"` // 流量重置日, 0表示使用用户的创建日
+	NodeSelector     string   `protobuf:"bytes,10,opt,name=node_selector,json=nodeSelector,proto3" json:"node_selector,omitempty"`               // 套餐适用的节点, 格式与target参数相同, 为空时不限制
 }
 
-func (x *UserOpReq) Reset() {
-	*x = UserOpReq{}
+func (x *Plan) Reset() {
+	*x = Plan{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_rpc_server_proto_msgTypes[4]
+		mi := &file_rpc_server_proto_msgTypes[2]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *UserOpReq) String() string {
+func (x *Plan) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UserOpReq) ProtoMessage() {}
+func (*Plan) ProtoMessage() {}
 
-func (x *UserOpReq) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_server_proto_msgTypes[4]
+func (x *Plan) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_server_proto_msgTypes[2]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -378,108 +468,109 @@ func (x *UserOpReq) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UserOpReq.ProtoReflect.Descriptor instead.
-func (*UserOpReq) Descriptor() ([]byte, []int) {
-	return file_rpc_server_proto_rawDescGZIP(), []int{4}
+// Deprecated: Use Plan.ProtoReflect.Descriptor instead.
+func (*Plan) Descriptor() ([]byte, []int) {
+	return file_rpc_server_proto_rawDescGZIP(), []int{2}
 }
 
-func (x *UserOpReq) GetNodeAuthInfo() *NodeAuthInfo {
+func (x *Plan) GetName() string {
 	if x != nil {
-		return x.NodeAuthInfo
+		return x.Name
 	}
-	return nil
+	return ""
 }
 
-func (x *UserOpReq) GetUsers() []*User {
+func (x *Plan) GetTags() []string {
 	if x != nil {
-		return x.Users
+		return x.Tags
 	}
 	return nil
 }
 
-type UserOpRsp struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	Code int32  `protobuf:"varint,1,opt,name=code,proto3" json:"code,omitempty"`
-	Msg  string `protobuf:"bytes,2,opt,name=msg,proto3" json:"msg,omitempty"`
+func (x *Plan) GetDuration() int64 {
+	if x != nil {
+		return x.Duration
+	}
+	return 0
 }
 
-func (x *UserOpRsp) Reset() {
-	*x = UserOpRsp{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_rpc_server_proto_msgTypes[5]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+func (x *Plan) GetQuota() int64 {
+	if x != nil {
+		return x.Quota
 	}
+	return 0
 }
 
-func (x *UserOpRsp) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *Plan) GetUplinkQuota() int64 {
+	if x != nil {
+		return x.UplinkQuota
+	}
+	return 0
 }
 
-func (*UserOpRsp) ProtoMessage() {}
+func (x *Plan) GetDownlinkQuota() int64 {
+	if x != nil {
+		return x.DownlinkQuota
+	}
+	return 0
+}
 
-func (x *UserOpRsp) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_server_proto_msgTypes[5]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+func (x *Plan) GetLevel() uint32 {
+	if x != nil {
+		return x.Level
 	}
-	return mi.MessageOf(x)
+	return 0
 }
 
-// Deprecated: Use UserOpRsp.ProtoReflect.Descriptor instead.
-func (*UserOpRsp) Descriptor() ([]byte, []int) {
-	return file_rpc_server_proto_rawDescGZIP(), []int{5}
+func (x *Plan) GetResetPeriod() uint32 {
+	if x != nil {
+		return x.ResetPeriod
+	}
+	return 0
 }
 
-func (x *UserOpRsp) GetCode() int32 {
+func (x *Plan) GetBillingAnchorDay() uint32 {
 	if x != nil {
-		return x.Code
+		return x.BillingAnchorDay
 	}
 	return 0
 }
 
-func (x *UserOpRsp) GetMsg() string {
+func (x *Plan) GetNodeSelector() string {
 	if x != nil {
-		return x.Msg
+		return x.NodeSelector
 	}
 	return ""
 }
 
-type GetSubReq struct {
+type NodeAuthInfo struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	NodeAuthInfo     *NodeAuthInfo `protobuf:"bytes,1,opt,name=node_auth_info,json=nodeAuthInfo,proto3" json:"node_auth_info,omitempty"`
-	User             *User         `protobuf:"bytes,2,opt,name=user,proto3" json:"user,omitempty"`
-	ExcludeProtocols []string      `protobuf:"bytes,3,rep,name=exclude_protocols,json=excludeProtocols,proto3" json:"exclude_protocols,omitempty"`
-	UseSni           bool          `protobuf:"varint,4,opt,name=use_sni,json=useSni,proto3" json:"use_sni,omitempty"`
+	Token     string `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	Node      *Node  `protobuf:"bytes,2,opt,name=node,proto3" json:"node,omitempty"`
+	Timestamp int64  `protobuf:"varint,3,opt,name=timestamp,proto3" json:"timestamp,omitempty"` // 请求时间, 单位为毫秒, 与nonce一起用于重放检查
+	Nonce     []byte `protobuf:"bytes,4,opt,name=nonce,proto3" json:"nonce,omitempty"`
 }
 
-func (x *GetSubReq) Reset() {
-	*x = GetSubReq{}
+func (x *NodeAuthInfo) Reset() {
+	*x = NodeAuthInfo{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_rpc_server_proto_msgTypes[6]
+		mi := &file_rpc_server_proto_msgTypes[3]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *GetSubReq) String() string {
+func (x *NodeAuthInfo) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetSubReq) ProtoMessage() {}
+func (*NodeAuthInfo) ProtoMessage() {}
 
-func (x *GetSubReq) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_server_proto_msgTypes[6]
+func (x *NodeAuthInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_server_proto_msgTypes[3]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -490,66 +581,64 @@ func (x *GetSubReq) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetSubReq.ProtoReflect.Descriptor instead.
-func (*GetSubReq) Descriptor() ([]byte, []int) {
-	return file_rpc_server_proto_rawDescGZIP(), []int{6}
+// Deprecated: Use NodeAuthInfo.ProtoReflect.Descriptor instead.
+func (*NodeAuthInfo) Descriptor() ([]byte, []int) {
+	return file_rpc_server_proto_rawDescGZIP(), []int{3}
 }
 
-func (x *GetSubReq) GetNodeAuthInfo() *NodeAuthInfo {
+func (x *NodeAuthInfo) GetToken() string {
 	if x != nil {
-		return x.NodeAuthInfo
+		return x.Token
 	}
-	return nil
+	return ""
 }
 
-func (x *GetSubReq) GetUser() *User {
+func (x *NodeAuthInfo) GetNode() *Node {
 	if x != nil {
-		return x.User
+		return x.Node
 	}
 	return nil
 }
 
-func (x *GetSubReq) GetExcludeProtocols() []string {
+func (x *NodeAuthInfo) GetTimestamp() int64 {
 	if x != nil {
-		return x.ExcludeProtocols
+		return x.Timestamp
 	}
-	return nil
+	return 0
 }
 
-func (x *GetSubReq) GetUseSni() bool {
+func (x *NodeAuthInfo) GetNonce() []byte {
 	if x != nil {
-		return x.UseSni
+		return x.Nonce
 	}
-	return false
+	return nil
 }
 
-type GetSubRsp struct {
+type GetUsersReq struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Code int32    `protobuf:"varint,1,opt,name=code,proto3" json:"code,omitempty"`
-	Msg  string   `protobuf:"bytes,2,opt,name=msg,proto3" json:"msg,omitempty"`
-	Uris []string `protobuf:"bytes,3,rep,name=uris,proto3" json:"uris,omitempty"`
+	NodeAuthInfo *NodeAuthInfo `protobuf:"bytes,1,opt,name=node_auth_info,json=nodeAuthInfo,proto3" json:"node_auth_info,omitempty"`
 }
 
-func (x *GetSubRsp) Reset() {
-	*x = GetSubRsp{}
+func (x *GetUsersReq) Reset() {
+	*x = GetUsersReq{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_rpc_server_proto_msgTypes[7]
+		mi := &file_rpc_server_proto_msgTypes[4]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *GetSubRsp) String() string {
+func (x *GetUsersReq) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetSubRsp) ProtoMessage() {}
+func (*GetUsersReq) ProtoMessage() {}
 
-func (x *GetSubRsp) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_server_proto_msgTypes[7]
+func (x *GetUsersReq) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_server_proto_msgTypes[4]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -560,57 +649,45 @@ func (x *GetSubRsp) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetSubRsp.ProtoReflect.Descriptor instead.
-func (*GetSubRsp) Descriptor() ([]byte, []int) {
-	return file_rpc_server_proto_rawDescGZIP(), []int{7}
-}
-
-func (x *GetSubRsp) GetCode() int32 {
-	if x != nil {
-		return x.Code
-	}
-	return 0
-}
-
-func (x *GetSubRsp) GetMsg() string {
-	if x != nil {
-		return x.Msg
-	}
-	return ""
+// Deprecated: Use GetUsersReq.ProtoReflect.Descriptor instead.
+func (*GetUsersReq) Descriptor() ([]byte, []int) {
+	return file_rpc_server_proto_rawDescGZIP(), []int{4}
 }
 
-func (x *GetSubRsp) GetUris() []string {
+func (x *GetUsersReq) GetNodeAuthInfo() *NodeAuthInfo {
 	if x != nil {
-		return x.Uris
+		return x.NodeAuthInfo
 	}
 	return nil
 }
 
-type HeartBeatReq struct {
+type GetUsersRsp struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	NodeAuthInfo *NodeAuthInfo `protobuf:"bytes,1,opt,name=node_auth_info,json=nodeAuthInfo,proto3" json:"node_auth_info,omitempty"`
+	Code  int32   `protobuf:"varint,1,opt,name=code,proto3" json:"code,omitempty"`
+	Msg   string  `protobuf:"bytes,2,opt,name=msg,proto3" json:"msg,omitempty"`
+	Users []*User `protobuf:"bytes,3,rep,name=users,proto3" json:"users,omitempty"`
 }
 
-func (x *HeartBeatReq) Reset() {
-	*x = HeartBeatReq{}
+func (x *GetUsersRsp) Reset() {
+	*x = GetUsersRsp{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_rpc_server_proto_msgTypes[8]
+		mi := &file_rpc_server_proto_msgTypes[5]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *HeartBeatReq) String() string {
+func (x *GetUsersRsp) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*HeartBeatReq) ProtoMessage() {}
+func (*GetUsersRsp) ProtoMessage() {}
 
-func (x *HeartBeatReq) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_server_proto_msgTypes[8]
+func (x *GetUsersRsp) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_server_proto_msgTypes[5]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -621,46 +698,66 @@ func (x *HeartBeatReq) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use HeartBeatReq.ProtoReflect.Descriptor instead.
-func (*HeartBeatReq) Descriptor() ([]byte, []int) {
-	return file_rpc_server_proto_rawDescGZIP(), []int{8}
+// Deprecated: Use GetUsersRsp.ProtoReflect.Descriptor instead.
+func (*GetUsersRsp) Descriptor() ([]byte, []int) {
+	return file_rpc_server_proto_rawDescGZIP(), []int{5}
 }
 
-func (x *HeartBeatReq) GetNodeAuthInfo() *NodeAuthInfo {
+func (x *GetUsersRsp) GetCode() int32 {
 	if x != nil {
-		return x.NodeAuthInfo
+		return x.Code
+	}
+	return 0
+}
+
+func (x *GetUsersRsp) GetMsg() string {
+	if x != nil {
+		return x.Msg
+	}
+	return ""
+}
+
+func (x *GetUsersRsp) GetUsers() []*User {
+	if x != nil {
+		return x.Users
 	}
 	return nil
 }
 
-type Node struct {
+// 部分更新用户时的字段掩码, 只修改指定的字段
+type UserUpdateMask struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Host        string `protobuf:"bytes,1,opt,name=host,proto3" json:"host,omitempty"`
-	Port        int32  `protobuf:"varint,2,opt,name=port,proto3" json:"port,omitempty"`
-	ClusterName string `protobuf:"bytes,3,opt,name=cluster_name,json=clusterName,proto3" json:"cluster_name,omitempty"`
-	Name        string `protobuf:"bytes,4,opt,name=name,proto3" json:"name,omitempty"`
+	Passwd       bool     `protobuf:"varint,1,opt,name=passwd,proto3" json:"passwd,omitempty"`                                 // 修改密码
+	ExpireTime   bool     `protobuf:"varint,2,opt,name=expire_time,json=expireTime,proto3" json:"expire_time,omitempty"`       // 修改过期时间
+	ExtendExpire int64    `protobuf:"varint,3,opt,name=extend_expire,json=extendExpire,proto3" json:"extend_expire,omitempty"` // 在当前过期时间的基础上延长, 单位秒, 已过期的用户从当前时间开始计算
+	Quota        bool     `protobuf:"varint,4,opt,name=quota,proto3" json:"quota,omitempty"`                                   // 修改流量配额
+	AddTags      []string `protobuf:"bytes,5,rep,name=add_tags,json=addTags,proto3" json:"add_tags,omitempty"`                 // 添加到指定的inbound
+	RemoveTags   []string `protobuf:"bytes,6,rep,name=remove_tags,json=removeTags,proto3" json:"remove_tags,omitempty"`        // 从指定的inbound中删除
+	Billing      bool     `protobuf:"varint,7,opt,name=billing,proto3" json:"billing,omitempty"`                               // 修改流量重置日与重置周期
+	SubToken     bool     `protobuf:"varint,8,opt,name=sub_token,json=subToken,proto3" json:"sub_token,omitempty"`             // 重置订阅token, 请求中的sub_token为空时随机生成
+	SpeedTier    bool     `protobuf:"varint,9,opt,name=speed_tier,json=speedTier,proto3" json:"speed_tier,omitempty"`          // 修改速度档位, 为空时恢复为level 0
 }
 
-func (x *Node) Reset() {
-	*x = Node{}
+func (x *UserUpdateMask) Reset() {
+	*x = UserUpdateMask{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_rpc_server_proto_msgTypes[9]
+		mi := &file_rpc_server_proto_msgTypes[6]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *Node) String() string {
+func (x *UserUpdateMask) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Node) ProtoMessage() {}
+func (*UserUpdateMask) ProtoMessage() {}
 
-func (x *Node) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_server_proto_msgTypes[9]
+func (x *UserUpdateMask) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_server_proto_msgTypes[6]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -671,66 +768,101 @@ func (x *Node) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Node.ProtoReflect.Descriptor instead.
-func (*Node) Descriptor() ([]byte, []int) {
-	return file_rpc_server_proto_rawDescGZIP(), []int{9}
+// Deprecated: Use UserUpdateMask.ProtoReflect.Descriptor instead.
+func (*UserUpdateMask) Descriptor() ([]byte, []int) {
+	return file_rpc_server_proto_rawDescGZIP(), []int{6}
 }
 
-func (x *Node) GetHost() string {
+func (x *UserUpdateMask) GetPasswd() bool {
 	if x != nil {
-		return x.Host
+		return x.Passwd
 	}
-	return ""
+	return false
 }
 
-func (x *Node) GetPort() int32 {
+func (x *UserUpdateMask) GetExpireTime() bool {
 	if x != nil {
-		return x.Port
+		return x.ExpireTime
+	}
+	return false
+}
+
+func (x *UserUpdateMask) GetExtendExpire() int64 {
+	if x != nil {
+		return x.ExtendExpire
 	}
 	return 0
 }
 
-func (x *Node) GetClusterName() string {
+func (x *UserUpdateMask) GetQuota() bool {
 	if x != nil {
-		return x.ClusterName
+		return x.Quota
 	}
-	return ""
+	return false
 }
 
-func (x *Node) GetName() string {
+func (x *UserUpdateMask) GetAddTags() []string {
 	if x != nil {
-		return x.Name
+		return x.AddTags
 	}
-	return ""
+	return nil
 }
 
-type HeartBeatRsp struct {
+func (x *UserUpdateMask) GetRemoveTags() []string {
+	if x != nil {
+		return x.RemoveTags
+	}
+	return nil
+}
+
+func (x *UserUpdateMask) GetBilling() bool {
+	if x != nil {
+		return x.Billing
+	}
+	return false
+}
+
+func (x *UserUpdateMask) GetSubToken() bool {
+	if x != nil {
+		return x.SubToken
+	}
+	return false
+}
+
+func (x *UserUpdateMask) GetSpeedTier() bool {
+	if x != nil {
+		return x.SpeedTier
+	}
+	return false
+}
+
+type UserOpReq struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Code     int32            `protobuf:"varint,1,opt,name=code,proto3" json:"code,omitempty"`
-	Msg      string           `protobuf:"bytes,2,opt,name=msg,proto3" json:"msg,omitempty"`
-	NodesMap map[string]*Node `protobuf:"bytes,3,rep,name=nodesMap,proto3" json:"nodesMap,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	NodeAuthInfo *NodeAuthInfo   `protobuf:"bytes,1,opt,name=node_auth_info,json=nodeAuthInfo,proto3" json:"node_auth_info,omitempty"`
+	Users        []*User         `protobuf:"bytes,2,rep,name=users,proto3" json:"users,omitempty"`
+	UpdateMask   *UserUpdateMask `protobuf:"bytes,3,opt,name=update_mask,json=updateMask,proto3" json:"update_mask,omitempty"` // 仅用于UpdateUsers, 为空时为全量更新(passwd, expire_time, quota)
 }
 
-func (x *HeartBeatRsp) Reset() {
-	*x = HeartBeatRsp{}
+func (x *UserOpReq) Reset() {
+	*x = UserOpReq{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_rpc_server_proto_msgTypes[10]
+		mi := &file_rpc_server_proto_msgTypes[7]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *HeartBeatRsp) String() string {
+func (x *UserOpReq) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*HeartBeatRsp) ProtoMessage() {}
+func (*UserOpReq) ProtoMessage() {}
 
-func (x *HeartBeatRsp) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_server_proto_msgTypes[10]
+func (x *UserOpReq) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_server_proto_msgTypes[7]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -741,57 +873,58 @@ func (x *HeartBeatRsp) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use HeartBeatRsp.ProtoReflect.Descriptor instead.
-func (*HeartBeatRsp) Descriptor() ([]byte, []int) {
-	return file_rpc_server_proto_rawDescGZIP(), []int{10}
+// Deprecated: Use UserOpReq.ProtoReflect.Descriptor instead.
+func (*UserOpReq) Descriptor() ([]byte, []int) {
+	return file_rpc_server_proto_rawDescGZIP(), []int{7}
 }
 
-func (x *HeartBeatRsp) GetCode() int32 {
+func (x *UserOpReq) GetNodeAuthInfo() *NodeAuthInfo {
 	if x != nil {
-		return x.Code
+		return x.NodeAuthInfo
 	}
-	return 0
+	return nil
 }
 
-func (x *HeartBeatRsp) GetMsg() string {
+func (x *UserOpReq) GetUsers() []*User {
 	if x != nil {
-		return x.Msg
+		return x.Users
 	}
-	return ""
+	return nil
 }
 
-func (x *HeartBeatRsp) GetNodesMap() map[string]*Node {
+func (x *UserOpReq) GetUpdateMask() *UserUpdateMask {
 	if x != nil {
-		return x.NodesMap
+		return x.UpdateMask
 	}
 	return nil
 }
 
-type Nodes struct {
+type UserOpRsp struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Nodes map[string]*Nodes `protobuf:"bytes,1,rep,name=nodes,proto3" json:"nodes,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	Code int32  `protobuf:"varint,1,opt,name=code,proto3" json:"code,omitempty"`
+	Msg  string `protobuf:"bytes,2,opt,name=msg,proto3" json:"msg,omitempty"`
 }
 
-func (x *Nodes) Reset() {
-	*x = Nodes{}
+func (x *UserOpRsp) Reset() {
+	*x = UserOpRsp{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_rpc_server_proto_msgTypes[11]
+		mi := &file_rpc_server_proto_msgTypes[8]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *Nodes) String() string {
+func (x *UserOpRsp) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Nodes) ProtoMessage() {}
+func (*UserOpRsp) ProtoMessage() {}
 
-func (x *Nodes) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_server_proto_msgTypes[11]
+func (x *UserOpRsp) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_server_proto_msgTypes[8]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -802,19 +935,26 @@ func (x *Nodes) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Nodes.ProtoReflect.Descriptor instead.
-func (*Nodes) Descriptor() ([]byte, []int) {
-	return file_rpc_server_proto_rawDescGZIP(), []int{11}
+// Deprecated: Use UserOpRsp.ProtoReflect.Descriptor instead.
+func (*UserOpRsp) Descriptor() ([]byte, []int) {
+	return file_rpc_server_proto_rawDescGZIP(), []int{8}
 }
 
-func (x *Nodes) GetNodes() map[string]*Nodes {
+func (x *UserOpRsp) GetCode() int32 {
 	if x != nil {
-		return x.Nodes
+		return x.Code
 	}
-	return nil
+	return 0
 }
 
-type RegisterNodeReq struct {
+func (x *UserOpRsp) GetMsg() string {
+	if x != nil {
+		return x.Msg
+	}
+	return ""
+}
+
+type ExportUsersReq struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
@@ -822,23 +962,23 @@ type RegisterNodeReq struct {
 	NodeAuthInfo *NodeAuthInfo `protobuf:"bytes,1,opt,name=node_auth_info,json=nodeAuthInfo,proto3" json:"node_auth_info,omitempty"`
 }
 
-func (x *RegisterNodeReq) Reset() {
-	*x = RegisterNodeReq{}
+func (x *ExportUsersReq) Reset() {
+	*x = ExportUsersReq{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_rpc_server_proto_msgTypes[12]
+		mi := &file_rpc_server_proto_msgTypes[9]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *RegisterNodeReq) String() string {
+func (x *ExportUsersReq) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RegisterNodeReq) ProtoMessage() {}
+func (*ExportUsersReq) ProtoMessage() {}
 
-func (x *RegisterNodeReq) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_server_proto_msgTypes[12]
+func (x *ExportUsersReq) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_server_proto_msgTypes[9]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -849,45 +989,45 @@ func (x *RegisterNodeReq) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RegisterNodeReq.ProtoReflect.Descriptor instead.
-func (*RegisterNodeReq) Descriptor() ([]byte, []int) {
-	return file_rpc_server_proto_rawDescGZIP(), []int{12}
+// Deprecated: Use ExportUsersReq.ProtoReflect.Descriptor instead.
+func (*ExportUsersReq) Descriptor() ([]byte, []int) {
+	return file_rpc_server_proto_rawDescGZIP(), []int{9}
 }
 
-func (x *RegisterNodeReq) GetNodeAuthInfo() *NodeAuthInfo {
+func (x *ExportUsersReq) GetNodeAuthInfo() *NodeAuthInfo {
 	if x != nil {
 		return x.NodeAuthInfo
 	}
 	return nil
 }
 
-type RegisterNodeRsp struct {
+type ExportUsersRsp struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Code int32  `protobuf:"varint,1,opt,name=code,proto3" json:"code,omitempty"`
-	Msg  string `protobuf:"bytes,2,opt,name=msg,proto3" json:"msg,omitempty"`
-	Data []byte `protobuf:"bytes,3,opt,name=data,proto3" json:"data,omitempty"` // end node 节点间注册返回token(string), 注册到center node返回[]Node
+	Code  int32   `protobuf:"varint,1,opt,name=code,proto3" json:"code,omitempty"`
+	Msg   string  `protobuf:"bytes,2,opt,name=msg,proto3" json:"msg,omitempty"`
+	Users []*User `protobuf:"bytes,3,rep,name=users,proto3" json:"users,omitempty"` // 包含passwd_hash与sub_token
 }
 
-func (x *RegisterNodeRsp) Reset() {
-	*x = RegisterNodeRsp{}
+func (x *ExportUsersRsp) Reset() {
+	*x = ExportUsersRsp{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_rpc_server_proto_msgTypes[13]
+		mi := &file_rpc_server_proto_msgTypes[10]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *RegisterNodeRsp) String() string {
+func (x *ExportUsersRsp) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RegisterNodeRsp) ProtoMessage() {}
+func (*ExportUsersRsp) ProtoMessage() {}
 
-func (x *RegisterNodeRsp) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_server_proto_msgTypes[13]
+func (x *ExportUsersRsp) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_server_proto_msgTypes[10]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -898,57 +1038,60 @@ func (x *RegisterNodeRsp) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RegisterNodeRsp.ProtoReflect.Descriptor instead.
-func (*RegisterNodeRsp) Descriptor() ([]byte, []int) {
-	return file_rpc_server_proto_rawDescGZIP(), []int{13}
+// Deprecated: Use ExportUsersRsp.ProtoReflect.Descriptor instead.
+func (*ExportUsersRsp) Descriptor() ([]byte, []int) {
+	return file_rpc_server_proto_rawDescGZIP(), []int{10}
 }
 
-func (x *RegisterNodeRsp) GetCode() int32 {
+func (x *ExportUsersRsp) GetCode() int32 {
 	if x != nil {
 		return x.Code
 	}
 	return 0
 }
 
-func (x *RegisterNodeRsp) GetMsg() string {
+func (x *ExportUsersRsp) GetMsg() string {
 	if x != nil {
 		return x.Msg
 	}
 	return ""
 }
 
-func (x *RegisterNodeRsp) GetData() []byte {
+func (x *ExportUsersRsp) GetUsers() []*User {
 	if x != nil {
-		return x.Data
+		return x.Users
 	}
 	return nil
 }
 
-type GetBandwidthStatsReq struct {
+type ImportUsersReq struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	NodeAuthInfo *NodeAuthInfo `protobuf:"bytes,1,opt,name=node_auth_info,json=nodeAuthInfo,proto3" json:"node_auth_info,omitempty"`
+	NodeAuthInfo   *NodeAuthInfo `protobuf:"bytes,1,opt,name=node_auth_info,json=nodeAuthInfo,proto3" json:"node_auth_info,omitempty"`
+	Users          []*User       `protobuf:"bytes,2,rep,name=users,proto3" json:"users,omitempty"`
+	ConflictPolicy string        `protobuf:"bytes,3,opt,name=conflict_policy,json=conflictPolicy,proto3" json:"conflict_policy,omitempty"` // 用户已存在时的处理方式: skip, overwrite, merge
+	DryRun         bool          `protobuf:"varint,4,opt,name=dry_run,json=dryRun,proto3" json:"dry_run,omitempty"`                        // 只返回导入结果, 不实际修改
 }
 
-func (x *GetBandwidthStatsReq) Reset() {
-	*x = GetBandwidthStatsReq{}
+func (x *ImportUsersReq) Reset() {
+	*x = ImportUsersReq{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_rpc_server_proto_msgTypes[14]
+		mi := &file_rpc_server_proto_msgTypes[11]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *GetBandwidthStatsReq) String() string {
+func (x *ImportUsersReq) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetBandwidthStatsReq) ProtoMessage() {}
+func (*ImportUsersReq) ProtoMessage() {}
 
-func (x *GetBandwidthStatsReq) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_server_proto_msgTypes[14]
+func (x *ImportUsersReq) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_server_proto_msgTypes[11]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -959,48 +1102,67 @@ func (x *GetBandwidthStatsReq) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetBandwidthStatsReq.ProtoReflect.Descriptor instead.
-func (*GetBandwidthStatsReq) Descriptor() ([]byte, []int) {
-	return file_rpc_server_proto_rawDescGZIP(), []int{14}
+// Deprecated: Use ImportUsersReq.ProtoReflect.Descriptor instead.
+func (*ImportUsersReq) Descriptor() ([]byte, []int) {
+	return file_rpc_server_proto_rawDescGZIP(), []int{11}
 }
 
-func (x *GetBandwidthStatsReq) GetNodeAuthInfo() *NodeAuthInfo {
+func (x *ImportUsersReq) GetNodeAuthInfo() *NodeAuthInfo {
 	if x != nil {
 		return x.NodeAuthInfo
 	}
 	return nil
 }
 
-type Stats struct {
+func (x *ImportUsersReq) GetUsers() []*User {
+	if x != nil {
+		return x.Users
+	}
+	return nil
+}
+
+func (x *ImportUsersReq) GetConflictPolicy() string {
+	if x != nil {
+		return x.ConflictPolicy
+	}
+	return ""
+}
+
+func (x *ImportUsersReq) GetDryRun() bool {
+	if x != nil {
+		return x.DryRun
+	}
+	return false
+}
+
+// 单个用户的导入结果
+type ImportUserResult struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Name     string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	Type     string `protobuf:"bytes,2,opt,name=type,proto3" json:"type,omitempty"`
-	Downlink int64  `protobuf:"varint,3,opt,name=downlink,proto3" json:"downlink,omitempty"`
-	Uplink   int64  `protobuf:"varint,4,opt,name=uplink,proto3" json:"uplink,omitempty"`
-	Proxy    string `protobuf:"bytes,5,opt,name=proxy,proto3" json:"proxy,omitempty"`   // proxy name
-	Source   string `protobuf:"bytes,6,opt,name=source,proto3" json:"source,omitempty"` // int64 timestamp = 7; // 记录统计的时间
+	Name   string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Action string `protobuf:"bytes,2,opt,name=action,proto3" json:"action,omitempty"` // add, skip, overwrite, merge, fail
+	Msg    string `protobuf:"bytes,3,opt,name=msg,proto3" json:"msg,omitempty"`
 }
 
-func (x *Stats) Reset() {
-	*x = Stats{}
+func (x *ImportUserResult) Reset() {
+	*x = ImportUserResult{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_rpc_server_proto_msgTypes[15]
+		mi := &file_rpc_server_proto_msgTypes[12]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *Stats) String() string {
+func (x *ImportUserResult) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Stats) ProtoMessage() {}
+func (*ImportUserResult) ProtoMessage() {}
 
-func (x *Stats) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_server_proto_msgTypes[15]
+func (x *ImportUserResult) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_server_proto_msgTypes[12]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1011,80 +1173,59 @@ func (x *Stats) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Stats.ProtoReflect.Descriptor instead.
-func (*Stats) Descriptor() ([]byte, []int) {
-	return file_rpc_server_proto_rawDescGZIP(), []int{15}
+// Deprecated: Use ImportUserResult.ProtoReflect.Descriptor instead.
+func (*ImportUserResult) Descriptor() ([]byte, []int) {
+	return file_rpc_server_proto_rawDescGZIP(), []int{12}
 }
 
-func (x *Stats) GetName() string {
+func (x *ImportUserResult) GetName() string {
 	if x != nil {
 		return x.Name
 	}
 	return ""
 }
 
-func (x *Stats) GetType() string {
-	if x != nil {
-		return x.Type
-	}
-	return ""
-}
-
-func (x *Stats) GetDownlink() int64 {
-	if x != nil {
-		return x.Downlink
-	}
-	return 0
-}
-
-func (x *Stats) GetUplink() int64 {
-	if x != nil {
-		return x.Uplink
-	}
-	return 0
-}
-
-func (x *Stats) GetProxy() string {
+func (x *ImportUserResult) GetAction() string {
 	if x != nil {
-		return x.Proxy
+		return x.Action
 	}
 	return ""
 }
 
-func (x *Stats) GetSource() string {
+func (x *ImportUserResult) GetMsg() string {
 	if x != nil {
-		return x.Source
+		return x.Msg
 	}
 	return ""
 }
 
-type GetBandwidthStatsRsp struct {
+type ImportUsersRsp struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Code  int32    `protobuf:"varint,1,opt,name=code,proto3" json:"code,omitempty"`
-	Msg   string   `protobuf:"bytes,2,opt,name=msg,proto3" json:"msg,omitempty"`
-	Stats []*Stats `protobuf:"bytes,3,rep,name=stats,proto3" json:"stats,omitempty"`
+	Code    int32               `protobuf:"varint,1,opt,name=code,proto3" json:"code,omitempty"`
+	Msg     string              `protobuf:"bytes,2,opt,name=msg,proto3" json:"msg,omitempty"`
+	Results []*ImportUserResult `protobuf:"bytes,3,rep,name=results,proto3" json:"results,omitempty"`
 }
 
-func (x *GetBandwidthStatsRsp) Reset() {
-	*x = GetBandwidthStatsRsp{}
+func (x *ImportUsersRsp) Reset() {
+	*x = ImportUsersRsp{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_rpc_server_proto_msgTypes[16]
+		mi := &file_rpc_server_proto_msgTypes[13]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *GetBandwidthStatsRsp) String() string {
+func (x *ImportUsersRsp) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetBandwidthStatsRsp) ProtoMessage() {}
+func (*ImportUsersRsp) ProtoMessage() {}
 
-func (x *GetBandwidthStatsRsp) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_server_proto_msgTypes[16]
+func (x *ImportUsersRsp) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_server_proto_msgTypes[13]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1095,58 +1236,62 @@ func (x *GetBandwidthStatsRsp) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetBandwidthStatsRsp.ProtoReflect.Descriptor instead.
-func (*GetBandwidthStatsRsp) Descriptor() ([]byte, []int) {
-	return file_rpc_server_proto_rawDescGZIP(), []int{16}
+// Deprecated: Use ImportUsersRsp.ProtoReflect.Descriptor instead.
+func (*ImportUsersRsp) Descriptor() ([]byte, []int) {
+	return file_rpc_server_proto_rawDescGZIP(), []int{13}
 }
 
-func (x *GetBandwidthStatsRsp) GetCode() int32 {
+func (x *ImportUsersRsp) GetCode() int32 {
 	if x != nil {
 		return x.Code
 	}
 	return 0
 }
 
-func (x *GetBandwidthStatsRsp) GetMsg() string {
+func (x *ImportUsersRsp) GetMsg() string {
 	if x != nil {
 		return x.Msg
 	}
 	return ""
 }
 
-func (x *GetBandwidthStatsRsp) GetStats() []*Stats {
+func (x *ImportUsersRsp) GetResults() []*ImportUserResult {
 	if x != nil {
-		return x.Stats
+		return x.Results
 	}
 	return nil
 }
 
-type InboundOpReq struct {
+// 以users为期望状态对比节点上的用户, 只比较节点上存在的inbound tag
+type ReconcileUsersReq struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
 	NodeAuthInfo *NodeAuthInfo `protobuf:"bytes,1,opt,name=node_auth_info,json=nodeAuthInfo,proto3" json:"node_auth_info,omitempty"`
-	InboundInfo  string        `protobuf:"bytes,2,opt,name=inboundInfo,proto3" json:"inboundInfo,omitempty"` // inbound info 添加inbound时为inbound对应的base64, 删除时为tag
+	Users        []*User       `protobuf:"bytes,2,rep,name=users,proto3" json:"users,omitempty"`
+	Repair       bool          `protobuf:"varint,3,opt,name=repair,proto3" json:"repair,omitempty"`                           // 修复缺失与不一致的用户
+	Prune        bool          `protobuf:"varint,4,opt,name=prune,proto3" json:"prune,omitempty"`                             // 修复时删除期望状态中不存在的用户
+	RepairUuid   bool          `protobuf:"varint,6,opt,name=repair_uuid,json=repairUuid,proto3" json:"repair_uuid,omitempty"` // 即使repair为false也统一uuid不一致的用户, 默认不修复
 }
 
-func (x *InboundOpReq) Reset() {
-	*x = InboundOpReq{}
+func (x *ReconcileUsersReq) Reset() {
+	*x = ReconcileUsersReq{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_rpc_server_proto_msgTypes[17]
+		mi := &file_rpc_server_proto_msgTypes[14]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *InboundOpReq) String() string {
+func (x *ReconcileUsersReq) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*InboundOpReq) ProtoMessage() {}
+func (*ReconcileUsersReq) ProtoMessage() {}
 
-func (x *InboundOpReq) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_server_proto_msgTypes[17]
+func (x *ReconcileUsersReq) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_server_proto_msgTypes[14]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1157,51 +1302,76 @@ func (x *InboundOpReq) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use InboundOpReq.ProtoReflect.Descriptor instead.
-func (*InboundOpReq) Descriptor() ([]byte, []int) {
-	return file_rpc_server_proto_rawDescGZIP(), []int{17}
+// Deprecated: Use ReconcileUsersReq.ProtoReflect.Descriptor instead.
+func (*ReconcileUsersReq) Descriptor() ([]byte, []int) {
+	return file_rpc_server_proto_rawDescGZIP(), []int{14}
 }
 
-func (x *InboundOpReq) GetNodeAuthInfo() *NodeAuthInfo {
+func (x *ReconcileUsersReq) GetNodeAuthInfo() *NodeAuthInfo {
 	if x != nil {
 		return x.NodeAuthInfo
 	}
 	return nil
 }
 
-func (x *InboundOpReq) GetInboundInfo() string {
+func (x *ReconcileUsersReq) GetUsers() []*User {
 	if x != nil {
-		return x.InboundInfo
+		return x.Users
 	}
-	return ""
+	return nil
 }
 
-type InboundOpRsp struct {
+func (x *ReconcileUsersReq) GetRepair() bool {
+	if x != nil {
+		return x.Repair
+	}
+	return false
+}
+
+func (x *ReconcileUsersReq) GetPrune() bool {
+	if x != nil {
+		return x.Prune
+	}
+	return false
+}
+
+func (x *ReconcileUsersReq) GetRepairUuid() bool {
+	if x != nil {
+		return x.RepairUuid
+	}
+	return false
+}
+
+// 单个用户的差异
+type UserDrift struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Code int32  `protobuf:"varint,1,opt,name=code,proto3" json:"code,omitempty"`
-	Msg  string `protobuf:"bytes,2,opt,name=msg,proto3" json:"msg,omitempty"`
+	Name     string   `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Type     string   `protobuf:"bytes,2,opt,name=type,proto3" json:"type,omitempty"`     // missing: 节点上缺失, extra: 节点上多余, mismatch: 字段不一致
+	Fields   []string `protobuf:"bytes,3,rep,name=fields,proto3" json:"fields,omitempty"` // 不一致的字段: tags, expire_time, quota, suspended, uuid
+	Repaired bool     `protobuf:"varint,4,opt,name=repaired,proto3" json:"repaired,omitempty"`
+	Msg      string   `protobuf:"bytes,5,opt,name=msg,proto3" json:"msg,omitempty"` // 修复失败的原因
 }
 
-func (x *InboundOpRsp) Reset() {
-	*x = InboundOpRsp{}
+func (x *UserDrift) Reset() {
+	*x = UserDrift{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_rpc_server_proto_msgTypes[18]
+		mi := &file_rpc_server_proto_msgTypes[15]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *InboundOpRsp) String() string {
+func (x *UserDrift) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*InboundOpRsp) ProtoMessage() {}
+func (*UserDrift) ProtoMessage() {}
 
-func (x *InboundOpRsp) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_server_proto_msgTypes[18]
+func (x *UserDrift) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_server_proto_msgTypes[15]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1212,52 +1382,73 @@ func (x *InboundOpRsp) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use InboundOpRsp.ProtoReflect.Descriptor instead.
-func (*InboundOpRsp) Descriptor() ([]byte, []int) {
-	return file_rpc_server_proto_rawDescGZIP(), []int{18}
+// Deprecated: Use UserDrift.ProtoReflect.Descriptor instead.
+func (*UserDrift) Descriptor() ([]byte, []int) {
+	return file_rpc_server_proto_rawDescGZIP(), []int{15}
 }
 
-func (x *InboundOpRsp) GetCode() int32 {
+func (x *UserDrift) GetName() string {
 	if x != nil {
-		return x.Code
+		return x.Name
 	}
-	return 0
+	return ""
 }
 
-func (x *InboundOpRsp) GetMsg() string {
+func (x *UserDrift) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *UserDrift) GetFields() []string {
+	if x != nil {
+		return x.Fields
+	}
+	return nil
+}
+
+func (x *UserDrift) GetRepaired() bool {
+	if x != nil {
+		return x.Repaired
+	}
+	return false
+}
+
+func (x *UserDrift) GetMsg() string {
 	if x != nil {
 		return x.Msg
 	}
 	return ""
 }
 
-type TransferInboundReq struct {
+type ReconcileUsersRsp struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	NodeAuthInfo *NodeAuthInfo `protobuf:"bytes,1,opt,name=node_auth_info,json=nodeAuthInfo,proto3" json:"node_auth_info,omitempty"`
-	Tag          string        `protobuf:"bytes,2,opt,name=tag,proto3" json:"tag,omitempty"`
-	NewPort      int32         `protobuf:"varint,3,opt,name=new_port,json=newPort,proto3" json:"new_port,omitempty"`
+	Code   int32        `protobuf:"varint,1,opt,name=code,proto3" json:"code,omitempty"`
+	Msg    string       `protobuf:"bytes,2,opt,name=msg,proto3" json:"msg,omitempty"`
+	Drifts []*UserDrift `protobuf:"bytes,3,rep,name=drifts,proto3" json:"drifts,omitempty"`
 }
 
-func (x *TransferInboundReq) Reset() {
-	*x = TransferInboundReq{}
+func (x *ReconcileUsersRsp) Reset() {
+	*x = ReconcileUsersRsp{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_rpc_server_proto_msgTypes[19]
+		mi := &file_rpc_server_proto_msgTypes[16]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *TransferInboundReq) String() string {
+func (x *ReconcileUsersRsp) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*TransferInboundReq) ProtoMessage() {}
+func (*ReconcileUsersRsp) ProtoMessage() {}
 
-func (x *TransferInboundReq) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_server_proto_msgTypes[19]
+func (x *ReconcileUsersRsp) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_server_proto_msgTypes[16]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1268,62 +1459,60 @@ func (x *TransferInboundReq) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use TransferInboundReq.ProtoReflect.Descriptor instead.
-func (*TransferInboundReq) Descriptor() ([]byte, []int) {
-	return file_rpc_server_proto_rawDescGZIP(), []int{19}
+// Deprecated: Use ReconcileUsersRsp.ProtoReflect.Descriptor instead.
+func (*ReconcileUsersRsp) Descriptor() ([]byte, []int) {
+	return file_rpc_server_proto_rawDescGZIP(), []int{16}
 }
 
-func (x *TransferInboundReq) GetNodeAuthInfo() *NodeAuthInfo {
+func (x *ReconcileUsersRsp) GetCode() int32 {
 	if x != nil {
-		return x.NodeAuthInfo
+		return x.Code
 	}
-	return nil
+	return 0
 }
 
-func (x *TransferInboundReq) GetTag() string {
+func (x *ReconcileUsersRsp) GetMsg() string {
 	if x != nil {
-		return x.Tag
+		return x.Msg
 	}
 	return ""
 }
 
-func (x *TransferInboundReq) GetNewPort() int32 {
+func (x *ReconcileUsersRsp) GetDrifts() []*UserDrift {
 	if x != nil {
-		return x.NewPort
+		return x.Drifts
 	}
-	return 0
+	return nil
 }
 
-type CopyInboundReq struct {
+type GetSubReq struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	NodeAuthInfo *NodeAuthInfo `protobuf:"bytes,1,opt,name=node_auth_info,json=nodeAuthInfo,proto3" json:"node_auth_info,omitempty"`
-	SrcTag       string        `protobuf:"bytes,2,opt,name=src_tag,json=srcTag,proto3" json:"src_tag,omitempty"`
-	NewTag       string        `protobuf:"bytes,3,opt,name=new_tag,json=newTag,proto3" json:"new_tag,omitempty"`
-	NewPort      int32         `protobuf:"varint,4,opt,name=new_port,json=newPort,proto3" json:"new_port,omitempty"`
-	IsCopyUser   bool          `protobuf:"varint,5,opt,name=is_copy_user,json=isCopyUser,proto3" json:"is_copy_user,omitempty"`
-	NewProtocol  string        `protobuf:"bytes,6,opt,name=new_protocol,json=newProtocol,proto3" json:"new_protocol,omitempty"`
+	NodeAuthInfo     *NodeAuthInfo `protobuf:"bytes,1,opt,name=node_auth_info,json=nodeAuthInfo,proto3" json:"node_auth_info,omitempty"`
+	User             *User         `protobuf:"bytes,2,opt,name=user,proto3" json:"user,omitempty"`
+	ExcludeProtocols []string      `protobuf:"bytes,3,rep,name=exclude_protocols,json=excludeProtocols,proto3" json:"exclude_protocols,omitempty"`
+	UseSni           bool          `protobuf:"varint,4,opt,name=use_sni,json=useSni,proto3" json:"use_sni,omitempty"`
 }
 
-func (x *CopyInboundReq) Reset() {
-	*x = CopyInboundReq{}
+func (x *GetSubReq) Reset() {
+	*x = GetSubReq{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_rpc_server_proto_msgTypes[20]
+		mi := &file_rpc_server_proto_msgTypes[17]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *CopyInboundReq) String() string {
+func (x *GetSubReq) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CopyInboundReq) ProtoMessage() {}
+func (*GetSubReq) ProtoMessage() {}
 
-func (x *CopyInboundReq) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_server_proto_msgTypes[20]
+func (x *GetSubReq) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_server_proto_msgTypes[17]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1334,80 +1523,67 @@ func (x *CopyInboundReq) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CopyInboundReq.ProtoReflect.Descriptor instead.
-func (*CopyInboundReq) Descriptor() ([]byte, []int) {
-	return file_rpc_server_proto_rawDescGZIP(), []int{20}
+// Deprecated: Use GetSubReq.ProtoReflect.Descriptor instead.
+func (*GetSubReq) Descriptor() ([]byte, []int) {
+	return file_rpc_server_proto_rawDescGZIP(), []int{17}
 }
 
-func (x *CopyInboundReq) GetNodeAuthInfo() *NodeAuthInfo {
+func (x *GetSubReq) GetNodeAuthInfo() *NodeAuthInfo {
 	if x != nil {
 		return x.NodeAuthInfo
 	}
 	return nil
 }
 
-func (x *CopyInboundReq) GetSrcTag() string {
-	if x != nil {
-		return x.SrcTag
-	}
-	return ""
-}
-
-func (x *CopyInboundReq) GetNewTag() string {
+func (x *GetSubReq) GetUser() *User {
 	if x != nil {
-		return x.NewTag
+		return x.User
 	}
-	return ""
+	return nil
 }
 
-func (x *CopyInboundReq) GetNewPort() int32 {
+func (x *GetSubReq) GetExcludeProtocols() []string {
 	if x != nil {
-		return x.NewPort
+		return x.ExcludeProtocols
 	}
-	return 0
+	return nil
 }
 
-func (x *CopyInboundReq) GetIsCopyUser() bool {
+func (x *GetSubReq) GetUseSni() bool {
 	if x != nil {
-		return x.IsCopyUser
+		return x.UseSni
 	}
 	return false
 }
 
-func (x *CopyInboundReq) GetNewProtocol() string {
-	if x != nil {
-		return x.NewProtocol
-	}
-	return ""
-}
-
-type CopyUserReq struct {
+type GetSubRsp struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	NodeAuthInfo *NodeAuthInfo `protobuf:"bytes,1,opt,name=node_auth_info,json=nodeAuthInfo,proto3" json:"node_auth_info,omitempty"`
-	SrcTag       string        `protobuf:"bytes,2,opt,name=src_tag,json=srcTag,proto3" json:"src_tag,omitempty"`
-	DstTag       string        `protobuf:"bytes,3,opt,name=dst_tag,json=dstTag,proto3" json:"dst_tag,omitempty"`
+	Code  int32    `protobuf:"varint,1,opt,name=code,proto3" json:"code,omitempty"`
+	Msg   string   `protobuf:"bytes,2,opt,name=msg,proto3" json:"msg,omitempty"`
+	Uris  []string `protobuf:"bytes,3,rep,name=uris,proto3" json:"uris,omitempty"`
+	Usage *User    `protobuf:"bytes,4,opt,name=usage,proto3" json:"usage,omitempty"` // 用户当前周期的流量与配额, 用于订阅的Subscription-Userinfo
 }
 
-func (x *CopyUserReq) Reset() {
-	*x = CopyUserReq{}
+func (x *GetSubRsp) Reset() {
+	*x = GetSubRsp{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_rpc_server_proto_msgTypes[21]
+		mi := &file_rpc_server_proto_msgTypes[18]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *CopyUserReq) String() string {
+func (x *GetSubRsp) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CopyUserReq) ProtoMessage() {}
+func (*GetSubRsp) ProtoMessage() {}
 
-func (x *CopyUserReq) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_server_proto_msgTypes[21]
+func (x *GetSubRsp) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_server_proto_msgTypes[18]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1418,58 +1594,65 @@ func (x *CopyUserReq) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CopyUserReq.ProtoReflect.Descriptor instead.
-func (*CopyUserReq) Descriptor() ([]byte, []int) {
-	return file_rpc_server_proto_rawDescGZIP(), []int{21}
+// Deprecated: Use GetSubRsp.ProtoReflect.Descriptor instead.
+func (*GetSubRsp) Descriptor() ([]byte, []int) {
+	return file_rpc_server_proto_rawDescGZIP(), []int{18}
 }
 
-func (x *CopyUserReq) GetNodeAuthInfo() *NodeAuthInfo {
+func (x *GetSubRsp) GetCode() int32 {
 	if x != nil {
-		return x.NodeAuthInfo
+		return x.Code
 	}
-	return nil
+	return 0
 }
 
-func (x *CopyUserReq) GetSrcTag() string {
+func (x *GetSubRsp) GetMsg() string {
 	if x != nil {
-		return x.SrcTag
+		return x.Msg
 	}
 	return ""
 }
 
-func (x *CopyUserReq) GetDstTag() string {
+func (x *GetSubRsp) GetUris() []string {
 	if x != nil {
-		return x.DstTag
+		return x.Uris
 	}
-	return ""
+	return nil
 }
 
-type GetInboundReq struct {
+func (x *GetSubRsp) GetUsage() *User {
+	if x != nil {
+		return x.Usage
+	}
+	return nil
+}
+
+type HeartBeatReq struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
 	NodeAuthInfo *NodeAuthInfo `protobuf:"bytes,1,opt,name=node_auth_info,json=nodeAuthInfo,proto3" json:"node_auth_info,omitempty"`
-	Tag          string        `protobuf:"bytes,2,opt,name=tag,proto3" json:"tag,omitempty"`
+	Health       *NodeHealth   `protobuf:"bytes,2,opt,name=health,proto3" json:"health,omitempty"` // 上报节点的健康状态
 }
 
-func (x *GetInboundReq) Reset() {
-	*x = GetInboundReq{}
+func (x *HeartBeatReq) Reset() {
+	*x = HeartBeatReq{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_rpc_server_proto_msgTypes[22]
+		mi := &file_rpc_server_proto_msgTypes[19]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *GetInboundReq) String() string {
+func (x *HeartBeatReq) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetInboundReq) ProtoMessage() {}
+func (*HeartBeatReq) ProtoMessage() {}
 
-func (x *GetInboundReq) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_server_proto_msgTypes[22]
+func (x *HeartBeatReq) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_server_proto_msgTypes[19]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1480,52 +1663,52 @@ func (x *GetInboundReq) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetInboundReq.ProtoReflect.Descriptor instead.
-func (*GetInboundReq) Descriptor() ([]byte, []int) {
-	return file_rpc_server_proto_rawDescGZIP(), []int{22}
+// Deprecated: Use HeartBeatReq.ProtoReflect.Descriptor instead.
+func (*HeartBeatReq) Descriptor() ([]byte, []int) {
+	return file_rpc_server_proto_rawDescGZIP(), []int{19}
 }
 
-func (x *GetInboundReq) GetNodeAuthInfo() *NodeAuthInfo {
+func (x *HeartBeatReq) GetNodeAuthInfo() *NodeAuthInfo {
 	if x != nil {
 		return x.NodeAuthInfo
 	}
 	return nil
 }
 
-func (x *GetInboundReq) GetTag() string {
+func (x *HeartBeatReq) GetHealth() *NodeHealth {
 	if x != nil {
-		return x.Tag
+		return x.Health
 	}
-	return ""
+	return nil
 }
 
-type GetInboundRsp struct {
+type ProcessHealth struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Code int32  `protobuf:"varint,1,opt,name=code,proto3" json:"code,omitempty"`
-	Msg  string `protobuf:"bytes,2,opt,name=msg,proto3" json:"msg,omitempty"`
-	Data string `protobuf:"bytes,3,opt,name=data,proto3" json:"data,omitempty"`
+	Running   bool   `protobuf:"varint,1,opt,name=running,proto3" json:"running,omitempty"`
+	Version   string `protobuf:"bytes,2,opt,name=version,proto3" json:"version,omitempty"`
+	StartTime int64  `protobuf:"varint,3,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"` // 进程启动时间, unix时间戳, 单位秒
 }
 
-func (x *GetInboundRsp) Reset() {
-	*x = GetInboundRsp{}
+func (x *ProcessHealth) Reset() {
+	*x = ProcessHealth{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_rpc_server_proto_msgTypes[23]
+		mi := &file_rpc_server_proto_msgTypes[20]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *GetInboundRsp) String() string {
+func (x *ProcessHealth) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetInboundRsp) ProtoMessage() {}
+func (*ProcessHealth) ProtoMessage() {}
 
-func (x *GetInboundRsp) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_server_proto_msgTypes[23]
+func (x *ProcessHealth) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_server_proto_msgTypes[20]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1536,57 +1719,70 @@ func (x *GetInboundRsp) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetInboundRsp.ProtoReflect.Descriptor instead.
-func (*GetInboundRsp) Descriptor() ([]byte, []int) {
-	return file_rpc_server_proto_rawDescGZIP(), []int{23}
+// Deprecated: Use ProcessHealth.ProtoReflect.Descriptor instead.
+func (*ProcessHealth) Descriptor() ([]byte, []int) {
+	return file_rpc_server_proto_rawDescGZIP(), []int{20}
 }
 
-func (x *GetInboundRsp) GetCode() int32 {
+func (x *ProcessHealth) GetRunning() bool {
 	if x != nil {
-		return x.Code
+		return x.Running
 	}
-	return 0
+	return false
 }
 
-func (x *GetInboundRsp) GetMsg() string {
+func (x *ProcessHealth) GetVersion() string {
 	if x != nil {
-		return x.Msg
+		return x.Version
 	}
 	return ""
 }
 
-func (x *GetInboundRsp) GetData() string {
+func (x *ProcessHealth) GetStartTime() int64 {
 	if x != nil {
-		return x.Data
+		return x.StartTime
 	}
-	return ""
+	return 0
 }
 
-type GetTagReq struct {
+type NodeHealth struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	NodeAuthInfo *NodeAuthInfo `protobuf:"bytes,1,opt,name=node_auth_info,json=nodeAuthInfo,proto3" json:"node_auth_info,omitempty"`
-}
-
-func (x *GetTagReq) Reset() {
-	*x = GetTagReq{}
+	Status       string           `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`     // healthy, degraded, down
+	Proxy        *ProcessHealth   `protobuf:"bytes,2,opt,name=proxy,proto3" json:"proxy,omitempty"`       // xray/v2ray进程状态, gateway模式下为空
+	Hysteria     *ProcessHealth   `protobuf:"bytes,3,opt,name=hysteria,proto3" json:"hysteria,omitempty"` // hysteria进程状态, 未配置hysteria时为空
+	Uptime       int64            `protobuf:"varint,4,opt,name=uptime,proto3" json:"uptime,omitempty"`    // v2raymg运行时长, 单位秒
+	UserCount    int32            `protobuf:"varint,5,opt,name=user_count,json=userCount,proto3" json:"user_count,omitempty"`
+	InboundTags  []string         `protobuf:"bytes,6,rep,name=inbound_tags,json=inboundTags,proto3" json:"inbound_tags,omitempty"`
+	CertExpiries map[string]int64 `protobuf:"bytes,7,rep,name=cert_expiries,json=certExpiries,proto3" json:"cert_expiries,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"` // key = 域名, value = 过期时间, unix时间戳, 单位秒
+	CpuPercent   float64          `protobuf:"fixed64,8,opt,name=cpu_percent,json=cpuPercent,proto3" json:"cpu_percent,omitempty"`
+	MemTotal     uint64           `protobuf:"varint,9,opt,name=mem_total,json=memTotal,proto3" json:"mem_total,omitempty"` // 单位字节
+	MemUsed      uint64           `protobuf:"varint,10,opt,name=mem_used,json=memUsed,proto3" json:"mem_used,omitempty"`
+	NetRxRate    uint64           `protobuf:"varint,11,opt,name=net_rx_rate,json=netRxRate,proto3" json:"net_rx_rate,omitempty"` // 全部非lo网卡的接收速率, 单位字节/秒
+	NetTxRate    uint64           `protobuf:"varint,12,opt,name=net_tx_rate,json=netTxRate,proto3" json:"net_tx_rate,omitempty"`
+	Load1        float64          `protobuf:"fixed64,13,opt,name=load1,proto3" json:"load1,omitempty"`
+	ReportTime   int64            `protobuf:"varint,14,opt,name=report_time,json=reportTime,proto3" json:"report_time,omitempty"` // 采集时间, unix时间戳, 单位秒
+}
+
+func (x *NodeHealth) Reset() {
+	*x = NodeHealth{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_rpc_server_proto_msgTypes[24]
+		mi := &file_rpc_server_proto_msgTypes[21]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *GetTagReq) String() string {
+func (x *NodeHealth) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetTagReq) ProtoMessage() {}
+func (*NodeHealth) ProtoMessage() {}
 
-func (x *GetTagReq) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_server_proto_msgTypes[24]
+func (x *NodeHealth) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_server_proto_msgTypes[21]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1597,107 +1793,138 @@ func (x *GetTagReq) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetTagReq.ProtoReflect.Descriptor instead.
-func (*GetTagReq) Descriptor() ([]byte, []int) {
-	return file_rpc_server_proto_rawDescGZIP(), []int{24}
+// Deprecated: Use NodeHealth.ProtoReflect.Descriptor instead.
+func (*NodeHealth) Descriptor() ([]byte, []int) {
+	return file_rpc_server_proto_rawDescGZIP(), []int{21}
 }
 
-func (x *GetTagReq) GetNodeAuthInfo() *NodeAuthInfo {
+func (x *NodeHealth) GetStatus() string {
 	if x != nil {
-		return x.NodeAuthInfo
+		return x.Status
+	}
+	return ""
+}
+
+func (x *NodeHealth) GetProxy() *ProcessHealth {
+	if x != nil {
+		return x.Proxy
 	}
 	return nil
 }
 
-type GetTagRsp struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
+func (x *NodeHealth) GetHysteria() *ProcessHealth {
+	if x != nil {
+		return x.Hysteria
+	}
+	return nil
+}
 
-	Code int32    `protobuf:"varint,1,opt,name=code,proto3" json:"code,omitempty"`
-	Msg  string   `protobuf:"bytes,2,opt,name=msg,proto3" json:"msg,omitempty"`
-	Tags []string `protobuf:"bytes,3,rep,name=tags,proto3" json:"tags,omitempty"`
+func (x *NodeHealth) GetUptime() int64 {
+	if x != nil {
+		return x.Uptime
+	}
+	return 0
 }
 
-func (x *GetTagRsp) Reset() {
-	*x = GetTagRsp{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_rpc_server_proto_msgTypes[25]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+func (x *NodeHealth) GetUserCount() int32 {
+	if x != nil {
+		return x.UserCount
 	}
+	return 0
 }
 
-func (x *GetTagRsp) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *NodeHealth) GetInboundTags() []string {
+	if x != nil {
+		return x.InboundTags
+	}
+	return nil
 }
 
-func (*GetTagRsp) ProtoMessage() {}
+func (x *NodeHealth) GetCertExpiries() map[string]int64 {
+	if x != nil {
+		return x.CertExpiries
+	}
+	return nil
+}
 
-func (x *GetTagRsp) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_server_proto_msgTypes[25]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+func (x *NodeHealth) GetCpuPercent() float64 {
+	if x != nil {
+		return x.CpuPercent
 	}
-	return mi.MessageOf(x)
+	return 0
 }
 
-// Deprecated: Use GetTagRsp.ProtoReflect.Descriptor instead.
-func (*GetTagRsp) Descriptor() ([]byte, []int) {
-	return file_rpc_server_proto_rawDescGZIP(), []int{25}
+func (x *NodeHealth) GetMemTotal() uint64 {
+	if x != nil {
+		return x.MemTotal
+	}
+	return 0
 }
 
-func (x *GetTagRsp) GetCode() int32 {
+func (x *NodeHealth) GetMemUsed() uint64 {
 	if x != nil {
-		return x.Code
+		return x.MemUsed
 	}
 	return 0
 }
 
-func (x *GetTagRsp) GetMsg() string {
+func (x *NodeHealth) GetNetRxRate() uint64 {
 	if x != nil {
-		return x.Msg
+		return x.NetRxRate
 	}
-	return ""
+	return 0
 }
 
-func (x *GetTagRsp) GetTags() []string {
+func (x *NodeHealth) GetNetTxRate() uint64 {
 	if x != nil {
-		return x.Tags
+		return x.NetTxRate
 	}
-	return nil
+	return 0
 }
 
-type UpdateProxyReq struct {
+func (x *NodeHealth) GetLoad1() float64 {
+	if x != nil {
+		return x.Load1
+	}
+	return 0
+}
+
+func (x *NodeHealth) GetReportTime() int64 {
+	if x != nil {
+		return x.ReportTime
+	}
+	return 0
+}
+
+type Node struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	NodeAuthInfo *NodeAuthInfo `protobuf:"bytes,1,opt,name=node_auth_info,json=nodeAuthInfo,proto3" json:"node_auth_info,omitempty"`
-	Tag          string        `protobuf:"bytes,2,opt,name=tag,proto3" json:"tag,omitempty"`
+	Host        string            `protobuf:"bytes,1,opt,name=host,proto3" json:"host,omitempty"`
+	Port        int32             `protobuf:"varint,2,opt,name=port,proto3" json:"port,omitempty"`
+	ClusterName string            `protobuf:"bytes,3,opt,name=cluster_name,json=clusterName,proto3" json:"cluster_name,omitempty"`
+	Name        string            `protobuf:"bytes,4,opt,name=name,proto3" json:"name,omitempty"`
+	Labels      map[string]string `protobuf:"bytes,5,rep,name=labels,proto3" json:"labels,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"` // 节点标签, 用于按标签选择目标节点
 }
 
-func (x *UpdateProxyReq) Reset() {
-	*x = UpdateProxyReq{}
+func (x *Node) Reset() {
+	*x = Node{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_rpc_server_proto_msgTypes[26]
+		mi := &file_rpc_server_proto_msgTypes[22]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *UpdateProxyReq) String() string {
+func (x *Node) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateProxyReq) ProtoMessage() {}
+func (*Node) ProtoMessage() {}
 
-func (x *UpdateProxyReq) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_server_proto_msgTypes[26]
+func (x *Node) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_server_proto_msgTypes[22]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1708,51 +1935,73 @@ func (x *UpdateProxyReq) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateProxyReq.ProtoReflect.Descriptor instead.
-func (*UpdateProxyReq) Descriptor() ([]byte, []int) {
-	return file_rpc_server_proto_rawDescGZIP(), []int{26}
-}
+// Deprecated: Use Node.ProtoReflect.Descriptor instead.
+func (*Node) Descriptor() ([]byte, []int) {
+	return file_rpc_server_proto_rawDescGZIP(), []int{22}
+}
 
-func (x *UpdateProxyReq) GetNodeAuthInfo() *NodeAuthInfo {
+func (x *Node) GetHost() string {
 	if x != nil {
-		return x.NodeAuthInfo
+		return x.Host
 	}
-	return nil
+	return ""
 }
 
-func (x *UpdateProxyReq) GetTag() string {
+func (x *Node) GetPort() int32 {
 	if x != nil {
-		return x.Tag
+		return x.Port
+	}
+	return 0
+}
+
+func (x *Node) GetClusterName() string {
+	if x != nil {
+		return x.ClusterName
 	}
 	return ""
 }
 
-type UpdateProxyRsp struct {
+func (x *Node) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Node) GetLabels() map[string]string {
+	if x != nil {
+		return x.Labels
+	}
+	return nil
+}
+
+type HeartBeatRsp struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Code int32  `protobuf:"varint,1,opt,name=code,proto3" json:"code,omitempty"`
-	Msg  string `protobuf:"bytes,2,opt,name=msg,proto3" json:"msg,omitempty"`
+	Code     int32            `protobuf:"varint,1,opt,name=code,proto3" json:"code,omitempty"`
+	Msg      string           `protobuf:"bytes,2,opt,name=msg,proto3" json:"msg,omitempty"`
+	NodesMap map[string]*Node `protobuf:"bytes,3,rep,name=nodesMap,proto3" json:"nodesMap,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
 }
 
-func (x *UpdateProxyRsp) Reset() {
-	*x = UpdateProxyRsp{}
+func (x *HeartBeatRsp) Reset() {
+	*x = HeartBeatRsp{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_rpc_server_proto_msgTypes[27]
+		mi := &file_rpc_server_proto_msgTypes[23]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *UpdateProxyRsp) String() string {
+func (x *HeartBeatRsp) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateProxyRsp) ProtoMessage() {}
+func (*HeartBeatRsp) ProtoMessage() {}
 
-func (x *UpdateProxyRsp) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_server_proto_msgTypes[27]
+func (x *HeartBeatRsp) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_server_proto_msgTypes[23]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1763,52 +2012,57 @@ func (x *UpdateProxyRsp) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateProxyRsp.ProtoReflect.Descriptor instead.
-func (*UpdateProxyRsp) Descriptor() ([]byte, []int) {
-	return file_rpc_server_proto_rawDescGZIP(), []int{27}
+// Deprecated: Use HeartBeatRsp.ProtoReflect.Descriptor instead.
+func (*HeartBeatRsp) Descriptor() ([]byte, []int) {
+	return file_rpc_server_proto_rawDescGZIP(), []int{23}
 }
 
-func (x *UpdateProxyRsp) GetCode() int32 {
+func (x *HeartBeatRsp) GetCode() int32 {
 	if x != nil {
 		return x.Code
 	}
 	return 0
 }
 
-func (x *UpdateProxyRsp) GetMsg() string {
+func (x *HeartBeatRsp) GetMsg() string {
 	if x != nil {
 		return x.Msg
 	}
 	return ""
 }
 
-type AdaptiveOpReq struct {
+func (x *HeartBeatRsp) GetNodesMap() map[string]*Node {
+	if x != nil {
+		return x.NodesMap
+	}
+	return nil
+}
+
+type Nodes struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	NodeAuthInfo *NodeAuthInfo `protobuf:"bytes,1,opt,name=node_auth_info,json=nodeAuthInfo,proto3" json:"node_auth_info,omitempty"`
-	Ports        []string      `protobuf:"bytes,2,rep,name=ports,proto3" json:"ports,omitempty"` // 可以为port range port1-port2
-	Tags         []string      `protobuf:"bytes,3,rep,name=tags,proto3" json:"tags,omitempty"`
+	Nodes map[string]*Nodes `protobuf:"bytes,1,rep,name=nodes,proto3" json:"nodes,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
 }
 
-func (x *AdaptiveOpReq) Reset() {
-	*x = AdaptiveOpReq{}
+func (x *Nodes) Reset() {
+	*x = Nodes{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_rpc_server_proto_msgTypes[28]
+		mi := &file_rpc_server_proto_msgTypes[24]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *AdaptiveOpReq) String() string {
+func (x *Nodes) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AdaptiveOpReq) ProtoMessage() {}
+func (*Nodes) ProtoMessage() {}
 
-func (x *AdaptiveOpReq) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_server_proto_msgTypes[28]
+func (x *Nodes) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_server_proto_msgTypes[24]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1819,58 +2073,43 @@ func (x *AdaptiveOpReq) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AdaptiveOpReq.ProtoReflect.Descriptor instead.
-func (*AdaptiveOpReq) Descriptor() ([]byte, []int) {
-	return file_rpc_server_proto_rawDescGZIP(), []int{28}
-}
-
-func (x *AdaptiveOpReq) GetNodeAuthInfo() *NodeAuthInfo {
-	if x != nil {
-		return x.NodeAuthInfo
-	}
-	return nil
-}
-
-func (x *AdaptiveOpReq) GetPorts() []string {
-	if x != nil {
-		return x.Ports
-	}
-	return nil
+// Deprecated: Use Nodes.ProtoReflect.Descriptor instead.
+func (*Nodes) Descriptor() ([]byte, []int) {
+	return file_rpc_server_proto_rawDescGZIP(), []int{24}
 }
 
-func (x *AdaptiveOpReq) GetTags() []string {
+func (x *Nodes) GetNodes() map[string]*Nodes {
 	if x != nil {
-		return x.Tags
+		return x.Nodes
 	}
 	return nil
 }
 
-type AdaptiveReq struct {
+type RegisterNodeReq struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
 	NodeAuthInfo *NodeAuthInfo `protobuf:"bytes,1,opt,name=node_auth_info,json=nodeAuthInfo,proto3" json:"node_auth_info,omitempty"`
-	Tags         []string      `protobuf:"bytes,2,rep,name=tags,proto3" json:"tags,omitempty"`
 }
 
-func (x *AdaptiveReq) Reset() {
-	*x = AdaptiveReq{}
+func (x *RegisterNodeReq) Reset() {
+	*x = RegisterNodeReq{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_rpc_server_proto_msgTypes[29]
+		mi := &file_rpc_server_proto_msgTypes[25]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *AdaptiveReq) String() string {
+func (x *RegisterNodeReq) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AdaptiveReq) ProtoMessage() {}
+func (*RegisterNodeReq) ProtoMessage() {}
 
-func (x *AdaptiveReq) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_server_proto_msgTypes[29]
+func (x *RegisterNodeReq) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_server_proto_msgTypes[25]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1881,51 +2120,45 @@ func (x *AdaptiveReq) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AdaptiveReq.ProtoReflect.Descriptor instead.
-func (*AdaptiveReq) Descriptor() ([]byte, []int) {
-	return file_rpc_server_proto_rawDescGZIP(), []int{29}
+// Deprecated: Use RegisterNodeReq.ProtoReflect.Descriptor instead.
+func (*RegisterNodeReq) Descriptor() ([]byte, []int) {
+	return file_rpc_server_proto_rawDescGZIP(), []int{25}
 }
 
-func (x *AdaptiveReq) GetNodeAuthInfo() *NodeAuthInfo {
+func (x *RegisterNodeReq) GetNodeAuthInfo() *NodeAuthInfo {
 	if x != nil {
 		return x.NodeAuthInfo
 	}
 	return nil
 }
 
-func (x *AdaptiveReq) GetTags() []string {
-	if x != nil {
-		return x.Tags
-	}
-	return nil
-}
-
-type AdaptiveRsp struct {
+type RegisterNodeRsp struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
 	Code int32  `protobuf:"varint,1,opt,name=code,proto3" json:"code,omitempty"`
 	Msg  string `protobuf:"bytes,2,opt,name=msg,proto3" json:"msg,omitempty"`
+	Data []byte `protobuf:"bytes,3,opt,name=data,proto3" json:"data,omitempty"` // end node 节点间注册返回token(string), 注册到center node返回[]Node
 }
 
-func (x *AdaptiveRsp) Reset() {
-	*x = AdaptiveRsp{}
+func (x *RegisterNodeRsp) Reset() {
+	*x = RegisterNodeRsp{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_rpc_server_proto_msgTypes[30]
+		mi := &file_rpc_server_proto_msgTypes[26]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *AdaptiveRsp) String() string {
+func (x *RegisterNodeRsp) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AdaptiveRsp) ProtoMessage() {}
+func (*RegisterNodeRsp) ProtoMessage() {}
 
-func (x *AdaptiveRsp) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_server_proto_msgTypes[30]
+func (x *RegisterNodeRsp) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_server_proto_msgTypes[26]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1936,51 +2169,59 @@ func (x *AdaptiveRsp) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AdaptiveRsp.ProtoReflect.Descriptor instead.
-func (*AdaptiveRsp) Descriptor() ([]byte, []int) {
-	return file_rpc_server_proto_rawDescGZIP(), []int{30}
+// Deprecated: Use RegisterNodeRsp.ProtoReflect.Descriptor instead.
+func (*RegisterNodeRsp) Descriptor() ([]byte, []int) {
+	return file_rpc_server_proto_rawDescGZIP(), []int{26}
 }
 
-func (x *AdaptiveRsp) GetCode() int32 {
+func (x *RegisterNodeRsp) GetCode() int32 {
 	if x != nil {
 		return x.Code
 	}
 	return 0
 }
 
-func (x *AdaptiveRsp) GetMsg() string {
+func (x *RegisterNodeRsp) GetMsg() string {
 	if x != nil {
 		return x.Msg
 	}
 	return ""
 }
 
-type SetGatewayModelReq struct {
+func (x *RegisterNodeRsp) GetData() []byte {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+// 向持有集群CA私钥的节点申请节点间mTLS使用的证书, 使用集群token认证
+type IssueNodeCertReq struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	NodeAuthInfo       *NodeAuthInfo `protobuf:"bytes,1,opt,name=node_auth_info,json=nodeAuthInfo,proto3" json:"node_auth_info,omitempty"`
-	EnableGatewayModel bool          `protobuf:"varint,2,opt,name=enableGatewayModel,proto3" json:"enableGatewayModel,omitempty"`
+	NodeAuthInfo *NodeAuthInfo `protobuf:"bytes,1,opt,name=node_auth_info,json=nodeAuthInfo,proto3" json:"node_auth_info,omitempty"`
+	Csr          []byte        `protobuf:"bytes,2,opt,name=csr,proto3" json:"csr,omitempty"` // pem格式的证书请求
 }
 
-func (x *SetGatewayModelReq) Reset() {
-	*x = SetGatewayModelReq{}
+func (x *IssueNodeCertReq) Reset() {
+	*x = IssueNodeCertReq{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_rpc_server_proto_msgTypes[31]
+		mi := &file_rpc_server_proto_msgTypes[27]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *SetGatewayModelReq) String() string {
+func (x *IssueNodeCertReq) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SetGatewayModelReq) ProtoMessage() {}
+func (*IssueNodeCertReq) ProtoMessage() {}
 
-func (x *SetGatewayModelReq) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_server_proto_msgTypes[31]
+func (x *IssueNodeCertReq) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_server_proto_msgTypes[27]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1991,51 +2232,53 @@ func (x *SetGatewayModelReq) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SetGatewayModelReq.ProtoReflect.Descriptor instead.
-func (*SetGatewayModelReq) Descriptor() ([]byte, []int) {
-	return file_rpc_server_proto_rawDescGZIP(), []int{31}
+// Deprecated: Use IssueNodeCertReq.ProtoReflect.Descriptor instead.
+func (*IssueNodeCertReq) Descriptor() ([]byte, []int) {
+	return file_rpc_server_proto_rawDescGZIP(), []int{27}
 }
 
-func (x *SetGatewayModelReq) GetNodeAuthInfo() *NodeAuthInfo {
+func (x *IssueNodeCertReq) GetNodeAuthInfo() *NodeAuthInfo {
 	if x != nil {
 		return x.NodeAuthInfo
 	}
 	return nil
 }
 
-func (x *SetGatewayModelReq) GetEnableGatewayModel() bool {
+func (x *IssueNodeCertReq) GetCsr() []byte {
 	if x != nil {
-		return x.EnableGatewayModel
+		return x.Csr
 	}
-	return false
+	return nil
 }
 
-type SetGatewayModelRsp struct {
+type IssueNodeCertRsp struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Code int32  `protobuf:"varint,1,opt,name=code,proto3" json:"code,omitempty"`
-	Msg  string `protobuf:"bytes,2,opt,name=msg,proto3" json:"msg,omitempty"`
+	Code   int32  `protobuf:"varint,1,opt,name=code,proto3" json:"code,omitempty"`
+	Msg    string `protobuf:"bytes,2,opt,name=msg,proto3" json:"msg,omitempty"`
+	Cert   []byte `protobuf:"bytes,3,opt,name=cert,proto3" json:"cert,omitempty"`                   // pem格式的节点证书
+	CaCert []byte `protobuf:"bytes,4,opt,name=ca_cert,json=caCert,proto3" json:"ca_cert,omitempty"` // pem格式的集群CA证书
 }
 
-func (x *SetGatewayModelRsp) Reset() {
-	*x = SetGatewayModelRsp{}
+func (x *IssueNodeCertRsp) Reset() {
+	*x = IssueNodeCertRsp{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_rpc_server_proto_msgTypes[32]
+		mi := &file_rpc_server_proto_msgTypes[28]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *SetGatewayModelRsp) String() string {
+func (x *IssueNodeCertRsp) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SetGatewayModelRsp) ProtoMessage() {}
+func (*IssueNodeCertRsp) ProtoMessage() {}
 
-func (x *SetGatewayModelRsp) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_server_proto_msgTypes[32]
+func (x *IssueNodeCertRsp) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_server_proto_msgTypes[28]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2046,51 +2289,69 @@ func (x *SetGatewayModelRsp) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SetGatewayModelRsp.ProtoReflect.Descriptor instead.
-func (*SetGatewayModelRsp) Descriptor() ([]byte, []int) {
-	return file_rpc_server_proto_rawDescGZIP(), []int{32}
+// Deprecated: Use IssueNodeCertRsp.ProtoReflect.Descriptor instead.
+func (*IssueNodeCertRsp) Descriptor() ([]byte, []int) {
+	return file_rpc_server_proto_rawDescGZIP(), []int{28}
 }
 
-func (x *SetGatewayModelRsp) GetCode() int32 {
+func (x *IssueNodeCertRsp) GetCode() int32 {
 	if x != nil {
 		return x.Code
 	}
 	return 0
 }
 
-func (x *SetGatewayModelRsp) GetMsg() string {
+func (x *IssueNodeCertRsp) GetMsg() string {
 	if x != nil {
 		return x.Msg
 	}
 	return ""
 }
 
-type ObtainNewCertReq struct {
+func (x *IssueNodeCertRsp) GetCert() []byte {
+	if x != nil {
+		return x.Cert
+	}
+	return nil
+}
+
+func (x *IssueNodeCertRsp) GetCaCert() []byte {
+	if x != nil {
+		return x.CaCert
+	}
+	return nil
+}
+
+// gossip成员协议中捎带的成员状态
+type GossipMember struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	NodeAuthInfo *NodeAuthInfo `protobuf:"bytes,1,opt,name=node_auth_info,json=nodeAuthInfo,proto3" json:"node_auth_info,omitempty"`
-	Domain       string        `protobuf:"bytes,2,opt,name=domain,proto3" json:"domain,omitempty"`
+	Node        *Node       `protobuf:"bytes,1,opt,name=node,proto3" json:"node,omitempty"`
+	State       string      `protobuf:"bytes,2,opt,name=state,proto3" json:"state,omitempty"`              // alive, suspect, dead
+	Incarnation uint64      `protobuf:"varint,3,opt,name=incarnation,proto3" json:"incarnation,omitempty"` // 节点被怀疑时递增, 用于反驳旧的状态
+	Generation  int64       `protobuf:"varint,4,opt,name=generation,proto3" json:"generation,omitempty"`   // 节点进程的启动时间, unix时间戳, 单位毫秒, 变化时表示节点重启
+	Health      *NodeHealth `protobuf:"bytes,5,opt,name=health,proto3" json:"health,omitempty"`            // 仅节点自身的状态中携带
 }
 
-func (x *ObtainNewCertReq) Reset() {
-	*x = ObtainNewCertReq{}
+func (x *GossipMember) Reset() {
+	*x = GossipMember{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_rpc_server_proto_msgTypes[33]
+		mi := &file_rpc_server_proto_msgTypes[29]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *ObtainNewCertReq) String() string {
+func (x *GossipMember) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ObtainNewCertReq) ProtoMessage() {}
+func (*GossipMember) ProtoMessage() {}
 
-func (x *ObtainNewCertReq) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_server_proto_msgTypes[33]
+func (x *GossipMember) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_server_proto_msgTypes[29]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2101,51 +2362,73 @@ func (x *ObtainNewCertReq) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ObtainNewCertReq.ProtoReflect.Descriptor instead.
-func (*ObtainNewCertReq) Descriptor() ([]byte, []int) {
-	return file_rpc_server_proto_rawDescGZIP(), []int{33}
+// Deprecated: Use GossipMember.ProtoReflect.Descriptor instead.
+func (*GossipMember) Descriptor() ([]byte, []int) {
+	return file_rpc_server_proto_rawDescGZIP(), []int{29}
 }
 
-func (x *ObtainNewCertReq) GetNodeAuthInfo() *NodeAuthInfo {
+func (x *GossipMember) GetNode() *Node {
 	if x != nil {
-		return x.NodeAuthInfo
+		return x.Node
 	}
 	return nil
 }
 
-func (x *ObtainNewCertReq) GetDomain() string {
+func (x *GossipMember) GetState() string {
 	if x != nil {
-		return x.Domain
+		return x.State
 	}
 	return ""
 }
 
-type ObtainNewCertRsp struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
+func (x *GossipMember) GetIncarnation() uint64 {
+	if x != nil {
+		return x.Incarnation
+	}
+	return 0
+}
 
-	Code int32  `protobuf:"varint,1,opt,name=code,proto3" json:"code,omitempty"`
-	Msg  string `protobuf:"bytes,2,opt,name=msg,proto3" json:"msg,omitempty"`
+func (x *GossipMember) GetGeneration() int64 {
+	if x != nil {
+		return x.Generation
+	}
+	return 0
 }
 
-func (x *ObtainNewCertRsp) Reset() {
-	*x = ObtainNewCertRsp{}
+func (x *GossipMember) GetHealth() *NodeHealth {
+	if x != nil {
+		return x.Health
+	}
+	return nil
+}
+
+type GossipPingReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	NodeAuthInfo *NodeAuthInfo   `protobuf:"bytes,1,opt,name=node_auth_info,json=nodeAuthInfo,proto3" json:"node_auth_info,omitempty"` // token为集群token
+	Target       string          `protobuf:"bytes,2,opt,name=target,proto3" json:"target,omitempty"`                                   // 间接探测的目标节点名称, 为空时表示直接探测
+	Members      []*GossipMember `protobuf:"bytes,3,rep,name=members,proto3" json:"members,omitempty"`
+}
+
+func (x *GossipPingReq) Reset() {
+	*x = GossipPingReq{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_rpc_server_proto_msgTypes[34]
+		mi := &file_rpc_server_proto_msgTypes[30]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *ObtainNewCertRsp) String() string {
+func (x *GossipPingReq) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ObtainNewCertRsp) ProtoMessage() {}
+func (*GossipPingReq) ProtoMessage() {}
 
-func (x *ObtainNewCertRsp) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_server_proto_msgTypes[34]
+func (x *GossipPingReq) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_server_proto_msgTypes[30]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2156,56 +2439,60 @@ func (x *ObtainNewCertRsp) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ObtainNewCertRsp.ProtoReflect.Descriptor instead.
-func (*ObtainNewCertRsp) Descriptor() ([]byte, []int) {
-	return file_rpc_server_proto_rawDescGZIP(), []int{34}
+// Deprecated: Use GossipPingReq.ProtoReflect.Descriptor instead.
+func (*GossipPingReq) Descriptor() ([]byte, []int) {
+	return file_rpc_server_proto_rawDescGZIP(), []int{30}
 }
 
-func (x *ObtainNewCertRsp) GetCode() int32 {
+func (x *GossipPingReq) GetNodeAuthInfo() *NodeAuthInfo {
 	if x != nil {
-		return x.Code
+		return x.NodeAuthInfo
 	}
-	return 0
+	return nil
 }
 
-func (x *ObtainNewCertRsp) GetMsg() string {
+func (x *GossipPingReq) GetTarget() string {
 	if x != nil {
-		return x.Msg
+		return x.Target
 	}
 	return ""
 }
 
-type FastAddInboundReq struct {
+func (x *GossipPingReq) GetMembers() []*GossipMember {
+	if x != nil {
+		return x.Members
+	}
+	return nil
+}
+
+type GossipPingRsp struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	NodeAuthInfo       *NodeAuthInfo `protobuf:"bytes,1,opt,name=node_auth_info,json=nodeAuthInfo,proto3" json:"node_auth_info,omitempty"`
-	InboundBuilderType BuilderType   `protobuf:"varint,2,opt,name=inboundBuilderType,proto3,enum=proto.BuilderType" json:"inboundBuilderType,omitempty"`
-	StreamBuilderType  BuilderType   `protobuf:"varint,3,opt,name=streamBuilderType,proto3,enum=proto.BuilderType" json:"streamBuilderType,omitempty"`
-	Port               int32         `protobuf:"varint,4,opt,name=port,proto3" json:"port,omitempty"`
-	Domain             string        `protobuf:"bytes,5,opt,name=domain,proto3" json:"domain,omitempty"`
-	IsXtls             bool          `protobuf:"varint,6,opt,name=isXtls,proto3" json:"isXtls,omitempty"`
-	Tag                string        `protobuf:"bytes,7,opt,name=tag,proto3" json:"tag,omitempty"`
+	Code    int32           `protobuf:"varint,1,opt,name=code,proto3" json:"code,omitempty"`
+	Msg     string          `protobuf:"bytes,2,opt,name=msg,proto3" json:"msg,omitempty"`
+	Ack     bool            `protobuf:"varint,3,opt,name=ack,proto3" json:"ack,omitempty"` // 探测是否成功
+	Members []*GossipMember `protobuf:"bytes,4,rep,name=members,proto3" json:"members,omitempty"`
 }
 
-func (x *FastAddInboundReq) Reset() {
-	*x = FastAddInboundReq{}
+func (x *GossipPingRsp) Reset() {
+	*x = GossipPingRsp{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_rpc_server_proto_msgTypes[35]
+		mi := &file_rpc_server_proto_msgTypes[31]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *FastAddInboundReq) String() string {
+func (x *GossipPingRsp) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*FastAddInboundReq) ProtoMessage() {}
+func (*GossipPingRsp) ProtoMessage() {}
 
-func (x *FastAddInboundReq) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_server_proto_msgTypes[35]
+func (x *GossipPingRsp) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_server_proto_msgTypes[31]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2216,86 +2503,64 @@ func (x *FastAddInboundReq) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use FastAddInboundReq.ProtoReflect.Descriptor instead.
-func (*FastAddInboundReq) Descriptor() ([]byte, []int) {
-	return file_rpc_server_proto_rawDescGZIP(), []int{35}
-}
-
-func (x *FastAddInboundReq) GetNodeAuthInfo() *NodeAuthInfo {
-	if x != nil {
-		return x.NodeAuthInfo
-	}
-	return nil
-}
-
-func (x *FastAddInboundReq) GetInboundBuilderType() BuilderType {
-	if x != nil {
-		return x.InboundBuilderType
-	}
-	return BuilderType_UnknowBuilderType
-}
-
-func (x *FastAddInboundReq) GetStreamBuilderType() BuilderType {
-	if x != nil {
-		return x.StreamBuilderType
-	}
-	return BuilderType_UnknowBuilderType
+// Deprecated: Use GossipPingRsp.ProtoReflect.Descriptor instead.
+func (*GossipPingRsp) Descriptor() ([]byte, []int) {
+	return file_rpc_server_proto_rawDescGZIP(), []int{31}
 }
 
-func (x *FastAddInboundReq) GetPort() int32 {
+func (x *GossipPingRsp) GetCode() int32 {
 	if x != nil {
-		return x.Port
+		return x.Code
 	}
 	return 0
 }
 
-func (x *FastAddInboundReq) GetDomain() string {
+func (x *GossipPingRsp) GetMsg() string {
 	if x != nil {
-		return x.Domain
+		return x.Msg
 	}
 	return ""
 }
 
-func (x *FastAddInboundReq) GetIsXtls() bool {
+func (x *GossipPingRsp) GetAck() bool {
 	if x != nil {
-		return x.IsXtls
+		return x.Ack
 	}
 	return false
 }
 
-func (x *FastAddInboundReq) GetTag() string {
+func (x *GossipPingRsp) GetMembers() []*GossipMember {
 	if x != nil {
-		return x.Tag
+		return x.Members
 	}
-	return ""
+	return nil
 }
 
-type FastAddInboundRsp struct {
+type GetBandwidthStatsReq struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Code int32  `protobuf:"varint,1,opt,name=code,proto3" json:"code,omitempty"`
-	Msg  string `protobuf:"bytes,2,opt,name=msg,proto3" json:"msg,omitempty"`
+	NodeAuthInfo *NodeAuthInfo `protobuf:"bytes,1,opt,name=node_auth_info,json=nodeAuthInfo,proto3" json:"node_auth_info,omitempty"`
 }
 
-func (x *FastAddInboundRsp) Reset() {
-	*x = FastAddInboundRsp{}
+func (x *GetBandwidthStatsReq) Reset() {
+	*x = GetBandwidthStatsReq{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_rpc_server_proto_msgTypes[36]
+		mi := &file_rpc_server_proto_msgTypes[32]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *FastAddInboundRsp) String() string {
+func (x *GetBandwidthStatsReq) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*FastAddInboundRsp) ProtoMessage() {}
+func (*GetBandwidthStatsReq) ProtoMessage() {}
 
-func (x *FastAddInboundRsp) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_server_proto_msgTypes[36]
+func (x *GetBandwidthStatsReq) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_server_proto_msgTypes[32]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2306,53 +2571,48 @@ func (x *FastAddInboundRsp) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use FastAddInboundRsp.ProtoReflect.Descriptor instead.
-func (*FastAddInboundRsp) Descriptor() ([]byte, []int) {
-	return file_rpc_server_proto_rawDescGZIP(), []int{36}
-}
-
-func (x *FastAddInboundRsp) GetCode() int32 {
-	if x != nil {
-		return x.Code
-	}
-	return 0
+// Deprecated: Use GetBandwidthStatsReq.ProtoReflect.Descriptor instead.
+func (*GetBandwidthStatsReq) Descriptor() ([]byte, []int) {
+	return file_rpc_server_proto_rawDescGZIP(), []int{32}
 }
 
-func (x *FastAddInboundRsp) GetMsg() string {
+func (x *GetBandwidthStatsReq) GetNodeAuthInfo() *NodeAuthInfo {
 	if x != nil {
-		return x.Msg
+		return x.NodeAuthInfo
 	}
-	return ""
+	return nil
 }
 
-type TransferCertReq struct {
+type Stats struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	NodeAuthInfo *NodeAuthInfo `protobuf:"bytes,1,opt,name=node_auth_info,json=nodeAuthInfo,proto3" json:"node_auth_info,omitempty"`
-	Domain       string        `protobuf:"bytes,2,opt,name=domain,proto3" json:"domain,omitempty"`
-	CertData     []byte        `protobuf:"bytes,3,opt,name=cert_data,json=certData,proto3" json:"cert_data,omitempty"`
-	KeyDatas     []byte        `protobuf:"bytes,4,opt,name=key_datas,json=keyDatas,proto3" json:"key_datas,omitempty"`
+	Name     string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Type     string `protobuf:"bytes,2,opt,name=type,proto3" json:"type,omitempty"`
+	Downlink int64  `protobuf:"varint,3,opt,name=downlink,proto3" json:"downlink,omitempty"`
+	Uplink   int64  `protobuf:"varint,4,opt,name=uplink,proto3" json:"uplink,omitempty"`
+	Proxy    string `protobuf:"bytes,5,opt,name=proxy,proto3" json:"proxy,omitempty"`   // proxy name
+	Source   string `protobuf:"bytes,6,opt,name=source,proto3" json:"source,omitempty"` // int64 timestamp = 7; // 记录统计的时间
 }
 
-func (x *TransferCertReq) Reset() {
-	*x = TransferCertReq{}
+func (x *Stats) Reset() {
+	*x = Stats{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_rpc_server_proto_msgTypes[37]
+		mi := &file_rpc_server_proto_msgTypes[33]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *TransferCertReq) String() string {
+func (x *Stats) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*TransferCertReq) ProtoMessage() {}
+func (*Stats) ProtoMessage() {}
 
-func (x *TransferCertReq) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_server_proto_msgTypes[37]
+func (x *Stats) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_server_proto_msgTypes[33]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2363,65 +2623,80 @@ func (x *TransferCertReq) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use TransferCertReq.ProtoReflect.Descriptor instead.
-func (*TransferCertReq) Descriptor() ([]byte, []int) {
-	return file_rpc_server_proto_rawDescGZIP(), []int{37}
+// Deprecated: Use Stats.ProtoReflect.Descriptor instead.
+func (*Stats) Descriptor() ([]byte, []int) {
+	return file_rpc_server_proto_rawDescGZIP(), []int{33}
 }
 
-func (x *TransferCertReq) GetNodeAuthInfo() *NodeAuthInfo {
+func (x *Stats) GetName() string {
 	if x != nil {
-		return x.NodeAuthInfo
+		return x.Name
 	}
-	return nil
+	return ""
 }
 
-func (x *TransferCertReq) GetDomain() string {
+func (x *Stats) GetType() string {
 	if x != nil {
-		return x.Domain
+		return x.Type
 	}
 	return ""
 }
 
-func (x *TransferCertReq) GetCertData() []byte {
+func (x *Stats) GetDownlink() int64 {
 	if x != nil {
-		return x.CertData
+		return x.Downlink
 	}
-	return nil
+	return 0
 }
 
-func (x *TransferCertReq) GetKeyDatas() []byte {
+func (x *Stats) GetUplink() int64 {
 	if x != nil {
-		return x.KeyDatas
+		return x.Uplink
 	}
-	return nil
+	return 0
 }
 
-type TransferCertRsp struct {
+func (x *Stats) GetProxy() string {
+	if x != nil {
+		return x.Proxy
+	}
+	return ""
+}
+
+func (x *Stats) GetSource() string {
+	if x != nil {
+		return x.Source
+	}
+	return ""
+}
+
+type GetBandwidthStatsRsp struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Code int32  `protobuf:"varint,1,opt,name=code,proto3" json:"code,omitempty"`
-	Msg  string `protobuf:"bytes,2,opt,name=msg,proto3" json:"msg,omitempty"`
+	Code  int32    `protobuf:"varint,1,opt,name=code,proto3" json:"code,omitempty"`
+	Msg   string   `protobuf:"bytes,2,opt,name=msg,proto3" json:"msg,omitempty"`
+	Stats []*Stats `protobuf:"bytes,3,rep,name=stats,proto3" json:"stats,omitempty"`
 }
 
-func (x *TransferCertRsp) Reset() {
-	*x = TransferCertRsp{}
+func (x *GetBandwidthStatsRsp) Reset() {
+	*x = GetBandwidthStatsRsp{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_rpc_server_proto_msgTypes[38]
+		mi := &file_rpc_server_proto_msgTypes[34]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *TransferCertRsp) String() string {
+func (x *GetBandwidthStatsRsp) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*TransferCertRsp) ProtoMessage() {}
+func (*GetBandwidthStatsRsp) ProtoMessage() {}
 
-func (x *TransferCertRsp) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_server_proto_msgTypes[38]
+func (x *GetBandwidthStatsRsp) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_server_proto_msgTypes[34]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2432,53 +2707,60 @@ func (x *TransferCertRsp) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use TransferCertRsp.ProtoReflect.Descriptor instead.
-func (*TransferCertRsp) Descriptor() ([]byte, []int) {
-	return file_rpc_server_proto_rawDescGZIP(), []int{38}
+// Deprecated: Use GetBandwidthStatsRsp.ProtoReflect.Descriptor instead.
+func (*GetBandwidthStatsRsp) Descriptor() ([]byte, []int) {
+	return file_rpc_server_proto_rawDescGZIP(), []int{34}
 }
 
-func (x *TransferCertRsp) GetCode() int32 {
+func (x *GetBandwidthStatsRsp) GetCode() int32 {
 	if x != nil {
 		return x.Code
 	}
 	return 0
 }
 
-func (x *TransferCertRsp) GetMsg() string {
+func (x *GetBandwidthStatsRsp) GetMsg() string {
 	if x != nil {
 		return x.Msg
 	}
 	return ""
 }
 
-type Cert struct {
+func (x *GetBandwidthStatsRsp) GetStats() []*Stats {
+	if x != nil {
+		return x.Stats
+	}
+	return nil
+}
+
+// 按小时或天聚合的流量数据点, timestamp为时间段的开始时间
+type TrafficPoint struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Domain     string `protobuf:"bytes,1,opt,name=domain,proto3" json:"domain,omitempty"`
-	KeyFile    string `protobuf:"bytes,2,opt,name=key_file,json=keyFile,proto3" json:"key_file,omitempty"`
-	CertFile   string `protobuf:"bytes,3,opt,name=cert_file,json=certFile,proto3" json:"cert_file,omitempty"`
-	ExpireTime string `protobuf:"bytes,4,opt,name=expire_time,json=expireTime,proto3" json:"expire_time,omitempty"`
+	Timestamp int64 `protobuf:"varint,1,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	Uplink    int64 `protobuf:"varint,2,opt,name=uplink,proto3" json:"uplink,omitempty"`
+	Downlink  int64 `protobuf:"varint,3,opt,name=downlink,proto3" json:"downlink,omitempty"`
 }
 
-func (x *Cert) Reset() {
-	*x = Cert{}
+func (x *TrafficPoint) Reset() {
+	*x = TrafficPoint{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_rpc_server_proto_msgTypes[39]
+		mi := &file_rpc_server_proto_msgTypes[35]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *Cert) String() string {
+func (x *TrafficPoint) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Cert) ProtoMessage() {}
+func (*TrafficPoint) ProtoMessage() {}
 
-func (x *Cert) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_server_proto_msgTypes[39]
+func (x *TrafficPoint) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_server_proto_msgTypes[35]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2489,64 +2771,62 @@ func (x *Cert) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Cert.ProtoReflect.Descriptor instead.
-func (*Cert) Descriptor() ([]byte, []int) {
-	return file_rpc_server_proto_rawDescGZIP(), []int{39}
-}
-
-func (x *Cert) GetDomain() string {
-	if x != nil {
-		return x.Domain
-	}
-	return ""
+// Deprecated: Use TrafficPoint.ProtoReflect.Descriptor instead.
+func (*TrafficPoint) Descriptor() ([]byte, []int) {
+	return file_rpc_server_proto_rawDescGZIP(), []int{35}
 }
 
-func (x *Cert) GetKeyFile() string {
+func (x *TrafficPoint) GetTimestamp() int64 {
 	if x != nil {
-		return x.KeyFile
+		return x.Timestamp
 	}
-	return ""
+	return 0
 }
 
-func (x *Cert) GetCertFile() string {
+func (x *TrafficPoint) GetUplink() int64 {
 	if x != nil {
-		return x.CertFile
+		return x.Uplink
 	}
-	return ""
+	return 0
 }
 
-func (x *Cert) GetExpireTime() string {
+func (x *TrafficPoint) GetDownlink() int64 {
 	if x != nil {
-		return x.ExpireTime
+		return x.Downlink
 	}
-	return ""
+	return 0
 }
 
-type GetCertsReq struct {
+type TrafficSeries struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	NodeAuthInfo *NodeAuthInfo `protobuf:"bytes,1,opt,name=node_auth_info,json=nodeAuthInfo,proto3" json:"node_auth_info,omitempty"`
+	Name     string          `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Type     string          `protobuf:"bytes,2,opt,name=type,proto3" json:"type,omitempty"`     // user, inbound, outbound, node
+	Source   string          `protobuf:"bytes,3,opt,name=source,proto3" json:"source,omitempty"` // node name
+	Points   []*TrafficPoint `protobuf:"bytes,4,rep,name=points,proto3" json:"points,omitempty"`
+	Uplink   int64           `protobuf:"varint,5,opt,name=uplink,proto3" json:"uplink,omitempty"` // 查询时间段内的总流量
+	Downlink int64           `protobuf:"varint,6,opt,name=downlink,proto3" json:"downlink,omitempty"`
 }
 
-func (x *GetCertsReq) Reset() {
-	*x = GetCertsReq{}
+func (x *TrafficSeries) Reset() {
+	*x = TrafficSeries{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_rpc_server_proto_msgTypes[40]
+		mi := &file_rpc_server_proto_msgTypes[36]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *GetCertsReq) String() string {
+func (x *TrafficSeries) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetCertsReq) ProtoMessage() {}
+func (*TrafficSeries) ProtoMessage() {}
 
-func (x *GetCertsReq) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_server_proto_msgTypes[40]
+func (x *TrafficSeries) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_server_proto_msgTypes[36]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2557,99 +2837,83 @@ func (x *GetCertsReq) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetCertsReq.ProtoReflect.Descriptor instead.
-func (*GetCertsReq) Descriptor() ([]byte, []int) {
-	return file_rpc_server_proto_rawDescGZIP(), []int{40}
+// Deprecated: Use TrafficSeries.ProtoReflect.Descriptor instead.
+func (*TrafficSeries) Descriptor() ([]byte, []int) {
+	return file_rpc_server_proto_rawDescGZIP(), []int{36}
 }
 
-func (x *GetCertsReq) GetNodeAuthInfo() *NodeAuthInfo {
+func (x *TrafficSeries) GetName() string {
 	if x != nil {
-		return x.NodeAuthInfo
+		return x.Name
 	}
-	return nil
-}
-
-type GetCertsRsp struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	Code  int32   `protobuf:"varint,1,opt,name=code,proto3" json:"code,omitempty"`
-	Certs []*Cert `protobuf:"bytes,2,rep,name=certs,proto3" json:"certs,omitempty"`
+	return ""
 }
 
-func (x *GetCertsRsp) Reset() {
-	*x = GetCertsRsp{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_rpc_server_proto_msgTypes[41]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+func (x *TrafficSeries) GetType() string {
+	if x != nil {
+		return x.Type
 	}
+	return ""
 }
 
-func (x *GetCertsRsp) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*GetCertsRsp) ProtoMessage() {}
-
-func (x *GetCertsRsp) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_server_proto_msgTypes[41]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+func (x *TrafficSeries) GetSource() string {
+	if x != nil {
+		return x.Source
 	}
-	return mi.MessageOf(x)
+	return ""
 }
 
-// Deprecated: Use GetCertsRsp.ProtoReflect.Descriptor instead.
-func (*GetCertsRsp) Descriptor() ([]byte, []int) {
-	return file_rpc_server_proto_rawDescGZIP(), []int{41}
+func (x *TrafficSeries) GetPoints() []*TrafficPoint {
+	if x != nil {
+		return x.Points
+	}
+	return nil
 }
 
-func (x *GetCertsRsp) GetCode() int32 {
+func (x *TrafficSeries) GetUplink() int64 {
 	if x != nil {
-		return x.Code
+		return x.Uplink
 	}
 	return 0
 }
 
-func (x *GetCertsRsp) GetCerts() []*Cert {
+func (x *TrafficSeries) GetDownlink() int64 {
 	if x != nil {
-		return x.Certs
+		return x.Downlink
 	}
-	return nil
+	return 0
 }
 
-type ClearUsersReq struct {
+type GetTrafficReportReq struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
 	NodeAuthInfo *NodeAuthInfo `protobuf:"bytes,1,opt,name=node_auth_info,json=nodeAuthInfo,proto3" json:"node_auth_info,omitempty"`
-	Users        []string      `protobuf:"bytes,2,rep,name=users,proto3" json:"users,omitempty"`
+	Type         string        `protobuf:"bytes,2,opt,name=type,proto3" json:"type,omitempty"`
+	Name         string        `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`               // 为空时返回该类型的全部数据
+	Granularity  string        `protobuf:"bytes,4,opt,name=granularity,proto3" json:"granularity,omitempty"` // hour or day
+	Start        int64         `protobuf:"varint,5,opt,name=start,proto3" json:"start,omitempty"`
+	End          int64         `protobuf:"varint,6,opt,name=end,proto3" json:"end,omitempty"`
 }
 
-func (x *ClearUsersReq) Reset() {
-	*x = ClearUsersReq{}
+func (x *GetTrafficReportReq) Reset() {
+	*x = GetTrafficReportReq{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_rpc_server_proto_msgTypes[42]
+		mi := &file_rpc_server_proto_msgTypes[37]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *ClearUsersReq) String() string {
+func (x *GetTrafficReportReq) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ClearUsersReq) ProtoMessage() {}
+func (*GetTrafficReportReq) ProtoMessage() {}
 
-func (x *ClearUsersReq) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_server_proto_msgTypes[42]
+func (x *GetTrafficReportReq) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_server_proto_msgTypes[37]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2660,107 +2924,80 @@ func (x *ClearUsersReq) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ClearUsersReq.ProtoReflect.Descriptor instead.
-func (*ClearUsersReq) Descriptor() ([]byte, []int) {
-	return file_rpc_server_proto_rawDescGZIP(), []int{42}
+// Deprecated: Use GetTrafficReportReq.ProtoReflect.Descriptor instead.
+func (*GetTrafficReportReq) Descriptor() ([]byte, []int) {
+	return file_rpc_server_proto_rawDescGZIP(), []int{37}
 }
 
-func (x *ClearUsersReq) GetNodeAuthInfo() *NodeAuthInfo {
+func (x *GetTrafficReportReq) GetNodeAuthInfo() *NodeAuthInfo {
 	if x != nil {
 		return x.NodeAuthInfo
 	}
 	return nil
 }
 
-func (x *ClearUsersReq) GetUsers() []string {
+func (x *GetTrafficReportReq) GetType() string {
 	if x != nil {
-		return x.Users
+		return x.Type
 	}
-	return nil
-}
-
-type ClearUsersRsp struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	Code int32  `protobuf:"varint,1,opt,name=code,proto3" json:"code,omitempty"`
-	Msg  string `protobuf:"bytes,2,opt,name=msg,proto3" json:"msg,omitempty"`
+	return ""
 }
 
-func (x *ClearUsersRsp) Reset() {
-	*x = ClearUsersRsp{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_rpc_server_proto_msgTypes[43]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+func (x *GetTrafficReportReq) GetName() string {
+	if x != nil {
+		return x.Name
 	}
+	return ""
 }
 
-func (x *ClearUsersRsp) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*ClearUsersRsp) ProtoMessage() {}
-
-func (x *ClearUsersRsp) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_server_proto_msgTypes[43]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+func (x *GetTrafficReportReq) GetGranularity() string {
+	if x != nil {
+		return x.Granularity
 	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use ClearUsersRsp.ProtoReflect.Descriptor instead.
-func (*ClearUsersRsp) Descriptor() ([]byte, []int) {
-	return file_rpc_server_proto_rawDescGZIP(), []int{43}
+	return ""
 }
 
-func (x *ClearUsersRsp) GetCode() int32 {
+func (x *GetTrafficReportReq) GetStart() int64 {
 	if x != nil {
-		return x.Code
+		return x.Start
 	}
 	return 0
 }
 
-func (x *ClearUsersRsp) GetMsg() string {
+func (x *GetTrafficReportReq) GetEnd() int64 {
 	if x != nil {
-		return x.Msg
+		return x.End
 	}
-	return ""
+	return 0
 }
 
-type PingMetric struct {
+type GetTrafficReportRsp struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Host    string        `protobuf:"bytes,1,opt,name=host,proto3" json:"host,omitempty"` // ip or domain
-	Source  string        `protobuf:"bytes,2,opt,name=source,proto3" json:"source,omitempty"`
-	Results []*PingResult `protobuf:"bytes,3,rep,name=results,proto3" json:"results,omitempty"`
+	Code   int32            `protobuf:"varint,1,opt,name=code,proto3" json:"code,omitempty"`
+	Msg    string           `protobuf:"bytes,2,opt,name=msg,proto3" json:"msg,omitempty"`
+	Series []*TrafficSeries `protobuf:"bytes,3,rep,name=series,proto3" json:"series,omitempty"`
 }
 
-func (x *PingMetric) Reset() {
-	*x = PingMetric{}
+func (x *GetTrafficReportRsp) Reset() {
+	*x = GetTrafficReportRsp{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_rpc_server_proto_msgTypes[44]
+		mi := &file_rpc_server_proto_msgTypes[38]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *PingMetric) String() string {
+func (x *GetTrafficReportRsp) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*PingMetric) ProtoMessage() {}
+func (*GetTrafficReportRsp) ProtoMessage() {}
 
-func (x *PingMetric) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_server_proto_msgTypes[44]
+func (x *GetTrafficReportRsp) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_server_proto_msgTypes[38]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2771,64 +3008,66 @@ func (x *PingMetric) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use PingMetric.ProtoReflect.Descriptor instead.
-func (*PingMetric) Descriptor() ([]byte, []int) {
-	return file_rpc_server_proto_rawDescGZIP(), []int{44}
+// Deprecated: Use GetTrafficReportRsp.ProtoReflect.Descriptor instead.
+func (*GetTrafficReportRsp) Descriptor() ([]byte, []int) {
+	return file_rpc_server_proto_rawDescGZIP(), []int{38}
 }
 
-func (x *PingMetric) GetHost() string {
+func (x *GetTrafficReportRsp) GetCode() int32 {
 	if x != nil {
-		return x.Host
+		return x.Code
 	}
-	return ""
+	return 0
 }
 
-func (x *PingMetric) GetSource() string {
+func (x *GetTrafficReportRsp) GetMsg() string {
 	if x != nil {
-		return x.Source
+		return x.Msg
 	}
 	return ""
 }
 
-func (x *PingMetric) GetResults() []*PingResult {
+func (x *GetTrafficReportRsp) GetSeries() []*TrafficSeries {
 	if x != nil {
-		return x.Results
+		return x.Series
 	}
 	return nil
 }
 
-type PingResult struct {
+// 管理操作的审计记录
+type AuditEntry struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Geo         string  `protobuf:"bytes,1,opt,name=geo,proto3" json:"geo,omitempty"` // location
-	Isp         string  `protobuf:"bytes,2,opt,name=isp,proto3" json:"isp,omitempty"` // provider
-	MaxDelay    float32 `protobuf:"fixed32,10,opt,name=max_delay,json=maxDelay,proto3" json:"max_delay,omitempty"`
-	MinDelay    float32 `protobuf:"fixed32,11,opt,name=min_delay,json=minDelay,proto3" json:"min_delay,omitempty"`
-	StDevDelay  float32 `protobuf:"fixed32,12,opt,name=st_dev_delay,json=stDevDelay,proto3" json:"st_dev_delay,omitempty"`
-	AvgDelay    float32 `protobuf:"fixed32,13,opt,name=avg_delay,json=avgDelay,proto3" json:"avg_delay,omitempty"`
-	Loss        float32 `protobuf:"fixed32,14,opt,name=loss,proto3" json:"loss,omitempty"` // 丢包率
-	LatestDelay float32 `protobuf:"fixed32,15,opt,name=latest_delay,json=latestDelay,proto3" json:"latest_delay,omitempty"`
+	Time      int64  `protobuf:"varint,1,opt,name=time,proto3" json:"time,omitempty"`          // 毫秒时间戳
+	Node      string `protobuf:"bytes,2,opt,name=node,proto3" json:"node,omitempty"`           // 记录该操作的节点
+	Source    string `protobuf:"bytes,3,opt,name=source,proto3" json:"source,omitempty"`       // http: 通过http接口调用, rpc: 其他节点转发
+	Caller    string `protobuf:"bytes,4,opt,name=caller,proto3" json:"caller,omitempty"`       // http请求的来源ip或者发起rpc请求的节点名称
+	Operation string `protobuf:"bytes,5,opt,name=operation,proto3" json:"operation,omitempty"` // http path或者rpc方法名
+	Target    string `protobuf:"bytes,6,opt,name=target,proto3" json:"target,omitempty"`       // 操作的目标节点
+	Params    string `protobuf:"bytes,7,opt,name=params,proto3" json:"params,omitempty"`       // 脱敏后的请求参数, json格式
+	Success   bool   `protobuf:"varint,8,opt,name=success,proto3" json:"success,omitempty"`
+	Msg       string `protobuf:"bytes,9,opt,name=msg,proto3" json:"msg,omitempty"` // 返回结果或者失败原因
 }
 
-func (x *PingResult) Reset() {
-	*x = PingResult{}
+func (x *AuditEntry) Reset() {
+	*x = AuditEntry{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_rpc_server_proto_msgTypes[45]
+		mi := &file_rpc_server_proto_msgTypes[39]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *PingResult) String() string {
+func (x *AuditEntry) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*PingResult) ProtoMessage() {}
+func (*AuditEntry) ProtoMessage() {}
 
-func (x *PingResult) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_server_proto_msgTypes[45]
+func (x *AuditEntry) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_server_proto_msgTypes[39]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2839,92 +3078,104 @@ func (x *PingResult) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use PingResult.ProtoReflect.Descriptor instead.
-func (*PingResult) Descriptor() ([]byte, []int) {
-	return file_rpc_server_proto_rawDescGZIP(), []int{45}
+// Deprecated: Use AuditEntry.ProtoReflect.Descriptor instead.
+func (*AuditEntry) Descriptor() ([]byte, []int) {
+	return file_rpc_server_proto_rawDescGZIP(), []int{39}
 }
 
-func (x *PingResult) GetGeo() string {
+func (x *AuditEntry) GetTime() int64 {
 	if x != nil {
-		return x.Geo
+		return x.Time
+	}
+	return 0
+}
+
+func (x *AuditEntry) GetNode() string {
+	if x != nil {
+		return x.Node
 	}
 	return ""
 }
 
-func (x *PingResult) GetIsp() string {
+func (x *AuditEntry) GetSource() string {
 	if x != nil {
-		return x.Isp
+		return x.Source
 	}
 	return ""
 }
 
-func (x *PingResult) GetMaxDelay() float32 {
+func (x *AuditEntry) GetCaller() string {
 	if x != nil {
-		return x.MaxDelay
+		return x.Caller
 	}
-	return 0
+	return ""
 }
 
-func (x *PingResult) GetMinDelay() float32 {
+func (x *AuditEntry) GetOperation() string {
 	if x != nil {
-		return x.MinDelay
+		return x.Operation
 	}
-	return 0
+	return ""
 }
 
-func (x *PingResult) GetStDevDelay() float32 {
+func (x *AuditEntry) GetTarget() string {
 	if x != nil {
-		return x.StDevDelay
+		return x.Target
 	}
-	return 0
+	return ""
 }
 
-func (x *PingResult) GetAvgDelay() float32 {
+func (x *AuditEntry) GetParams() string {
 	if x != nil {
-		return x.AvgDelay
+		return x.Params
 	}
-	return 0
+	return ""
 }
 
-func (x *PingResult) GetLoss() float32 {
+func (x *AuditEntry) GetSuccess() bool {
 	if x != nil {
-		return x.Loss
+		return x.Success
 	}
-	return 0
+	return false
 }
 
-func (x *PingResult) GetLatestDelay() float32 {
+func (x *AuditEntry) GetMsg() string {
 	if x != nil {
-		return x.LatestDelay
+		return x.Msg
 	}
-	return 0
+	return ""
 }
 
-type GetPingMetricReq struct {
+type GetAuditLogReq struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
 	NodeAuthInfo *NodeAuthInfo `protobuf:"bytes,1,opt,name=node_auth_info,json=nodeAuthInfo,proto3" json:"node_auth_info,omitempty"`
+	Start        int64         `protobuf:"varint,2,opt,name=start,proto3" json:"start,omitempty"` // 秒级时间戳
+	End          int64         `protobuf:"varint,3,opt,name=end,proto3" json:"end,omitempty"`
+	Caller       string        `protobuf:"bytes,4,opt,name=caller,proto3" json:"caller,omitempty"`       // 为空时不过滤
+	Operation    string        `protobuf:"bytes,5,opt,name=operation,proto3" json:"operation,omitempty"` // 为空时不过滤
+	Limit        int32         `protobuf:"varint,6,opt,name=limit,proto3" json:"limit,omitempty"`        // 返回最近的limit条记录
 }
 
-func (x *GetPingMetricReq) Reset() {
-	*x = GetPingMetricReq{}
+func (x *GetAuditLogReq) Reset() {
+	*x = GetAuditLogReq{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_rpc_server_proto_msgTypes[46]
+		mi := &file_rpc_server_proto_msgTypes[40]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *GetPingMetricReq) String() string {
+func (x *GetAuditLogReq) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetPingMetricReq) ProtoMessage() {}
+func (*GetAuditLogReq) ProtoMessage() {}
 
-func (x *GetPingMetricReq) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_server_proto_msgTypes[46]
+func (x *GetAuditLogReq) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_server_proto_msgTypes[40]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2935,45 +3186,80 @@ func (x *GetPingMetricReq) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetPingMetricReq.ProtoReflect.Descriptor instead.
-func (*GetPingMetricReq) Descriptor() ([]byte, []int) {
-	return file_rpc_server_proto_rawDescGZIP(), []int{46}
+// Deprecated: Use GetAuditLogReq.ProtoReflect.Descriptor instead.
+func (*GetAuditLogReq) Descriptor() ([]byte, []int) {
+	return file_rpc_server_proto_rawDescGZIP(), []int{40}
 }
 
-func (x *GetPingMetricReq) GetNodeAuthInfo() *NodeAuthInfo {
+func (x *GetAuditLogReq) GetNodeAuthInfo() *NodeAuthInfo {
 	if x != nil {
 		return x.NodeAuthInfo
 	}
 	return nil
 }
 
-type GetPingMetricRsp struct {
+func (x *GetAuditLogReq) GetStart() int64 {
+	if x != nil {
+		return x.Start
+	}
+	return 0
+}
+
+func (x *GetAuditLogReq) GetEnd() int64 {
+	if x != nil {
+		return x.End
+	}
+	return 0
+}
+
+func (x *GetAuditLogReq) GetCaller() string {
+	if x != nil {
+		return x.Caller
+	}
+	return ""
+}
+
+func (x *GetAuditLogReq) GetOperation() string {
+	if x != nil {
+		return x.Operation
+	}
+	return ""
+}
+
+func (x *GetAuditLogReq) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type GetAuditLogRsp struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Code   int32       `protobuf:"varint,1,opt,name=code,proto3" json:"code,omitempty"`
-	Msg    string      `protobuf:"bytes,2,opt,name=msg,proto3" json:"msg,omitempty"`
-	Metric *PingMetric `protobuf:"bytes,3,opt,name=metric,proto3" json:"metric,omitempty"`
+	Code    int32         `protobuf:"varint,1,opt,name=code,proto3" json:"code,omitempty"`
+	Msg     string        `protobuf:"bytes,2,opt,name=msg,proto3" json:"msg,omitempty"`
+	Entries []*AuditEntry `protobuf:"bytes,3,rep,name=entries,proto3" json:"entries,omitempty"`
 }
 
-func (x *GetPingMetricRsp) Reset() {
-	*x = GetPingMetricRsp{}
+func (x *GetAuditLogRsp) Reset() {
+	*x = GetAuditLogRsp{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_rpc_server_proto_msgTypes[47]
+		mi := &file_rpc_server_proto_msgTypes[41]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *GetPingMetricRsp) String() string {
+func (x *GetAuditLogRsp) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetPingMetricRsp) ProtoMessage() {}
+func (*GetAuditLogRsp) ProtoMessage() {}
 
-func (x *GetPingMetricRsp) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_server_proto_msgTypes[47]
+func (x *GetAuditLogRsp) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_server_proto_msgTypes[41]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2984,57 +3270,58 @@ func (x *GetPingMetricRsp) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetPingMetricRsp.ProtoReflect.Descriptor instead.
-func (*GetPingMetricRsp) Descriptor() ([]byte, []int) {
-	return file_rpc_server_proto_rawDescGZIP(), []int{47}
+// Deprecated: Use GetAuditLogRsp.ProtoReflect.Descriptor instead.
+func (*GetAuditLogRsp) Descriptor() ([]byte, []int) {
+	return file_rpc_server_proto_rawDescGZIP(), []int{41}
 }
 
-func (x *GetPingMetricRsp) GetCode() int32 {
+func (x *GetAuditLogRsp) GetCode() int32 {
 	if x != nil {
 		return x.Code
 	}
 	return 0
 }
 
-func (x *GetPingMetricRsp) GetMsg() string {
+func (x *GetAuditLogRsp) GetMsg() string {
 	if x != nil {
 		return x.Msg
 	}
 	return ""
 }
 
-func (x *GetPingMetricRsp) GetMetric() *PingMetric {
+func (x *GetAuditLogRsp) GetEntries() []*AuditEntry {
 	if x != nil {
-		return x.Metric
+		return x.Entries
 	}
 	return nil
 }
 
-type GetClutersReq struct {
+type InboundOpReq struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	ClusterName string `protobuf:"bytes,1,opt,name=cluster_name,json=clusterName,proto3" json:"cluster_name,omitempty"`
+	NodeAuthInfo *NodeAuthInfo `protobuf:"bytes,1,opt,name=node_auth_info,json=nodeAuthInfo,proto3" json:"node_auth_info,omitempty"`
+	InboundInfo  string        `protobuf:"bytes,2,opt,name=inboundInfo,proto3" json:"inboundInfo,omitempty"` // inbound info 添加inbound时为inbound对应的base64, 删除时为tag
 }
 
-func (x *GetClutersReq) Reset() {
-	*x = GetClutersReq{}
+func (x *InboundOpReq) Reset() {
+	*x = InboundOpReq{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_rpc_server_proto_msgTypes[48]
+		mi := &file_rpc_server_proto_msgTypes[42]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *GetClutersReq) String() string {
+func (x *InboundOpReq) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetClutersReq) ProtoMessage() {}
+func (*InboundOpReq) ProtoMessage() {}
 
-func (x *GetClutersReq) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_server_proto_msgTypes[48]
+func (x *InboundOpReq) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_server_proto_msgTypes[42]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3045,43 +3332,51 @@ func (x *GetClutersReq) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetClutersReq.ProtoReflect.Descriptor instead.
-func (*GetClutersReq) Descriptor() ([]byte, []int) {
-	return file_rpc_server_proto_rawDescGZIP(), []int{48}
+// Deprecated: Use InboundOpReq.ProtoReflect.Descriptor instead.
+func (*InboundOpReq) Descriptor() ([]byte, []int) {
+	return file_rpc_server_proto_rawDescGZIP(), []int{42}
 }
 
-func (x *GetClutersReq) GetClusterName() string {
+func (x *InboundOpReq) GetNodeAuthInfo() *NodeAuthInfo {
 	if x != nil {
-		return x.ClusterName
+		return x.NodeAuthInfo
+	}
+	return nil
+}
+
+func (x *InboundOpReq) GetInboundInfo() string {
+	if x != nil {
+		return x.InboundInfo
 	}
 	return ""
 }
 
-type GetClutersRsp struct {
+type InboundOpRsp struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	ClusterNames []string `protobuf:"bytes,1,rep,name=cluster_names,json=clusterNames,proto3" json:"cluster_names,omitempty"`
+	Code int32  `protobuf:"varint,1,opt,name=code,proto3" json:"code,omitempty"`
+	Msg  string `protobuf:"bytes,2,opt,name=msg,proto3" json:"msg,omitempty"`
 }
 
-func (x *GetClutersRsp) Reset() {
-	*x = GetClutersRsp{}
+func (x *InboundOpRsp) Reset() {
+	*x = InboundOpRsp{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_rpc_server_proto_msgTypes[49]
+		mi := &file_rpc_server_proto_msgTypes[43]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *GetClutersRsp) String() string {
+func (x *InboundOpRsp) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetClutersRsp) ProtoMessage() {}
+func (*InboundOpRsp) ProtoMessage() {}
 
-func (x *GetClutersRsp) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_server_proto_msgTypes[49]
+func (x *InboundOpRsp) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_server_proto_msgTypes[43]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3092,43 +3387,52 @@ func (x *GetClutersRsp) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetClutersRsp.ProtoReflect.Descriptor instead.
-func (*GetClutersRsp) Descriptor() ([]byte, []int) {
-	return file_rpc_server_proto_rawDescGZIP(), []int{49}
+// Deprecated: Use InboundOpRsp.ProtoReflect.Descriptor instead.
+func (*InboundOpRsp) Descriptor() ([]byte, []int) {
+	return file_rpc_server_proto_rawDescGZIP(), []int{43}
 }
 
-func (x *GetClutersRsp) GetClusterNames() []string {
+func (x *InboundOpRsp) GetCode() int32 {
 	if x != nil {
-		return x.ClusterNames
+		return x.Code
 	}
-	return nil
+	return 0
 }
 
-type GetNodesReq struct {
+func (x *InboundOpRsp) GetMsg() string {
+	if x != nil {
+		return x.Msg
+	}
+	return ""
+}
+
+type TransferInboundReq struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	ClusterName string `protobuf:"bytes,1,opt,name=cluster_name,json=clusterName,proto3" json:"cluster_name,omitempty"`
+	NodeAuthInfo *NodeAuthInfo `protobuf:"bytes,1,opt,name=node_auth_info,json=nodeAuthInfo,proto3" json:"node_auth_info,omitempty"`
+	Tag          string        `protobuf:"bytes,2,opt,name=tag,proto3" json:"tag,omitempty"`
+	NewPort      int32         `protobuf:"varint,3,opt,name=new_port,json=newPort,proto3" json:"new_port,omitempty"`
 }
 
-func (x *GetNodesReq) Reset() {
-	*x = GetNodesReq{}
+func (x *TransferInboundReq) Reset() {
+	*x = TransferInboundReq{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_rpc_server_proto_msgTypes[50]
+		mi := &file_rpc_server_proto_msgTypes[44]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *GetNodesReq) String() string {
+func (x *TransferInboundReq) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetNodesReq) ProtoMessage() {}
+func (*TransferInboundReq) ProtoMessage() {}
 
-func (x *GetNodesReq) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_server_proto_msgTypes[50]
+func (x *TransferInboundReq) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_server_proto_msgTypes[44]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3139,44 +3443,62 @@ func (x *GetNodesReq) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetNodesReq.ProtoReflect.Descriptor instead.
-func (*GetNodesReq) Descriptor() ([]byte, []int) {
-	return file_rpc_server_proto_rawDescGZIP(), []int{50}
+// Deprecated: Use TransferInboundReq.ProtoReflect.Descriptor instead.
+func (*TransferInboundReq) Descriptor() ([]byte, []int) {
+	return file_rpc_server_proto_rawDescGZIP(), []int{44}
 }
 
-func (x *GetNodesReq) GetClusterName() string {
+func (x *TransferInboundReq) GetNodeAuthInfo() *NodeAuthInfo {
 	if x != nil {
-		return x.ClusterName
+		return x.NodeAuthInfo
+	}
+	return nil
+}
+
+func (x *TransferInboundReq) GetTag() string {
+	if x != nil {
+		return x.Tag
 	}
 	return ""
 }
 
-type GetNodesRsp struct {
+func (x *TransferInboundReq) GetNewPort() int32 {
+	if x != nil {
+		return x.NewPort
+	}
+	return 0
+}
+
+type CopyInboundReq struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	ClusterName string           `protobuf:"bytes,1,opt,name=cluster_name,json=clusterName,proto3" json:"cluster_name,omitempty"`
-	NodesMap    map[string]*Node `protobuf:"bytes,2,rep,name=nodesMap,proto3" json:"nodesMap,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	NodeAuthInfo *NodeAuthInfo `protobuf:"bytes,1,opt,name=node_auth_info,json=nodeAuthInfo,proto3" json:"node_auth_info,omitempty"`
+	SrcTag       string        `protobuf:"bytes,2,opt,name=src_tag,json=srcTag,proto3" json:"src_tag,omitempty"`
+	NewTag       string        `protobuf:"bytes,3,opt,name=new_tag,json=newTag,proto3" json:"new_tag,omitempty"`
+	NewPort      int32         `protobuf:"varint,4,opt,name=new_port,json=newPort,proto3" json:"new_port,omitempty"`
+	IsCopyUser   bool          `protobuf:"varint,5,opt,name=is_copy_user,json=isCopyUser,proto3" json:"is_copy_user,omitempty"`
+	NewProtocol  string        `protobuf:"bytes,6,opt,name=new_protocol,json=newProtocol,proto3" json:"new_protocol,omitempty"`
 }
 
-func (x *GetNodesRsp) Reset() {
-	*x = GetNodesRsp{}
+func (x *CopyInboundReq) Reset() {
+	*x = CopyInboundReq{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_rpc_server_proto_msgTypes[51]
+		mi := &file_rpc_server_proto_msgTypes[45]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *GetNodesRsp) String() string {
+func (x *CopyInboundReq) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetNodesRsp) ProtoMessage() {}
+func (*CopyInboundReq) ProtoMessage() {}
 
-func (x *GetNodesRsp) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_server_proto_msgTypes[51]
+func (x *CopyInboundReq) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_server_proto_msgTypes[45]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3187,408 +3509,3091 @@ func (x *GetNodesRsp) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetNodesRsp.ProtoReflect.Descriptor instead.
-func (*GetNodesRsp) Descriptor() ([]byte, []int) {
-	return file_rpc_server_proto_rawDescGZIP(), []int{51}
+// Deprecated: Use CopyInboundReq.ProtoReflect.Descriptor instead.
+func (*CopyInboundReq) Descriptor() ([]byte, []int) {
+	return file_rpc_server_proto_rawDescGZIP(), []int{45}
 }
 
-func (x *GetNodesRsp) GetClusterName() string {
+func (x *CopyInboundReq) GetNodeAuthInfo() *NodeAuthInfo {
 	if x != nil {
-		return x.ClusterName
+		return x.NodeAuthInfo
+	}
+	return nil
+}
+
+func (x *CopyInboundReq) GetSrcTag() string {
+	if x != nil {
+		return x.SrcTag
 	}
 	return ""
 }
 
-func (x *GetNodesRsp) GetNodesMap() map[string]*Node {
+func (x *CopyInboundReq) GetNewTag() string {
 	if x != nil {
-		return x.NodesMap
+		return x.NewTag
 	}
-	return nil
+	return ""
 }
 
-var File_rpc_server_proto protoreflect.FileDescriptor
+func (x *CopyInboundReq) GetNewPort() int32 {
+	if x != nil {
+		return x.NewPort
+	}
+	return 0
+}
 
-var file_rpc_server_proto_rawDesc = []byte{
-	0x0a, 0x10, 0x72, 0x70, 0x63, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x12, 0x05, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x9b, 0x01, 0x0a, 0x04, 0x55, 0x73,
-	0x65, 0x72, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x61, 0x73, 0x73, 0x77, 0x64,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x70, 0x61, 0x73, 0x73, 0x77, 0x64, 0x12, 0x1f,
-	0x0a, 0x0b, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x03, 0x20,
-	0x01, 0x28, 0x03, 0x52, 0x0a, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x12,
-	0x12, 0x0a, 0x04, 0x74, 0x61, 0x67, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x09, 0x52, 0x04, 0x74,
-	0x61, 0x67, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x64, 0x6f, 0x77, 0x6e, 0x6c, 0x69, 0x6e, 0x6b, 0x18,
-	0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x64, 0x6f, 0x77, 0x6e, 0x6c, 0x69, 0x6e, 0x6b, 0x12,
-	0x16, 0x0a, 0x06, 0x75, 0x70, 0x6c, 0x69, 0x6e, 0x6b, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52,
-	0x06, 0x75, 0x70, 0x6c, 0x69, 0x6e, 0x6b, 0x22, 0x45, 0x0a, 0x0c, 0x4e, 0x6f, 0x64, 0x65, 0x41,
-	0x75, 0x74, 0x68, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x1f, 0x0a,
-	0x04, 0x6e, 0x6f, 0x64, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x2e, 0x4e, 0x6f, 0x64, 0x65, 0x52, 0x04, 0x6e, 0x6f, 0x64, 0x65, 0x22, 0x48,
-	0x0a, 0x0b, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x73, 0x52, 0x65, 0x71, 0x12, 0x39, 0x0a,
-	0x0e, 0x6e, 0x6f, 0x64, 0x65, 0x5f, 0x61, 0x75, 0x74, 0x68, 0x5f, 0x69, 0x6e, 0x66, 0x6f, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x4e, 0x6f,
-	0x64, 0x65, 0x41, 0x75, 0x74, 0x68, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x0c, 0x6e, 0x6f, 0x64, 0x65,
-	0x41, 0x75, 0x74, 0x68, 0x49, 0x6e, 0x66, 0x6f, 0x22, 0x56, 0x0a, 0x0b, 0x47, 0x65, 0x74, 0x55,
-	0x73, 0x65, 0x72, 0x73, 0x52, 0x73, 0x70, 0x12, 0x12, 0x0a, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x6d,
-	0x73, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6d, 0x73, 0x67, 0x12, 0x21, 0x0a,
-	0x05, 0x75, 0x73, 0x65, 0x72, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x52, 0x05, 0x75, 0x73, 0x65, 0x72, 0x73,
-	0x22, 0x69, 0x0a, 0x09, 0x55, 0x73, 0x65, 0x72, 0x4f, 0x70, 0x52, 0x65, 0x71, 0x12, 0x39, 0x0a,
-	0x0e, 0x6e, 0x6f, 0x64, 0x65, 0x5f, 0x61, 0x75, 0x74, 0x68, 0x5f, 0x69, 0x6e, 0x66, 0x6f, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x4e, 0x6f,
-	0x64, 0x65, 0x41, 0x75, 0x74, 0x68, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x0c, 0x6e, 0x6f, 0x64, 0x65,
-	0x41, 0x75, 0x74, 0x68, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x21, 0x0a, 0x05, 0x75, 0x73, 0x65, 0x72,
-	0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e,
-	0x55, 0x73, 0x65, 0x72, 0x52, 0x05, 0x75, 0x73, 0x65, 0x72, 0x73, 0x22, 0x31, 0x0a, 0x09, 0x55,
-	0x73, 0x65, 0x72, 0x4f, 0x70, 0x52, 0x73, 0x70, 0x12, 0x12, 0x0a, 0x04, 0x63, 0x6f, 0x64, 0x65,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x12, 0x10, 0x0a, 0x03,
-	0x6d, 0x73, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6d, 0x73, 0x67, 0x22, 0xad,
-	0x01, 0x0a, 0x09, 0x47, 0x65, 0x74, 0x53, 0x75, 0x62, 0x52, 0x65, 0x71, 0x12, 0x39, 0x0a, 0x0e,
-	0x6e, 0x6f, 0x64, 0x65, 0x5f, 0x61, 0x75, 0x74, 0x68, 0x5f, 0x69, 0x6e, 0x66, 0x6f, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x4e, 0x6f, 0x64,
-	0x65, 0x41, 0x75, 0x74, 0x68, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x0c, 0x6e, 0x6f, 0x64, 0x65, 0x41,
-	0x75, 0x74, 0x68, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x1f, 0x0a, 0x04, 0x75, 0x73, 0x65, 0x72, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x55, 0x73,
-	0x65, 0x72, 0x52, 0x04, 0x75, 0x73, 0x65, 0x72, 0x12, 0x2b, 0x0a, 0x11, 0x65, 0x78, 0x63, 0x6c,
-	0x75, 0x64, 0x65, 0x5f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x73, 0x18, 0x03, 0x20,
-	0x03, 0x28, 0x09, 0x52, 0x10, 0x65, 0x78, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x50, 0x72, 0x6f, 0x74,
-	0x6f, 0x63, 0x6f, 0x6c, 0x73, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x5f, 0x73, 0x6e, 0x69,
-	0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x75, 0x73, 0x65, 0x53, 0x6e, 0x69, 0x22, 0x45,
-	0x0a, 0x09, 0x47, 0x65, 0x74, 0x53, 0x75, 0x62, 0x52, 0x73, 0x70, 0x12, 0x12, 0x0a, 0x04, 0x63,
-	0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x12,
-	0x10, 0x0a, 0x03, 0x6d, 0x73, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6d, 0x73,
-	0x67, 0x12, 0x12, 0x0a, 0x04, 0x75, 0x72, 0x69, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52,
-	0x04, 0x75, 0x72, 0x69, 0x73, 0x22, 0x49, 0x0a, 0x0c, 0x48, 0x65, 0x61, 0x72, 0x74, 0x42, 0x65,
-	0x61, 0x74, 0x52, 0x65, 0x71, 0x12, 0x39, 0x0a, 0x0e, 0x6e, 0x6f, 0x64, 0x65, 0x5f, 0x61, 0x75,
-	0x74, 0x68, 0x5f, 0x69, 0x6e, 0x66, 0x6f, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x4e, 0x6f, 0x64, 0x65, 0x41, 0x75, 0x74, 0x68, 0x49, 0x6e,
-	0x66, 0x6f, 0x52, 0x0c, 0x6e, 0x6f, 0x64, 0x65, 0x41, 0x75, 0x74, 0x68, 0x49, 0x6e, 0x66, 0x6f,
-	0x22, 0x65, 0x0a, 0x04, 0x4e, 0x6f, 0x64, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x68, 0x6f, 0x73, 0x74,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x68, 0x6f, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04,
-	0x70, 0x6f, 0x72, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x70, 0x6f, 0x72, 0x74,
-	0x12, 0x21, 0x0a, 0x0c, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x5f, 0x6e, 0x61, 0x6d, 0x65,
-	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x4e,
-	0x61, 0x6d, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0xbd, 0x01, 0x0a, 0x0c, 0x48, 0x65, 0x61, 0x72,
-	0x74, 0x42, 0x65, 0x61, 0x74, 0x52, 0x73, 0x70, 0x12, 0x12, 0x0a, 0x04, 0x63, 0x6f, 0x64, 0x65,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x12, 0x10, 0x0a, 0x03,
-	0x6d, 0x73, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6d, 0x73, 0x67, 0x12, 0x3d,
-	0x0a, 0x08, 0x6e, 0x6f, 0x64, 0x65, 0x73, 0x4d, 0x61, 0x70, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b,
-	0x32, 0x21, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x48, 0x65, 0x61, 0x72, 0x74, 0x42, 0x65,
-	0x61, 0x74, 0x52, 0x73, 0x70, 0x2e, 0x4e, 0x6f, 0x64, 0x65, 0x73, 0x4d, 0x61, 0x70, 0x45, 0x6e,
-	0x74, 0x72, 0x79, 0x52, 0x08, 0x6e, 0x6f, 0x64, 0x65, 0x73, 0x4d, 0x61, 0x70, 0x1a, 0x48, 0x0a,
-	0x0d, 0x4e, 0x6f, 0x64, 0x65, 0x73, 0x4d, 0x61, 0x70, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10,
-	0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79,
-	0x12, 0x21, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x0b, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x4e, 0x6f, 0x64, 0x65, 0x52, 0x05, 0x76, 0x61,
-	0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x7e, 0x0a, 0x05, 0x4e, 0x6f, 0x64, 0x65, 0x73,
-	0x12, 0x2d, 0x0a, 0x05, 0x6e, 0x6f, 0x64, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32,
-	0x17, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x4e, 0x6f, 0x64, 0x65, 0x73, 0x2e, 0x4e, 0x6f,
-	0x64, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x05, 0x6e, 0x6f, 0x64, 0x65, 0x73, 0x1a,
-	0x46, 0x0a, 0x0a, 0x4e, 0x6f, 0x64, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a,
-	0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12,
-	0x22, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c,
-	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x4e, 0x6f, 0x64, 0x65, 0x73, 0x52, 0x05, 0x76, 0x61,
-	0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x4c, 0x0a, 0x0f, 0x52, 0x65, 0x67, 0x69, 0x73,
-	0x74, 0x65, 0x72, 0x4e, 0x6f, 0x64, 0x65, 0x52, 0x65, 0x71, 0x12, 0x39, 0x0a, 0x0e, 0x6e, 0x6f,
-	0x64, 0x65, 0x5f, 0x61, 0x75, 0x74, 0x68, 0x5f, 0x69, 0x6e, 0x66, 0x6f, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x13, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x4e, 0x6f, 0x64, 0x65, 0x41,
-	0x75, 0x74, 0x68, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x0c, 0x6e, 0x6f, 0x64, 0x65, 0x41, 0x75, 0x74,
-	0x68, 0x49, 0x6e, 0x66, 0x6f, 0x22, 0x4b, 0x0a, 0x0f, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65,
-	0x72, 0x4e, 0x6f, 0x64, 0x65, 0x52, 0x73, 0x70, 0x12, 0x12, 0x0a, 0x04, 0x63, 0x6f, 0x64, 0x65,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x12, 0x10, 0x0a, 0x03,
-	0x6d, 0x73, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6d, 0x73, 0x67, 0x12, 0x12,
-	0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x64, 0x61,
-	0x74, 0x61, 0x22, 0x51, 0x0a, 0x14, 0x47, 0x65, 0x74, 0x42, 0x61, 0x6e, 0x64, 0x77, 0x69, 0x64,
-	0x74, 0x68, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x65, 0x71, 0x12, 0x39, 0x0a, 0x0e, 0x6e, 0x6f,
-	0x64, 0x65, 0x5f, 0x61, 0x75, 0x74, 0x68, 0x5f, 0x69, 0x6e, 0x66, 0x6f, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x13, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x4e, 0x6f, 0x64, 0x65, 0x41,
-	0x75, 0x74, 0x68, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x0c, 0x6e, 0x6f, 0x64, 0x65, 0x41, 0x75, 0x74,
-	0x68, 0x49, 0x6e, 0x66, 0x6f, 0x22, 0x91, 0x01, 0x0a, 0x05, 0x53, 0x74, 0x61, 0x74, 0x73, 0x12,
-	0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e,
-	0x61, 0x6d, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x64, 0x6f, 0x77, 0x6e, 0x6c,
-	0x69, 0x6e, 0x6b, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x64, 0x6f, 0x77, 0x6e, 0x6c,
-	0x69, 0x6e, 0x6b, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x70, 0x6c, 0x69, 0x6e, 0x6b, 0x18, 0x04, 0x20,
-	0x01, 0x28, 0x03, 0x52, 0x06, 0x75, 0x70, 0x6c, 0x69, 0x6e, 0x6b, 0x12, 0x14, 0x0a, 0x05, 0x70,
-	0x72, 0x6f, 0x78, 0x79, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x70, 0x72, 0x6f, 0x78,
-	0x79, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x06, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x22, 0x60, 0x0a, 0x14, 0x47, 0x65, 0x74,
-	0x42, 0x61, 0x6e, 0x64, 0x77, 0x69, 0x64, 0x74, 0x68, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x73,
-	0x70, 0x12, 0x12, 0x0a, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52,
-	0x04, 0x63, 0x6f, 0x64, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x6d, 0x73, 0x67, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x03, 0x6d, 0x73, 0x67, 0x12, 0x22, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x74, 0x73,
-	0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x53,
-	0x74, 0x61, 0x74, 0x73, 0x52, 0x05, 0x73, 0x74, 0x61, 0x74, 0x73, 0x22, 0x6b, 0x0a, 0x0c, 0x49,
-	0x6e, 0x62, 0x6f, 0x75, 0x6e, 0x64, 0x4f, 0x70, 0x52, 0x65, 0x71, 0x12, 0x39, 0x0a, 0x0e, 0x6e,
-	0x6f, 0x64, 0x65, 0x5f, 0x61, 0x75, 0x74, 0x68, 0x5f, 0x69, 0x6e, 0x66, 0x6f, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x4e, 0x6f, 0x64, 0x65,
-	0x41, 0x75, 0x74, 0x68, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x0c, 0x6e, 0x6f, 0x64, 0x65, 0x41, 0x75,
-	0x74, 0x68, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x20, 0x0a, 0x0b, 0x69, 0x6e, 0x62, 0x6f, 0x75, 0x6e,
-	0x64, 0x49, 0x6e, 0x66, 0x6f, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x69, 0x6e, 0x62,
-	0x6f, 0x75, 0x6e, 0x64, 0x49, 0x6e, 0x66, 0x6f, 0x22, 0x34, 0x0a, 0x0c, 0x49, 0x6e, 0x62, 0x6f,
-	0x75, 0x6e, 0x64, 0x4f, 0x70, 0x52, 0x73, 0x70, 0x12, 0x12, 0x0a, 0x04, 0x63, 0x6f, 0x64, 0x65,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x12, 0x10, 0x0a, 0x03,
-	0x6d, 0x73, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6d, 0x73, 0x67, 0x22, 0x7c,
-	0x0a, 0x12, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x49, 0x6e, 0x62, 0x6f, 0x75, 0x6e,
-	0x64, 0x52, 0x65, 0x71, 0x12, 0x39, 0x0a, 0x0e, 0x6e, 0x6f, 0x64, 0x65, 0x5f, 0x61, 0x75, 0x74,
+func (x *CopyInboundReq) GetIsCopyUser() bool {
+	if x != nil {
+		return x.IsCopyUser
+	}
+	return false
+}
+
+func (x *CopyInboundReq) GetNewProtocol() string {
+	if x != nil {
+		return x.NewProtocol
+	}
+	return ""
+}
+
+type CopyUserReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	NodeAuthInfo *NodeAuthInfo `protobuf:"bytes,1,opt,name=node_auth_info,json=nodeAuthInfo,proto3" json:"node_auth_info,omitempty"`
+	SrcTag       string        `protobuf:"bytes,2,opt,name=src_tag,json=srcTag,proto3" json:"src_tag,omitempty"`
+	DstTag       string        `protobuf:"bytes,3,opt,name=dst_tag,json=dstTag,proto3" json:"dst_tag,omitempty"`
+}
+
+func (x *CopyUserReq) Reset() {
+	*x = CopyUserReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpc_server_proto_msgTypes[46]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CopyUserReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CopyUserReq) ProtoMessage() {}
+
+func (x *CopyUserReq) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_server_proto_msgTypes[46]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CopyUserReq.ProtoReflect.Descriptor instead.
+func (*CopyUserReq) Descriptor() ([]byte, []int) {
+	return file_rpc_server_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *CopyUserReq) GetNodeAuthInfo() *NodeAuthInfo {
+	if x != nil {
+		return x.NodeAuthInfo
+	}
+	return nil
+}
+
+func (x *CopyUserReq) GetSrcTag() string {
+	if x != nil {
+		return x.SrcTag
+	}
+	return ""
+}
+
+func (x *CopyUserReq) GetDstTag() string {
+	if x != nil {
+		return x.DstTag
+	}
+	return ""
+}
+
+type GetInboundReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	NodeAuthInfo *NodeAuthInfo `protobuf:"bytes,1,opt,name=node_auth_info,json=nodeAuthInfo,proto3" json:"node_auth_info,omitempty"`
+	Tag          string        `protobuf:"bytes,2,opt,name=tag,proto3" json:"tag,omitempty"`
+}
+
+func (x *GetInboundReq) Reset() {
+	*x = GetInboundReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpc_server_proto_msgTypes[47]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetInboundReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetInboundReq) ProtoMessage() {}
+
+func (x *GetInboundReq) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_server_proto_msgTypes[47]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetInboundReq.ProtoReflect.Descriptor instead.
+func (*GetInboundReq) Descriptor() ([]byte, []int) {
+	return file_rpc_server_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *GetInboundReq) GetNodeAuthInfo() *NodeAuthInfo {
+	if x != nil {
+		return x.NodeAuthInfo
+	}
+	return nil
+}
+
+func (x *GetInboundReq) GetTag() string {
+	if x != nil {
+		return x.Tag
+	}
+	return ""
+}
+
+type GetInboundRsp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Code int32  `protobuf:"varint,1,opt,name=code,proto3" json:"code,omitempty"`
+	Msg  string `protobuf:"bytes,2,opt,name=msg,proto3" json:"msg,omitempty"`
+	Data string `protobuf:"bytes,3,opt,name=data,proto3" json:"data,omitempty"`
+}
+
+func (x *GetInboundRsp) Reset() {
+	*x = GetInboundRsp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpc_server_proto_msgTypes[48]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetInboundRsp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetInboundRsp) ProtoMessage() {}
+
+func (x *GetInboundRsp) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_server_proto_msgTypes[48]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetInboundRsp.ProtoReflect.Descriptor instead.
+func (*GetInboundRsp) Descriptor() ([]byte, []int) {
+	return file_rpc_server_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *GetInboundRsp) GetCode() int32 {
+	if x != nil {
+		return x.Code
+	}
+	return 0
+}
+
+func (x *GetInboundRsp) GetMsg() string {
+	if x != nil {
+		return x.Msg
+	}
+	return ""
+}
+
+func (x *GetInboundRsp) GetData() string {
+	if x != nil {
+		return x.Data
+	}
+	return ""
+}
+
+type GetTagReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	NodeAuthInfo *NodeAuthInfo `protobuf:"bytes,1,opt,name=node_auth_info,json=nodeAuthInfo,proto3" json:"node_auth_info,omitempty"`
+}
+
+func (x *GetTagReq) Reset() {
+	*x = GetTagReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpc_server_proto_msgTypes[49]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetTagReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTagReq) ProtoMessage() {}
+
+func (x *GetTagReq) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_server_proto_msgTypes[49]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTagReq.ProtoReflect.Descriptor instead.
+func (*GetTagReq) Descriptor() ([]byte, []int) {
+	return file_rpc_server_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *GetTagReq) GetNodeAuthInfo() *NodeAuthInfo {
+	if x != nil {
+		return x.NodeAuthInfo
+	}
+	return nil
+}
+
+type GetTagRsp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Code int32    `protobuf:"varint,1,opt,name=code,proto3" json:"code,omitempty"`
+	Msg  string   `protobuf:"bytes,2,opt,name=msg,proto3" json:"msg,omitempty"`
+	Tags []string `protobuf:"bytes,3,rep,name=tags,proto3" json:"tags,omitempty"`
+}
+
+func (x *GetTagRsp) Reset() {
+	*x = GetTagRsp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpc_server_proto_msgTypes[50]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetTagRsp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTagRsp) ProtoMessage() {}
+
+func (x *GetTagRsp) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_server_proto_msgTypes[50]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTagRsp.ProtoReflect.Descriptor instead.
+func (*GetTagRsp) Descriptor() ([]byte, []int) {
+	return file_rpc_server_proto_rawDescGZIP(), []int{50}
+}
+
+func (x *GetTagRsp) GetCode() int32 {
+	if x != nil {
+		return x.Code
+	}
+	return 0
+}
+
+func (x *GetTagRsp) GetMsg() string {
+	if x != nil {
+		return x.Msg
+	}
+	return ""
+}
+
+func (x *GetTagRsp) GetTags() []string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+type UpdateProxyReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	NodeAuthInfo *NodeAuthInfo `protobuf:"bytes,1,opt,name=node_auth_info,json=nodeAuthInfo,proto3" json:"node_auth_info,omitempty"`
+	Tag          string        `protobuf:"bytes,2,opt,name=tag,proto3" json:"tag,omitempty"`
+}
+
+func (x *UpdateProxyReq) Reset() {
+	*x = UpdateProxyReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpc_server_proto_msgTypes[51]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UpdateProxyReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateProxyReq) ProtoMessage() {}
+
+func (x *UpdateProxyReq) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_server_proto_msgTypes[51]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateProxyReq.ProtoReflect.Descriptor instead.
+func (*UpdateProxyReq) Descriptor() ([]byte, []int) {
+	return file_rpc_server_proto_rawDescGZIP(), []int{51}
+}
+
+func (x *UpdateProxyReq) GetNodeAuthInfo() *NodeAuthInfo {
+	if x != nil {
+		return x.NodeAuthInfo
+	}
+	return nil
+}
+
+func (x *UpdateProxyReq) GetTag() string {
+	if x != nil {
+		return x.Tag
+	}
+	return ""
+}
+
+type UpdateProxyRsp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Code int32  `protobuf:"varint,1,opt,name=code,proto3" json:"code,omitempty"`
+	Msg  string `protobuf:"bytes,2,opt,name=msg,proto3" json:"msg,omitempty"`
+}
+
+func (x *UpdateProxyRsp) Reset() {
+	*x = UpdateProxyRsp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpc_server_proto_msgTypes[52]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UpdateProxyRsp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateProxyRsp) ProtoMessage() {}
+
+func (x *UpdateProxyRsp) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_server_proto_msgTypes[52]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateProxyRsp.ProtoReflect.Descriptor instead.
+func (*UpdateProxyRsp) Descriptor() ([]byte, []int) {
+	return file_rpc_server_proto_rawDescGZIP(), []int{52}
+}
+
+func (x *UpdateProxyRsp) GetCode() int32 {
+	if x != nil {
+		return x.Code
+	}
+	return 0
+}
+
+func (x *UpdateProxyRsp) GetMsg() string {
+	if x != nil {
+		return x.Msg
+	}
+	return ""
+}
+
+type AdaptiveOpReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	NodeAuthInfo *NodeAuthInfo `protobuf:"bytes,1,opt,name=node_auth_info,json=nodeAuthInfo,proto3" json:"node_auth_info,omitempty"`
+	Ports        []string      `protobuf:"bytes,2,rep,name=ports,proto3" json:"ports,omitempty"` // 可以为port range port1-port2
+	Tags         []string      `protobuf:"bytes,3,rep,name=tags,proto3" json:"tags,omitempty"`
+}
+
+func (x *AdaptiveOpReq) Reset() {
+	*x = AdaptiveOpReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpc_server_proto_msgTypes[53]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AdaptiveOpReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdaptiveOpReq) ProtoMessage() {}
+
+func (x *AdaptiveOpReq) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_server_proto_msgTypes[53]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdaptiveOpReq.ProtoReflect.Descriptor instead.
+func (*AdaptiveOpReq) Descriptor() ([]byte, []int) {
+	return file_rpc_server_proto_rawDescGZIP(), []int{53}
+}
+
+func (x *AdaptiveOpReq) GetNodeAuthInfo() *NodeAuthInfo {
+	if x != nil {
+		return x.NodeAuthInfo
+	}
+	return nil
+}
+
+func (x *AdaptiveOpReq) GetPorts() []string {
+	if x != nil {
+		return x.Ports
+	}
+	return nil
+}
+
+func (x *AdaptiveOpReq) GetTags() []string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+type AdaptiveReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	NodeAuthInfo *NodeAuthInfo `protobuf:"bytes,1,opt,name=node_auth_info,json=nodeAuthInfo,proto3" json:"node_auth_info,omitempty"`
+	Tags         []string      `protobuf:"bytes,2,rep,name=tags,proto3" json:"tags,omitempty"`
+}
+
+func (x *AdaptiveReq) Reset() {
+	*x = AdaptiveReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpc_server_proto_msgTypes[54]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AdaptiveReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdaptiveReq) ProtoMessage() {}
+
+func (x *AdaptiveReq) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_server_proto_msgTypes[54]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdaptiveReq.ProtoReflect.Descriptor instead.
+func (*AdaptiveReq) Descriptor() ([]byte, []int) {
+	return file_rpc_server_proto_rawDescGZIP(), []int{54}
+}
+
+func (x *AdaptiveReq) GetNodeAuthInfo() *NodeAuthInfo {
+	if x != nil {
+		return x.NodeAuthInfo
+	}
+	return nil
+}
+
+func (x *AdaptiveReq) GetTags() []string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+type AdaptiveRsp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Code int32  `protobuf:"varint,1,opt,name=code,proto3" json:"code,omitempty"`
+	Msg  string `protobuf:"bytes,2,opt,name=msg,proto3" json:"msg,omitempty"`
+}
+
+func (x *AdaptiveRsp) Reset() {
+	*x = AdaptiveRsp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpc_server_proto_msgTypes[55]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AdaptiveRsp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdaptiveRsp) ProtoMessage() {}
+
+func (x *AdaptiveRsp) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_server_proto_msgTypes[55]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdaptiveRsp.ProtoReflect.Descriptor instead.
+func (*AdaptiveRsp) Descriptor() ([]byte, []int) {
+	return file_rpc_server_proto_rawDescGZIP(), []int{55}
+}
+
+func (x *AdaptiveRsp) GetCode() int32 {
+	if x != nil {
+		return x.Code
+	}
+	return 0
+}
+
+func (x *AdaptiveRsp) GetMsg() string {
+	if x != nil {
+		return x.Msg
+	}
+	return ""
+}
+
+type SetGatewayModelReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	NodeAuthInfo       *NodeAuthInfo `protobuf:"bytes,1,opt,name=node_auth_info,json=nodeAuthInfo,proto3" json:"node_auth_info,omitempty"`
+	EnableGatewayModel bool          `protobuf:"varint,2,opt,name=enableGatewayModel,proto3" json:"enableGatewayModel,omitempty"`
+}
+
+func (x *SetGatewayModelReq) Reset() {
+	*x = SetGatewayModelReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpc_server_proto_msgTypes[56]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SetGatewayModelReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetGatewayModelReq) ProtoMessage() {}
+
+func (x *SetGatewayModelReq) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_server_proto_msgTypes[56]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetGatewayModelReq.ProtoReflect.Descriptor instead.
+func (*SetGatewayModelReq) Descriptor() ([]byte, []int) {
+	return file_rpc_server_proto_rawDescGZIP(), []int{56}
+}
+
+func (x *SetGatewayModelReq) GetNodeAuthInfo() *NodeAuthInfo {
+	if x != nil {
+		return x.NodeAuthInfo
+	}
+	return nil
+}
+
+func (x *SetGatewayModelReq) GetEnableGatewayModel() bool {
+	if x != nil {
+		return x.EnableGatewayModel
+	}
+	return false
+}
+
+type SetGatewayModelRsp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Code int32  `protobuf:"varint,1,opt,name=code,proto3" json:"code,omitempty"`
+	Msg  string `protobuf:"bytes,2,opt,name=msg,proto3" json:"msg,omitempty"`
+}
+
+func (x *SetGatewayModelRsp) Reset() {
+	*x = SetGatewayModelRsp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpc_server_proto_msgTypes[57]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SetGatewayModelRsp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetGatewayModelRsp) ProtoMessage() {}
+
+func (x *SetGatewayModelRsp) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_server_proto_msgTypes[57]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetGatewayModelRsp.ProtoReflect.Descriptor instead.
+func (*SetGatewayModelRsp) Descriptor() ([]byte, []int) {
+	return file_rpc_server_proto_rawDescGZIP(), []int{57}
+}
+
+func (x *SetGatewayModelRsp) GetCode() int32 {
+	if x != nil {
+		return x.Code
+	}
+	return 0
+}
+
+func (x *SetGatewayModelRsp) GetMsg() string {
+	if x != nil {
+		return x.Msg
+	}
+	return ""
+}
+
+type ObtainNewCertReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	NodeAuthInfo *NodeAuthInfo `protobuf:"bytes,1,opt,name=node_auth_info,json=nodeAuthInfo,proto3" json:"node_auth_info,omitempty"`
+	Domain       string        `protobuf:"bytes,2,opt,name=domain,proto3" json:"domain,omitempty"`
+}
+
+func (x *ObtainNewCertReq) Reset() {
+	*x = ObtainNewCertReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpc_server_proto_msgTypes[58]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ObtainNewCertReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ObtainNewCertReq) ProtoMessage() {}
+
+func (x *ObtainNewCertReq) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_server_proto_msgTypes[58]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ObtainNewCertReq.ProtoReflect.Descriptor instead.
+func (*ObtainNewCertReq) Descriptor() ([]byte, []int) {
+	return file_rpc_server_proto_rawDescGZIP(), []int{58}
+}
+
+func (x *ObtainNewCertReq) GetNodeAuthInfo() *NodeAuthInfo {
+	if x != nil {
+		return x.NodeAuthInfo
+	}
+	return nil
+}
+
+func (x *ObtainNewCertReq) GetDomain() string {
+	if x != nil {
+		return x.Domain
+	}
+	return ""
+}
+
+type ObtainNewCertRsp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Code int32  `protobuf:"varint,1,opt,name=code,proto3" json:"code,omitempty"`
+	Msg  string `protobuf:"bytes,2,opt,name=msg,proto3" json:"msg,omitempty"`
+}
+
+func (x *ObtainNewCertRsp) Reset() {
+	*x = ObtainNewCertRsp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpc_server_proto_msgTypes[59]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ObtainNewCertRsp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ObtainNewCertRsp) ProtoMessage() {}
+
+func (x *ObtainNewCertRsp) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_server_proto_msgTypes[59]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ObtainNewCertRsp.ProtoReflect.Descriptor instead.
+func (*ObtainNewCertRsp) Descriptor() ([]byte, []int) {
+	return file_rpc_server_proto_rawDescGZIP(), []int{59}
+}
+
+func (x *ObtainNewCertRsp) GetCode() int32 {
+	if x != nil {
+		return x.Code
+	}
+	return 0
+}
+
+func (x *ObtainNewCertRsp) GetMsg() string {
+	if x != nil {
+		return x.Msg
+	}
+	return ""
+}
+
+type FastAddInboundReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	NodeAuthInfo       *NodeAuthInfo `protobuf:"bytes,1,opt,name=node_auth_info,json=nodeAuthInfo,proto3" json:"node_auth_info,omitempty"`
+	InboundBuilderType BuilderType   `protobuf:"varint,2,opt,name=inboundBuilderType,proto3,enum=proto.BuilderType" json:"inboundBuilderType,omitempty"`
+	StreamBuilderType  BuilderType   `protobuf:"varint,3,opt,name=streamBuilderType,proto3,enum=proto.BuilderType" json:"streamBuilderType,omitempty"`
+	Port               int32         `protobuf:"varint,4,opt,name=port,proto3" json:"port,omitempty"`
+	Domain             string        `protobuf:"bytes,5,opt,name=domain,proto3" json:"domain,omitempty"`
+	IsXtls             bool          `protobuf:"varint,6,opt,name=isXtls,proto3" json:"isXtls,omitempty"`
+	Tag                string        `protobuf:"bytes,7,opt,name=tag,proto3" json:"tag,omitempty"`
+}
+
+func (x *FastAddInboundReq) Reset() {
+	*x = FastAddInboundReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpc_server_proto_msgTypes[60]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FastAddInboundReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FastAddInboundReq) ProtoMessage() {}
+
+func (x *FastAddInboundReq) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_server_proto_msgTypes[60]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FastAddInboundReq.ProtoReflect.Descriptor instead.
+func (*FastAddInboundReq) Descriptor() ([]byte, []int) {
+	return file_rpc_server_proto_rawDescGZIP(), []int{60}
+}
+
+func (x *FastAddInboundReq) GetNodeAuthInfo() *NodeAuthInfo {
+	if x != nil {
+		return x.NodeAuthInfo
+	}
+	return nil
+}
+
+func (x *FastAddInboundReq) GetInboundBuilderType() BuilderType {
+	if x != nil {
+		return x.InboundBuilderType
+	}
+	return BuilderType_UnknowBuilderType
+}
+
+func (x *FastAddInboundReq) GetStreamBuilderType() BuilderType {
+	if x != nil {
+		return x.StreamBuilderType
+	}
+	return BuilderType_UnknowBuilderType
+}
+
+func (x *FastAddInboundReq) GetPort() int32 {
+	if x != nil {
+		return x.Port
+	}
+	return 0
+}
+
+func (x *FastAddInboundReq) GetDomain() string {
+	if x != nil {
+		return x.Domain
+	}
+	return ""
+}
+
+func (x *FastAddInboundReq) GetIsXtls() bool {
+	if x != nil {
+		return x.IsXtls
+	}
+	return false
+}
+
+func (x *FastAddInboundReq) GetTag() string {
+	if x != nil {
+		return x.Tag
+	}
+	return ""
+}
+
+type FastAddInboundRsp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Code int32  `protobuf:"varint,1,opt,name=code,proto3" json:"code,omitempty"`
+	Msg  string `protobuf:"bytes,2,opt,name=msg,proto3" json:"msg,omitempty"`
+}
+
+func (x *FastAddInboundRsp) Reset() {
+	*x = FastAddInboundRsp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpc_server_proto_msgTypes[61]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FastAddInboundRsp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FastAddInboundRsp) ProtoMessage() {}
+
+func (x *FastAddInboundRsp) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_server_proto_msgTypes[61]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FastAddInboundRsp.ProtoReflect.Descriptor instead.
+func (*FastAddInboundRsp) Descriptor() ([]byte, []int) {
+	return file_rpc_server_proto_rawDescGZIP(), []int{61}
+}
+
+func (x *FastAddInboundRsp) GetCode() int32 {
+	if x != nil {
+		return x.Code
+	}
+	return 0
+}
+
+func (x *FastAddInboundRsp) GetMsg() string {
+	if x != nil {
+		return x.Msg
+	}
+	return ""
+}
+
+type TransferCertReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	NodeAuthInfo *NodeAuthInfo `protobuf:"bytes,1,opt,name=node_auth_info,json=nodeAuthInfo,proto3" json:"node_auth_info,omitempty"`
+	Domain       string        `protobuf:"bytes,2,opt,name=domain,proto3" json:"domain,omitempty"`
+	CertData     []byte        `protobuf:"bytes,3,opt,name=cert_data,json=certData,proto3" json:"cert_data,omitempty"`
+	KeyDatas     []byte        `protobuf:"bytes,4,opt,name=key_datas,json=keyDatas,proto3" json:"key_datas,omitempty"`
+}
+
+func (x *TransferCertReq) Reset() {
+	*x = TransferCertReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpc_server_proto_msgTypes[62]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TransferCertReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TransferCertReq) ProtoMessage() {}
+
+func (x *TransferCertReq) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_server_proto_msgTypes[62]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TransferCertReq.ProtoReflect.Descriptor instead.
+func (*TransferCertReq) Descriptor() ([]byte, []int) {
+	return file_rpc_server_proto_rawDescGZIP(), []int{62}
+}
+
+func (x *TransferCertReq) GetNodeAuthInfo() *NodeAuthInfo {
+	if x != nil {
+		return x.NodeAuthInfo
+	}
+	return nil
+}
+
+func (x *TransferCertReq) GetDomain() string {
+	if x != nil {
+		return x.Domain
+	}
+	return ""
+}
+
+func (x *TransferCertReq) GetCertData() []byte {
+	if x != nil {
+		return x.CertData
+	}
+	return nil
+}
+
+func (x *TransferCertReq) GetKeyDatas() []byte {
+	if x != nil {
+		return x.KeyDatas
+	}
+	return nil
+}
+
+type TransferCertRsp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Code int32  `protobuf:"varint,1,opt,name=code,proto3" json:"code,omitempty"`
+	Msg  string `protobuf:"bytes,2,opt,name=msg,proto3" json:"msg,omitempty"`
+}
+
+func (x *TransferCertRsp) Reset() {
+	*x = TransferCertRsp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpc_server_proto_msgTypes[63]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TransferCertRsp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TransferCertRsp) ProtoMessage() {}
+
+func (x *TransferCertRsp) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_server_proto_msgTypes[63]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TransferCertRsp.ProtoReflect.Descriptor instead.
+func (*TransferCertRsp) Descriptor() ([]byte, []int) {
+	return file_rpc_server_proto_rawDescGZIP(), []int{63}
+}
+
+func (x *TransferCertRsp) GetCode() int32 {
+	if x != nil {
+		return x.Code
+	}
+	return 0
+}
+
+func (x *TransferCertRsp) GetMsg() string {
+	if x != nil {
+		return x.Msg
+	}
+	return ""
+}
+
+type Cert struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Domain     string `protobuf:"bytes,1,opt,name=domain,proto3" json:"domain,omitempty"`
+	KeyFile    string `protobuf:"bytes,2,opt,name=key_file,json=keyFile,proto3" json:"key_file,omitempty"`
+	CertFile   string `protobuf:"bytes,3,opt,name=cert_file,json=certFile,proto3" json:"cert_file,omitempty"`
+	ExpireTime string `protobuf:"bytes,4,opt,name=expire_time,json=expireTime,proto3" json:"expire_time,omitempty"`
+}
+
+func (x *Cert) Reset() {
+	*x = Cert{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpc_server_proto_msgTypes[64]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Cert) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Cert) ProtoMessage() {}
+
+func (x *Cert) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_server_proto_msgTypes[64]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Cert.ProtoReflect.Descriptor instead.
+func (*Cert) Descriptor() ([]byte, []int) {
+	return file_rpc_server_proto_rawDescGZIP(), []int{64}
+}
+
+func (x *Cert) GetDomain() string {
+	if x != nil {
+		return x.Domain
+	}
+	return ""
+}
+
+func (x *Cert) GetKeyFile() string {
+	if x != nil {
+		return x.KeyFile
+	}
+	return ""
+}
+
+func (x *Cert) GetCertFile() string {
+	if x != nil {
+		return x.CertFile
+	}
+	return ""
+}
+
+func (x *Cert) GetExpireTime() string {
+	if x != nil {
+		return x.ExpireTime
+	}
+	return ""
+}
+
+type GetCertsReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	NodeAuthInfo *NodeAuthInfo `protobuf:"bytes,1,opt,name=node_auth_info,json=nodeAuthInfo,proto3" json:"node_auth_info,omitempty"`
+}
+
+func (x *GetCertsReq) Reset() {
+	*x = GetCertsReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpc_server_proto_msgTypes[65]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetCertsReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCertsReq) ProtoMessage() {}
+
+func (x *GetCertsReq) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_server_proto_msgTypes[65]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCertsReq.ProtoReflect.Descriptor instead.
+func (*GetCertsReq) Descriptor() ([]byte, []int) {
+	return file_rpc_server_proto_rawDescGZIP(), []int{65}
+}
+
+func (x *GetCertsReq) GetNodeAuthInfo() *NodeAuthInfo {
+	if x != nil {
+		return x.NodeAuthInfo
+	}
+	return nil
+}
+
+type GetCertsRsp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Code  int32   `protobuf:"varint,1,opt,name=code,proto3" json:"code,omitempty"`
+	Certs []*Cert `protobuf:"bytes,2,rep,name=certs,proto3" json:"certs,omitempty"`
+}
+
+func (x *GetCertsRsp) Reset() {
+	*x = GetCertsRsp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpc_server_proto_msgTypes[66]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetCertsRsp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCertsRsp) ProtoMessage() {}
+
+func (x *GetCertsRsp) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_server_proto_msgTypes[66]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCertsRsp.ProtoReflect.Descriptor instead.
+func (*GetCertsRsp) Descriptor() ([]byte, []int) {
+	return file_rpc_server_proto_rawDescGZIP(), []int{66}
+}
+
+func (x *GetCertsRsp) GetCode() int32 {
+	if x != nil {
+		return x.Code
+	}
+	return 0
+}
+
+func (x *GetCertsRsp) GetCerts() []*Cert {
+	if x != nil {
+		return x.Certs
+	}
+	return nil
+}
+
+type ClearUsersReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	NodeAuthInfo *NodeAuthInfo `protobuf:"bytes,1,opt,name=node_auth_info,json=nodeAuthInfo,proto3" json:"node_auth_info,omitempty"`
+	Users        []string      `protobuf:"bytes,2,rep,name=users,proto3" json:"users,omitempty"`
+}
+
+func (x *ClearUsersReq) Reset() {
+	*x = ClearUsersReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpc_server_proto_msgTypes[67]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ClearUsersReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ClearUsersReq) ProtoMessage() {}
+
+func (x *ClearUsersReq) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_server_proto_msgTypes[67]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ClearUsersReq.ProtoReflect.Descriptor instead.
+func (*ClearUsersReq) Descriptor() ([]byte, []int) {
+	return file_rpc_server_proto_rawDescGZIP(), []int{67}
+}
+
+func (x *ClearUsersReq) GetNodeAuthInfo() *NodeAuthInfo {
+	if x != nil {
+		return x.NodeAuthInfo
+	}
+	return nil
+}
+
+func (x *ClearUsersReq) GetUsers() []string {
+	if x != nil {
+		return x.Users
+	}
+	return nil
+}
+
+type ClearUsersRsp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Code int32  `protobuf:"varint,1,opt,name=code,proto3" json:"code,omitempty"`
+	Msg  string `protobuf:"bytes,2,opt,name=msg,proto3" json:"msg,omitempty"`
+}
+
+func (x *ClearUsersRsp) Reset() {
+	*x = ClearUsersRsp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpc_server_proto_msgTypes[68]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ClearUsersRsp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ClearUsersRsp) ProtoMessage() {}
+
+func (x *ClearUsersRsp) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_server_proto_msgTypes[68]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ClearUsersRsp.ProtoReflect.Descriptor instead.
+func (*ClearUsersRsp) Descriptor() ([]byte, []int) {
+	return file_rpc_server_proto_rawDescGZIP(), []int{68}
+}
+
+func (x *ClearUsersRsp) GetCode() int32 {
+	if x != nil {
+		return x.Code
+	}
+	return 0
+}
+
+func (x *ClearUsersRsp) GetMsg() string {
+	if x != nil {
+		return x.Msg
+	}
+	return ""
+}
+
+type PlanOpReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	NodeAuthInfo *NodeAuthInfo `protobuf:"bytes,1,opt,name=node_auth_info,json=nodeAuthInfo,proto3" json:"node_auth_info,omitempty"`
+	Plans        []*Plan       `protobuf:"bytes,2,rep,name=plans,proto3" json:"plans,omitempty"`      // 删除时只需要name
+	Reapply      bool          `protobuf:"varint,3,opt,name=reapply,proto3" json:"reapply,omitempty"` // 修改后重新应用到使用该套餐的用户
+}
+
+func (x *PlanOpReq) Reset() {
+	*x = PlanOpReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpc_server_proto_msgTypes[69]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PlanOpReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PlanOpReq) ProtoMessage() {}
+
+func (x *PlanOpReq) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_server_proto_msgTypes[69]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PlanOpReq.ProtoReflect.Descriptor instead.
+func (*PlanOpReq) Descriptor() ([]byte, []int) {
+	return file_rpc_server_proto_rawDescGZIP(), []int{69}
+}
+
+func (x *PlanOpReq) GetNodeAuthInfo() *NodeAuthInfo {
+	if x != nil {
+		return x.NodeAuthInfo
+	}
+	return nil
+}
+
+func (x *PlanOpReq) GetPlans() []*Plan {
+	if x != nil {
+		return x.Plans
+	}
+	return nil
+}
+
+func (x *PlanOpReq) GetReapply() bool {
+	if x != nil {
+		return x.Reapply
+	}
+	return false
+}
+
+type PlanOpRsp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Code int32  `protobuf:"varint,1,opt,name=code,proto3" json:"code,omitempty"`
+	Msg  string `protobuf:"bytes,2,opt,name=msg,proto3" json:"msg,omitempty"`
+}
+
+func (x *PlanOpRsp) Reset() {
+	*x = PlanOpRsp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpc_server_proto_msgTypes[70]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PlanOpRsp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PlanOpRsp) ProtoMessage() {}
+
+func (x *PlanOpRsp) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_server_proto_msgTypes[70]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PlanOpRsp.ProtoReflect.Descriptor instead.
+func (*PlanOpRsp) Descriptor() ([]byte, []int) {
+	return file_rpc_server_proto_rawDescGZIP(), []int{70}
+}
+
+func (x *PlanOpRsp) GetCode() int32 {
+	if x != nil {
+		return x.Code
+	}
+	return 0
+}
+
+func (x *PlanOpRsp) GetMsg() string {
+	if x != nil {
+		return x.Msg
+	}
+	return ""
+}
+
+type GetPlansReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	NodeAuthInfo *NodeAuthInfo `protobuf:"bytes,1,opt,name=node_auth_info,json=nodeAuthInfo,proto3" json:"node_auth_info,omitempty"`
+}
+
+func (x *GetPlansReq) Reset() {
+	*x = GetPlansReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpc_server_proto_msgTypes[71]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetPlansReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPlansReq) ProtoMessage() {}
+
+func (x *GetPlansReq) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_server_proto_msgTypes[71]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPlansReq.ProtoReflect.Descriptor instead.
+func (*GetPlansReq) Descriptor() ([]byte, []int) {
+	return file_rpc_server_proto_rawDescGZIP(), []int{71}
+}
+
+func (x *GetPlansReq) GetNodeAuthInfo() *NodeAuthInfo {
+	if x != nil {
+		return x.NodeAuthInfo
+	}
+	return nil
+}
+
+type GetPlansRsp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Code  int32   `protobuf:"varint,1,opt,name=code,proto3" json:"code,omitempty"`
+	Msg   string  `protobuf:"bytes,2,opt,name=msg,proto3" json:"msg,omitempty"`
+	Plans []*Plan `protobuf:"bytes,3,rep,name=plans,proto3" json:"plans,omitempty"`
+}
+
+func (x *GetPlansRsp) Reset() {
+	*x = GetPlansRsp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpc_server_proto_msgTypes[72]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetPlansRsp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPlansRsp) ProtoMessage() {}
+
+func (x *GetPlansRsp) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_server_proto_msgTypes[72]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPlansRsp.ProtoReflect.Descriptor instead.
+func (*GetPlansRsp) Descriptor() ([]byte, []int) {
+	return file_rpc_server_proto_rawDescGZIP(), []int{72}
+}
+
+func (x *GetPlansRsp) GetCode() int32 {
+	if x != nil {
+		return x.Code
+	}
+	return 0
+}
+
+func (x *GetPlansRsp) GetMsg() string {
+	if x != nil {
+		return x.Msg
+	}
+	return ""
+}
+
+func (x *GetPlansRsp) GetPlans() []*Plan {
+	if x != nil {
+		return x.Plans
+	}
+	return nil
+}
+
+type PingMetric struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Host    string        `protobuf:"bytes,1,opt,name=host,proto3" json:"host,omitempty"` // ip or domain
+	Source  string        `protobuf:"bytes,2,opt,name=source,proto3" json:"source,omitempty"`
+	Results []*PingResult `protobuf:"bytes,3,rep,name=results,proto3" json:"results,omitempty"`
+}
+
+func (x *PingMetric) Reset() {
+	*x = PingMetric{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpc_server_proto_msgTypes[73]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PingMetric) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PingMetric) ProtoMessage() {}
+
+func (x *PingMetric) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_server_proto_msgTypes[73]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PingMetric.ProtoReflect.Descriptor instead.
+func (*PingMetric) Descriptor() ([]byte, []int) {
+	return file_rpc_server_proto_rawDescGZIP(), []int{73}
+}
+
+func (x *PingMetric) GetHost() string {
+	if x != nil {
+		return x.Host
+	}
+	return ""
+}
+
+func (x *PingMetric) GetSource() string {
+	if x != nil {
+		return x.Source
+	}
+	return ""
+}
+
+func (x *PingMetric) GetResults() []*PingResult {
+	if x != nil {
+		return x.Results
+	}
+	return nil
+}
+
+type PingResult struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Geo         string  `protobuf:"bytes,1,opt,name=geo,proto3" json:"geo,omitempty"` // location
+	Isp         string  `protobuf:"bytes,2,opt,name=isp,proto3" json:"isp,omitempty"` // provider
+	MaxDelay    float32 `protobuf:"fixed32,10,opt,name=max_delay,json=maxDelay,proto3" json:"max_delay,omitempty"`
+	MinDelay    float32 `protobuf:"fixed32,11,opt,name=min_delay,json=minDelay,proto3" json:"min_delay,omitempty"`
+	StDevDelay  float32 `protobuf:"fixed32,12,opt,name=st_dev_delay,json=stDevDelay,proto3" json:"st_dev_delay,omitempty"`
+	AvgDelay    float32 `protobuf:"fixed32,13,opt,name=avg_delay,json=avgDelay,proto3" json:"avg_delay,omitempty"`
+	Loss        float32 `protobuf:"fixed32,14,opt,name=loss,proto3" json:"loss,omitempty"` // 丢包率
+	LatestDelay float32 `protobuf:"fixed32,15,opt,name=latest_delay,json=latestDelay,proto3" json:"latest_delay,omitempty"`
+}
+
+func (x *PingResult) Reset() {
+	*x = PingResult{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpc_server_proto_msgTypes[74]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PingResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PingResult) ProtoMessage() {}
+
+func (x *PingResult) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_server_proto_msgTypes[74]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PingResult.ProtoReflect.Descriptor instead.
+func (*PingResult) Descriptor() ([]byte, []int) {
+	return file_rpc_server_proto_rawDescGZIP(), []int{74}
+}
+
+func (x *PingResult) GetGeo() string {
+	if x != nil {
+		return x.Geo
+	}
+	return ""
+}
+
+func (x *PingResult) GetIsp() string {
+	if x != nil {
+		return x.Isp
+	}
+	return ""
+}
+
+func (x *PingResult) GetMaxDelay() float32 {
+	if x != nil {
+		return x.MaxDelay
+	}
+	return 0
+}
+
+func (x *PingResult) GetMinDelay() float32 {
+	if x != nil {
+		return x.MinDelay
+	}
+	return 0
+}
+
+func (x *PingResult) GetStDevDelay() float32 {
+	if x != nil {
+		return x.StDevDelay
+	}
+	return 0
+}
+
+func (x *PingResult) GetAvgDelay() float32 {
+	if x != nil {
+		return x.AvgDelay
+	}
+	return 0
+}
+
+func (x *PingResult) GetLoss() float32 {
+	if x != nil {
+		return x.Loss
+	}
+	return 0
+}
+
+func (x *PingResult) GetLatestDelay() float32 {
+	if x != nil {
+		return x.LatestDelay
+	}
+	return 0
+}
+
+type GetPingMetricReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	NodeAuthInfo *NodeAuthInfo `protobuf:"bytes,1,opt,name=node_auth_info,json=nodeAuthInfo,proto3" json:"node_auth_info,omitempty"`
+}
+
+func (x *GetPingMetricReq) Reset() {
+	*x = GetPingMetricReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpc_server_proto_msgTypes[75]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetPingMetricReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPingMetricReq) ProtoMessage() {}
+
+func (x *GetPingMetricReq) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_server_proto_msgTypes[75]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPingMetricReq.ProtoReflect.Descriptor instead.
+func (*GetPingMetricReq) Descriptor() ([]byte, []int) {
+	return file_rpc_server_proto_rawDescGZIP(), []int{75}
+}
+
+func (x *GetPingMetricReq) GetNodeAuthInfo() *NodeAuthInfo {
+	if x != nil {
+		return x.NodeAuthInfo
+	}
+	return nil
+}
+
+type GetPingMetricRsp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Code   int32       `protobuf:"varint,1,opt,name=code,proto3" json:"code,omitempty"`
+	Msg    string      `protobuf:"bytes,2,opt,name=msg,proto3" json:"msg,omitempty"`
+	Metric *PingMetric `protobuf:"bytes,3,opt,name=metric,proto3" json:"metric,omitempty"`
+}
+
+func (x *GetPingMetricRsp) Reset() {
+	*x = GetPingMetricRsp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpc_server_proto_msgTypes[76]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetPingMetricRsp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPingMetricRsp) ProtoMessage() {}
+
+func (x *GetPingMetricRsp) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_server_proto_msgTypes[76]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPingMetricRsp.ProtoReflect.Descriptor instead.
+func (*GetPingMetricRsp) Descriptor() ([]byte, []int) {
+	return file_rpc_server_proto_rawDescGZIP(), []int{76}
+}
+
+func (x *GetPingMetricRsp) GetCode() int32 {
+	if x != nil {
+		return x.Code
+	}
+	return 0
+}
+
+func (x *GetPingMetricRsp) GetMsg() string {
+	if x != nil {
+		return x.Msg
+	}
+	return ""
+}
+
+func (x *GetPingMetricRsp) GetMetric() *PingMetric {
+	if x != nil {
+		return x.Metric
+	}
+	return nil
+}
+
+type GetClutersReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ClusterName string `protobuf:"bytes,1,opt,name=cluster_name,json=clusterName,proto3" json:"cluster_name,omitempty"`
+}
+
+func (x *GetClutersReq) Reset() {
+	*x = GetClutersReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpc_server_proto_msgTypes[77]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetClutersReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetClutersReq) ProtoMessage() {}
+
+func (x *GetClutersReq) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_server_proto_msgTypes[77]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetClutersReq.ProtoReflect.Descriptor instead.
+func (*GetClutersReq) Descriptor() ([]byte, []int) {
+	return file_rpc_server_proto_rawDescGZIP(), []int{77}
+}
+
+func (x *GetClutersReq) GetClusterName() string {
+	if x != nil {
+		return x.ClusterName
+	}
+	return ""
+}
+
+type GetClutersRsp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ClusterNames []string `protobuf:"bytes,1,rep,name=cluster_names,json=clusterNames,proto3" json:"cluster_names,omitempty"`
+}
+
+func (x *GetClutersRsp) Reset() {
+	*x = GetClutersRsp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpc_server_proto_msgTypes[78]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetClutersRsp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetClutersRsp) ProtoMessage() {}
+
+func (x *GetClutersRsp) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_server_proto_msgTypes[78]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetClutersRsp.ProtoReflect.Descriptor instead.
+func (*GetClutersRsp) Descriptor() ([]byte, []int) {
+	return file_rpc_server_proto_rawDescGZIP(), []int{78}
+}
+
+func (x *GetClutersRsp) GetClusterNames() []string {
+	if x != nil {
+		return x.ClusterNames
+	}
+	return nil
+}
+
+type GetNodesReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ClusterName string `protobuf:"bytes,1,opt,name=cluster_name,json=clusterName,proto3" json:"cluster_name,omitempty"`
+}
+
+func (x *GetNodesReq) Reset() {
+	*x = GetNodesReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpc_server_proto_msgTypes[79]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetNodesReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetNodesReq) ProtoMessage() {}
+
+func (x *GetNodesReq) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_server_proto_msgTypes[79]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetNodesReq.ProtoReflect.Descriptor instead.
+func (*GetNodesReq) Descriptor() ([]byte, []int) {
+	return file_rpc_server_proto_rawDescGZIP(), []int{79}
+}
+
+func (x *GetNodesReq) GetClusterName() string {
+	if x != nil {
+		return x.ClusterName
+	}
+	return ""
+}
+
+type GetNodesRsp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ClusterName string           `protobuf:"bytes,1,opt,name=cluster_name,json=clusterName,proto3" json:"cluster_name,omitempty"`
+	NodesMap    map[string]*Node `protobuf:"bytes,2,rep,name=nodesMap,proto3" json:"nodesMap,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+}
+
+func (x *GetNodesRsp) Reset() {
+	*x = GetNodesRsp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpc_server_proto_msgTypes[80]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetNodesRsp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetNodesRsp) ProtoMessage() {}
+
+func (x *GetNodesRsp) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_server_proto_msgTypes[80]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetNodesRsp.ProtoReflect.Descriptor instead.
+func (*GetNodesRsp) Descriptor() ([]byte, []int) {
+	return file_rpc_server_proto_rawDescGZIP(), []int{80}
+}
+
+func (x *GetNodesRsp) GetClusterName() string {
+	if x != nil {
+		return x.ClusterName
+	}
+	return ""
+}
+
+func (x *GetNodesRsp) GetNodesMap() map[string]*Node {
+	if x != nil {
+		return x.NodesMap
+	}
+	return nil
+}
+
+type MemberNode struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Node          *Node       `protobuf:"bytes,1,opt,name=node,proto3" json:"node,omitempty"`
+	HeartbeatTime int64       `protobuf:"varint,2,opt,name=heartbeat_time,json=heartbeatTime,proto3" json:"heartbeat_time,omitempty"` // 中心节点最近一次收到该节点心跳的时间, unix时间戳, 单位秒
+	Health        *NodeHealth `protobuf:"bytes,3,opt,name=health,proto3" json:"health,omitempty"`
+}
+
+func (x *MemberNode) Reset() {
+	*x = MemberNode{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpc_server_proto_msgTypes[81]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MemberNode) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MemberNode) ProtoMessage() {}
+
+func (x *MemberNode) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_server_proto_msgTypes[81]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MemberNode.ProtoReflect.Descriptor instead.
+func (*MemberNode) Descriptor() ([]byte, []int) {
+	return file_rpc_server_proto_rawDescGZIP(), []int{81}
+}
+
+func (x *MemberNode) GetNode() *Node {
+	if x != nil {
+		return x.Node
+	}
+	return nil
+}
+
+func (x *MemberNode) GetHeartbeatTime() int64 {
+	if x != nil {
+		return x.HeartbeatTime
+	}
+	return 0
+}
+
+func (x *MemberNode) GetHealth() *NodeHealth {
+	if x != nil {
+		return x.Health
+	}
+	return nil
+}
+
+// 中心节点之间同步集群成员, 请求中为发送方的全部有效节点, 响应中只包含cluster_names中集群的有效节点
+type SyncMembershipReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	CenterName   string        `protobuf:"bytes,1,opt,name=center_name,json=centerName,proto3" json:"center_name,omitempty"`
+	Members      []*MemberNode `protobuf:"bytes,2,rep,name=members,proto3" json:"members,omitempty"`
+	Token        string        `protobuf:"bytes,3,opt,name=token,proto3" json:"token,omitempty"`                                   // 中心节点之间共享的cluster.center_token
+	ClusterNames []string      `protobuf:"bytes,4,rep,name=cluster_names,json=clusterNames,proto3" json:"cluster_names,omitempty"` // 发送方负责的集群
+}
+
+func (x *SyncMembershipReq) Reset() {
+	*x = SyncMembershipReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpc_server_proto_msgTypes[82]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SyncMembershipReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SyncMembershipReq) ProtoMessage() {}
+
+func (x *SyncMembershipReq) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_server_proto_msgTypes[82]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SyncMembershipReq.ProtoReflect.Descriptor instead.
+func (*SyncMembershipReq) Descriptor() ([]byte, []int) {
+	return file_rpc_server_proto_rawDescGZIP(), []int{82}
+}
+
+func (x *SyncMembershipReq) GetCenterName() string {
+	if x != nil {
+		return x.CenterName
+	}
+	return ""
+}
+
+func (x *SyncMembershipReq) GetMembers() []*MemberNode {
+	if x != nil {
+		return x.Members
+	}
+	return nil
+}
+
+func (x *SyncMembershipReq) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+func (x *SyncMembershipReq) GetClusterNames() []string {
+	if x != nil {
+		return x.ClusterNames
+	}
+	return nil
+}
+
+type SyncMembershipRsp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Code    int32         `protobuf:"varint,1,opt,name=code,proto3" json:"code,omitempty"`
+	Msg     string        `protobuf:"bytes,2,opt,name=msg,proto3" json:"msg,omitempty"`
+	Members []*MemberNode `protobuf:"bytes,3,rep,name=members,proto3" json:"members,omitempty"`
+}
+
+func (x *SyncMembershipRsp) Reset() {
+	*x = SyncMembershipRsp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpc_server_proto_msgTypes[83]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SyncMembershipRsp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SyncMembershipRsp) ProtoMessage() {}
+
+func (x *SyncMembershipRsp) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_server_proto_msgTypes[83]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SyncMembershipRsp.ProtoReflect.Descriptor instead.
+func (*SyncMembershipRsp) Descriptor() ([]byte, []int) {
+	return file_rpc_server_proto_rawDescGZIP(), []int{83}
+}
+
+func (x *SyncMembershipRsp) GetCode() int32 {
+	if x != nil {
+		return x.Code
+	}
+	return 0
+}
+
+func (x *SyncMembershipRsp) GetMsg() string {
+	if x != nil {
+		return x.Msg
+	}
+	return ""
+}
+
+func (x *SyncMembershipRsp) GetMembers() []*MemberNode {
+	if x != nil {
+		return x.Members
+	}
+	return nil
+}
+
+var File_rpc_server_proto protoreflect.FileDescriptor
+
+var file_rpc_server_proto_rawDesc = []byte{
+	0x0a, 0x10, 0x72, 0x70, 0x63, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x12, 0x05, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xa7, 0x06, 0x0a, 0x04, 0x55, 0x73,
+	0x65, 0x72, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x61, 0x73, 0x73, 0x77, 0x64,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x70, 0x61, 0x73, 0x73, 0x77, 0x64, 0x12, 0x1f,
+	0x0a, 0x0b, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x0a, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x12,
+	0x12, 0x0a, 0x04, 0x74, 0x61, 0x67, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x09, 0x52, 0x04, 0x74,
+	0x61, 0x67, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x64, 0x6f, 0x77, 0x6e, 0x6c, 0x69, 0x6e, 0x6b, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x64, 0x6f, 0x77, 0x6e, 0x6c, 0x69, 0x6e, 0x6b, 0x12,
+	0x16, 0x0a, 0x06, 0x75, 0x70, 0x6c, 0x69, 0x6e, 0x6b, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x06, 0x75, 0x70, 0x6c, 0x69, 0x6e, 0x6b, 0x12, 0x14, 0x0a, 0x05, 0x71, 0x75, 0x6f, 0x74, 0x61,
+	0x18, 0x07, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x71, 0x75, 0x6f, 0x74, 0x61, 0x12, 0x21, 0x0a,
+	0x0c, 0x75, 0x70, 0x6c, 0x69, 0x6e, 0x6b, 0x5f, 0x71, 0x75, 0x6f, 0x74, 0x61, 0x18, 0x08, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x0b, 0x75, 0x70, 0x6c, 0x69, 0x6e, 0x6b, 0x51, 0x75, 0x6f, 0x74, 0x61,
+	0x12, 0x25, 0x0a, 0x0e, 0x64, 0x6f, 0x77, 0x6e, 0x6c, 0x69, 0x6e, 0x6b, 0x5f, 0x71, 0x75, 0x6f,
+	0x74, 0x61, 0x18, 0x09, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0d, 0x64, 0x6f, 0x77, 0x6e, 0x6c, 0x69,
+	0x6e, 0x6b, 0x51, 0x75, 0x6f, 0x74, 0x61, 0x12, 0x1f, 0x0a, 0x0b, 0x75, 0x73, 0x65, 0x64, 0x5f,
+	0x75, 0x70, 0x6c, 0x69, 0x6e, 0x6b, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x75, 0x73,
+	0x65, 0x64, 0x55, 0x70, 0x6c, 0x69, 0x6e, 0x6b, 0x12, 0x23, 0x0a, 0x0d, 0x75, 0x73, 0x65, 0x64,
+	0x5f, 0x64, 0x6f, 0x77, 0x6e, 0x6c, 0x69, 0x6e, 0x6b, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x0c, 0x75, 0x73, 0x65, 0x64, 0x44, 0x6f, 0x77, 0x6e, 0x6c, 0x69, 0x6e, 0x6b, 0x12, 0x27, 0x0a,
+	0x0f, 0x72, 0x65, 0x6d, 0x61, 0x69, 0x6e, 0x69, 0x6e, 0x67, 0x5f, 0x71, 0x75, 0x6f, 0x74, 0x61,
+	0x18, 0x0c, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0e, 0x72, 0x65, 0x6d, 0x61, 0x69, 0x6e, 0x69, 0x6e,
+	0x67, 0x51, 0x75, 0x6f, 0x74, 0x61, 0x12, 0x1d, 0x0a, 0x0a, 0x6f, 0x76, 0x65, 0x72, 0x5f, 0x71,
+	0x75, 0x6f, 0x74, 0x61, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x6f, 0x76, 0x65, 0x72,
+	0x51, 0x75, 0x6f, 0x74, 0x61, 0x12, 0x1f, 0x0a, 0x0b, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x5f,
+	0x74, 0x69, 0x6d, 0x65, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x63, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x75, 0x73, 0x70, 0x65, 0x6e,
+	0x64, 0x65, 0x64, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x73, 0x75, 0x73, 0x70, 0x65,
+	0x6e, 0x64, 0x65, 0x64, 0x12, 0x26, 0x0a, 0x0f, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x6c, 0x6f, 0x67,
+	0x69, 0x6e, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x18, 0x10, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x6c,
+	0x61, 0x73, 0x74, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x41, 0x64, 0x64, 0x72, 0x12, 0x26, 0x0a, 0x0f,
+	0x6c, 0x61, 0x73, 0x74, 0x5f, 0x6c, 0x6f, 0x67, 0x69, 0x6e, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18,
+	0x11, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0d, 0x6c, 0x61, 0x73, 0x74, 0x4c, 0x6f, 0x67, 0x69, 0x6e,
+	0x54, 0x69, 0x6d, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x6c, 0x61, 0x6e, 0x18, 0x12, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x04, 0x70, 0x6c, 0x61, 0x6e, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x65, 0x76, 0x65,
+	0x6c, 0x18, 0x13, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x12, 0x2c,
+	0x0a, 0x12, 0x62, 0x69, 0x6c, 0x6c, 0x69, 0x6e, 0x67, 0x5f, 0x61, 0x6e, 0x63, 0x68, 0x6f, 0x72,
+	0x5f, 0x64, 0x61, 0x79, 0x18, 0x14, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x10, 0x62, 0x69, 0x6c, 0x6c,
+	0x69, 0x6e, 0x67, 0x41, 0x6e, 0x63, 0x68, 0x6f, 0x72, 0x44, 0x61, 0x79, 0x12, 0x21, 0x0a, 0x0c,
+	0x72, 0x65, 0x73, 0x65, 0x74, 0x5f, 0x70, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x18, 0x15, 0x20, 0x01,
+	0x28, 0x0d, 0x52, 0x0b, 0x72, 0x65, 0x73, 0x65, 0x74, 0x50, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x12,
+	0x21, 0x0a, 0x0c, 0x70, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x5f, 0x73, 0x74, 0x61, 0x72, 0x74, 0x18,
+	0x16, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x70, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x53, 0x74, 0x61,
+	0x72, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x70, 0x61, 0x73, 0x73, 0x77, 0x64, 0x5f, 0x68, 0x61, 0x73,
+	0x68, 0x18, 0x17, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x70, 0x61, 0x73, 0x73, 0x77, 0x64, 0x48,
+	0x61, 0x73, 0x68, 0x12, 0x1b, 0x0a, 0x09, 0x73, 0x75, 0x62, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e,
+	0x18, 0x18, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x73, 0x75, 0x62, 0x54, 0x6f, 0x6b, 0x65, 0x6e,
+	0x12, 0x12, 0x0a, 0x04, 0x75, 0x75, 0x69, 0x64, 0x18, 0x19, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
+	0x75, 0x75, 0x69, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x70, 0x65, 0x65, 0x64, 0x5f, 0x74, 0x69,
+	0x65, 0x72, 0x18, 0x1a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x70, 0x65, 0x65, 0x64, 0x54,
+	0x69, 0x65, 0x72, 0x22, 0x7b, 0x0a, 0x09, 0x55, 0x73, 0x65, 0x72, 0x55, 0x73, 0x61, 0x67, 0x65,
+	0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
+	0x6e, 0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x72, 0x74, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x05, 0x73, 0x74, 0x61, 0x72, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x65, 0x6e,
+	0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x03, 0x65, 0x6e, 0x64, 0x12, 0x16, 0x0a, 0x06,
+	0x75, 0x70, 0x6c, 0x69, 0x6e, 0x6b, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x75, 0x70,
+	0x6c, 0x69, 0x6e, 0x6b, 0x12, 0x1a, 0x0a, 0x08, 0x64, 0x6f, 0x77, 0x6e, 0x6c, 0x69, 0x6e, 0x6b,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x64, 0x6f, 0x77, 0x6e, 0x6c, 0x69, 0x6e, 0x6b,
+	0x22, 0xb6, 0x02, 0x0a, 0x04, 0x50, 0x6c, 0x61, 0x6e, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x12, 0x0a,
+	0x04, 0x74, 0x61, 0x67, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x04, 0x74, 0x61, 0x67,
+	0x73, 0x12, 0x1a, 0x0a, 0x08, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x08, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x14, 0x0a,
+	0x05, 0x71, 0x75, 0x6f, 0x74, 0x61, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x71, 0x75,
+	0x6f, 0x74, 0x61, 0x12, 0x21, 0x0a, 0x0c, 0x75, 0x70, 0x6c, 0x69, 0x6e, 0x6b, 0x5f, 0x71, 0x75,
+	0x6f, 0x74, 0x61, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x75, 0x70, 0x6c, 0x69, 0x6e,
+	0x6b, 0x51, 0x75, 0x6f, 0x74, 0x61, 0x12, 0x25, 0x0a, 0x0e, 0x64, 0x6f, 0x77, 0x6e, 0x6c, 0x69,
+	0x6e, 0x6b, 0x5f, 0x71, 0x75, 0x6f, 0x74, 0x61, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0d,
+	0x64, 0x6f, 0x77, 0x6e, 0x6c, 0x69, 0x6e, 0x6b, 0x51, 0x75, 0x6f, 0x74, 0x61, 0x12, 0x14, 0x0a,
+	0x05, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x6c, 0x65,
+	0x76, 0x65, 0x6c, 0x12, 0x21, 0x0a, 0x0c, 0x72, 0x65, 0x73, 0x65, 0x74, 0x5f, 0x70, 0x65, 0x72,
+	0x69, 0x6f, 0x64, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0b, 0x72, 0x65, 0x73, 0x65, 0x74,
+	0x50, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x12, 0x2c, 0x0a, 0x12, 0x62, 0x69, 0x6c, 0x6c, 0x69, 0x6e,
+	0x67, 0x5f, 0x61, 0x6e, 0x63, 0x68, 0x6f, 0x72, 0x5f, 0x64, 0x61, 0x79, 0x18, 0x09, 0x20, 0x01,
+	0x28, 0x0d, 0x52, 0x10, 0x62, 0x69, 0x6c, 0x6c, 0x69, 0x6e, 0x67, 0x41, 0x6e, 0x63, 0x68, 0x6f,
+	0x72, 0x44, 0x61, 0x79, 0x12, 0x23, 0x0a, 0x0d, 0x6e, 0x6f, 0x64, 0x65, 0x5f, 0x73, 0x65, 0x6c,
+	0x65, 0x63, 0x74, 0x6f, 0x72, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x6e, 0x6f, 0x64,
+	0x65, 0x53, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x22, 0x79, 0x0a, 0x0c, 0x4e, 0x6f, 0x64,
+	0x65, 0x41, 0x75, 0x74, 0x68, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x6b,
+	0x65, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x12,
+	0x1f, 0x0a, 0x04, 0x6e, 0x6f, 0x64, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0b, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x4e, 0x6f, 0x64, 0x65, 0x52, 0x04, 0x6e, 0x6f, 0x64, 0x65,
+	0x12, 0x1c, 0x0a, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x12, 0x14,
+	0x0a, 0x05, 0x6e, 0x6f, 0x6e, 0x63, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x05, 0x6e,
+	0x6f, 0x6e, 0x63, 0x65, 0x22, 0x48, 0x0a, 0x0b, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x73,
+	0x52, 0x65, 0x71, 0x12, 0x39, 0x0a, 0x0e, 0x6e, 0x6f, 0x64, 0x65, 0x5f, 0x61, 0x75, 0x74, 0x68,
+	0x5f, 0x69, 0x6e, 0x66, 0x6f, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x2e, 0x4e, 0x6f, 0x64, 0x65, 0x41, 0x75, 0x74, 0x68, 0x49, 0x6e, 0x66, 0x6f,
+	0x52, 0x0c, 0x6e, 0x6f, 0x64, 0x65, 0x41, 0x75, 0x74, 0x68, 0x49, 0x6e, 0x66, 0x6f, 0x22, 0x56,
+	0x0a, 0x0b, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x73, 0x52, 0x73, 0x70, 0x12, 0x12, 0x0a,
+	0x04, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x63, 0x6f, 0x64,
+	0x65, 0x12, 0x10, 0x0a, 0x03, 0x6d, 0x73, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03,
+	0x6d, 0x73, 0x67, 0x12, 0x21, 0x0a, 0x05, 0x75, 0x73, 0x65, 0x72, 0x73, 0x18, 0x03, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x52,
+	0x05, 0x75, 0x73, 0x65, 0x72, 0x73, 0x22, 0x96, 0x02, 0x0a, 0x0e, 0x55, 0x73, 0x65, 0x72, 0x55,
+	0x70, 0x64, 0x61, 0x74, 0x65, 0x4d, 0x61, 0x73, 0x6b, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x61, 0x73,
+	0x73, 0x77, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x70, 0x61, 0x73, 0x73, 0x77,
+	0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x5f, 0x74, 0x69, 0x6d, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x54, 0x69,
+	0x6d, 0x65, 0x12, 0x23, 0x0a, 0x0d, 0x65, 0x78, 0x74, 0x65, 0x6e, 0x64, 0x5f, 0x65, 0x78, 0x70,
+	0x69, 0x72, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0c, 0x65, 0x78, 0x74, 0x65, 0x6e,
+	0x64, 0x45, 0x78, 0x70, 0x69, 0x72, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x71, 0x75, 0x6f, 0x74, 0x61,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x71, 0x75, 0x6f, 0x74, 0x61, 0x12, 0x19, 0x0a,
+	0x08, 0x61, 0x64, 0x64, 0x5f, 0x74, 0x61, 0x67, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x09, 0x52,
+	0x07, 0x61, 0x64, 0x64, 0x54, 0x61, 0x67, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x72, 0x65, 0x6d, 0x6f,
+	0x76, 0x65, 0x5f, 0x74, 0x61, 0x67, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0a, 0x72,
+	0x65, 0x6d, 0x6f, 0x76, 0x65, 0x54, 0x61, 0x67, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x62, 0x69, 0x6c,
+	0x6c, 0x69, 0x6e, 0x67, 0x18, 0x07, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x62, 0x69, 0x6c, 0x6c,
+	0x69, 0x6e, 0x67, 0x12, 0x1b, 0x0a, 0x09, 0x73, 0x75, 0x62, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e,
+	0x18, 0x08, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x73, 0x75, 0x62, 0x54, 0x6f, 0x6b, 0x65, 0x6e,
+	0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x70, 0x65, 0x65, 0x64, 0x5f, 0x74, 0x69, 0x65, 0x72, 0x18, 0x09,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x73, 0x70, 0x65, 0x65, 0x64, 0x54, 0x69, 0x65, 0x72, 0x22,
+	0xa1, 0x01, 0x0a, 0x09, 0x55, 0x73, 0x65, 0x72, 0x4f, 0x70, 0x52, 0x65, 0x71, 0x12, 0x39, 0x0a,
+	0x0e, 0x6e, 0x6f, 0x64, 0x65, 0x5f, 0x61, 0x75, 0x74, 0x68, 0x5f, 0x69, 0x6e, 0x66, 0x6f, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x4e, 0x6f,
+	0x64, 0x65, 0x41, 0x75, 0x74, 0x68, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x0c, 0x6e, 0x6f, 0x64, 0x65,
+	0x41, 0x75, 0x74, 0x68, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x21, 0x0a, 0x05, 0x75, 0x73, 0x65, 0x72,
+	0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e,
+	0x55, 0x73, 0x65, 0x72, 0x52, 0x05, 0x75, 0x73, 0x65, 0x72, 0x73, 0x12, 0x36, 0x0a, 0x0b, 0x75,
+	0x70, 0x64, 0x61, 0x74, 0x65, 0x5f, 0x6d, 0x61, 0x73, 0x6b, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x15, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x55, 0x70, 0x64,
+	0x61, 0x74, 0x65, 0x4d, 0x61, 0x73, 0x6b, 0x52, 0x0a, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4d,
+	0x61, 0x73, 0x6b, 0x22, 0x31, 0x0a, 0x09, 0x55, 0x73, 0x65, 0x72, 0x4f, 0x70, 0x52, 0x73, 0x70,
+	0x12, 0x12, 0x0a, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04,
+	0x63, 0x6f, 0x64, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x6d, 0x73, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x03, 0x6d, 0x73, 0x67, 0x22, 0x4b, 0x0a, 0x0e, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74,
+	0x55, 0x73, 0x65, 0x72, 0x73, 0x52, 0x65, 0x71, 0x12, 0x39, 0x0a, 0x0e, 0x6e, 0x6f, 0x64, 0x65,
+	0x5f, 0x61, 0x75, 0x74, 0x68, 0x5f, 0x69, 0x6e, 0x66, 0x6f, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x13, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x4e, 0x6f, 0x64, 0x65, 0x41, 0x75, 0x74,
+	0x68, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x0c, 0x6e, 0x6f, 0x64, 0x65, 0x41, 0x75, 0x74, 0x68, 0x49,
+	0x6e, 0x66, 0x6f, 0x22, 0x59, 0x0a, 0x0e, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x55, 0x73, 0x65,
+	0x72, 0x73, 0x52, 0x73, 0x70, 0x12, 0x12, 0x0a, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x6d, 0x73, 0x67,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6d, 0x73, 0x67, 0x12, 0x21, 0x0a, 0x05, 0x75,
+	0x73, 0x65, 0x72, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x52, 0x05, 0x75, 0x73, 0x65, 0x72, 0x73, 0x22, 0xb0,
+	0x01, 0x0a, 0x0e, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x55, 0x73, 0x65, 0x72, 0x73, 0x52, 0x65,
+	0x71, 0x12, 0x39, 0x0a, 0x0e, 0x6e, 0x6f, 0x64, 0x65, 0x5f, 0x61, 0x75, 0x74, 0x68, 0x5f, 0x69,
+	0x6e, 0x66, 0x6f, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x2e, 0x4e, 0x6f, 0x64, 0x65, 0x41, 0x75, 0x74, 0x68, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x0c,
+	0x6e, 0x6f, 0x64, 0x65, 0x41, 0x75, 0x74, 0x68, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x21, 0x0a, 0x05,
+	0x75, 0x73, 0x65, 0x72, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x52, 0x05, 0x75, 0x73, 0x65, 0x72, 0x73, 0x12,
+	0x27, 0x0a, 0x0f, 0x63, 0x6f, 0x6e, 0x66, 0x6c, 0x69, 0x63, 0x74, 0x5f, 0x70, 0x6f, 0x6c, 0x69,
+	0x63, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x63, 0x6f, 0x6e, 0x66, 0x6c, 0x69,
+	0x63, 0x74, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x12, 0x17, 0x0a, 0x07, 0x64, 0x72, 0x79, 0x5f,
+	0x72, 0x75, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x64, 0x72, 0x79, 0x52, 0x75,
+	0x6e, 0x22, 0x50, 0x0a, 0x10, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x55, 0x73, 0x65, 0x72, 0x52,
+	0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x61, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x12, 0x10, 0x0a, 0x03, 0x6d, 0x73, 0x67, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03,
+	0x6d, 0x73, 0x67, 0x22, 0x69, 0x0a, 0x0e, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x55, 0x73, 0x65,
+	0x72, 0x73, 0x52, 0x73, 0x70, 0x12, 0x12, 0x0a, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x6d, 0x73, 0x67,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6d, 0x73, 0x67, 0x12, 0x31, 0x0a, 0x07, 0x72,
+	0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x55, 0x73, 0x65, 0x72, 0x52,
+	0x65, 0x73, 0x75, 0x6c, 0x74, 0x52, 0x07, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x22, 0xc6,
+	0x01, 0x0a, 0x11, 0x52, 0x65, 0x63, 0x6f, 0x6e, 0x63, 0x69, 0x6c, 0x65, 0x55, 0x73, 0x65, 0x72,
+	0x73, 0x52, 0x65, 0x71, 0x12, 0x39, 0x0a, 0x0e, 0x6e, 0x6f, 0x64, 0x65, 0x5f, 0x61, 0x75, 0x74,
 	0x68, 0x5f, 0x69, 0x6e, 0x66, 0x6f, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x70,
 	0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x4e, 0x6f, 0x64, 0x65, 0x41, 0x75, 0x74, 0x68, 0x49, 0x6e, 0x66,
 	0x6f, 0x52, 0x0c, 0x6e, 0x6f, 0x64, 0x65, 0x41, 0x75, 0x74, 0x68, 0x49, 0x6e, 0x66, 0x6f, 0x12,
-	0x10, 0x0a, 0x03, 0x74, 0x61, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x74, 0x61,
-	0x67, 0x12, 0x19, 0x0a, 0x08, 0x6e, 0x65, 0x77, 0x5f, 0x70, 0x6f, 0x72, 0x74, 0x18, 0x03, 0x20,
-	0x01, 0x28, 0x05, 0x52, 0x07, 0x6e, 0x65, 0x77, 0x50, 0x6f, 0x72, 0x74, 0x22, 0xdd, 0x01, 0x0a,
-	0x0e, 0x43, 0x6f, 0x70, 0x79, 0x49, 0x6e, 0x62, 0x6f, 0x75, 0x6e, 0x64, 0x52, 0x65, 0x71, 0x12,
-	0x39, 0x0a, 0x0e, 0x6e, 0x6f, 0x64, 0x65, 0x5f, 0x61, 0x75, 0x74, 0x68, 0x5f, 0x69, 0x6e, 0x66,
-	0x6f, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e,
-	0x4e, 0x6f, 0x64, 0x65, 0x41, 0x75, 0x74, 0x68, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x0c, 0x6e, 0x6f,
-	0x64, 0x65, 0x41, 0x75, 0x74, 0x68, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x17, 0x0a, 0x07, 0x73, 0x72,
-	0x63, 0x5f, 0x74, 0x61, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x72, 0x63,
-	0x54, 0x61, 0x67, 0x12, 0x17, 0x0a, 0x07, 0x6e, 0x65, 0x77, 0x5f, 0x74, 0x61, 0x67, 0x18, 0x03,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6e, 0x65, 0x77, 0x54, 0x61, 0x67, 0x12, 0x19, 0x0a, 0x08,
-	0x6e, 0x65, 0x77, 0x5f, 0x70, 0x6f, 0x72, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07,
-	0x6e, 0x65, 0x77, 0x50, 0x6f, 0x72, 0x74, 0x12, 0x20, 0x0a, 0x0c, 0x69, 0x73, 0x5f, 0x63, 0x6f,
-	0x70, 0x79, 0x5f, 0x75, 0x73, 0x65, 0x72, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x69,
-	0x73, 0x43, 0x6f, 0x70, 0x79, 0x55, 0x73, 0x65, 0x72, 0x12, 0x21, 0x0a, 0x0c, 0x6e, 0x65, 0x77,
-	0x5f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x0b, 0x6e, 0x65, 0x77, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x22, 0x7a, 0x0a, 0x0b,
-	0x43, 0x6f, 0x70, 0x79, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x71, 0x12, 0x39, 0x0a, 0x0e, 0x6e,
-	0x6f, 0x64, 0x65, 0x5f, 0x61, 0x75, 0x74, 0x68, 0x5f, 0x69, 0x6e, 0x66, 0x6f, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x4e, 0x6f, 0x64, 0x65,
-	0x41, 0x75, 0x74, 0x68, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x0c, 0x6e, 0x6f, 0x64, 0x65, 0x41, 0x75,
-	0x74, 0x68, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x17, 0x0a, 0x07, 0x73, 0x72, 0x63, 0x5f, 0x74, 0x61,
-	0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x72, 0x63, 0x54, 0x61, 0x67, 0x12,
-	0x17, 0x0a, 0x07, 0x64, 0x73, 0x74, 0x5f, 0x74, 0x61, 0x67, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x06, 0x64, 0x73, 0x74, 0x54, 0x61, 0x67, 0x22, 0x5c, 0x0a, 0x0d, 0x47, 0x65, 0x74, 0x49,
-	0x6e, 0x62, 0x6f, 0x75, 0x6e, 0x64, 0x52, 0x65, 0x71, 0x12, 0x39, 0x0a, 0x0e, 0x6e, 0x6f, 0x64,
-	0x65, 0x5f, 0x61, 0x75, 0x74, 0x68, 0x5f, 0x69, 0x6e, 0x66, 0x6f, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x13, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x4e, 0x6f, 0x64, 0x65, 0x41, 0x75,
-	0x74, 0x68, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x0c, 0x6e, 0x6f, 0x64, 0x65, 0x41, 0x75, 0x74, 0x68,
-	0x49, 0x6e, 0x66, 0x6f, 0x12, 0x10, 0x0a, 0x03, 0x74, 0x61, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x03, 0x74, 0x61, 0x67, 0x22, 0x49, 0x0a, 0x0d, 0x47, 0x65, 0x74, 0x49, 0x6e, 0x62,
-	0x6f, 0x75, 0x6e, 0x64, 0x52, 0x73, 0x70, 0x12, 0x12, 0x0a, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x18,
+	0x21, 0x0a, 0x05, 0x75, 0x73, 0x65, 0x72, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0b,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x52, 0x05, 0x75, 0x73, 0x65,
+	0x72, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x70, 0x61, 0x69, 0x72, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x06, 0x72, 0x65, 0x70, 0x61, 0x69, 0x72, 0x12, 0x14, 0x0a, 0x05, 0x70, 0x72,
+	0x75, 0x6e, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x70, 0x72, 0x75, 0x6e, 0x65,
+	0x12, 0x1f, 0x0a, 0x0b, 0x72, 0x65, 0x70, 0x61, 0x69, 0x72, 0x5f, 0x75, 0x75, 0x69, 0x64, 0x18,
+	0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x72, 0x65, 0x70, 0x61, 0x69, 0x72, 0x55, 0x75, 0x69,
+	0x64, 0x4a, 0x04, 0x08, 0x05, 0x10, 0x06, 0x22, 0x79, 0x0a, 0x09, 0x55, 0x73, 0x65, 0x72, 0x44,
+	0x72, 0x69, 0x66, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x16, 0x0a, 0x06,
+	0x66, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x06, 0x66, 0x69,
+	0x65, 0x6c, 0x64, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x72, 0x65, 0x70, 0x61, 0x69, 0x72, 0x65, 0x64,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x72, 0x65, 0x70, 0x61, 0x69, 0x72, 0x65, 0x64,
+	0x12, 0x10, 0x0a, 0x03, 0x6d, 0x73, 0x67, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6d,
+	0x73, 0x67, 0x22, 0x63, 0x0a, 0x11, 0x52, 0x65, 0x63, 0x6f, 0x6e, 0x63, 0x69, 0x6c, 0x65, 0x55,
+	0x73, 0x65, 0x72, 0x73, 0x52, 0x73, 0x70, 0x12, 0x12, 0x0a, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x18,
 	0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x6d,
-	0x73, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6d, 0x73, 0x67, 0x12, 0x12, 0x0a,
-	0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x64, 0x61, 0x74,
-	0x61, 0x22, 0x46, 0x0a, 0x09, 0x47, 0x65, 0x74, 0x54, 0x61, 0x67, 0x52, 0x65, 0x71, 0x12, 0x39,
-	0x0a, 0x0e, 0x6e, 0x6f, 0x64, 0x65, 0x5f, 0x61, 0x75, 0x74, 0x68, 0x5f, 0x69, 0x6e, 0x66, 0x6f,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x4e,
-	0x6f, 0x64, 0x65, 0x41, 0x75, 0x74, 0x68, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x0c, 0x6e, 0x6f, 0x64,
-	0x65, 0x41, 0x75, 0x74, 0x68, 0x49, 0x6e, 0x66, 0x6f, 0x22, 0x45, 0x0a, 0x09, 0x47, 0x65, 0x74,
-	0x54, 0x61, 0x67, 0x52, 0x73, 0x70, 0x12, 0x12, 0x0a, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x6d, 0x73,
-	0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6d, 0x73, 0x67, 0x12, 0x12, 0x0a, 0x04,
-	0x74, 0x61, 0x67, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x04, 0x74, 0x61, 0x67, 0x73,
-	0x22, 0x5d, 0x0a, 0x0e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x72, 0x6f, 0x78, 0x79, 0x52,
-	0x65, 0x71, 0x12, 0x39, 0x0a, 0x0e, 0x6e, 0x6f, 0x64, 0x65, 0x5f, 0x61, 0x75, 0x74, 0x68, 0x5f,
-	0x69, 0x6e, 0x66, 0x6f, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x2e, 0x4e, 0x6f, 0x64, 0x65, 0x41, 0x75, 0x74, 0x68, 0x49, 0x6e, 0x66, 0x6f, 0x52,
-	0x0c, 0x6e, 0x6f, 0x64, 0x65, 0x41, 0x75, 0x74, 0x68, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x10, 0x0a,
-	0x03, 0x74, 0x61, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x74, 0x61, 0x67, 0x22,
-	0x36, 0x0a, 0x0e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x72, 0x6f, 0x78, 0x79, 0x52, 0x73,
-	0x70, 0x12, 0x12, 0x0a, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52,
-	0x04, 0x63, 0x6f, 0x64, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x6d, 0x73, 0x67, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x03, 0x6d, 0x73, 0x67, 0x22, 0x74, 0x0a, 0x0d, 0x41, 0x64, 0x61, 0x70, 0x74,
-	0x69, 0x76, 0x65, 0x4f, 0x70, 0x52, 0x65, 0x71, 0x12, 0x39, 0x0a, 0x0e, 0x6e, 0x6f, 0x64, 0x65,
+	0x73, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6d, 0x73, 0x67, 0x12, 0x28, 0x0a,
+	0x06, 0x64, 0x72, 0x69, 0x66, 0x74, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x10, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x44, 0x72, 0x69, 0x66, 0x74, 0x52,
+	0x06, 0x64, 0x72, 0x69, 0x66, 0x74, 0x73, 0x22, 0xad, 0x01, 0x0a, 0x09, 0x47, 0x65, 0x74, 0x53,
+	0x75, 0x62, 0x52, 0x65, 0x71, 0x12, 0x39, 0x0a, 0x0e, 0x6e, 0x6f, 0x64, 0x65, 0x5f, 0x61, 0x75,
+	0x74, 0x68, 0x5f, 0x69, 0x6e, 0x66, 0x6f, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x4e, 0x6f, 0x64, 0x65, 0x41, 0x75, 0x74, 0x68, 0x49, 0x6e,
+	0x66, 0x6f, 0x52, 0x0c, 0x6e, 0x6f, 0x64, 0x65, 0x41, 0x75, 0x74, 0x68, 0x49, 0x6e, 0x66, 0x6f,
+	0x12, 0x1f, 0x0a, 0x04, 0x75, 0x73, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0b,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x52, 0x04, 0x75, 0x73, 0x65,
+	0x72, 0x12, 0x2b, 0x0a, 0x11, 0x65, 0x78, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x5f, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x10, 0x65, 0x78,
+	0x63, 0x6c, 0x75, 0x64, 0x65, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x73, 0x12, 0x17,
+	0x0a, 0x07, 0x75, 0x73, 0x65, 0x5f, 0x73, 0x6e, 0x69, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x06, 0x75, 0x73, 0x65, 0x53, 0x6e, 0x69, 0x22, 0x68, 0x0a, 0x09, 0x47, 0x65, 0x74, 0x53, 0x75,
+	0x62, 0x52, 0x73, 0x70, 0x12, 0x12, 0x0a, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x6d, 0x73, 0x67, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6d, 0x73, 0x67, 0x12, 0x12, 0x0a, 0x04, 0x75, 0x72,
+	0x69, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x04, 0x75, 0x72, 0x69, 0x73, 0x12, 0x21,
+	0x0a, 0x05, 0x75, 0x73, 0x61, 0x67, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0b, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x52, 0x05, 0x75, 0x73, 0x61, 0x67,
+	0x65, 0x22, 0x74, 0x0a, 0x0c, 0x48, 0x65, 0x61, 0x72, 0x74, 0x42, 0x65, 0x61, 0x74, 0x52, 0x65,
+	0x71, 0x12, 0x39, 0x0a, 0x0e, 0x6e, 0x6f, 0x64, 0x65, 0x5f, 0x61, 0x75, 0x74, 0x68, 0x5f, 0x69,
+	0x6e, 0x66, 0x6f, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x2e, 0x4e, 0x6f, 0x64, 0x65, 0x41, 0x75, 0x74, 0x68, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x0c,
+	0x6e, 0x6f, 0x64, 0x65, 0x41, 0x75, 0x74, 0x68, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x29, 0x0a, 0x06,
+	0x68, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x4e, 0x6f, 0x64, 0x65, 0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x52,
+	0x06, 0x68, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x22, 0x62, 0x0a, 0x0d, 0x50, 0x72, 0x6f, 0x63, 0x65,
+	0x73, 0x73, 0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x12, 0x18, 0x0a, 0x07, 0x72, 0x75, 0x6e, 0x6e,
+	0x69, 0x6e, 0x67, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x72, 0x75, 0x6e, 0x6e, 0x69,
+	0x6e, 0x67, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x1d, 0x0a, 0x0a,
+	0x73, 0x74, 0x61, 0x72, 0x74, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x09, 0x73, 0x74, 0x61, 0x72, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x22, 0xb7, 0x04, 0x0a, 0x0a,
+	0x4e, 0x6f, 0x64, 0x65, 0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x12, 0x2a, 0x0a, 0x05, 0x70, 0x72, 0x6f, 0x78, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x14, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x50, 0x72, 0x6f, 0x63, 0x65, 0x73,
+	0x73, 0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x52, 0x05, 0x70, 0x72, 0x6f, 0x78, 0x79, 0x12, 0x30,
+	0x0a, 0x08, 0x68, 0x79, 0x73, 0x74, 0x65, 0x72, 0x69, 0x61, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x14, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x50, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73,
+	0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x52, 0x08, 0x68, 0x79, 0x73, 0x74, 0x65, 0x72, 0x69, 0x61,
+	0x12, 0x16, 0x0a, 0x06, 0x75, 0x70, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x06, 0x75, 0x70, 0x74, 0x69, 0x6d, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x75, 0x73, 0x65, 0x72,
+	0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x75, 0x73,
+	0x65, 0x72, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x21, 0x0a, 0x0c, 0x69, 0x6e, 0x62, 0x6f, 0x75,
+	0x6e, 0x64, 0x5f, 0x74, 0x61, 0x67, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0b, 0x69,
+	0x6e, 0x62, 0x6f, 0x75, 0x6e, 0x64, 0x54, 0x61, 0x67, 0x73, 0x12, 0x48, 0x0a, 0x0d, 0x63, 0x65,
+	0x72, 0x74, 0x5f, 0x65, 0x78, 0x70, 0x69, 0x72, 0x69, 0x65, 0x73, 0x18, 0x07, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x23, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x4e, 0x6f, 0x64, 0x65, 0x48, 0x65,
+	0x61, 0x6c, 0x74, 0x68, 0x2e, 0x43, 0x65, 0x72, 0x74, 0x45, 0x78, 0x70, 0x69, 0x72, 0x69, 0x65,
+	0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x0c, 0x63, 0x65, 0x72, 0x74, 0x45, 0x78, 0x70, 0x69,
+	0x72, 0x69, 0x65, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x63, 0x70, 0x75, 0x5f, 0x70, 0x65, 0x72, 0x63,
+	0x65, 0x6e, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0a, 0x63, 0x70, 0x75, 0x50, 0x65,
+	0x72, 0x63, 0x65, 0x6e, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x6d, 0x65, 0x6d, 0x5f, 0x74, 0x6f, 0x74,
+	0x61, 0x6c, 0x18, 0x09, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x6d, 0x65, 0x6d, 0x54, 0x6f, 0x74,
+	0x61, 0x6c, 0x12, 0x19, 0x0a, 0x08, 0x6d, 0x65, 0x6d, 0x5f, 0x75, 0x73, 0x65, 0x64, 0x18, 0x0a,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x6d, 0x65, 0x6d, 0x55, 0x73, 0x65, 0x64, 0x12, 0x1e, 0x0a,
+	0x0b, 0x6e, 0x65, 0x74, 0x5f, 0x72, 0x78, 0x5f, 0x72, 0x61, 0x74, 0x65, 0x18, 0x0b, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x09, 0x6e, 0x65, 0x74, 0x52, 0x78, 0x52, 0x61, 0x74, 0x65, 0x12, 0x1e, 0x0a,
+	0x0b, 0x6e, 0x65, 0x74, 0x5f, 0x74, 0x78, 0x5f, 0x72, 0x61, 0x74, 0x65, 0x18, 0x0c, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x09, 0x6e, 0x65, 0x74, 0x54, 0x78, 0x52, 0x61, 0x74, 0x65, 0x12, 0x14, 0x0a,
+	0x05, 0x6c, 0x6f, 0x61, 0x64, 0x31, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x01, 0x52, 0x05, 0x6c, 0x6f,
+	0x61, 0x64, 0x31, 0x12, 0x1f, 0x0a, 0x0b, 0x72, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x5f, 0x74, 0x69,
+	0x6d, 0x65, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x72, 0x65, 0x70, 0x6f, 0x72, 0x74,
+	0x54, 0x69, 0x6d, 0x65, 0x1a, 0x3f, 0x0a, 0x11, 0x43, 0x65, 0x72, 0x74, 0x45, 0x78, 0x70, 0x69,
+	0x72, 0x69, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76,
+	0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75,
+	0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0xd1, 0x01, 0x0a, 0x04, 0x4e, 0x6f, 0x64, 0x65, 0x12, 0x12,
+	0x0a, 0x04, 0x68, 0x6f, 0x73, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x68, 0x6f,
+	0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x6f, 0x72, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x04, 0x70, 0x6f, 0x72, 0x74, 0x12, 0x21, 0x0a, 0x0c, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65,
+	0x72, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x63, 0x6c,
+	0x75, 0x73, 0x74, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d,
+	0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x2f, 0x0a,
+	0x06, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x4e, 0x6f, 0x64, 0x65, 0x2e, 0x4c, 0x61, 0x62, 0x65, 0x6c,
+	0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x06, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x1a, 0x39,
+	0x0a, 0x0b, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a,
+	0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12,
+	0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05,
+	0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0xbd, 0x01, 0x0a, 0x0c, 0x48, 0x65,
+	0x61, 0x72, 0x74, 0x42, 0x65, 0x61, 0x74, 0x52, 0x73, 0x70, 0x12, 0x12, 0x0a, 0x04, 0x63, 0x6f,
+	0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x12, 0x10,
+	0x0a, 0x03, 0x6d, 0x73, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6d, 0x73, 0x67,
+	0x12, 0x3d, 0x0a, 0x08, 0x6e, 0x6f, 0x64, 0x65, 0x73, 0x4d, 0x61, 0x70, 0x18, 0x03, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x21, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x48, 0x65, 0x61, 0x72, 0x74,
+	0x42, 0x65, 0x61, 0x74, 0x52, 0x73, 0x70, 0x2e, 0x4e, 0x6f, 0x64, 0x65, 0x73, 0x4d, 0x61, 0x70,
+	0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x08, 0x6e, 0x6f, 0x64, 0x65, 0x73, 0x4d, 0x61, 0x70, 0x1a,
+	0x48, 0x0a, 0x0d, 0x4e, 0x6f, 0x64, 0x65, 0x73, 0x4d, 0x61, 0x70, 0x45, 0x6e, 0x74, 0x72, 0x79,
+	0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b,
+	0x65, 0x79, 0x12, 0x21, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x0b, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x4e, 0x6f, 0x64, 0x65, 0x52, 0x05,
+	0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x7e, 0x0a, 0x05, 0x4e, 0x6f, 0x64,
+	0x65, 0x73, 0x12, 0x2d, 0x0a, 0x05, 0x6e, 0x6f, 0x64, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x17, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x4e, 0x6f, 0x64, 0x65, 0x73, 0x2e,
+	0x4e, 0x6f, 0x64, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x05, 0x6e, 0x6f, 0x64, 0x65,
+	0x73, 0x1a, 0x46, 0x0a, 0x0a, 0x4e, 0x6f, 0x64, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12,
+	0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65,
+	0x79, 0x12, 0x22, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x0c, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x4e, 0x6f, 0x64, 0x65, 0x73, 0x52, 0x05,
+	0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x4c, 0x0a, 0x0f, 0x52, 0x65, 0x67,
+	0x69, 0x73, 0x74, 0x65, 0x72, 0x4e, 0x6f, 0x64, 0x65, 0x52, 0x65, 0x71, 0x12, 0x39, 0x0a, 0x0e,
+	0x6e, 0x6f, 0x64, 0x65, 0x5f, 0x61, 0x75, 0x74, 0x68, 0x5f, 0x69, 0x6e, 0x66, 0x6f, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x4e, 0x6f, 0x64,
+	0x65, 0x41, 0x75, 0x74, 0x68, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x0c, 0x6e, 0x6f, 0x64, 0x65, 0x41,
+	0x75, 0x74, 0x68, 0x49, 0x6e, 0x66, 0x6f, 0x22, 0x4b, 0x0a, 0x0f, 0x52, 0x65, 0x67, 0x69, 0x73,
+	0x74, 0x65, 0x72, 0x4e, 0x6f, 0x64, 0x65, 0x52, 0x73, 0x70, 0x12, 0x12, 0x0a, 0x04, 0x63, 0x6f,
+	0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x12, 0x10,
+	0x0a, 0x03, 0x6d, 0x73, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6d, 0x73, 0x67,
+	0x12, 0x12, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04,
+	0x64, 0x61, 0x74, 0x61, 0x22, 0x5f, 0x0a, 0x10, 0x49, 0x73, 0x73, 0x75, 0x65, 0x4e, 0x6f, 0x64,
+	0x65, 0x43, 0x65, 0x72, 0x74, 0x52, 0x65, 0x71, 0x12, 0x39, 0x0a, 0x0e, 0x6e, 0x6f, 0x64, 0x65,
 	0x5f, 0x61, 0x75, 0x74, 0x68, 0x5f, 0x69, 0x6e, 0x66, 0x6f, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
 	0x32, 0x13, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x4e, 0x6f, 0x64, 0x65, 0x41, 0x75, 0x74,
 	0x68, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x0c, 0x6e, 0x6f, 0x64, 0x65, 0x41, 0x75, 0x74, 0x68, 0x49,
-	0x6e, 0x66, 0x6f, 0x12, 0x14, 0x0a, 0x05, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x18, 0x02, 0x20, 0x03,
-	0x28, 0x09, 0x52, 0x05, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x61, 0x67,
-	0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x04, 0x74, 0x61, 0x67, 0x73, 0x22, 0x5c, 0x0a,
-	0x0b, 0x41, 0x64, 0x61, 0x70, 0x74, 0x69, 0x76, 0x65, 0x52, 0x65, 0x71, 0x12, 0x39, 0x0a, 0x0e,
+	0x6e, 0x66, 0x6f, 0x12, 0x10, 0x0a, 0x03, 0x63, 0x73, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c,
+	0x52, 0x03, 0x63, 0x73, 0x72, 0x22, 0x65, 0x0a, 0x10, 0x49, 0x73, 0x73, 0x75, 0x65, 0x4e, 0x6f,
+	0x64, 0x65, 0x43, 0x65, 0x72, 0x74, 0x52, 0x73, 0x70, 0x12, 0x12, 0x0a, 0x04, 0x63, 0x6f, 0x64,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x12, 0x10, 0x0a,
+	0x03, 0x6d, 0x73, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6d, 0x73, 0x67, 0x12,
+	0x12, 0x0a, 0x04, 0x63, 0x65, 0x72, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x63,
+	0x65, 0x72, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x63, 0x61, 0x5f, 0x63, 0x65, 0x72, 0x74, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x0c, 0x52, 0x06, 0x63, 0x61, 0x43, 0x65, 0x72, 0x74, 0x22, 0xb2, 0x01, 0x0a,
+	0x0c, 0x47, 0x6f, 0x73, 0x73, 0x69, 0x70, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x1f, 0x0a,
+	0x04, 0x6e, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x2e, 0x4e, 0x6f, 0x64, 0x65, 0x52, 0x04, 0x6e, 0x6f, 0x64, 0x65, 0x12, 0x14,
+	0x0a, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x73,
+	0x74, 0x61, 0x74, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x69, 0x6e, 0x63, 0x61, 0x72, 0x6e, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0b, 0x69, 0x6e, 0x63, 0x61, 0x72,
+	0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1e, 0x0a, 0x0a, 0x67, 0x65, 0x6e, 0x65, 0x72, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x67, 0x65, 0x6e, 0x65,
+	0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x29, 0x0a, 0x06, 0x68, 0x65, 0x61, 0x6c, 0x74, 0x68,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x4e,
+	0x6f, 0x64, 0x65, 0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x52, 0x06, 0x68, 0x65, 0x61, 0x6c, 0x74,
+	0x68, 0x22, 0x91, 0x01, 0x0a, 0x0d, 0x47, 0x6f, 0x73, 0x73, 0x69, 0x70, 0x50, 0x69, 0x6e, 0x67,
+	0x52, 0x65, 0x71, 0x12, 0x39, 0x0a, 0x0e, 0x6e, 0x6f, 0x64, 0x65, 0x5f, 0x61, 0x75, 0x74, 0x68,
+	0x5f, 0x69, 0x6e, 0x66, 0x6f, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x2e, 0x4e, 0x6f, 0x64, 0x65, 0x41, 0x75, 0x74, 0x68, 0x49, 0x6e, 0x66, 0x6f,
+	0x52, 0x0c, 0x6e, 0x6f, 0x64, 0x65, 0x41, 0x75, 0x74, 0x68, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x16,
+	0x0a, 0x06, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
+	0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x12, 0x2d, 0x0a, 0x07, 0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72,
+	0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e,
+	0x47, 0x6f, 0x73, 0x73, 0x69, 0x70, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x52, 0x07, 0x6d, 0x65,
+	0x6d, 0x62, 0x65, 0x72, 0x73, 0x22, 0x76, 0x0a, 0x0d, 0x47, 0x6f, 0x73, 0x73, 0x69, 0x70, 0x50,
+	0x69, 0x6e, 0x67, 0x52, 0x73, 0x70, 0x12, 0x12, 0x0a, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x6d, 0x73,
+	0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6d, 0x73, 0x67, 0x12, 0x10, 0x0a, 0x03,
+	0x61, 0x63, 0x6b, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x03, 0x61, 0x63, 0x6b, 0x12, 0x2d,
+	0x0a, 0x07, 0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x13, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x47, 0x6f, 0x73, 0x73, 0x69, 0x70, 0x4d, 0x65,
+	0x6d, 0x62, 0x65, 0x72, 0x52, 0x07, 0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x22, 0x51, 0x0a,
+	0x14, 0x47, 0x65, 0x74, 0x42, 0x61, 0x6e, 0x64, 0x77, 0x69, 0x64, 0x74, 0x68, 0x53, 0x74, 0x61,
+	0x74, 0x73, 0x52, 0x65, 0x71, 0x12, 0x39, 0x0a, 0x0e, 0x6e, 0x6f, 0x64, 0x65, 0x5f, 0x61, 0x75,
+	0x74, 0x68, 0x5f, 0x69, 0x6e, 0x66, 0x6f, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x4e, 0x6f, 0x64, 0x65, 0x41, 0x75, 0x74, 0x68, 0x49, 0x6e,
+	0x66, 0x6f, 0x52, 0x0c, 0x6e, 0x6f, 0x64, 0x65, 0x41, 0x75, 0x74, 0x68, 0x49, 0x6e, 0x66, 0x6f,
+	0x22, 0x91, 0x01, 0x0a, 0x05, 0x53, 0x74, 0x61, 0x74, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61,
+	0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x12,
+	0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x79,
+	0x70, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x64, 0x6f, 0x77, 0x6e, 0x6c, 0x69, 0x6e, 0x6b, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x64, 0x6f, 0x77, 0x6e, 0x6c, 0x69, 0x6e, 0x6b, 0x12, 0x16,
+	0x0a, 0x06, 0x75, 0x70, 0x6c, 0x69, 0x6e, 0x6b, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06,
+	0x75, 0x70, 0x6c, 0x69, 0x6e, 0x6b, 0x12, 0x14, 0x0a, 0x05, 0x70, 0x72, 0x6f, 0x78, 0x79, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x70, 0x72, 0x6f, 0x78, 0x79, 0x12, 0x16, 0x0a, 0x06,
+	0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x6f,
+	0x75, 0x72, 0x63, 0x65, 0x22, 0x60, 0x0a, 0x14, 0x47, 0x65, 0x74, 0x42, 0x61, 0x6e, 0x64, 0x77,
+	0x69, 0x64, 0x74, 0x68, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x73, 0x70, 0x12, 0x12, 0x0a, 0x04,
+	0x63, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x63, 0x6f, 0x64, 0x65,
+	0x12, 0x10, 0x0a, 0x03, 0x6d, 0x73, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6d,
+	0x73, 0x67, 0x12, 0x22, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x74, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x0c, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52,
+	0x05, 0x73, 0x74, 0x61, 0x74, 0x73, 0x22, 0x60, 0x0a, 0x0c, 0x54, 0x72, 0x61, 0x66, 0x66, 0x69,
+	0x63, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74,
+	0x61, 0x6d, 0x70, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73,
+	0x74, 0x61, 0x6d, 0x70, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x70, 0x6c, 0x69, 0x6e, 0x6b, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x75, 0x70, 0x6c, 0x69, 0x6e, 0x6b, 0x12, 0x1a, 0x0a, 0x08,
+	0x64, 0x6f, 0x77, 0x6e, 0x6c, 0x69, 0x6e, 0x6b, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08,
+	0x64, 0x6f, 0x77, 0x6e, 0x6c, 0x69, 0x6e, 0x6b, 0x22, 0xb0, 0x01, 0x0a, 0x0d, 0x54, 0x72, 0x61,
+	0x66, 0x66, 0x69, 0x63, 0x53, 0x65, 0x72, 0x69, 0x65, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61,
+	0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x12,
+	0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x79,
+	0x70, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x06, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x12, 0x2b, 0x0a, 0x06, 0x70, 0x6f,
+	0x69, 0x6e, 0x74, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x2e, 0x54, 0x72, 0x61, 0x66, 0x66, 0x69, 0x63, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x52,
+	0x06, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x70, 0x6c, 0x69, 0x6e,
+	0x6b, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x75, 0x70, 0x6c, 0x69, 0x6e, 0x6b, 0x12,
+	0x1a, 0x0a, 0x08, 0x64, 0x6f, 0x77, 0x6e, 0x6c, 0x69, 0x6e, 0x6b, 0x18, 0x06, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x08, 0x64, 0x6f, 0x77, 0x6e, 0x6c, 0x69, 0x6e, 0x6b, 0x22, 0xc2, 0x01, 0x0a, 0x13,
+	0x47, 0x65, 0x74, 0x54, 0x72, 0x61, 0x66, 0x66, 0x69, 0x63, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74,
+	0x52, 0x65, 0x71, 0x12, 0x39, 0x0a, 0x0e, 0x6e, 0x6f, 0x64, 0x65, 0x5f, 0x61, 0x75, 0x74, 0x68,
+	0x5f, 0x69, 0x6e, 0x66, 0x6f, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x2e, 0x4e, 0x6f, 0x64, 0x65, 0x41, 0x75, 0x74, 0x68, 0x49, 0x6e, 0x66, 0x6f,
+	0x52, 0x0c, 0x6e, 0x6f, 0x64, 0x65, 0x41, 0x75, 0x74, 0x68, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x12,
+	0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x79,
+	0x70, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x67, 0x72, 0x61, 0x6e, 0x75, 0x6c,
+	0x61, 0x72, 0x69, 0x74, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x67, 0x72, 0x61,
+	0x6e, 0x75, 0x6c, 0x61, 0x72, 0x69, 0x74, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x72,
+	0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x73, 0x74, 0x61, 0x72, 0x74, 0x12, 0x10,
+	0x0a, 0x03, 0x65, 0x6e, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x03, 0x65, 0x6e, 0x64,
+	0x22, 0x69, 0x0a, 0x13, 0x47, 0x65, 0x74, 0x54, 0x72, 0x61, 0x66, 0x66, 0x69, 0x63, 0x52, 0x65,
+	0x70, 0x6f, 0x72, 0x74, 0x52, 0x73, 0x70, 0x12, 0x12, 0x0a, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x6d,
+	0x73, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6d, 0x73, 0x67, 0x12, 0x2c, 0x0a,
+	0x06, 0x73, 0x65, 0x72, 0x69, 0x65, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x54, 0x72, 0x61, 0x66, 0x66, 0x69, 0x63, 0x53, 0x65, 0x72,
+	0x69, 0x65, 0x73, 0x52, 0x06, 0x73, 0x65, 0x72, 0x69, 0x65, 0x73, 0x22, 0xde, 0x01, 0x0a, 0x0a,
+	0x41, 0x75, 0x64, 0x69, 0x74, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x69,
+	0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x04, 0x74, 0x69, 0x6d, 0x65, 0x12, 0x12,
+	0x0a, 0x04, 0x6e, 0x6f, 0x64, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x6f,
+	0x64, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x06, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x63, 0x61,
+	0x6c, 0x6c, 0x65, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x63, 0x61, 0x6c, 0x6c,
+	0x65, 0x72, 0x12, 0x1c, 0x0a, 0x09, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x12, 0x16, 0x0a, 0x06, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x06, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x61, 0x72, 0x61,
+	0x6d, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x73,
+	0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x08, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x10, 0x0a, 0x03, 0x6d, 0x73,
+	0x67, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6d, 0x73, 0x67, 0x22, 0xbf, 0x01, 0x0a,
+	0x0e, 0x47, 0x65, 0x74, 0x41, 0x75, 0x64, 0x69, 0x74, 0x4c, 0x6f, 0x67, 0x52, 0x65, 0x71, 0x12,
+	0x39, 0x0a, 0x0e, 0x6e, 0x6f, 0x64, 0x65, 0x5f, 0x61, 0x75, 0x74, 0x68, 0x5f, 0x69, 0x6e, 0x66,
+	0x6f, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e,
+	0x4e, 0x6f, 0x64, 0x65, 0x41, 0x75, 0x74, 0x68, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x0c, 0x6e, 0x6f,
+	0x64, 0x65, 0x41, 0x75, 0x74, 0x68, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x74,
+	0x61, 0x72, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x73, 0x74, 0x61, 0x72, 0x74,
+	0x12, 0x10, 0x0a, 0x03, 0x65, 0x6e, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x03, 0x65,
+	0x6e, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x63, 0x61, 0x6c, 0x6c, 0x65, 0x72, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x06, 0x63, 0x61, 0x6c, 0x6c, 0x65, 0x72, 0x12, 0x1c, 0x0a, 0x09, 0x6f, 0x70,
+	0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6f,
+	0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x69, 0x6d, 0x69,
+	0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x22, 0x63,
+	0x0a, 0x0e, 0x47, 0x65, 0x74, 0x41, 0x75, 0x64, 0x69, 0x74, 0x4c, 0x6f, 0x67, 0x52, 0x73, 0x70,
+	0x12, 0x12, 0x0a, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04,
+	0x63, 0x6f, 0x64, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x6d, 0x73, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x03, 0x6d, 0x73, 0x67, 0x12, 0x2b, 0x0a, 0x07, 0x65, 0x6e, 0x74, 0x72, 0x69, 0x65,
+	0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e,
+	0x41, 0x75, 0x64, 0x69, 0x74, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x07, 0x65, 0x6e, 0x74, 0x72,
+	0x69, 0x65, 0x73, 0x22, 0x6b, 0x0a, 0x0c, 0x49, 0x6e, 0x62, 0x6f, 0x75, 0x6e, 0x64, 0x4f, 0x70,
+	0x52, 0x65, 0x71, 0x12, 0x39, 0x0a, 0x0e, 0x6e, 0x6f, 0x64, 0x65, 0x5f, 0x61, 0x75, 0x74, 0x68,
+	0x5f, 0x69, 0x6e, 0x66, 0x6f, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x2e, 0x4e, 0x6f, 0x64, 0x65, 0x41, 0x75, 0x74, 0x68, 0x49, 0x6e, 0x66, 0x6f,
+	0x52, 0x0c, 0x6e, 0x6f, 0x64, 0x65, 0x41, 0x75, 0x74, 0x68, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x20,
+	0x0a, 0x0b, 0x69, 0x6e, 0x62, 0x6f, 0x75, 0x6e, 0x64, 0x49, 0x6e, 0x66, 0x6f, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0b, 0x69, 0x6e, 0x62, 0x6f, 0x75, 0x6e, 0x64, 0x49, 0x6e, 0x66, 0x6f,
+	0x22, 0x34, 0x0a, 0x0c, 0x49, 0x6e, 0x62, 0x6f, 0x75, 0x6e, 0x64, 0x4f, 0x70, 0x52, 0x73, 0x70,
+	0x12, 0x12, 0x0a, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04,
+	0x63, 0x6f, 0x64, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x6d, 0x73, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x03, 0x6d, 0x73, 0x67, 0x22, 0x7c, 0x0a, 0x12, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66,
+	0x65, 0x72, 0x49, 0x6e, 0x62, 0x6f, 0x75, 0x6e, 0x64, 0x52, 0x65, 0x71, 0x12, 0x39, 0x0a, 0x0e,
 	0x6e, 0x6f, 0x64, 0x65, 0x5f, 0x61, 0x75, 0x74, 0x68, 0x5f, 0x69, 0x6e, 0x66, 0x6f, 0x18, 0x01,
 	0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x4e, 0x6f, 0x64,
 	0x65, 0x41, 0x75, 0x74, 0x68, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x0c, 0x6e, 0x6f, 0x64, 0x65, 0x41,
-	0x75, 0x74, 0x68, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x61, 0x67, 0x73, 0x18,
-	0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x04, 0x74, 0x61, 0x67, 0x73, 0x22, 0x33, 0x0a, 0x0b, 0x41,
-	0x64, 0x61, 0x70, 0x74, 0x69, 0x76, 0x65, 0x52, 0x73, 0x70, 0x12, 0x12, 0x0a, 0x04, 0x63, 0x6f,
-	0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x12, 0x10,
-	0x0a, 0x03, 0x6d, 0x73, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6d, 0x73, 0x67,
-	0x22, 0x7f, 0x0a, 0x12, 0x53, 0x65, 0x74, 0x47, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x4d, 0x6f,
-	0x64, 0x65, 0x6c, 0x52, 0x65, 0x71, 0x12, 0x39, 0x0a, 0x0e, 0x6e, 0x6f, 0x64, 0x65, 0x5f, 0x61,
-	0x75, 0x74, 0x68, 0x5f, 0x69, 0x6e, 0x66, 0x6f, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13,
-	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x4e, 0x6f, 0x64, 0x65, 0x41, 0x75, 0x74, 0x68, 0x49,
-	0x6e, 0x66, 0x6f, 0x52, 0x0c, 0x6e, 0x6f, 0x64, 0x65, 0x41, 0x75, 0x74, 0x68, 0x49, 0x6e, 0x66,
-	0x6f, 0x12, 0x2e, 0x0a, 0x12, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x47, 0x61, 0x74, 0x65, 0x77,
-	0x61, 0x79, 0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x12, 0x65,
-	0x6e, 0x61, 0x62, 0x6c, 0x65, 0x47, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x4d, 0x6f, 0x64, 0x65,
-	0x6c, 0x22, 0x3a, 0x0a, 0x12, 0x53, 0x65, 0x74, 0x47, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x4d,
-	0x6f, 0x64, 0x65, 0x6c, 0x52, 0x73, 0x70, 0x12, 0x12, 0x0a, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x6d,
-	0x73, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6d, 0x73, 0x67, 0x22, 0x65, 0x0a,
-	0x10, 0x4f, 0x62, 0x74, 0x61, 0x69, 0x6e, 0x4e, 0x65, 0x77, 0x43, 0x65, 0x72, 0x74, 0x52, 0x65,
+	0x75, 0x74, 0x68, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x10, 0x0a, 0x03, 0x74, 0x61, 0x67, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x74, 0x61, 0x67, 0x12, 0x19, 0x0a, 0x08, 0x6e, 0x65, 0x77,
+	0x5f, 0x70, 0x6f, 0x72, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x6e, 0x65, 0x77,
+	0x50, 0x6f, 0x72, 0x74, 0x22, 0xdd, 0x01, 0x0a, 0x0e, 0x43, 0x6f, 0x70, 0x79, 0x49, 0x6e, 0x62,
+	0x6f, 0x75, 0x6e, 0x64, 0x52, 0x65, 0x71, 0x12, 0x39, 0x0a, 0x0e, 0x6e, 0x6f, 0x64, 0x65, 0x5f,
+	0x61, 0x75, 0x74, 0x68, 0x5f, 0x69, 0x6e, 0x66, 0x6f, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x13, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x4e, 0x6f, 0x64, 0x65, 0x41, 0x75, 0x74, 0x68,
+	0x49, 0x6e, 0x66, 0x6f, 0x52, 0x0c, 0x6e, 0x6f, 0x64, 0x65, 0x41, 0x75, 0x74, 0x68, 0x49, 0x6e,
+	0x66, 0x6f, 0x12, 0x17, 0x0a, 0x07, 0x73, 0x72, 0x63, 0x5f, 0x74, 0x61, 0x67, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x72, 0x63, 0x54, 0x61, 0x67, 0x12, 0x17, 0x0a, 0x07, 0x6e,
+	0x65, 0x77, 0x5f, 0x74, 0x61, 0x67, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6e, 0x65,
+	0x77, 0x54, 0x61, 0x67, 0x12, 0x19, 0x0a, 0x08, 0x6e, 0x65, 0x77, 0x5f, 0x70, 0x6f, 0x72, 0x74,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x6e, 0x65, 0x77, 0x50, 0x6f, 0x72, 0x74, 0x12,
+	0x20, 0x0a, 0x0c, 0x69, 0x73, 0x5f, 0x63, 0x6f, 0x70, 0x79, 0x5f, 0x75, 0x73, 0x65, 0x72, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x69, 0x73, 0x43, 0x6f, 0x70, 0x79, 0x55, 0x73, 0x65,
+	0x72, 0x12, 0x21, 0x0a, 0x0c, 0x6e, 0x65, 0x77, 0x5f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f,
+	0x6c, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x6e, 0x65, 0x77, 0x50, 0x72, 0x6f, 0x74,
+	0x6f, 0x63, 0x6f, 0x6c, 0x22, 0x7a, 0x0a, 0x0b, 0x43, 0x6f, 0x70, 0x79, 0x55, 0x73, 0x65, 0x72,
+	0x52, 0x65, 0x71, 0x12, 0x39, 0x0a, 0x0e, 0x6e, 0x6f, 0x64, 0x65, 0x5f, 0x61, 0x75, 0x74, 0x68,
+	0x5f, 0x69, 0x6e, 0x66, 0x6f, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x2e, 0x4e, 0x6f, 0x64, 0x65, 0x41, 0x75, 0x74, 0x68, 0x49, 0x6e, 0x66, 0x6f,
+	0x52, 0x0c, 0x6e, 0x6f, 0x64, 0x65, 0x41, 0x75, 0x74, 0x68, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x17,
+	0x0a, 0x07, 0x73, 0x72, 0x63, 0x5f, 0x74, 0x61, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x06, 0x73, 0x72, 0x63, 0x54, 0x61, 0x67, 0x12, 0x17, 0x0a, 0x07, 0x64, 0x73, 0x74, 0x5f, 0x74,
+	0x61, 0x67, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x64, 0x73, 0x74, 0x54, 0x61, 0x67,
+	0x22, 0x5c, 0x0a, 0x0d, 0x47, 0x65, 0x74, 0x49, 0x6e, 0x62, 0x6f, 0x75, 0x6e, 0x64, 0x52, 0x65,
 	0x71, 0x12, 0x39, 0x0a, 0x0e, 0x6e, 0x6f, 0x64, 0x65, 0x5f, 0x61, 0x75, 0x74, 0x68, 0x5f, 0x69,
 	0x6e, 0x66, 0x6f, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x70, 0x72, 0x6f, 0x74,
 	0x6f, 0x2e, 0x4e, 0x6f, 0x64, 0x65, 0x41, 0x75, 0x74, 0x68, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x0c,
-	0x6e, 0x6f, 0x64, 0x65, 0x41, 0x75, 0x74, 0x68, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x16, 0x0a, 0x06,
-	0x64, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x64, 0x6f,
-	0x6d, 0x61, 0x69, 0x6e, 0x22, 0x38, 0x0a, 0x10, 0x4f, 0x62, 0x74, 0x61, 0x69, 0x6e, 0x4e, 0x65,
-	0x77, 0x43, 0x65, 0x72, 0x74, 0x52, 0x73, 0x70, 0x12, 0x12, 0x0a, 0x04, 0x63, 0x6f, 0x64, 0x65,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x12, 0x10, 0x0a, 0x03,
-	0x6d, 0x73, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6d, 0x73, 0x67, 0x22, 0xaa,
-	0x02, 0x0a, 0x11, 0x46, 0x61, 0x73, 0x74, 0x41, 0x64, 0x64, 0x49, 0x6e, 0x62, 0x6f, 0x75, 0x6e,
-	0x64, 0x52, 0x65, 0x71, 0x12, 0x39, 0x0a, 0x0e, 0x6e, 0x6f, 0x64, 0x65, 0x5f, 0x61, 0x75, 0x74,
+	0x6e, 0x6f, 0x64, 0x65, 0x41, 0x75, 0x74, 0x68, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x10, 0x0a, 0x03,
+	0x74, 0x61, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x74, 0x61, 0x67, 0x22, 0x49,
+	0x0a, 0x0d, 0x47, 0x65, 0x74, 0x49, 0x6e, 0x62, 0x6f, 0x75, 0x6e, 0x64, 0x52, 0x73, 0x70, 0x12,
+	0x12, 0x0a, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x63,
+	0x6f, 0x64, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x6d, 0x73, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x03, 0x6d, 0x73, 0x67, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x22, 0x46, 0x0a, 0x09, 0x47, 0x65, 0x74,
+	0x54, 0x61, 0x67, 0x52, 0x65, 0x71, 0x12, 0x39, 0x0a, 0x0e, 0x6e, 0x6f, 0x64, 0x65, 0x5f, 0x61,
+	0x75, 0x74, 0x68, 0x5f, 0x69, 0x6e, 0x66, 0x6f, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x4e, 0x6f, 0x64, 0x65, 0x41, 0x75, 0x74, 0x68, 0x49,
+	0x6e, 0x66, 0x6f, 0x52, 0x0c, 0x6e, 0x6f, 0x64, 0x65, 0x41, 0x75, 0x74, 0x68, 0x49, 0x6e, 0x66,
+	0x6f, 0x22, 0x45, 0x0a, 0x09, 0x47, 0x65, 0x74, 0x54, 0x61, 0x67, 0x52, 0x73, 0x70, 0x12, 0x12,
+	0x0a, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x63, 0x6f,
+	0x64, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x6d, 0x73, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x03, 0x6d, 0x73, 0x67, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x61, 0x67, 0x73, 0x18, 0x03, 0x20, 0x03,
+	0x28, 0x09, 0x52, 0x04, 0x74, 0x61, 0x67, 0x73, 0x22, 0x5d, 0x0a, 0x0e, 0x55, 0x70, 0x64, 0x61,
+	0x74, 0x65, 0x50, 0x72, 0x6f, 0x78, 0x79, 0x52, 0x65, 0x71, 0x12, 0x39, 0x0a, 0x0e, 0x6e, 0x6f,
+	0x64, 0x65, 0x5f, 0x61, 0x75, 0x74, 0x68, 0x5f, 0x69, 0x6e, 0x66, 0x6f, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x13, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x4e, 0x6f, 0x64, 0x65, 0x41,
+	0x75, 0x74, 0x68, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x0c, 0x6e, 0x6f, 0x64, 0x65, 0x41, 0x75, 0x74,
+	0x68, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x10, 0x0a, 0x03, 0x74, 0x61, 0x67, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x03, 0x74, 0x61, 0x67, 0x22, 0x36, 0x0a, 0x0e, 0x55, 0x70, 0x64, 0x61, 0x74,
+	0x65, 0x50, 0x72, 0x6f, 0x78, 0x79, 0x52, 0x73, 0x70, 0x12, 0x12, 0x0a, 0x04, 0x63, 0x6f, 0x64,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x12, 0x10, 0x0a,
+	0x03, 0x6d, 0x73, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6d, 0x73, 0x67, 0x22,
+	0x74, 0x0a, 0x0d, 0x41, 0x64, 0x61, 0x70, 0x74, 0x69, 0x76, 0x65, 0x4f, 0x70, 0x52, 0x65, 0x71,
+	0x12, 0x39, 0x0a, 0x0e, 0x6e, 0x6f, 0x64, 0x65, 0x5f, 0x61, 0x75, 0x74, 0x68, 0x5f, 0x69, 0x6e,
+	0x66, 0x6f, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x2e, 0x4e, 0x6f, 0x64, 0x65, 0x41, 0x75, 0x74, 0x68, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x0c, 0x6e,
+	0x6f, 0x64, 0x65, 0x41, 0x75, 0x74, 0x68, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x14, 0x0a, 0x05, 0x70,
+	0x6f, 0x72, 0x74, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x05, 0x70, 0x6f, 0x72, 0x74,
+	0x73, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x61, 0x67, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52,
+	0x04, 0x74, 0x61, 0x67, 0x73, 0x22, 0x5c, 0x0a, 0x0b, 0x41, 0x64, 0x61, 0x70, 0x74, 0x69, 0x76,
+	0x65, 0x52, 0x65, 0x71, 0x12, 0x39, 0x0a, 0x0e, 0x6e, 0x6f, 0x64, 0x65, 0x5f, 0x61, 0x75, 0x74,
 	0x68, 0x5f, 0x69, 0x6e, 0x66, 0x6f, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x70,
 	0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x4e, 0x6f, 0x64, 0x65, 0x41, 0x75, 0x74, 0x68, 0x49, 0x6e, 0x66,
 	0x6f, 0x52, 0x0c, 0x6e, 0x6f, 0x64, 0x65, 0x41, 0x75, 0x74, 0x68, 0x49, 0x6e, 0x66, 0x6f, 0x12,
-	0x42, 0x0a, 0x12, 0x69, 0x6e, 0x62, 0x6f, 0x75, 0x6e, 0x64, 0x42, 0x75, 0x69, 0x6c, 0x64, 0x65,
-	0x72, 0x54, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x12, 0x2e, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x2e, 0x42, 0x75, 0x69, 0x6c, 0x64, 0x65, 0x72, 0x54, 0x79, 0x70, 0x65, 0x52,
-	0x12, 0x69, 0x6e, 0x62, 0x6f, 0x75, 0x6e, 0x64, 0x42, 0x75, 0x69, 0x6c, 0x64, 0x65, 0x72, 0x54,
-	0x79, 0x70, 0x65, 0x12, 0x40, 0x0a, 0x11, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x42, 0x75, 0x69,
-	0x6c, 0x64, 0x65, 0x72, 0x54, 0x79, 0x70, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x12,
-	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x42, 0x75, 0x69, 0x6c, 0x64, 0x65, 0x72, 0x54, 0x79,
-	0x70, 0x65, 0x52, 0x11, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x42, 0x75, 0x69, 0x6c, 0x64, 0x65,
-	0x72, 0x54, 0x79, 0x70, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x6f, 0x72, 0x74, 0x18, 0x04, 0x20,
-	0x01, 0x28, 0x05, 0x52, 0x04, 0x70, 0x6f, 0x72, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x64, 0x6f, 0x6d,
-	0x61, 0x69, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x64, 0x6f, 0x6d, 0x61, 0x69,
-	0x6e, 0x12, 0x16, 0x0a, 0x06, 0x69, 0x73, 0x58, 0x74, 0x6c, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28,
-	0x08, 0x52, 0x06, 0x69, 0x73, 0x58, 0x74, 0x6c, 0x73, 0x12, 0x10, 0x0a, 0x03, 0x74, 0x61, 0x67,
-	0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x74, 0x61, 0x67, 0x22, 0x39, 0x0a, 0x11, 0x46,
-	0x61, 0x73, 0x74, 0x41, 0x64, 0x64, 0x49, 0x6e, 0x62, 0x6f, 0x75, 0x6e, 0x64, 0x52, 0x73, 0x70,
+	0x12, 0x0a, 0x04, 0x74, 0x61, 0x67, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x04, 0x74,
+	0x61, 0x67, 0x73, 0x22, 0x33, 0x0a, 0x0b, 0x41, 0x64, 0x61, 0x70, 0x74, 0x69, 0x76, 0x65, 0x52,
+	0x73, 0x70, 0x12, 0x12, 0x0a, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x6d, 0x73, 0x67, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x03, 0x6d, 0x73, 0x67, 0x22, 0x7f, 0x0a, 0x12, 0x53, 0x65, 0x74, 0x47,
+	0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x52, 0x65, 0x71, 0x12, 0x39,
+	0x0a, 0x0e, 0x6e, 0x6f, 0x64, 0x65, 0x5f, 0x61, 0x75, 0x74, 0x68, 0x5f, 0x69, 0x6e, 0x66, 0x6f,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x4e,
+	0x6f, 0x64, 0x65, 0x41, 0x75, 0x74, 0x68, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x0c, 0x6e, 0x6f, 0x64,
+	0x65, 0x41, 0x75, 0x74, 0x68, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x2e, 0x0a, 0x12, 0x65, 0x6e, 0x61,
+	0x62, 0x6c, 0x65, 0x47, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x12, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x47, 0x61, 0x74,
+	0x65, 0x77, 0x61, 0x79, 0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x22, 0x3a, 0x0a, 0x12, 0x53, 0x65, 0x74,
+	0x47, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x52, 0x73, 0x70, 0x12,
+	0x12, 0x0a, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x63,
+	0x6f, 0x64, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x6d, 0x73, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x03, 0x6d, 0x73, 0x67, 0x22, 0x65, 0x0a, 0x10, 0x4f, 0x62, 0x74, 0x61, 0x69, 0x6e, 0x4e,
+	0x65, 0x77, 0x43, 0x65, 0x72, 0x74, 0x52, 0x65, 0x71, 0x12, 0x39, 0x0a, 0x0e, 0x6e, 0x6f, 0x64,
+	0x65, 0x5f, 0x61, 0x75, 0x74, 0x68, 0x5f, 0x69, 0x6e, 0x66, 0x6f, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x13, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x4e, 0x6f, 0x64, 0x65, 0x41, 0x75,
+	0x74, 0x68, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x0c, 0x6e, 0x6f, 0x64, 0x65, 0x41, 0x75, 0x74, 0x68,
+	0x49, 0x6e, 0x66, 0x6f, 0x12, 0x16, 0x0a, 0x06, 0x64, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x64, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x22, 0x38, 0x0a, 0x10,
+	0x4f, 0x62, 0x74, 0x61, 0x69, 0x6e, 0x4e, 0x65, 0x77, 0x43, 0x65, 0x72, 0x74, 0x52, 0x73, 0x70,
 	0x12, 0x12, 0x0a, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04,
 	0x63, 0x6f, 0x64, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x6d, 0x73, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x03, 0x6d, 0x73, 0x67, 0x22, 0x9e, 0x01, 0x0a, 0x0f, 0x54, 0x72, 0x61, 0x6e, 0x73,
-	0x66, 0x65, 0x72, 0x43, 0x65, 0x72, 0x74, 0x52, 0x65, 0x71, 0x12, 0x39, 0x0a, 0x0e, 0x6e, 0x6f,
-	0x64, 0x65, 0x5f, 0x61, 0x75, 0x74, 0x68, 0x5f, 0x69, 0x6e, 0x66, 0x6f, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x13, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x4e, 0x6f, 0x64, 0x65, 0x41,
-	0x75, 0x74, 0x68, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x0c, 0x6e, 0x6f, 0x64, 0x65, 0x41, 0x75, 0x74,
-	0x68, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x16, 0x0a, 0x06, 0x64, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x64, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x12, 0x1b, 0x0a,
-	0x09, 0x63, 0x65, 0x72, 0x74, 0x5f, 0x64, 0x61, 0x74, 0x61, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c,
-	0x52, 0x08, 0x63, 0x65, 0x72, 0x74, 0x44, 0x61, 0x74, 0x61, 0x12, 0x1b, 0x0a, 0x09, 0x6b, 0x65,
-	0x79, 0x5f, 0x64, 0x61, 0x74, 0x61, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x08, 0x6b,
-	0x65, 0x79, 0x44, 0x61, 0x74, 0x61, 0x73, 0x22, 0x37, 0x0a, 0x0f, 0x54, 0x72, 0x61, 0x6e, 0x73,
-	0x66, 0x65, 0x72, 0x43, 0x65, 0x72, 0x74, 0x52, 0x73, 0x70, 0x12, 0x12, 0x0a, 0x04, 0x63, 0x6f,
-	0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x12, 0x10,
-	0x0a, 0x03, 0x6d, 0x73, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6d, 0x73, 0x67,
-	0x22, 0x77, 0x0a, 0x04, 0x43, 0x65, 0x72, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x64, 0x6f, 0x6d, 0x61,
-	0x69, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x64, 0x6f, 0x6d, 0x61, 0x69, 0x6e,
-	0x12, 0x19, 0x0a, 0x08, 0x6b, 0x65, 0x79, 0x5f, 0x66, 0x69, 0x6c, 0x65, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x07, 0x6b, 0x65, 0x79, 0x46, 0x69, 0x6c, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x63,
-	0x65, 0x72, 0x74, 0x5f, 0x66, 0x69, 0x6c, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08,
-	0x63, 0x65, 0x72, 0x74, 0x46, 0x69, 0x6c, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x65, 0x78, 0x70, 0x69,
-	0x72, 0x65, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x65,
-	0x78, 0x70, 0x69, 0x72, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x22, 0x48, 0x0a, 0x0b, 0x47, 0x65, 0x74,
-	0x43, 0x65, 0x72, 0x74, 0x73, 0x52, 0x65, 0x71, 0x12, 0x39, 0x0a, 0x0e, 0x6e, 0x6f, 0x64, 0x65,
+	0x09, 0x52, 0x03, 0x6d, 0x73, 0x67, 0x22, 0xaa, 0x02, 0x0a, 0x11, 0x46, 0x61, 0x73, 0x74, 0x41,
+	0x64, 0x64, 0x49, 0x6e, 0x62, 0x6f, 0x75, 0x6e, 0x64, 0x52, 0x65, 0x71, 0x12, 0x39, 0x0a, 0x0e,
+	0x6e, 0x6f, 0x64, 0x65, 0x5f, 0x61, 0x75, 0x74, 0x68, 0x5f, 0x69, 0x6e, 0x66, 0x6f, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x4e, 0x6f, 0x64,
+	0x65, 0x41, 0x75, 0x74, 0x68, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x0c, 0x6e, 0x6f, 0x64, 0x65, 0x41,
+	0x75, 0x74, 0x68, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x42, 0x0a, 0x12, 0x69, 0x6e, 0x62, 0x6f, 0x75,
+	0x6e, 0x64, 0x42, 0x75, 0x69, 0x6c, 0x64, 0x65, 0x72, 0x54, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0e, 0x32, 0x12, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x42, 0x75, 0x69, 0x6c,
+	0x64, 0x65, 0x72, 0x54, 0x79, 0x70, 0x65, 0x52, 0x12, 0x69, 0x6e, 0x62, 0x6f, 0x75, 0x6e, 0x64,
+	0x42, 0x75, 0x69, 0x6c, 0x64, 0x65, 0x72, 0x54, 0x79, 0x70, 0x65, 0x12, 0x40, 0x0a, 0x11, 0x73,
+	0x74, 0x72, 0x65, 0x61, 0x6d, 0x42, 0x75, 0x69, 0x6c, 0x64, 0x65, 0x72, 0x54, 0x79, 0x70, 0x65,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x12, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x42,
+	0x75, 0x69, 0x6c, 0x64, 0x65, 0x72, 0x54, 0x79, 0x70, 0x65, 0x52, 0x11, 0x73, 0x74, 0x72, 0x65,
+	0x61, 0x6d, 0x42, 0x75, 0x69, 0x6c, 0x64, 0x65, 0x72, 0x54, 0x79, 0x70, 0x65, 0x12, 0x12, 0x0a,
+	0x04, 0x70, 0x6f, 0x72, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x70, 0x6f, 0x72,
+	0x74, 0x12, 0x16, 0x0a, 0x06, 0x64, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x06, 0x64, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x12, 0x16, 0x0a, 0x06, 0x69, 0x73, 0x58,
+	0x74, 0x6c, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x69, 0x73, 0x58, 0x74, 0x6c,
+	0x73, 0x12, 0x10, 0x0a, 0x03, 0x74, 0x61, 0x67, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03,
+	0x74, 0x61, 0x67, 0x22, 0x39, 0x0a, 0x11, 0x46, 0x61, 0x73, 0x74, 0x41, 0x64, 0x64, 0x49, 0x6e,
+	0x62, 0x6f, 0x75, 0x6e, 0x64, 0x52, 0x73, 0x70, 0x12, 0x12, 0x0a, 0x04, 0x63, 0x6f, 0x64, 0x65,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x12, 0x10, 0x0a, 0x03,
+	0x6d, 0x73, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6d, 0x73, 0x67, 0x22, 0x9e,
+	0x01, 0x0a, 0x0f, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x43, 0x65, 0x72, 0x74, 0x52,
+	0x65, 0x71, 0x12, 0x39, 0x0a, 0x0e, 0x6e, 0x6f, 0x64, 0x65, 0x5f, 0x61, 0x75, 0x74, 0x68, 0x5f,
+	0x69, 0x6e, 0x66, 0x6f, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x2e, 0x4e, 0x6f, 0x64, 0x65, 0x41, 0x75, 0x74, 0x68, 0x49, 0x6e, 0x66, 0x6f, 0x52,
+	0x0c, 0x6e, 0x6f, 0x64, 0x65, 0x41, 0x75, 0x74, 0x68, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x16, 0x0a,
+	0x06, 0x64, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x64,
+	0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x12, 0x1b, 0x0a, 0x09, 0x63, 0x65, 0x72, 0x74, 0x5f, 0x64, 0x61,
+	0x74, 0x61, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x08, 0x63, 0x65, 0x72, 0x74, 0x44, 0x61,
+	0x74, 0x61, 0x12, 0x1b, 0x0a, 0x09, 0x6b, 0x65, 0x79, 0x5f, 0x64, 0x61, 0x74, 0x61, 0x73, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x08, 0x6b, 0x65, 0x79, 0x44, 0x61, 0x74, 0x61, 0x73, 0x22,
+	0x37, 0x0a, 0x0f, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x43, 0x65, 0x72, 0x74, 0x52,
+	0x73, 0x70, 0x12, 0x12, 0x0a, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x6d, 0x73, 0x67, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x03, 0x6d, 0x73, 0x67, 0x22, 0x77, 0x0a, 0x04, 0x43, 0x65, 0x72, 0x74,
+	0x12, 0x16, 0x0a, 0x06, 0x64, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x06, 0x64, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x12, 0x19, 0x0a, 0x08, 0x6b, 0x65, 0x79, 0x5f,
+	0x66, 0x69, 0x6c, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6b, 0x65, 0x79, 0x46,
+	0x69, 0x6c, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x63, 0x65, 0x72, 0x74, 0x5f, 0x66, 0x69, 0x6c, 0x65,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x63, 0x65, 0x72, 0x74, 0x46, 0x69, 0x6c, 0x65,
+	0x12, 0x1f, 0x0a, 0x0b, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x54, 0x69, 0x6d,
+	0x65, 0x22, 0x48, 0x0a, 0x0b, 0x47, 0x65, 0x74, 0x43, 0x65, 0x72, 0x74, 0x73, 0x52, 0x65, 0x71,
+	0x12, 0x39, 0x0a, 0x0e, 0x6e, 0x6f, 0x64, 0x65, 0x5f, 0x61, 0x75, 0x74, 0x68, 0x5f, 0x69, 0x6e,
+	0x66, 0x6f, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x2e, 0x4e, 0x6f, 0x64, 0x65, 0x41, 0x75, 0x74, 0x68, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x0c, 0x6e,
+	0x6f, 0x64, 0x65, 0x41, 0x75, 0x74, 0x68, 0x49, 0x6e, 0x66, 0x6f, 0x22, 0x44, 0x0a, 0x0b, 0x47,
+	0x65, 0x74, 0x43, 0x65, 0x72, 0x74, 0x73, 0x52, 0x73, 0x70, 0x12, 0x12, 0x0a, 0x04, 0x63, 0x6f,
+	0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x12, 0x21,
+	0x0a, 0x05, 0x63, 0x65, 0x72, 0x74, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0b, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x43, 0x65, 0x72, 0x74, 0x52, 0x05, 0x63, 0x65, 0x72, 0x74,
+	0x73, 0x22, 0x60, 0x0a, 0x0d, 0x43, 0x6c, 0x65, 0x61, 0x72, 0x55, 0x73, 0x65, 0x72, 0x73, 0x52,
+	0x65, 0x71, 0x12, 0x39, 0x0a, 0x0e, 0x6e, 0x6f, 0x64, 0x65, 0x5f, 0x61, 0x75, 0x74, 0x68, 0x5f,
+	0x69, 0x6e, 0x66, 0x6f, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x2e, 0x4e, 0x6f, 0x64, 0x65, 0x41, 0x75, 0x74, 0x68, 0x49, 0x6e, 0x66, 0x6f, 0x52,
+	0x0c, 0x6e, 0x6f, 0x64, 0x65, 0x41, 0x75, 0x74, 0x68, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x14, 0x0a,
+	0x05, 0x75, 0x73, 0x65, 0x72, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x05, 0x75, 0x73,
+	0x65, 0x72, 0x73, 0x22, 0x35, 0x0a, 0x0d, 0x43, 0x6c, 0x65, 0x61, 0x72, 0x55, 0x73, 0x65, 0x72,
+	0x73, 0x52, 0x73, 0x70, 0x12, 0x12, 0x0a, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x6d, 0x73, 0x67, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6d, 0x73, 0x67, 0x22, 0x83, 0x01, 0x0a, 0x09, 0x50,
+	0x6c, 0x61, 0x6e, 0x4f, 0x70, 0x52, 0x65, 0x71, 0x12, 0x39, 0x0a, 0x0e, 0x6e, 0x6f, 0x64, 0x65,
 	0x5f, 0x61, 0x75, 0x74, 0x68, 0x5f, 0x69, 0x6e, 0x66, 0x6f, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
 	0x32, 0x13, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x4e, 0x6f, 0x64, 0x65, 0x41, 0x75, 0x74,
 	0x68, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x0c, 0x6e, 0x6f, 0x64, 0x65, 0x41, 0x75, 0x74, 0x68, 0x49,
-	0x6e, 0x66, 0x6f, 0x22, 0x44, 0x0a, 0x0b, 0x47, 0x65, 0x74, 0x43, 0x65, 0x72, 0x74, 0x73, 0x52,
-	0x73, 0x70, 0x12, 0x12, 0x0a, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05,
-	0x52, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x12, 0x21, 0x0a, 0x05, 0x63, 0x65, 0x72, 0x74, 0x73, 0x18,
-	0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x43, 0x65,
-	0x72, 0x74, 0x52, 0x05, 0x63, 0x65, 0x72, 0x74, 0x73, 0x22, 0x60, 0x0a, 0x0d, 0x43, 0x6c, 0x65,
-	0x61, 0x72, 0x55, 0x73, 0x65, 0x72, 0x73, 0x52, 0x65, 0x71, 0x12, 0x39, 0x0a, 0x0e, 0x6e, 0x6f,
-	0x64, 0x65, 0x5f, 0x61, 0x75, 0x74, 0x68, 0x5f, 0x69, 0x6e, 0x66, 0x6f, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x13, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x4e, 0x6f, 0x64, 0x65, 0x41,
-	0x75, 0x74, 0x68, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x0c, 0x6e, 0x6f, 0x64, 0x65, 0x41, 0x75, 0x74,
-	0x68, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x14, 0x0a, 0x05, 0x75, 0x73, 0x65, 0x72, 0x73, 0x18, 0x02,
-	0x20, 0x03, 0x28, 0x09, 0x52, 0x05, 0x75, 0x73, 0x65, 0x72, 0x73, 0x22, 0x35, 0x0a, 0x0d, 0x43,
-	0x6c, 0x65, 0x61, 0x72, 0x55, 0x73, 0x65, 0x72, 0x73, 0x52, 0x73, 0x70, 0x12, 0x12, 0x0a, 0x04,
+	0x6e, 0x66, 0x6f, 0x12, 0x21, 0x0a, 0x05, 0x70, 0x6c, 0x61, 0x6e, 0x73, 0x18, 0x02, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x50, 0x6c, 0x61, 0x6e, 0x52,
+	0x05, 0x70, 0x6c, 0x61, 0x6e, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x72, 0x65, 0x61, 0x70, 0x70, 0x6c,
+	0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x72, 0x65, 0x61, 0x70, 0x70, 0x6c, 0x79,
+	0x22, 0x31, 0x0a, 0x09, 0x50, 0x6c, 0x61, 0x6e, 0x4f, 0x70, 0x52, 0x73, 0x70, 0x12, 0x12, 0x0a,
+	0x04, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x63, 0x6f, 0x64,
+	0x65, 0x12, 0x10, 0x0a, 0x03, 0x6d, 0x73, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03,
+	0x6d, 0x73, 0x67, 0x22, 0x48, 0x0a, 0x0b, 0x47, 0x65, 0x74, 0x50, 0x6c, 0x61, 0x6e, 0x73, 0x52,
+	0x65, 0x71, 0x12, 0x39, 0x0a, 0x0e, 0x6e, 0x6f, 0x64, 0x65, 0x5f, 0x61, 0x75, 0x74, 0x68, 0x5f,
+	0x69, 0x6e, 0x66, 0x6f, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x2e, 0x4e, 0x6f, 0x64, 0x65, 0x41, 0x75, 0x74, 0x68, 0x49, 0x6e, 0x66, 0x6f, 0x52,
+	0x0c, 0x6e, 0x6f, 0x64, 0x65, 0x41, 0x75, 0x74, 0x68, 0x49, 0x6e, 0x66, 0x6f, 0x22, 0x56, 0x0a,
+	0x0b, 0x47, 0x65, 0x74, 0x50, 0x6c, 0x61, 0x6e, 0x73, 0x52, 0x73, 0x70, 0x12, 0x12, 0x0a, 0x04,
 	0x63, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x63, 0x6f, 0x64, 0x65,
 	0x12, 0x10, 0x0a, 0x03, 0x6d, 0x73, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6d,
-	0x73, 0x67, 0x22, 0x65, 0x0a, 0x0a, 0x50, 0x69, 0x6e, 0x67, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63,
-	0x12, 0x12, 0x0a, 0x04, 0x68, 0x6f, 0x73, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
-	0x68, 0x6f, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x12, 0x2b, 0x0a, 0x07,
-	0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x11, 0x2e,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x50, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74,
-	0x52, 0x07, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x22, 0xe0, 0x01, 0x0a, 0x0a, 0x50, 0x69,
-	0x6e, 0x67, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x67, 0x65, 0x6f, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x67, 0x65, 0x6f, 0x12, 0x10, 0x0a, 0x03, 0x69, 0x73,
-	0x70, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x69, 0x73, 0x70, 0x12, 0x1b, 0x0a, 0x09,
-	0x6d, 0x61, 0x78, 0x5f, 0x64, 0x65, 0x6c, 0x61, 0x79, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x02, 0x52,
-	0x08, 0x6d, 0x61, 0x78, 0x44, 0x65, 0x6c, 0x61, 0x79, 0x12, 0x1b, 0x0a, 0x09, 0x6d, 0x69, 0x6e,
-	0x5f, 0x64, 0x65, 0x6c, 0x61, 0x79, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x02, 0x52, 0x08, 0x6d, 0x69,
-	0x6e, 0x44, 0x65, 0x6c, 0x61, 0x79, 0x12, 0x20, 0x0a, 0x0c, 0x73, 0x74, 0x5f, 0x64, 0x65, 0x76,
-	0x5f, 0x64, 0x65, 0x6c, 0x61, 0x79, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x02, 0x52, 0x0a, 0x73, 0x74,
-	0x44, 0x65, 0x76, 0x44, 0x65, 0x6c, 0x61, 0x79, 0x12, 0x1b, 0x0a, 0x09, 0x61, 0x76, 0x67, 0x5f,
-	0x64, 0x65, 0x6c, 0x61, 0x79, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x02, 0x52, 0x08, 0x61, 0x76, 0x67,
-	0x44, 0x65, 0x6c, 0x61, 0x79, 0x12, 0x12, 0x0a, 0x04, 0x6c, 0x6f, 0x73, 0x73, 0x18, 0x0e, 0x20,
-	0x01, 0x28, 0x02, 0x52, 0x04, 0x6c, 0x6f, 0x73, 0x73, 0x12, 0x21, 0x0a, 0x0c, 0x6c, 0x61, 0x74,
-	0x65, 0x73, 0x74, 0x5f, 0x64, 0x65, 0x6c, 0x61, 0x79, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x02, 0x52,
-	0x0b, 0x6c, 0x61, 0x74, 0x65, 0x73, 0x74, 0x44, 0x65, 0x6c, 0x61, 0x79, 0x22, 0x4d, 0x0a, 0x10,
-	0x47, 0x65, 0x74, 0x50, 0x69, 0x6e, 0x67, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x52, 0x65, 0x71,
-	0x12, 0x39, 0x0a, 0x0e, 0x6e, 0x6f, 0x64, 0x65, 0x5f, 0x61, 0x75, 0x74, 0x68, 0x5f, 0x69, 0x6e,
-	0x66, 0x6f, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x2e, 0x4e, 0x6f, 0x64, 0x65, 0x41, 0x75, 0x74, 0x68, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x0c, 0x6e,
-	0x6f, 0x64, 0x65, 0x41, 0x75, 0x74, 0x68, 0x49, 0x6e, 0x66, 0x6f, 0x22, 0x63, 0x0a, 0x10, 0x47,
-	0x65, 0x74, 0x50, 0x69, 0x6e, 0x67, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x52, 0x73, 0x70, 0x12,
+	0x73, 0x67, 0x12, 0x21, 0x0a, 0x05, 0x70, 0x6c, 0x61, 0x6e, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x0b, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x50, 0x6c, 0x61, 0x6e, 0x52, 0x05,
+	0x70, 0x6c, 0x61, 0x6e, 0x73, 0x22, 0x65, 0x0a, 0x0a, 0x50, 0x69, 0x6e, 0x67, 0x4d, 0x65, 0x74,
+	0x72, 0x69, 0x63, 0x12, 0x12, 0x0a, 0x04, 0x68, 0x6f, 0x73, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x04, 0x68, 0x6f, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x6f, 0x75, 0x72, 0x63,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x12,
+	0x2b, 0x0a, 0x07, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x11, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x50, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x73,
+	0x75, 0x6c, 0x74, 0x52, 0x07, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x22, 0xe0, 0x01, 0x0a,
+	0x0a, 0x50, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x67,
+	0x65, 0x6f, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x67, 0x65, 0x6f, 0x12, 0x10, 0x0a,
+	0x03, 0x69, 0x73, 0x70, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x69, 0x73, 0x70, 0x12,
+	0x1b, 0x0a, 0x09, 0x6d, 0x61, 0x78, 0x5f, 0x64, 0x65, 0x6c, 0x61, 0x79, 0x18, 0x0a, 0x20, 0x01,
+	0x28, 0x02, 0x52, 0x08, 0x6d, 0x61, 0x78, 0x44, 0x65, 0x6c, 0x61, 0x79, 0x12, 0x1b, 0x0a, 0x09,
+	0x6d, 0x69, 0x6e, 0x5f, 0x64, 0x65, 0x6c, 0x61, 0x79, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x02, 0x52,
+	0x08, 0x6d, 0x69, 0x6e, 0x44, 0x65, 0x6c, 0x61, 0x79, 0x12, 0x20, 0x0a, 0x0c, 0x73, 0x74, 0x5f,
+	0x64, 0x65, 0x76, 0x5f, 0x64, 0x65, 0x6c, 0x61, 0x79, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x02, 0x52,
+	0x0a, 0x73, 0x74, 0x44, 0x65, 0x76, 0x44, 0x65, 0x6c, 0x61, 0x79, 0x12, 0x1b, 0x0a, 0x09, 0x61,
+	0x76, 0x67, 0x5f, 0x64, 0x65, 0x6c, 0x61, 0x79, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x02, 0x52, 0x08,
+	0x61, 0x76, 0x67, 0x44, 0x65, 0x6c, 0x61, 0x79, 0x12, 0x12, 0x0a, 0x04, 0x6c, 0x6f, 0x73, 0x73,
+	0x18, 0x0e, 0x20, 0x01, 0x28, 0x02, 0x52, 0x04, 0x6c, 0x6f, 0x73, 0x73, 0x12, 0x21, 0x0a, 0x0c,
+	0x6c, 0x61, 0x74, 0x65, 0x73, 0x74, 0x5f, 0x64, 0x65, 0x6c, 0x61, 0x79, 0x18, 0x0f, 0x20, 0x01,
+	0x28, 0x02, 0x52, 0x0b, 0x6c, 0x61, 0x74, 0x65, 0x73, 0x74, 0x44, 0x65, 0x6c, 0x61, 0x79, 0x22,
+	0x4d, 0x0a, 0x10, 0x47, 0x65, 0x74, 0x50, 0x69, 0x6e, 0x67, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63,
+	0x52, 0x65, 0x71, 0x12, 0x39, 0x0a, 0x0e, 0x6e, 0x6f, 0x64, 0x65, 0x5f, 0x61, 0x75, 0x74, 0x68,
+	0x5f, 0x69, 0x6e, 0x66, 0x6f, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x2e, 0x4e, 0x6f, 0x64, 0x65, 0x41, 0x75, 0x74, 0x68, 0x49, 0x6e, 0x66, 0x6f,
+	0x52, 0x0c, 0x6e, 0x6f, 0x64, 0x65, 0x41, 0x75, 0x74, 0x68, 0x49, 0x6e, 0x66, 0x6f, 0x22, 0x63,
+	0x0a, 0x10, 0x47, 0x65, 0x74, 0x50, 0x69, 0x6e, 0x67, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x52,
+	0x73, 0x70, 0x12, 0x12, 0x0a, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x6d, 0x73, 0x67, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x03, 0x6d, 0x73, 0x67, 0x12, 0x29, 0x0a, 0x06, 0x6d, 0x65, 0x74, 0x72,
+	0x69, 0x63, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x2e, 0x50, 0x69, 0x6e, 0x67, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x52, 0x06, 0x6d, 0x65, 0x74,
+	0x72, 0x69, 0x63, 0x22, 0x32, 0x0a, 0x0d, 0x47, 0x65, 0x74, 0x43, 0x6c, 0x75, 0x74, 0x65, 0x72,
+	0x73, 0x52, 0x65, 0x71, 0x12, 0x21, 0x0a, 0x0c, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x5f,
+	0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x63, 0x6c, 0x75, 0x73,
+	0x74, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x22, 0x34, 0x0a, 0x0d, 0x47, 0x65, 0x74, 0x43, 0x6c,
+	0x75, 0x74, 0x65, 0x72, 0x73, 0x52, 0x73, 0x70, 0x12, 0x23, 0x0a, 0x0d, 0x63, 0x6c, 0x75, 0x73,
+	0x74, 0x65, 0x72, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52,
+	0x0c, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x22, 0x30, 0x0a,
+	0x0b, 0x47, 0x65, 0x74, 0x4e, 0x6f, 0x64, 0x65, 0x73, 0x52, 0x65, 0x71, 0x12, 0x21, 0x0a, 0x0c,
+	0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0b, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x22,
+	0xb8, 0x01, 0x0a, 0x0b, 0x47, 0x65, 0x74, 0x4e, 0x6f, 0x64, 0x65, 0x73, 0x52, 0x73, 0x70, 0x12,
+	0x21, 0x0a, 0x0c, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x4e, 0x61,
+	0x6d, 0x65, 0x12, 0x3c, 0x0a, 0x08, 0x6e, 0x6f, 0x64, 0x65, 0x73, 0x4d, 0x61, 0x70, 0x18, 0x02,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x47, 0x65, 0x74,
+	0x4e, 0x6f, 0x64, 0x65, 0x73, 0x52, 0x73, 0x70, 0x2e, 0x4e, 0x6f, 0x64, 0x65, 0x73, 0x4d, 0x61,
+	0x70, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x08, 0x6e, 0x6f, 0x64, 0x65, 0x73, 0x4d, 0x61, 0x70,
+	0x1a, 0x48, 0x0a, 0x0d, 0x4e, 0x6f, 0x64, 0x65, 0x73, 0x4d, 0x61, 0x70, 0x45, 0x6e, 0x74, 0x72,
+	0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03,
+	0x6b, 0x65, 0x79, 0x12, 0x21, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x4e, 0x6f, 0x64, 0x65, 0x52,
+	0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x7f, 0x0a, 0x0a, 0x4d, 0x65,
+	0x6d, 0x62, 0x65, 0x72, 0x4e, 0x6f, 0x64, 0x65, 0x12, 0x1f, 0x0a, 0x04, 0x6e, 0x6f, 0x64, 0x65,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x4e,
+	0x6f, 0x64, 0x65, 0x52, 0x04, 0x6e, 0x6f, 0x64, 0x65, 0x12, 0x25, 0x0a, 0x0e, 0x68, 0x65, 0x61,
+	0x72, 0x74, 0x62, 0x65, 0x61, 0x74, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x0d, 0x68, 0x65, 0x61, 0x72, 0x74, 0x62, 0x65, 0x61, 0x74, 0x54, 0x69, 0x6d, 0x65,
+	0x12, 0x29, 0x0a, 0x06, 0x68, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x11, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x4e, 0x6f, 0x64, 0x65, 0x48, 0x65, 0x61,
+	0x6c, 0x74, 0x68, 0x52, 0x06, 0x68, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x22, 0x9c, 0x01, 0x0a, 0x11,
+	0x53, 0x79, 0x6e, 0x63, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x68, 0x69, 0x70, 0x52, 0x65,
+	0x71, 0x12, 0x1f, 0x0a, 0x0b, 0x63, 0x65, 0x6e, 0x74, 0x65, 0x72, 0x5f, 0x6e, 0x61, 0x6d, 0x65,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x63, 0x65, 0x6e, 0x74, 0x65, 0x72, 0x4e, 0x61,
+	0x6d, 0x65, 0x12, 0x2b, 0x0a, 0x07, 0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x18, 0x02, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x4d, 0x65, 0x6d, 0x62,
+	0x65, 0x72, 0x4e, 0x6f, 0x64, 0x65, 0x52, 0x07, 0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x12,
+	0x14, 0x0a, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05,
+	0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x23, 0x0a, 0x0d, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72,
+	0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0c, 0x63, 0x6c,
+	0x75, 0x73, 0x74, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x22, 0x66, 0x0a, 0x11, 0x53, 0x79,
+	0x6e, 0x63, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x68, 0x69, 0x70, 0x52, 0x73, 0x70, 0x12,
 	0x12, 0x0a, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x63,
 	0x6f, 0x64, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x6d, 0x73, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x03, 0x6d, 0x73, 0x67, 0x12, 0x29, 0x0a, 0x06, 0x6d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x18,
-	0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x50, 0x69,
-	0x6e, 0x67, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x52, 0x06, 0x6d, 0x65, 0x74, 0x72, 0x69, 0x63,
-	0x22, 0x32, 0x0a, 0x0d, 0x47, 0x65, 0x74, 0x43, 0x6c, 0x75, 0x74, 0x65, 0x72, 0x73, 0x52, 0x65,
-	0x71, 0x12, 0x21, 0x0a, 0x0c, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x5f, 0x6e, 0x61, 0x6d,
-	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72,
-	0x4e, 0x61, 0x6d, 0x65, 0x22, 0x34, 0x0a, 0x0d, 0x47, 0x65, 0x74, 0x43, 0x6c, 0x75, 0x74, 0x65,
-	0x72, 0x73, 0x52, 0x73, 0x70, 0x12, 0x23, 0x0a, 0x0d, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72,
-	0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0c, 0x63, 0x6c,
-	0x75, 0x73, 0x74, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x22, 0x30, 0x0a, 0x0b, 0x47, 0x65,
-	0x74, 0x4e, 0x6f, 0x64, 0x65, 0x73, 0x52, 0x65, 0x71, 0x12, 0x21, 0x0a, 0x0c, 0x63, 0x6c, 0x75,
-	0x73, 0x74, 0x65, 0x72, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x0b, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x22, 0xb8, 0x01, 0x0a,
-	0x0b, 0x47, 0x65, 0x74, 0x4e, 0x6f, 0x64, 0x65, 0x73, 0x52, 0x73, 0x70, 0x12, 0x21, 0x0a, 0x0c,
-	0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x0b, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x12,
-	0x3c, 0x0a, 0x08, 0x6e, 0x6f, 0x64, 0x65, 0x73, 0x4d, 0x61, 0x70, 0x18, 0x02, 0x20, 0x03, 0x28,
-	0x0b, 0x32, 0x20, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x47, 0x65, 0x74, 0x4e, 0x6f, 0x64,
-	0x65, 0x73, 0x52, 0x73, 0x70, 0x2e, 0x4e, 0x6f, 0x64, 0x65, 0x73, 0x4d, 0x61, 0x70, 0x45, 0x6e,
-	0x74, 0x72, 0x79, 0x52, 0x08, 0x6e, 0x6f, 0x64, 0x65, 0x73, 0x4d, 0x61, 0x70, 0x1a, 0x48, 0x0a,
-	0x0d, 0x4e, 0x6f, 0x64, 0x65, 0x73, 0x4d, 0x61, 0x70, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10,
-	0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79,
-	0x12, 0x21, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x0b, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x4e, 0x6f, 0x64, 0x65, 0x52, 0x05, 0x76, 0x61,
-	0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x2a, 0xf7, 0x01, 0x0a, 0x0b, 0x42, 0x75, 0x69, 0x6c,
-	0x64, 0x65, 0x72, 0x54, 0x79, 0x70, 0x65, 0x12, 0x15, 0x0a, 0x11, 0x55, 0x6e, 0x6b, 0x6e, 0x6f,
-	0x77, 0x42, 0x75, 0x69, 0x6c, 0x64, 0x65, 0x72, 0x54, 0x79, 0x70, 0x65, 0x10, 0x00, 0x12, 0x1b,
-	0x0a, 0x17, 0x56, 0x4c, 0x45, 0x53, 0x53, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x42, 0x75,
-	0x69, 0x6c, 0x64, 0x65, 0x72, 0x54, 0x79, 0x70, 0x65, 0x10, 0x0a, 0x12, 0x1b, 0x0a, 0x17, 0x56,
-	0x4d, 0x45, 0x53, 0x53, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x42, 0x75, 0x69, 0x6c, 0x64,
-	0x65, 0x72, 0x54, 0x79, 0x70, 0x65, 0x10, 0x0b, 0x12, 0x1c, 0x0a, 0x18, 0x54, 0x72, 0x6f, 0x6a,
-	0x61, 0x6e, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x42, 0x75, 0x69, 0x6c, 0x64, 0x65, 0x72,
-	0x54, 0x79, 0x70, 0x65, 0x10, 0x0c, 0x12, 0x12, 0x0a, 0x0e, 0x54, 0x43, 0x50, 0x42, 0x75, 0x69,
-	0x6c, 0x64, 0x65, 0x72, 0x54, 0x79, 0x70, 0x65, 0x10, 0x14, 0x12, 0x11, 0x0a, 0x0d, 0x57, 0x53,
-	0x42, 0x75, 0x69, 0x6c, 0x64, 0x65, 0x72, 0x54, 0x79, 0x70, 0x65, 0x10, 0x15, 0x12, 0x13, 0x0a,
-	0x0f, 0x51, 0x75, 0x69, 0x63, 0x42, 0x75, 0x69, 0x6c, 0x64, 0x65, 0x72, 0x54, 0x79, 0x70, 0x65,
-	0x10, 0x16, 0x12, 0x13, 0x0a, 0x0f, 0x4d, 0x6b, 0x63, 0x70, 0x42, 0x75, 0x69, 0x6c, 0x64, 0x65,
-	0x72, 0x54, 0x79, 0x70, 0x65, 0x10, 0x17, 0x12, 0x13, 0x0a, 0x0f, 0x47, 0x72, 0x70, 0x63, 0x42,
-	0x75, 0x69, 0x6c, 0x64, 0x65, 0x72, 0x54, 0x79, 0x70, 0x65, 0x10, 0x18, 0x12, 0x13, 0x0a, 0x0f,
-	0x48, 0x74, 0x74, 0x70, 0x42, 0x75, 0x69, 0x6c, 0x64, 0x65, 0x72, 0x54, 0x79, 0x70, 0x65, 0x10,
-	0x19, 0x32, 0xf7, 0x0c, 0x0a, 0x0d, 0x45, 0x6e, 0x64, 0x4e, 0x6f, 0x64, 0x65, 0x41, 0x63, 0x63,
-	0x65, 0x73, 0x73, 0x12, 0x34, 0x0a, 0x08, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x73, 0x12,
-	0x12, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x73,
-	0x52, 0x65, 0x71, 0x1a, 0x12, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x47, 0x65, 0x74, 0x55,
-	0x73, 0x65, 0x72, 0x73, 0x52, 0x73, 0x70, 0x22, 0x00, 0x12, 0x30, 0x0a, 0x08, 0x41, 0x64, 0x64,
-	0x55, 0x73, 0x65, 0x72, 0x73, 0x12, 0x10, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x55, 0x73,
-	0x65, 0x72, 0x4f, 0x70, 0x52, 0x65, 0x71, 0x1a, 0x10, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e,
-	0x55, 0x73, 0x65, 0x72, 0x4f, 0x70, 0x52, 0x73, 0x70, 0x22, 0x00, 0x12, 0x33, 0x0a, 0x0b, 0x44,
-	0x65, 0x6c, 0x65, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x73, 0x12, 0x10, 0x2e, 0x70, 0x72, 0x6f,
+	0x52, 0x03, 0x6d, 0x73, 0x67, 0x12, 0x2b, 0x0a, 0x07, 0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x73,
+	0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x4d,
+	0x65, 0x6d, 0x62, 0x65, 0x72, 0x4e, 0x6f, 0x64, 0x65, 0x52, 0x07, 0x6d, 0x65, 0x6d, 0x62, 0x65,
+	0x72, 0x73, 0x2a, 0xc1, 0x02, 0x0a, 0x0b, 0x42, 0x75, 0x69, 0x6c, 0x64, 0x65, 0x72, 0x54, 0x79,
+	0x70, 0x65, 0x12, 0x15, 0x0a, 0x11, 0x55, 0x6e, 0x6b, 0x6e, 0x6f, 0x77, 0x42, 0x75, 0x69, 0x6c,
+	0x64, 0x65, 0x72, 0x54, 0x79, 0x70, 0x65, 0x10, 0x00, 0x12, 0x1b, 0x0a, 0x17, 0x56, 0x4c, 0x45,
+	0x53, 0x53, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x42, 0x75, 0x69, 0x6c, 0x64, 0x65, 0x72,
+	0x54, 0x79, 0x70, 0x65, 0x10, 0x0a, 0x12, 0x1b, 0x0a, 0x17, 0x56, 0x4d, 0x45, 0x53, 0x53, 0x53,
+	0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x42, 0x75, 0x69, 0x6c, 0x64, 0x65, 0x72, 0x54, 0x79, 0x70,
+	0x65, 0x10, 0x0b, 0x12, 0x1c, 0x0a, 0x18, 0x54, 0x72, 0x6f, 0x6a, 0x61, 0x6e, 0x53, 0x65, 0x74,
+	0x74, 0x69, 0x6e, 0x67, 0x42, 0x75, 0x69, 0x6c, 0x64, 0x65, 0x72, 0x54, 0x79, 0x70, 0x65, 0x10,
+	0x0c, 0x12, 0x21, 0x0a, 0x1d, 0x53, 0x68, 0x61, 0x64, 0x6f, 0x77, 0x73, 0x6f, 0x63, 0x6b, 0x73,
+	0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x42, 0x75, 0x69, 0x6c, 0x64, 0x65, 0x72, 0x54, 0x79,
+	0x70, 0x65, 0x10, 0x0d, 0x12, 0x25, 0x0a, 0x21, 0x53, 0x68, 0x61, 0x64, 0x6f, 0x77, 0x73, 0x6f,
+	0x63, 0x6b, 0x73, 0x32, 0x30, 0x32, 0x32, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x42, 0x75,
+	0x69, 0x6c, 0x64, 0x65, 0x72, 0x54, 0x79, 0x70, 0x65, 0x10, 0x0e, 0x12, 0x12, 0x0a, 0x0e, 0x54,
+	0x43, 0x50, 0x42, 0x75, 0x69, 0x6c, 0x64, 0x65, 0x72, 0x54, 0x79, 0x70, 0x65, 0x10, 0x14, 0x12,
+	0x11, 0x0a, 0x0d, 0x57, 0x53, 0x42, 0x75, 0x69, 0x6c, 0x64, 0x65, 0x72, 0x54, 0x79, 0x70, 0x65,
+	0x10, 0x15, 0x12, 0x13, 0x0a, 0x0f, 0x51, 0x75, 0x69, 0x63, 0x42, 0x75, 0x69, 0x6c, 0x64, 0x65,
+	0x72, 0x54, 0x79, 0x70, 0x65, 0x10, 0x16, 0x12, 0x13, 0x0a, 0x0f, 0x4d, 0x6b, 0x63, 0x70, 0x42,
+	0x75, 0x69, 0x6c, 0x64, 0x65, 0x72, 0x54, 0x79, 0x70, 0x65, 0x10, 0x17, 0x12, 0x13, 0x0a, 0x0f,
+	0x47, 0x72, 0x70, 0x63, 0x42, 0x75, 0x69, 0x6c, 0x64, 0x65, 0x72, 0x54, 0x79, 0x70, 0x65, 0x10,
+	0x18, 0x12, 0x13, 0x0a, 0x0f, 0x48, 0x74, 0x74, 0x70, 0x42, 0x75, 0x69, 0x6c, 0x64, 0x65, 0x72,
+	0x54, 0x79, 0x70, 0x65, 0x10, 0x19, 0x32, 0xd3, 0x12, 0x0a, 0x0d, 0x45, 0x6e, 0x64, 0x4e, 0x6f,
+	0x64, 0x65, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x34, 0x0a, 0x08, 0x47, 0x65, 0x74, 0x55,
+	0x73, 0x65, 0x72, 0x73, 0x12, 0x12, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x47, 0x65, 0x74,
+	0x55, 0x73, 0x65, 0x72, 0x73, 0x52, 0x65, 0x71, 0x1a, 0x12, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x2e, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x73, 0x52, 0x73, 0x70, 0x22, 0x00, 0x12, 0x30,
+	0x0a, 0x08, 0x41, 0x64, 0x64, 0x55, 0x73, 0x65, 0x72, 0x73, 0x12, 0x10, 0x2e, 0x70, 0x72, 0x6f,
 	0x74, 0x6f, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x4f, 0x70, 0x52, 0x65, 0x71, 0x1a, 0x10, 0x2e, 0x70,
 	0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x4f, 0x70, 0x52, 0x73, 0x70, 0x22, 0x00,
-	0x12, 0x3a, 0x0a, 0x0a, 0x43, 0x6c, 0x65, 0x61, 0x72, 0x55, 0x73, 0x65, 0x72, 0x73, 0x12, 0x14,
-	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x43, 0x6c, 0x65, 0x61, 0x72, 0x55, 0x73, 0x65, 0x72,
-	0x73, 0x52, 0x65, 0x71, 0x1a, 0x14, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x43, 0x6c, 0x65,
-	0x61, 0x72, 0x55, 0x73, 0x65, 0x72, 0x73, 0x52, 0x73, 0x70, 0x22, 0x00, 0x12, 0x33, 0x0a, 0x0b,
-	0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x73, 0x12, 0x10, 0x2e, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x4f, 0x70, 0x52, 0x65, 0x71, 0x1a, 0x10, 0x2e,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x4f, 0x70, 0x52, 0x73, 0x70, 0x22,
-	0x00, 0x12, 0x31, 0x0a, 0x09, 0x52, 0x65, 0x73, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x12, 0x10,
+	0x12, 0x33, 0x0a, 0x0b, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x73, 0x12,
+	0x10, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x4f, 0x70, 0x52, 0x65,
+	0x71, 0x1a, 0x10, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x4f, 0x70,
+	0x52, 0x73, 0x70, 0x22, 0x00, 0x12, 0x3a, 0x0a, 0x0a, 0x43, 0x6c, 0x65, 0x61, 0x72, 0x55, 0x73,
+	0x65, 0x72, 0x73, 0x12, 0x14, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x43, 0x6c, 0x65, 0x61,
+	0x72, 0x55, 0x73, 0x65, 0x72, 0x73, 0x52, 0x65, 0x71, 0x1a, 0x14, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x2e, 0x43, 0x6c, 0x65, 0x61, 0x72, 0x55, 0x73, 0x65, 0x72, 0x73, 0x52, 0x73, 0x70, 0x22,
+	0x00, 0x12, 0x33, 0x0a, 0x0b, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x73,
+	0x12, 0x10, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x4f, 0x70, 0x52,
+	0x65, 0x71, 0x1a, 0x10, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x4f,
+	0x70, 0x52, 0x73, 0x70, 0x22, 0x00, 0x12, 0x31, 0x0a, 0x09, 0x52, 0x65, 0x73, 0x65, 0x74, 0x55,
+	0x73, 0x65, 0x72, 0x12, 0x10, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x55, 0x73, 0x65, 0x72,
+	0x4f, 0x70, 0x52, 0x65, 0x71, 0x1a, 0x10, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x55, 0x73,
+	0x65, 0x72, 0x4f, 0x70, 0x52, 0x73, 0x70, 0x22, 0x00, 0x12, 0x34, 0x0a, 0x0c, 0x53, 0x75, 0x73,
+	0x70, 0x65, 0x6e, 0x64, 0x55, 0x73, 0x65, 0x72, 0x73, 0x12, 0x10, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x4f, 0x70, 0x52, 0x65, 0x71, 0x1a, 0x10, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x4f, 0x70, 0x52, 0x73, 0x70, 0x22, 0x00, 0x12,
+	0x33, 0x0a, 0x0b, 0x52, 0x65, 0x73, 0x75, 0x6d, 0x65, 0x55, 0x73, 0x65, 0x72, 0x73, 0x12, 0x10,
 	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x4f, 0x70, 0x52, 0x65, 0x71,
 	0x1a, 0x10, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x4f, 0x70, 0x52,
-	0x73, 0x70, 0x22, 0x00, 0x12, 0x2e, 0x0a, 0x06, 0x47, 0x65, 0x74, 0x53, 0x75, 0x62, 0x12, 0x10,
-	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x75, 0x62, 0x52, 0x65, 0x71,
-	0x1a, 0x10, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x75, 0x62, 0x52,
-	0x73, 0x70, 0x22, 0x00, 0x12, 0x4f, 0x0a, 0x11, 0x47, 0x65, 0x74, 0x42, 0x61, 0x6e, 0x64, 0x57,
-	0x69, 0x64, 0x74, 0x68, 0x53, 0x74, 0x61, 0x74, 0x73, 0x12, 0x1b, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x73, 0x70, 0x22, 0x00, 0x12, 0x3d, 0x0a, 0x0b, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x55, 0x73,
+	0x65, 0x72, 0x73, 0x12, 0x15, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x45, 0x78, 0x70, 0x6f,
+	0x72, 0x74, 0x55, 0x73, 0x65, 0x72, 0x73, 0x52, 0x65, 0x71, 0x1a, 0x15, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x2e, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x55, 0x73, 0x65, 0x72, 0x73, 0x52, 0x73,
+	0x70, 0x22, 0x00, 0x12, 0x3d, 0x0a, 0x0b, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x55, 0x73, 0x65,
+	0x72, 0x73, 0x12, 0x15, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x49, 0x6d, 0x70, 0x6f, 0x72,
+	0x74, 0x55, 0x73, 0x65, 0x72, 0x73, 0x52, 0x65, 0x71, 0x1a, 0x15, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x2e, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x55, 0x73, 0x65, 0x72, 0x73, 0x52, 0x73, 0x70,
+	0x22, 0x00, 0x12, 0x46, 0x0a, 0x0e, 0x52, 0x65, 0x63, 0x6f, 0x6e, 0x63, 0x69, 0x6c, 0x65, 0x55,
+	0x73, 0x65, 0x72, 0x73, 0x12, 0x18, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x52, 0x65, 0x63,
+	0x6f, 0x6e, 0x63, 0x69, 0x6c, 0x65, 0x55, 0x73, 0x65, 0x72, 0x73, 0x52, 0x65, 0x71, 0x1a, 0x18,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x52, 0x65, 0x63, 0x6f, 0x6e, 0x63, 0x69, 0x6c, 0x65,
+	0x55, 0x73, 0x65, 0x72, 0x73, 0x52, 0x73, 0x70, 0x22, 0x00, 0x12, 0x30, 0x0a, 0x08, 0x53, 0x65,
+	0x74, 0x50, 0x6c, 0x61, 0x6e, 0x73, 0x12, 0x10, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x50,
+	0x6c, 0x61, 0x6e, 0x4f, 0x70, 0x52, 0x65, 0x71, 0x1a, 0x10, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x2e, 0x50, 0x6c, 0x61, 0x6e, 0x4f, 0x70, 0x52, 0x73, 0x70, 0x22, 0x00, 0x12, 0x33, 0x0a, 0x0b,
+	0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x50, 0x6c, 0x61, 0x6e, 0x73, 0x12, 0x10, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x2e, 0x50, 0x6c, 0x61, 0x6e, 0x4f, 0x70, 0x52, 0x65, 0x71, 0x1a, 0x10, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x50, 0x6c, 0x61, 0x6e, 0x4f, 0x70, 0x52, 0x73, 0x70, 0x22,
+	0x00, 0x12, 0x34, 0x0a, 0x08, 0x47, 0x65, 0x74, 0x50, 0x6c, 0x61, 0x6e, 0x73, 0x12, 0x12, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x47, 0x65, 0x74, 0x50, 0x6c, 0x61, 0x6e, 0x73, 0x52, 0x65,
+	0x71, 0x1a, 0x12, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x47, 0x65, 0x74, 0x50, 0x6c, 0x61,
+	0x6e, 0x73, 0x52, 0x73, 0x70, 0x22, 0x00, 0x12, 0x2e, 0x0a, 0x06, 0x47, 0x65, 0x74, 0x53, 0x75,
+	0x62, 0x12, 0x10, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x75, 0x62,
+	0x52, 0x65, 0x71, 0x1a, 0x10, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x47, 0x65, 0x74, 0x53,
+	0x75, 0x62, 0x52, 0x73, 0x70, 0x22, 0x00, 0x12, 0x4f, 0x0a, 0x11, 0x47, 0x65, 0x74, 0x42, 0x61,
+	0x6e, 0x64, 0x57, 0x69, 0x64, 0x74, 0x68, 0x53, 0x74, 0x61, 0x74, 0x73, 0x12, 0x1b, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x47, 0x65, 0x74, 0x42, 0x61, 0x6e, 0x64, 0x77, 0x69, 0x64, 0x74,
+	0x68, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x65, 0x71, 0x1a, 0x1b, 0x2e, 0x70, 0x72, 0x6f, 0x74,
 	0x6f, 0x2e, 0x47, 0x65, 0x74, 0x42, 0x61, 0x6e, 0x64, 0x77, 0x69, 0x64, 0x74, 0x68, 0x53, 0x74,
-	0x61, 0x74, 0x73, 0x52, 0x65, 0x71, 0x1a, 0x1b, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x47,
-	0x65, 0x74, 0x42, 0x61, 0x6e, 0x64, 0x77, 0x69, 0x64, 0x74, 0x68, 0x53, 0x74, 0x61, 0x74, 0x73,
+	0x61, 0x74, 0x73, 0x52, 0x73, 0x70, 0x22, 0x00, 0x12, 0x4c, 0x0a, 0x10, 0x47, 0x65, 0x74, 0x54,
+	0x72, 0x61, 0x66, 0x66, 0x69, 0x63, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x12, 0x1a, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x47, 0x65, 0x74, 0x54, 0x72, 0x61, 0x66, 0x66, 0x69, 0x63, 0x52,
+	0x65, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x65, 0x71, 0x1a, 0x1a, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x2e, 0x47, 0x65, 0x74, 0x54, 0x72, 0x61, 0x66, 0x66, 0x69, 0x63, 0x52, 0x65, 0x70, 0x6f, 0x72,
+	0x74, 0x52, 0x73, 0x70, 0x22, 0x00, 0x12, 0x3d, 0x0a, 0x0b, 0x47, 0x65, 0x74, 0x41, 0x75, 0x64,
+	0x69, 0x74, 0x4c, 0x6f, 0x67, 0x12, 0x15, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x47, 0x65,
+	0x74, 0x41, 0x75, 0x64, 0x69, 0x74, 0x4c, 0x6f, 0x67, 0x52, 0x65, 0x71, 0x1a, 0x15, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x47, 0x65, 0x74, 0x41, 0x75, 0x64, 0x69, 0x74, 0x4c, 0x6f, 0x67,
 	0x52, 0x73, 0x70, 0x22, 0x00, 0x12, 0x37, 0x0a, 0x09, 0x48, 0x65, 0x61, 0x72, 0x74, 0x42, 0x65,
 	0x61, 0x74, 0x12, 0x13, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x48, 0x65, 0x61, 0x72, 0x74,
 	0x42, 0x65, 0x61, 0x74, 0x52, 0x65, 0x71, 0x1a, 0x13, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e,
@@ -3597,94 +6602,106 @@ var file_rpc_server_proto_rawDesc = []byte{
 	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x4e,
 	0x6f, 0x64, 0x65, 0x52, 0x65, 0x71, 0x1a, 0x16, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x52,
 	0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x4e, 0x6f, 0x64, 0x65, 0x52, 0x73, 0x70, 0x22, 0x00,
-	0x12, 0x49, 0x0a, 0x0f, 0x53, 0x65, 0x74, 0x47, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x4d, 0x6f,
-	0x64, 0x65, 0x6c, 0x12, 0x19, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x53, 0x65, 0x74, 0x47,
-	0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x52, 0x65, 0x71, 0x1a, 0x19,
-	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x53, 0x65, 0x74, 0x47, 0x61, 0x74, 0x65, 0x77, 0x61,
-	0x79, 0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x52, 0x73, 0x70, 0x22, 0x00, 0x12, 0x38, 0x0a, 0x0a, 0x41,
-	0x64, 0x64, 0x49, 0x6e, 0x62, 0x6f, 0x75, 0x6e, 0x64, 0x12, 0x13, 0x2e, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x2e, 0x49, 0x6e, 0x62, 0x6f, 0x75, 0x6e, 0x64, 0x4f, 0x70, 0x52, 0x65, 0x71, 0x1a, 0x13,
+	0x12, 0x43, 0x0a, 0x0d, 0x49, 0x73, 0x73, 0x75, 0x65, 0x4e, 0x6f, 0x64, 0x65, 0x43, 0x65, 0x72,
+	0x74, 0x12, 0x17, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x49, 0x73, 0x73, 0x75, 0x65, 0x4e,
+	0x6f, 0x64, 0x65, 0x43, 0x65, 0x72, 0x74, 0x52, 0x65, 0x71, 0x1a, 0x17, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x2e, 0x49, 0x73, 0x73, 0x75, 0x65, 0x4e, 0x6f, 0x64, 0x65, 0x43, 0x65, 0x72, 0x74,
+	0x52, 0x73, 0x70, 0x22, 0x00, 0x12, 0x3a, 0x0a, 0x0a, 0x47, 0x6f, 0x73, 0x73, 0x69, 0x70, 0x50,
+	0x69, 0x6e, 0x67, 0x12, 0x14, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x47, 0x6f, 0x73, 0x73,
+	0x69, 0x70, 0x50, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x71, 0x1a, 0x14, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x2e, 0x47, 0x6f, 0x73, 0x73, 0x69, 0x70, 0x50, 0x69, 0x6e, 0x67, 0x52, 0x73, 0x70, 0x22,
+	0x00, 0x12, 0x49, 0x0a, 0x0f, 0x53, 0x65, 0x74, 0x47, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x4d,
+	0x6f, 0x64, 0x65, 0x6c, 0x12, 0x19, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x53, 0x65, 0x74,
+	0x47, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x52, 0x65, 0x71, 0x1a,
+	0x19, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x53, 0x65, 0x74, 0x47, 0x61, 0x74, 0x65, 0x77,
+	0x61, 0x79, 0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x52, 0x73, 0x70, 0x22, 0x00, 0x12, 0x38, 0x0a, 0x0a,
+	0x41, 0x64, 0x64, 0x49, 0x6e, 0x62, 0x6f, 0x75, 0x6e, 0x64, 0x12, 0x13, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x2e, 0x49, 0x6e, 0x62, 0x6f, 0x75, 0x6e, 0x64, 0x4f, 0x70, 0x52, 0x65, 0x71, 0x1a,
+	0x13, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x49, 0x6e, 0x62, 0x6f, 0x75, 0x6e, 0x64, 0x4f,
+	0x70, 0x52, 0x73, 0x70, 0x22, 0x00, 0x12, 0x3b, 0x0a, 0x0d, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65,
+	0x49, 0x6e, 0x62, 0x6f, 0x75, 0x6e, 0x64, 0x12, 0x13, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e,
+	0x49, 0x6e, 0x62, 0x6f, 0x75, 0x6e, 0x64, 0x4f, 0x70, 0x52, 0x65, 0x71, 0x1a, 0x13, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x49, 0x6e, 0x62, 0x6f, 0x75, 0x6e, 0x64, 0x4f, 0x70, 0x52, 0x73,
+	0x70, 0x22, 0x00, 0x12, 0x43, 0x0a, 0x0f, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x49,
+	0x6e, 0x62, 0x6f, 0x75, 0x6e, 0x64, 0x12, 0x19, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x54,
+	0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x49, 0x6e, 0x62, 0x6f, 0x75, 0x6e, 0x64, 0x52, 0x65,
+	0x71, 0x1a, 0x13, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x49, 0x6e, 0x62, 0x6f, 0x75, 0x6e,
+	0x64, 0x4f, 0x70, 0x52, 0x73, 0x70, 0x22, 0x00, 0x12, 0x3b, 0x0a, 0x0b, 0x43, 0x6f, 0x70, 0x79,
+	0x49, 0x6e, 0x62, 0x6f, 0x75, 0x6e, 0x64, 0x12, 0x15, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e,
+	0x43, 0x6f, 0x70, 0x79, 0x49, 0x6e, 0x62, 0x6f, 0x75, 0x6e, 0x64, 0x52, 0x65, 0x71, 0x1a, 0x13,
 	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x49, 0x6e, 0x62, 0x6f, 0x75, 0x6e, 0x64, 0x4f, 0x70,
-	0x52, 0x73, 0x70, 0x22, 0x00, 0x12, 0x3b, 0x0a, 0x0d, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x49,
-	0x6e, 0x62, 0x6f, 0x75, 0x6e, 0x64, 0x12, 0x13, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x49,
-	0x6e, 0x62, 0x6f, 0x75, 0x6e, 0x64, 0x4f, 0x70, 0x52, 0x65, 0x71, 0x1a, 0x13, 0x2e, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x2e, 0x49, 0x6e, 0x62, 0x6f, 0x75, 0x6e, 0x64, 0x4f, 0x70, 0x52, 0x73, 0x70,
-	0x22, 0x00, 0x12, 0x43, 0x0a, 0x0f, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x49, 0x6e,
-	0x62, 0x6f, 0x75, 0x6e, 0x64, 0x12, 0x19, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x54, 0x72,
-	0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x49, 0x6e, 0x62, 0x6f, 0x75, 0x6e, 0x64, 0x52, 0x65, 0x71,
-	0x1a, 0x13, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x49, 0x6e, 0x62, 0x6f, 0x75, 0x6e, 0x64,
-	0x4f, 0x70, 0x52, 0x73, 0x70, 0x22, 0x00, 0x12, 0x3b, 0x0a, 0x0b, 0x43, 0x6f, 0x70, 0x79, 0x49,
-	0x6e, 0x62, 0x6f, 0x75, 0x6e, 0x64, 0x12, 0x15, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x43,
-	0x6f, 0x70, 0x79, 0x49, 0x6e, 0x62, 0x6f, 0x75, 0x6e, 0x64, 0x52, 0x65, 0x71, 0x1a, 0x13, 0x2e,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x49, 0x6e, 0x62, 0x6f, 0x75, 0x6e, 0x64, 0x4f, 0x70, 0x52,
-	0x73, 0x70, 0x22, 0x00, 0x12, 0x35, 0x0a, 0x08, 0x43, 0x6f, 0x70, 0x79, 0x55, 0x73, 0x65, 0x72,
-	0x12, 0x12, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x43, 0x6f, 0x70, 0x79, 0x55, 0x73, 0x65,
-	0x72, 0x52, 0x65, 0x71, 0x1a, 0x13, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x49, 0x6e, 0x62,
-	0x6f, 0x75, 0x6e, 0x64, 0x4f, 0x70, 0x52, 0x73, 0x70, 0x22, 0x00, 0x12, 0x3a, 0x0a, 0x0a, 0x47,
-	0x65, 0x74, 0x49, 0x6e, 0x62, 0x6f, 0x75, 0x6e, 0x64, 0x12, 0x14, 0x2e, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x2e, 0x47, 0x65, 0x74, 0x49, 0x6e, 0x62, 0x6f, 0x75, 0x6e, 0x64, 0x52, 0x65, 0x71, 0x1a,
-	0x14, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x47, 0x65, 0x74, 0x49, 0x6e, 0x62, 0x6f, 0x75,
-	0x6e, 0x64, 0x52, 0x73, 0x70, 0x22, 0x00, 0x12, 0x2e, 0x0a, 0x06, 0x47, 0x65, 0x74, 0x54, 0x61,
-	0x67, 0x12, 0x10, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x47, 0x65, 0x74, 0x54, 0x61, 0x67,
-	0x52, 0x65, 0x71, 0x1a, 0x10, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x47, 0x65, 0x74, 0x54,
-	0x61, 0x67, 0x52, 0x73, 0x70, 0x22, 0x00, 0x12, 0x3d, 0x0a, 0x0b, 0x55, 0x70, 0x64, 0x61, 0x74,
-	0x65, 0x50, 0x72, 0x6f, 0x78, 0x79, 0x12, 0x15, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x55,
-	0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x72, 0x6f, 0x78, 0x79, 0x52, 0x65, 0x71, 0x1a, 0x15, 0x2e,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x72, 0x6f, 0x78,
-	0x79, 0x52, 0x73, 0x70, 0x22, 0x00, 0x12, 0x3f, 0x0a, 0x11, 0x41, 0x64, 0x64, 0x41, 0x64, 0x61,
-	0x70, 0x74, 0x69, 0x76, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x14, 0x2e, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x2e, 0x41, 0x64, 0x61, 0x70, 0x74, 0x69, 0x76, 0x65, 0x4f, 0x70, 0x52, 0x65,
-	0x71, 0x1a, 0x12, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x41, 0x64, 0x61, 0x70, 0x74, 0x69,
-	0x76, 0x65, 0x52, 0x73, 0x70, 0x22, 0x00, 0x12, 0x42, 0x0a, 0x14, 0x44, 0x65, 0x6c, 0x65, 0x74,
-	0x65, 0x41, 0x64, 0x61, 0x70, 0x74, 0x69, 0x76, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12,
-	0x14, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x41, 0x64, 0x61, 0x70, 0x74, 0x69, 0x76, 0x65,
-	0x4f, 0x70, 0x52, 0x65, 0x71, 0x1a, 0x12, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x41, 0x64,
-	0x61, 0x70, 0x74, 0x69, 0x76, 0x65, 0x52, 0x73, 0x70, 0x22, 0x00, 0x12, 0x34, 0x0a, 0x08, 0x41,
-	0x64, 0x61, 0x70, 0x74, 0x69, 0x76, 0x65, 0x12, 0x12, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e,
-	0x41, 0x64, 0x61, 0x70, 0x74, 0x69, 0x76, 0x65, 0x52, 0x65, 0x71, 0x1a, 0x12, 0x2e, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x2e, 0x41, 0x64, 0x61, 0x70, 0x74, 0x69, 0x76, 0x65, 0x52, 0x73, 0x70, 0x22,
-	0x00, 0x12, 0x46, 0x0a, 0x0e, 0x46, 0x61, 0x73, 0x74, 0x41, 0x64, 0x64, 0x49, 0x6e, 0x62, 0x6f,
-	0x75, 0x6e, 0x64, 0x12, 0x18, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x46, 0x61, 0x73, 0x74,
-	0x41, 0x64, 0x64, 0x49, 0x6e, 0x62, 0x6f, 0x75, 0x6e, 0x64, 0x52, 0x65, 0x71, 0x1a, 0x18, 0x2e,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x46, 0x61, 0x73, 0x74, 0x41, 0x64, 0x64, 0x49, 0x6e, 0x62,
-	0x6f, 0x75, 0x6e, 0x64, 0x52, 0x73, 0x70, 0x22, 0x00, 0x12, 0x43, 0x0a, 0x0d, 0x4f, 0x62, 0x74,
-	0x61, 0x69, 0x6e, 0x4e, 0x65, 0x77, 0x43, 0x65, 0x72, 0x74, 0x12, 0x17, 0x2e, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x2e, 0x4f, 0x62, 0x74, 0x61, 0x69, 0x6e, 0x4e, 0x65, 0x77, 0x43, 0x65, 0x72, 0x74,
-	0x52, 0x65, 0x71, 0x1a, 0x17, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x4f, 0x62, 0x74, 0x61,
-	0x69, 0x6e, 0x4e, 0x65, 0x77, 0x43, 0x65, 0x72, 0x74, 0x52, 0x73, 0x70, 0x22, 0x00, 0x12, 0x40,
-	0x0a, 0x0c, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x43, 0x65, 0x72, 0x74, 0x12, 0x16,
-	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x43,
-	0x65, 0x72, 0x74, 0x52, 0x65, 0x71, 0x1a, 0x16, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x54,
-	0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x43, 0x65, 0x72, 0x74, 0x52, 0x73, 0x70, 0x22, 0x00,
-	0x12, 0x34, 0x0a, 0x08, 0x47, 0x65, 0x74, 0x43, 0x65, 0x72, 0x74, 0x73, 0x12, 0x12, 0x2e, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x65, 0x72, 0x74, 0x73, 0x52, 0x65, 0x71,
-	0x1a, 0x12, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x65, 0x72, 0x74,
-	0x73, 0x52, 0x73, 0x70, 0x22, 0x00, 0x12, 0x43, 0x0a, 0x0d, 0x47, 0x65, 0x74, 0x50, 0x69, 0x6e,
-	0x67, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x12, 0x17, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e,
-	0x47, 0x65, 0x74, 0x50, 0x69, 0x6e, 0x67, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x52, 0x65, 0x71,
-	0x1a, 0x17, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x47, 0x65, 0x74, 0x50, 0x69, 0x6e, 0x67,
-	0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x52, 0x73, 0x70, 0x22, 0x00, 0x32, 0x83, 0x01, 0x0a, 0x0f,
-	0x43, 0x65, 0x6e, 0x74, 0x65, 0x72, 0x4e, 0x6f, 0x64, 0x65, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x12,
-	0x3a, 0x0a, 0x0a, 0x47, 0x65, 0x74, 0x43, 0x6c, 0x75, 0x74, 0x65, 0x72, 0x73, 0x12, 0x14, 0x2e,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x6c, 0x75, 0x74, 0x65, 0x72, 0x73,
-	0x52, 0x65, 0x71, 0x1a, 0x14, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x47, 0x65, 0x74, 0x43,
-	0x6c, 0x75, 0x74, 0x65, 0x72, 0x73, 0x52, 0x73, 0x70, 0x22, 0x00, 0x12, 0x34, 0x0a, 0x08, 0x47,
-	0x65, 0x74, 0x4e, 0x6f, 0x64, 0x65, 0x73, 0x12, 0x12, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e,
-	0x47, 0x65, 0x74, 0x4e, 0x6f, 0x64, 0x65, 0x73, 0x52, 0x65, 0x71, 0x1a, 0x12, 0x2e, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x2e, 0x47, 0x65, 0x74, 0x4e, 0x6f, 0x64, 0x65, 0x73, 0x52, 0x73, 0x70, 0x22,
-	0x00, 0x32, 0x8d, 0x01, 0x0a, 0x10, 0x43, 0x65, 0x6e, 0x74, 0x65, 0x72, 0x4e, 0x6f, 0x64, 0x65,
-	0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x37, 0x0a, 0x09, 0x48, 0x65, 0x61, 0x72, 0x74, 0x42,
-	0x65, 0x61, 0x74, 0x12, 0x13, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x48, 0x65, 0x61, 0x72,
-	0x74, 0x42, 0x65, 0x61, 0x74, 0x52, 0x65, 0x71, 0x1a, 0x13, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x2e, 0x48, 0x65, 0x61, 0x72, 0x74, 0x42, 0x65, 0x61, 0x74, 0x52, 0x73, 0x70, 0x22, 0x00, 0x12,
-	0x40, 0x0a, 0x0c, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x4e, 0x6f, 0x64, 0x65, 0x12,
-	0x16, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72,
-	0x4e, 0x6f, 0x64, 0x65, 0x52, 0x65, 0x71, 0x1a, 0x16, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e,
-	0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x4e, 0x6f, 0x64, 0x65, 0x52, 0x73, 0x70, 0x22,
-	0x00, 0x42, 0x29, 0x5a, 0x27, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f,
-	0x6c, 0x75, 0x72, 0x65, 0x69, 0x6e, 0x79, 0x2f, 0x76, 0x32, 0x72, 0x61, 0x79, 0x6d, 0x67, 0x2f,
-	0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x06, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x33,
+	0x52, 0x73, 0x70, 0x22, 0x00, 0x12, 0x35, 0x0a, 0x08, 0x43, 0x6f, 0x70, 0x79, 0x55, 0x73, 0x65,
+	0x72, 0x12, 0x12, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x43, 0x6f, 0x70, 0x79, 0x55, 0x73,
+	0x65, 0x72, 0x52, 0x65, 0x71, 0x1a, 0x13, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x49, 0x6e,
+	0x62, 0x6f, 0x75, 0x6e, 0x64, 0x4f, 0x70, 0x52, 0x73, 0x70, 0x22, 0x00, 0x12, 0x3a, 0x0a, 0x0a,
+	0x47, 0x65, 0x74, 0x49, 0x6e, 0x62, 0x6f, 0x75, 0x6e, 0x64, 0x12, 0x14, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x2e, 0x47, 0x65, 0x74, 0x49, 0x6e, 0x62, 0x6f, 0x75, 0x6e, 0x64, 0x52, 0x65, 0x71,
+	0x1a, 0x14, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x47, 0x65, 0x74, 0x49, 0x6e, 0x62, 0x6f,
+	0x75, 0x6e, 0x64, 0x52, 0x73, 0x70, 0x22, 0x00, 0x12, 0x2e, 0x0a, 0x06, 0x47, 0x65, 0x74, 0x54,
+	0x61, 0x67, 0x12, 0x10, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x47, 0x65, 0x74, 0x54, 0x61,
+	0x67, 0x52, 0x65, 0x71, 0x1a, 0x10, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x47, 0x65, 0x74,
+	0x54, 0x61, 0x67, 0x52, 0x73, 0x70, 0x22, 0x00, 0x12, 0x3d, 0x0a, 0x0b, 0x55, 0x70, 0x64, 0x61,
+	0x74, 0x65, 0x50, 0x72, 0x6f, 0x78, 0x79, 0x12, 0x15, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e,
+	0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x72, 0x6f, 0x78, 0x79, 0x52, 0x65, 0x71, 0x1a, 0x15,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x72, 0x6f,
+	0x78, 0x79, 0x52, 0x73, 0x70, 0x22, 0x00, 0x12, 0x3f, 0x0a, 0x11, 0x41, 0x64, 0x64, 0x41, 0x64,
+	0x61, 0x70, 0x74, 0x69, 0x76, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x14, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x41, 0x64, 0x61, 0x70, 0x74, 0x69, 0x76, 0x65, 0x4f, 0x70, 0x52,
+	0x65, 0x71, 0x1a, 0x12, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x41, 0x64, 0x61, 0x70, 0x74,
+	0x69, 0x76, 0x65, 0x52, 0x73, 0x70, 0x22, 0x00, 0x12, 0x42, 0x0a, 0x14, 0x44, 0x65, 0x6c, 0x65,
+	0x74, 0x65, 0x41, 0x64, 0x61, 0x70, 0x74, 0x69, 0x76, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67,
+	0x12, 0x14, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x41, 0x64, 0x61, 0x70, 0x74, 0x69, 0x76,
+	0x65, 0x4f, 0x70, 0x52, 0x65, 0x71, 0x1a, 0x12, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x41,
+	0x64, 0x61, 0x70, 0x74, 0x69, 0x76, 0x65, 0x52, 0x73, 0x70, 0x22, 0x00, 0x12, 0x34, 0x0a, 0x08,
+	0x41, 0x64, 0x61, 0x70, 0x74, 0x69, 0x76, 0x65, 0x12, 0x12, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x2e, 0x41, 0x64, 0x61, 0x70, 0x74, 0x69, 0x76, 0x65, 0x52, 0x65, 0x71, 0x1a, 0x12, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x41, 0x64, 0x61, 0x70, 0x74, 0x69, 0x76, 0x65, 0x52, 0x73, 0x70,
+	0x22, 0x00, 0x12, 0x46, 0x0a, 0x0e, 0x46, 0x61, 0x73, 0x74, 0x41, 0x64, 0x64, 0x49, 0x6e, 0x62,
+	0x6f, 0x75, 0x6e, 0x64, 0x12, 0x18, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x46, 0x61, 0x73,
+	0x74, 0x41, 0x64, 0x64, 0x49, 0x6e, 0x62, 0x6f, 0x75, 0x6e, 0x64, 0x52, 0x65, 0x71, 0x1a, 0x18,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x46, 0x61, 0x73, 0x74, 0x41, 0x64, 0x64, 0x49, 0x6e,
+	0x62, 0x6f, 0x75, 0x6e, 0x64, 0x52, 0x73, 0x70, 0x22, 0x00, 0x12, 0x43, 0x0a, 0x0d, 0x4f, 0x62,
+	0x74, 0x61, 0x69, 0x6e, 0x4e, 0x65, 0x77, 0x43, 0x65, 0x72, 0x74, 0x12, 0x17, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x2e, 0x4f, 0x62, 0x74, 0x61, 0x69, 0x6e, 0x4e, 0x65, 0x77, 0x43, 0x65, 0x72,
+	0x74, 0x52, 0x65, 0x71, 0x1a, 0x17, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x4f, 0x62, 0x74,
+	0x61, 0x69, 0x6e, 0x4e, 0x65, 0x77, 0x43, 0x65, 0x72, 0x74, 0x52, 0x73, 0x70, 0x22, 0x00, 0x12,
+	0x40, 0x0a, 0x0c, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x43, 0x65, 0x72, 0x74, 0x12,
+	0x16, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72,
+	0x43, 0x65, 0x72, 0x74, 0x52, 0x65, 0x71, 0x1a, 0x16, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e,
+	0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x43, 0x65, 0x72, 0x74, 0x52, 0x73, 0x70, 0x22,
+	0x00, 0x12, 0x34, 0x0a, 0x08, 0x47, 0x65, 0x74, 0x43, 0x65, 0x72, 0x74, 0x73, 0x12, 0x12, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x65, 0x72, 0x74, 0x73, 0x52, 0x65,
+	0x71, 0x1a, 0x12, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x65, 0x72,
+	0x74, 0x73, 0x52, 0x73, 0x70, 0x22, 0x00, 0x12, 0x43, 0x0a, 0x0d, 0x47, 0x65, 0x74, 0x50, 0x69,
+	0x6e, 0x67, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x12, 0x17, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x2e, 0x47, 0x65, 0x74, 0x50, 0x69, 0x6e, 0x67, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x52, 0x65,
+	0x71, 0x1a, 0x17, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x47, 0x65, 0x74, 0x50, 0x69, 0x6e,
+	0x67, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x52, 0x73, 0x70, 0x22, 0x00, 0x32, 0x83, 0x01, 0x0a,
+	0x0f, 0x43, 0x65, 0x6e, 0x74, 0x65, 0x72, 0x4e, 0x6f, 0x64, 0x65, 0x41, 0x64, 0x6d, 0x69, 0x6e,
+	0x12, 0x3a, 0x0a, 0x0a, 0x47, 0x65, 0x74, 0x43, 0x6c, 0x75, 0x74, 0x65, 0x72, 0x73, 0x12, 0x14,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x6c, 0x75, 0x74, 0x65, 0x72,
+	0x73, 0x52, 0x65, 0x71, 0x1a, 0x14, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x47, 0x65, 0x74,
+	0x43, 0x6c, 0x75, 0x74, 0x65, 0x72, 0x73, 0x52, 0x73, 0x70, 0x22, 0x00, 0x12, 0x34, 0x0a, 0x08,
+	0x47, 0x65, 0x74, 0x4e, 0x6f, 0x64, 0x65, 0x73, 0x12, 0x12, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x2e, 0x47, 0x65, 0x74, 0x4e, 0x6f, 0x64, 0x65, 0x73, 0x52, 0x65, 0x71, 0x1a, 0x12, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x47, 0x65, 0x74, 0x4e, 0x6f, 0x64, 0x65, 0x73, 0x52, 0x73, 0x70,
+	0x22, 0x00, 0x32, 0xd5, 0x01, 0x0a, 0x10, 0x43, 0x65, 0x6e, 0x74, 0x65, 0x72, 0x4e, 0x6f, 0x64,
+	0x65, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x37, 0x0a, 0x09, 0x48, 0x65, 0x61, 0x72, 0x74,
+	0x42, 0x65, 0x61, 0x74, 0x12, 0x13, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x48, 0x65, 0x61,
+	0x72, 0x74, 0x42, 0x65, 0x61, 0x74, 0x52, 0x65, 0x71, 0x1a, 0x13, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x2e, 0x48, 0x65, 0x61, 0x72, 0x74, 0x42, 0x65, 0x61, 0x74, 0x52, 0x73, 0x70, 0x22, 0x00,
+	0x12, 0x40, 0x0a, 0x0c, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x4e, 0x6f, 0x64, 0x65,
+	0x12, 0x16, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65,
+	0x72, 0x4e, 0x6f, 0x64, 0x65, 0x52, 0x65, 0x71, 0x1a, 0x16, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x2e, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x4e, 0x6f, 0x64, 0x65, 0x52, 0x73, 0x70,
+	0x22, 0x00, 0x12, 0x46, 0x0a, 0x0e, 0x53, 0x79, 0x6e, 0x63, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72,
+	0x73, 0x68, 0x69, 0x70, 0x12, 0x18, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x53, 0x79, 0x6e,
+	0x63, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x68, 0x69, 0x70, 0x52, 0x65, 0x71, 0x1a, 0x18,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x53, 0x79, 0x6e, 0x63, 0x4d, 0x65, 0x6d, 0x62, 0x65,
+	0x72, 0x73, 0x68, 0x69, 0x70, 0x52, 0x73, 0x70, 0x22, 0x00, 0x42, 0x29, 0x5a, 0x27, 0x67, 0x69,
+	0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x6c, 0x75, 0x72, 0x65, 0x69, 0x6e, 0x79,
+	0x2f, 0x76, 0x32, 0x72, 0x61, 0x79, 0x6d, 0x67, 0x2f, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2f,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -3700,171 +6717,265 @@ func file_rpc_server_proto_rawDescGZIP() []byte {
 }
 
 var file_rpc_server_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
-var file_rpc_server_proto_msgTypes = make([]protoimpl.MessageInfo, 55)
+var file_rpc_server_proto_msgTypes = make([]protoimpl.MessageInfo, 89)
 var file_rpc_server_proto_goTypes = []interface{}{
 	(BuilderType)(0),             // 0: proto.BuilderType
 	(*User)(nil),                 // 1: proto.User
-	(*NodeAuthInfo)(nil),         // 2: proto.NodeAuthInfo
-	(*GetUsersReq)(nil),          // 3: proto.GetUsersReq
-	(*GetUsersRsp)(nil),          // 4: proto.GetUsersRsp
-	(*UserOpReq)(nil),            // 5: proto.UserOpReq
-	(*UserOpRsp)(nil),            // 6: proto.UserOpRsp
-	(*GetSubReq)(nil),            // 7: proto.GetSubReq
-	(*GetSubRsp)(nil),            // 8: proto.GetSubRsp
-	(*HeartBeatReq)(nil),         // 9: proto.HeartBeatReq
-	(*Node)(nil),                 // 10: proto.Node
-	(*HeartBeatRsp)(nil),         // 11: proto.HeartBeatRsp
-	(*Nodes)(nil),                // 12: proto.Nodes
-	(*RegisterNodeReq)(nil),      // 13: proto.RegisterNodeReq
-	(*RegisterNodeRsp)(nil),      // 14: proto.RegisterNodeRsp
-	(*GetBandwidthStatsReq)(nil), // 15: proto.GetBandwidthStatsReq
-	(*Stats)(nil),                // 16: proto.Stats
-	(*GetBandwidthStatsRsp)(nil), // 17: proto.GetBandwidthStatsRsp
-	(*InboundOpReq)(nil),         // 18: proto.InboundOpReq
-	(*InboundOpRsp)(nil),         // 19: proto.InboundOpRsp
-	(*TransferInboundReq)(nil),   // 20: proto.TransferInboundReq
-	(*CopyInboundReq)(nil),       // 21: proto.CopyInboundReq
-	(*CopyUserReq)(nil),          // 22: proto.CopyUserReq
-	(*GetInboundReq)(nil),        // 23: proto.GetInboundReq
-	(*GetInboundRsp)(nil),        // 24: proto.GetInboundRsp
-	(*GetTagReq)(nil),            // 25: proto.GetTagReq
-	(*GetTagRsp)(nil),            // 26: proto.GetTagRsp
-	(*UpdateProxyReq)(nil),       // 27: proto.UpdateProxyReq
-	(*UpdateProxyRsp)(nil),       // 28: proto.UpdateProxyRsp
-	(*AdaptiveOpReq)(nil),        // 29: proto.AdaptiveOpReq
-	(*AdaptiveReq)(nil),          // 30: proto.AdaptiveReq
-	(*AdaptiveRsp)(nil),          // 31: proto.AdaptiveRsp
-	(*SetGatewayModelReq)(nil),   // 32: proto.SetGatewayModelReq
-	(*SetGatewayModelRsp)(nil),   // 33: proto.SetGatewayModelRsp
-	(*ObtainNewCertReq)(nil),     // 34: proto.ObtainNewCertReq
-	(*ObtainNewCertRsp)(nil),     // 35: proto.ObtainNewCertRsp
-	(*FastAddInboundReq)(nil),    // 36: proto.FastAddInboundReq
-	(*FastAddInboundRsp)(nil),    // 37: proto.FastAddInboundRsp
-	(*TransferCertReq)(nil),      // 38: proto.TransferCertReq
-	(*TransferCertRsp)(nil),      // 39: proto.TransferCertRsp
-	(*Cert)(nil),                 // 40: proto.Cert
-	(*GetCertsReq)(nil),          // 41: proto.GetCertsReq
-	(*GetCertsRsp)(nil),          // 42: proto.GetCertsRsp
-	(*ClearUsersReq)(nil),        // 43: proto.ClearUsersReq
-	(*ClearUsersRsp)(nil),        // 44: proto.ClearUsersRsp
-	(*PingMetric)(nil),           // 45: proto.PingMetric
-	(*PingResult)(nil),           // 46: proto.PingResult
-	(*GetPingMetricReq)(nil),     // 47: proto.GetPingMetricReq
-	(*GetPingMetricRsp)(nil),     // 48: proto.GetPingMetricRsp
-	(*GetClutersReq)(nil),        // 49: proto.GetClutersReq
-	(*GetClutersRsp)(nil),        // 50: proto.GetClutersRsp
-	(*GetNodesReq)(nil),          // 51: proto.GetNodesReq
-	(*GetNodesRsp)(nil),          // 52: proto.GetNodesRsp
-	nil,                          // 53: proto.HeartBeatRsp.NodesMapEntry
-	nil,                          // 54: proto.Nodes.NodesEntry
-	nil,                          // 55: proto.GetNodesRsp.NodesMapEntry
+	(*UserUsage)(nil),            // 2: proto.UserUsage
+	(*Plan)(nil),                 // 3: proto.Plan
+	(*NodeAuthInfo)(nil),         // 4: proto.NodeAuthInfo
+	(*GetUsersReq)(nil),          // 5: proto.GetUsersReq
+	(*GetUsersRsp)(nil),          // 6: proto.GetUsersRsp
+	(*UserUpdateMask)(nil),       // 7: proto.UserUpdateMask
+	(*UserOpReq)(nil),            // 8: proto.UserOpReq
+	(*UserOpRsp)(nil),            // 9: proto.UserOpRsp
+	(*ExportUsersReq)(nil),       // 10: proto.ExportUsersReq
+	(*ExportUsersRsp)(nil),       // 11: proto.ExportUsersRsp
+	(*ImportUsersReq)(nil),       // 12: proto.ImportUsersReq
+	(*ImportUserResult)(nil),     // 13: proto.ImportUserResult
+	(*ImportUsersRsp)(nil),       // 14: proto.ImportUsersRsp
+	(*ReconcileUsersReq)(nil),    // 15: proto.ReconcileUsersReq
+	(*UserDrift)(nil),            // 16: proto.UserDrift
+	(*ReconcileUsersRsp)(nil),    // 17: proto.ReconcileUsersRsp
+	(*GetSubReq)(nil),            // 18: proto.GetSubReq
+	(*GetSubRsp)(nil),            // 19: proto.GetSubRsp
+	(*HeartBeatReq)(nil),         // 20: proto.HeartBeatReq
+	(*ProcessHealth)(nil),        // 21: proto.ProcessHealth
+	(*NodeHealth)(nil),           // 22: proto.NodeHealth
+	(*Node)(nil),                 // 23: proto.Node
+	(*HeartBeatRsp)(nil),         // 24: proto.HeartBeatRsp
+	(*Nodes)(nil),                // 25: proto.Nodes
+	(*RegisterNodeReq)(nil),      // 26: proto.RegisterNodeReq
+	(*RegisterNodeRsp)(nil),      // 27: proto.RegisterNodeRsp
+	(*IssueNodeCertReq)(nil),     // 28: proto.IssueNodeCertReq
+	(*IssueNodeCertRsp)(nil),     // 29: proto.IssueNodeCertRsp
+	(*GossipMember)(nil),         // 30: proto.GossipMember
+	(*GossipPingReq)(nil),        // 31: proto.GossipPingReq
+	(*GossipPingRsp)(nil),        // 32: proto.GossipPingRsp
+	(*GetBandwidthStatsReq)(nil), // 33: proto.GetBandwidthStatsReq
+	(*Stats)(nil),                // 34: proto.Stats
+	(*GetBandwidthStatsRsp)(nil), // 35: proto.GetBandwidthStatsRsp
+	(*TrafficPoint)(nil),         // 36: proto.TrafficPoint
+	(*TrafficSeries)(nil),        // 37: proto.TrafficSeries
+	(*GetTrafficReportReq)(nil),  // 38: proto.GetTrafficReportReq
+	(*GetTrafficReportRsp)(nil),  // 39: proto.GetTrafficReportRsp
+	(*AuditEntry)(nil),           // 40: proto.AuditEntry
+	(*GetAuditLogReq)(nil),       // 41: proto.GetAuditLogReq
+	(*GetAuditLogRsp)(nil),       // 42: proto.GetAuditLogRsp
+	(*InboundOpReq)(nil),         // 43: proto.InboundOpReq
+	(*InboundOpRsp)(nil),         // 44: proto.InboundOpRsp
+	(*TransferInboundReq)(nil),   // 45: proto.TransferInboundReq
+	(*CopyInboundReq)(nil),       // 46: proto.CopyInboundReq
+	(*CopyUserReq)(nil),          // 47: proto.CopyUserReq
+	(*GetInboundReq)(nil),        // 48: proto.GetInboundReq
+	(*GetInboundRsp)(nil),        // 49: proto.GetInboundRsp
+	(*GetTagReq)(nil),            // 50: proto.GetTagReq
+	(*GetTagRsp)(nil),            // 51: proto.GetTagRsp
+	(*UpdateProxyReq)(nil),       // 52: proto.UpdateProxyReq
+	(*UpdateProxyRsp)(nil),       // 53: proto.UpdateProxyRsp
+	(*AdaptiveOpReq)(nil),        // 54: proto.AdaptiveOpReq
+	(*AdaptiveReq)(nil),          // 55: proto.AdaptiveReq
+	(*AdaptiveRsp)(nil),          // 56: proto.AdaptiveRsp
+	(*SetGatewayModelReq)(nil),   // 57: proto.SetGatewayModelReq
+	(*SetGatewayModelRsp)(nil),   // 58: proto.SetGatewayModelRsp
+	(*ObtainNewCertReq)(nil),     // 59: proto.ObtainNewCertReq
+	(*ObtainNewCertRsp)(nil),     // 60: proto.ObtainNewCertRsp
+	(*FastAddInboundReq)(nil),    // 61: proto.FastAddInboundReq
+	(*FastAddInboundRsp)(nil),    // 62: proto.FastAddInboundRsp
+	(*TransferCertReq)(nil),      // 63: proto.TransferCertReq
+	(*TransferCertRsp)(nil),      // 64: proto.TransferCertRsp
+	(*Cert)(nil),                 // 65: proto.Cert
+	(*GetCertsReq)(nil),          // 66: proto.GetCertsReq
+	(*GetCertsRsp)(nil),          // 67: proto.GetCertsRsp
+	(*ClearUsersReq)(nil),        // 68: proto.ClearUsersReq
+	(*ClearUsersRsp)(nil),        // 69: proto.ClearUsersRsp
+	(*PlanOpReq)(nil),            // 70: proto.PlanOpReq
+	(*PlanOpRsp)(nil),            // 71: proto.PlanOpRsp
+	(*GetPlansReq)(nil),          // 72: proto.GetPlansReq
+	(*GetPlansRsp)(nil),          // 73: proto.GetPlansRsp
+	(*PingMetric)(nil),           // 74: proto.PingMetric
+	(*PingResult)(nil),           // 75: proto.PingResult
+	(*GetPingMetricReq)(nil),     // 76: proto.GetPingMetricReq
+	(*GetPingMetricRsp)(nil),     // 77: proto.GetPingMetricRsp
+	(*GetClutersReq)(nil),        // 78: proto.GetClutersReq
+	(*GetClutersRsp)(nil),        // 79: proto.GetClutersRsp
+	(*GetNodesReq)(nil),          // 80: proto.GetNodesReq
+	(*GetNodesRsp)(nil),          // 81: proto.GetNodesRsp
+	(*MemberNode)(nil),           // 82: proto.MemberNode
+	(*SyncMembershipReq)(nil),    // 83: proto.SyncMembershipReq
+	(*SyncMembershipRsp)(nil),    // 84: proto.SyncMembershipRsp
+	nil,                          // 85: proto.NodeHealth.CertExpiriesEntry
+	nil,                          // 86: proto.Node.LabelsEntry
+	nil,                          // 87: proto.HeartBeatRsp.NodesMapEntry
+	nil,                          // 88: proto.Nodes.NodesEntry
+	nil,                          // 89: proto.GetNodesRsp.NodesMapEntry
 }
 var file_rpc_server_proto_depIdxs = []int32{
-	10, // 0: proto.NodeAuthInfo.node:type_name -> proto.Node
-	2,  // 1: proto.GetUsersReq.node_auth_info:type_name -> proto.NodeAuthInfo
-	1,  // 2: proto.GetUsersRsp.users:type_name -> proto.User
-	2,  // 3: proto.UserOpReq.node_auth_info:type_name -> proto.NodeAuthInfo
-	1,  // 4: proto.UserOpReq.users:type_name -> proto.User
-	2,  // 5: proto.GetSubReq.node_auth_info:type_name -> proto.NodeAuthInfo
-	1,  // 6: proto.GetSubReq.user:type_name -> proto.User
-	2,  // 7: proto.HeartBeatReq.node_auth_info:type_name -> proto.NodeAuthInfo
-	53, // 8: proto.HeartBeatRsp.nodesMap:type_name -> proto.HeartBeatRsp.NodesMapEntry
-	54, // 9: proto.Nodes.nodes:type_name -> proto.Nodes.NodesEntry
-	2,  // 10: proto.RegisterNodeReq.node_auth_info:type_name -> proto.NodeAuthInfo
-	2,  // 11: proto.GetBandwidthStatsReq.node_auth_info:type_name -> proto.NodeAuthInfo
-	16, // 12: proto.GetBandwidthStatsRsp.stats:type_name -> proto.Stats
-	2,  // 13: proto.InboundOpReq.node_auth_info:type_name -> proto.NodeAuthInfo
-	2,  // 14: proto.TransferInboundReq.node_auth_info:type_name -> proto.NodeAuthInfo
-	2,  // 15: proto.CopyInboundReq.node_auth_info:type_name -> proto.NodeAuthInfo
-	2,  // 16: proto.CopyUserReq.node_auth_info:type_name -> proto.NodeAuthInfo
-	2,  // 17: proto.GetInboundReq.node_auth_info:type_name -> proto.NodeAuthInfo
-	2,  // 18: proto.GetTagReq.node_auth_info:type_name -> proto.NodeAuthInfo
-	2,  // 19: proto.UpdateProxyReq.node_auth_info:type_name -> proto.NodeAuthInfo
-	2,  // 20: proto.AdaptiveOpReq.node_auth_info:type_name -> proto.NodeAuthInfo
-	2,  // 21: proto.AdaptiveReq.node_auth_info:type_name -> proto.NodeAuthInfo
-	2,  // 22: proto.SetGatewayModelReq.node_auth_info:type_name -> proto.NodeAuthInfo
-	2,  // 23: proto.ObtainNewCertReq.node_auth_info:type_name -> proto.NodeAuthInfo
-	2,  // 24: proto.FastAddInboundReq.node_auth_info:type_name -> proto.NodeAuthInfo
-	0,  // 25: proto.FastAddInboundReq.inboundBuilderType:type_name -> proto.BuilderType
-	0,  // 26: proto.FastAddInboundReq.streamBuilderType:type_name -> proto.BuilderType
-	2,  // 27: proto.TransferCertReq.node_auth_info:type_name -> proto.NodeAuthInfo
-	2,  // 28: proto.GetCertsReq.node_auth_info:type_name -> proto.NodeAuthInfo
-	40, // 29: proto.GetCertsRsp.certs:type_name -> proto.Cert
-	2,  // 30: proto.ClearUsersReq.node_auth_info:type_name -> proto.NodeAuthInfo
-	46, // 31: proto.PingMetric.results:type_name -> proto.PingResult
-	2,  // 32: proto.GetPingMetricReq.node_auth_info:type_name -> proto.NodeAuthInfo
-	45, // 33: proto.GetPingMetricRsp.metric:type_name -> proto.PingMetric
-	55, // 34: proto.GetNodesRsp.nodesMap:type_name -> proto.GetNodesRsp.NodesMapEntry
-	10, // 35: proto.HeartBeatRsp.NodesMapEntry.value:type_name -> proto.Node
-	12, // 36: proto.Nodes.NodesEntry.value:type_name -> proto.Nodes
-	10, // 37: proto.GetNodesRsp.NodesMapEntry.value:type_name -> proto.Node
-	3,  // 38: proto.EndNodeAccess.GetUsers:input_type -> proto.GetUsersReq
-	5,  // 39: proto.EndNodeAccess.AddUsers:input_type -> proto.UserOpReq
-	5,  // 40: proto.EndNodeAccess.DeleteUsers:input_type -> proto.UserOpReq
-	43, // 41: proto.EndNodeAccess.ClearUsers:input_type -> proto.ClearUsersReq
-	5,  // 42: proto.EndNodeAccess.UpdateUsers:input_type -> proto.UserOpReq
-	5,  // 43: proto.EndNodeAccess.ResetUser:input_type -> proto.UserOpReq
-	7,  // 44: proto.EndNodeAccess.GetSub:input_type -> proto.GetSubReq
-	15, // 45: proto.EndNodeAccess.GetBandWidthStats:input_type -> proto.GetBandwidthStatsReq
-	9,  // 46: proto.EndNodeAccess.HeartBeat:input_type -> proto.HeartBeatReq
-	13, // 47: proto.EndNodeAccess.RegisterNode:input_type -> proto.RegisterNodeReq
-	32, // 48: proto.EndNodeAccess.SetGatewayModel:input_type -> proto.SetGatewayModelReq
-	18, // 49: proto.EndNodeAccess.AddInbound:input_type -> proto.InboundOpReq
-	18, // 50: proto.EndNodeAccess.DeleteInbound:input_type -> proto.InboundOpReq
-	20, // 51: proto.EndNodeAccess.TransferInbound:input_type -> proto.TransferInboundReq
-	21, // 52: proto.EndNodeAccess.CopyInbound:input_type -> proto.CopyInboundReq
-	22, // 53: proto.EndNodeAccess.CopyUser:input_type -> proto.CopyUserReq
-	23, // 54: proto.EndNodeAccess.GetInbound:input_type -> proto.GetInboundReq
-	25, // 55: proto.EndNodeAccess.GetTag:input_type -> proto.GetTagReq
-	27, // 56: proto.EndNodeAccess.UpdateProxy:input_type -> proto.UpdateProxyReq
-	29, // 57: proto.EndNodeAccess.AddAdaptiveConfig:input_type -> proto.AdaptiveOpReq
-	29, // 58: proto.EndNodeAccess.DeleteAdaptiveConfig:input_type -> proto.AdaptiveOpReq
-	30, // 59: proto.EndNodeAccess.Adaptive:input_type -> proto.AdaptiveReq
-	36, // 60: proto.EndNodeAccess.FastAddInbound:input_type -> proto.FastAddInboundReq
-	34, // 61: proto.EndNodeAccess.ObtainNewCert:input_type -> proto.ObtainNewCertReq
-	38, // 62: proto.EndNodeAccess.TransferCert:input_type -> proto.TransferCertReq
-	41, // 63: proto.EndNodeAccess.GetCerts:input_type -> proto.GetCertsReq
-	47, // 64: proto.EndNodeAccess.GetPingMetric:input_type -> proto.GetPingMetricReq
-	49, // 65: proto.CenterNodeAdmin.GetCluters:input_type -> proto.GetClutersReq
-	51, // 66: proto.CenterNodeAdmin.GetNodes:input_type -> proto.GetNodesReq
-	9,  // 67: proto.CenterNodeAccess.HeartBeat:input_type -> proto.HeartBeatReq
-	13, // 68: proto.CenterNodeAccess.RegisterNode:input_type -> proto.RegisterNodeReq
-	4,  // 69: proto.EndNodeAccess.GetUsers:output_type -> proto.GetUsersRsp
-	6,  // 70: proto.EndNodeAccess.AddUsers:output_type -> proto.UserOpRsp
-	6,  // 71: proto.EndNodeAccess.DeleteUsers:output_type -> proto.UserOpRsp
-	44, // 72: proto.EndNodeAccess.ClearUsers:output_type -> proto.ClearUsersRsp
-	6,  // 73: proto.EndNodeAccess.UpdateUsers:output_type -> proto.UserOpRsp
-	6,  // 74: proto.EndNodeAccess.ResetUser:output_type -> proto.UserOpRsp
-	8,  // 75: proto.EndNodeAccess.GetSub:output_type -> proto.GetSubRsp
-	17, // 76: proto.EndNodeAccess.GetBandWidthStats:output_type -> proto.GetBandwidthStatsRsp
-	11, // 77: proto.EndNodeAccess.HeartBeat:output_type -> proto.HeartBeatRsp
-	14, // 78: proto.EndNodeAccess.RegisterNode:output_type -> proto.RegisterNodeRsp
-	33, // 79: proto.EndNodeAccess.SetGatewayModel:output_type -> proto.SetGatewayModelRsp
-	19, // 80: proto.EndNodeAccess.AddInbound:output_type -> proto.InboundOpRsp
-	19, // 81: proto.EndNodeAccess.DeleteInbound:output_type -> proto.InboundOpRsp
-	19, // 82: proto.EndNodeAccess.TransferInbound:output_type -> proto.InboundOpRsp
-	19, // 83: proto.EndNodeAccess.CopyInbound:output_type -> proto.InboundOpRsp
-	19, // 84: proto.EndNodeAccess.CopyUser:output_type -> proto.InboundOpRsp
-	24, // 85: proto.EndNodeAccess.GetInbound:output_type -> proto.GetInboundRsp
-	26, // 86: proto.EndNodeAccess.GetTag:output_type -> proto.GetTagRsp
-	28, // 87: proto.EndNodeAccess.UpdateProxy:output_type -> proto.UpdateProxyRsp
-	31, // 88: proto.EndNodeAccess.AddAdaptiveConfig:output_type -> proto.AdaptiveRsp
-	31, // 89: proto.EndNodeAccess.DeleteAdaptiveConfig:output_type -> proto.AdaptiveRsp
-	31, // 90: proto.EndNodeAccess.Adaptive:output_type -> proto.AdaptiveRsp
-	37, // 91: proto.EndNodeAccess.FastAddInbound:output_type -> proto.FastAddInboundRsp
-	35, // 92: proto.EndNodeAccess.ObtainNewCert:output_type -> proto.ObtainNewCertRsp
-	39, // 93: proto.EndNodeAccess.TransferCert:output_type -> proto.TransferCertRsp
-	42, // 94: proto.EndNodeAccess.GetCerts:output_type -> proto.GetCertsRsp
-	48, // 95: proto.EndNodeAccess.GetPingMetric:output_type -> proto.GetPingMetricRsp
-	50, // 96: proto.CenterNodeAdmin.GetCluters:output_type -> proto.GetClutersRsp
-	52, // 97: proto.CenterNodeAdmin.GetNodes:output_type -> proto.GetNodesRsp
-	11, // 98: proto.CenterNodeAccess.HeartBeat:output_type -> proto.HeartBeatRsp
-	14, // 99: proto.CenterNodeAccess.RegisterNode:output_type -> proto.RegisterNodeRsp
-	69, // [69:100] is the sub-list for method output_type
-	38, // [38:69] is the sub-list for method input_type
-	38, // [38:38] is the sub-list for extension type_name
-	38, // [38:38] is the sub-list for extension extendee
-	0,  // [0:38] is the sub-list for field type_name
+	23,  // 0: proto.NodeAuthInfo.node:type_name -> proto.Node
+	4,   // 1: proto.GetUsersReq.node_auth_info:type_name -> proto.NodeAuthInfo
+	1,   // 2: proto.GetUsersRsp.users:type_name -> proto.User
+	4,   // 3: proto.UserOpReq.node_auth_info:type_name -> proto.NodeAuthInfo
+	1,   // 4: proto.UserOpReq.users:type_name -> proto.User
+	7,   // 5: proto.UserOpReq.update_mask:type_name -> proto.UserUpdateMask
+	4,   // 6: proto.ExportUsersReq.node_auth_info:type_name -> proto.NodeAuthInfo
+	1,   // 7: proto.ExportUsersRsp.users:type_name -> proto.User
+	4,   // 8: proto.ImportUsersReq.node_auth_info:type_name -> proto.NodeAuthInfo
+	1,   // 9: proto.ImportUsersReq.users:type_name -> proto.User
+	13,  // 10: proto.ImportUsersRsp.results:type_name -> proto.ImportUserResult
+	4,   // 11: proto.ReconcileUsersReq.node_auth_info:type_name -> proto.NodeAuthInfo
+	1,   // 12: proto.ReconcileUsersReq.users:type_name -> proto.User
+	16,  // 13: proto.ReconcileUsersRsp.drifts:type_name -> proto.UserDrift
+	4,   // 14: proto.GetSubReq.node_auth_info:type_name -> proto.NodeAuthInfo
+	1,   // 15: proto.GetSubReq.user:type_name -> proto.User
+	1,   // 16: proto.GetSubRsp.usage:type_name -> proto.User
+	4,   // 17: proto.HeartBeatReq.node_auth_info:type_name -> proto.NodeAuthInfo
+	22,  // 18: proto.HeartBeatReq.health:type_name -> proto.NodeHealth
+	21,  // 19: proto.NodeHealth.proxy:type_name -> proto.ProcessHealth
+	21,  // 20: proto.NodeHealth.hysteria:type_name -> proto.ProcessHealth
+	85,  // 21: proto.NodeHealth.cert_expiries:type_name -> proto.NodeHealth.CertExpiriesEntry
+	86,  // 22: proto.Node.labels:type_name -> proto.Node.LabelsEntry
+	87,  // 23: proto.HeartBeatRsp.nodesMap:type_name -> proto.HeartBeatRsp.NodesMapEntry
+	88,  // 24: proto.Nodes.nodes:type_name -> proto.Nodes.NodesEntry
+	4,   // 25: proto.RegisterNodeReq.node_auth_info:type_name -> proto.NodeAuthInfo
+	4,   // 26: proto.IssueNodeCertReq.node_auth_info:type_name -> proto.NodeAuthInfo
+	23,  // 27: proto.GossipMember.node:type_name -> proto.Node
+	22,  // 28: proto.GossipMember.health:type_name -> proto.NodeHealth
+	4,   // 29: proto.GossipPingReq.node_auth_info:type_name -> proto.NodeAuthInfo
+	30,  // 30: proto.GossipPingReq.members:type_name -> proto.GossipMember
+	30,  // 31: proto.GossipPingRsp.members:type_name -> proto.GossipMember
+	4,   // 32: proto.GetBandwidthStatsReq.node_auth_info:type_name -> proto.NodeAuthInfo
+	34,  // 33: proto.GetBandwidthStatsRsp.stats:type_name -> proto.Stats
+	36,  // 34: proto.TrafficSeries.points:type_name -> proto.TrafficPoint
+	4,   // 35: proto.GetTrafficReportReq.node_auth_info:type_name -> proto.NodeAuthInfo
+	37,  // 36: proto.GetTrafficReportRsp.series:type_name -> proto.TrafficSeries
+	4,   // 37: proto.GetAuditLogReq.node_auth_info:type_name -> proto.NodeAuthInfo
+	40,  // 38: proto.GetAuditLogRsp.entries:type_name -> proto.AuditEntry
+	4,   // 39: proto.InboundOpReq.node_auth_info:type_name -> proto.NodeAuthInfo
+	4,   // 40: proto.TransferInboundReq.node_auth_info:type_name -> proto.NodeAuthInfo
+	4,   // 41: proto.CopyInboundReq.node_auth_info:type_name -> proto.NodeAuthInfo
+	4,   // 42: proto.CopyUserReq.node_auth_info:type_name -> proto.NodeAuthInfo
+	4,   // 43: proto.GetInboundReq.node_auth_info:type_name -> proto.NodeAuthInfo
+	4,   // 44: proto.GetTagReq.node_auth_info:type_name -> proto.NodeAuthInfo
+	4,   // 45: proto.UpdateProxyReq.node_auth_info:type_name -> proto.NodeAuthInfo
+	4,   // 46: proto.AdaptiveOpReq.node_auth_info:type_name -> proto.NodeAuthInfo
+	4,   // 47: proto.AdaptiveReq.node_auth_info:type_name -> proto.NodeAuthInfo
+	4,   // 48: proto.SetGatewayModelReq.node_auth_info:type_name -> proto.NodeAuthInfo
+	4,   // 49: proto.ObtainNewCertReq.node_auth_info:type_name -> proto.NodeAuthInfo
+	4,   // 50: proto.FastAddInboundReq.node_auth_info:type_name -> proto.NodeAuthInfo
+	0,   // 51: proto.FastAddInboundReq.inboundBuilderType:type_name -> proto.BuilderType
+	0,   // 52: proto.FastAddInboundReq.streamBuilderType:type_name -> proto.BuilderType
+	4,   // 53: proto.TransferCertReq.node_auth_info:type_name -> proto.NodeAuthInfo
+	4,   // 54: proto.GetCertsReq.node_auth_info:type_name -> proto.NodeAuthInfo
+	65,  // 55: proto.GetCertsRsp.certs:type_name -> proto.Cert
+	4,   // 56: proto.ClearUsersReq.node_auth_info:type_name -> proto.NodeAuthInfo
+	4,   // 57: proto.PlanOpReq.node_auth_info:type_name -> proto.NodeAuthInfo
+	3,   // 58: proto.PlanOpReq.plans:type_name -> proto.Plan
+	4,   // 59: proto.GetPlansReq.node_auth_info:type_name -> proto.NodeAuthInfo
+	3,   // 60: proto.GetPlansRsp.plans:type_name -> proto.Plan
+	75,  // 61: proto.PingMetric.results:type_name -> proto.PingResult
+	4,   // 62: proto.GetPingMetricReq.node_auth_info:type_name -> proto.NodeAuthInfo
+	74,  // 63: proto.GetPingMetricRsp.metric:type_name -> proto.PingMetric
+	89,  // 64: proto.GetNodesRsp.nodesMap:type_name -> proto.GetNodesRsp.NodesMapEntry
+	23,  // 65: proto.MemberNode.node:type_name -> proto.Node
+	22,  // 66: proto.MemberNode.health:type_name -> proto.NodeHealth
+	82,  // 67: proto.SyncMembershipReq.members:type_name -> proto.MemberNode
+	82,  // 68: proto.SyncMembershipRsp.members:type_name -> proto.MemberNode
+	23,  // 69: proto.HeartBeatRsp.NodesMapEntry.value:type_name -> proto.Node
+	25,  // 70: proto.Nodes.NodesEntry.value:type_name -> proto.Nodes
+	23,  // 71: proto.GetNodesRsp.NodesMapEntry.value:type_name -> proto.Node
+	5,   // 72: proto.EndNodeAccess.GetUsers:input_type -> proto.GetUsersReq
+	8,   // 73: proto.EndNodeAccess.AddUsers:input_type -> proto.UserOpReq
+	8,   // 74: proto.EndNodeAccess.DeleteUsers:input_type -> proto.UserOpReq
+	68,  // 75: proto.EndNodeAccess.ClearUsers:input_type -> proto.ClearUsersReq
+	8,   // 76: proto.EndNodeAccess.UpdateUsers:input_type -> proto.UserOpReq
+	8,   // 77: proto.EndNodeAccess.ResetUser:input_type -> proto.UserOpReq
+	8,   // 78: proto.EndNodeAccess.SuspendUsers:input_type -> proto.UserOpReq
+	8,   // 79: proto.EndNodeAccess.ResumeUsers:input_type -> proto.UserOpReq
+	10,  // 80: proto.EndNodeAccess.ExportUsers:input_type -> proto.ExportUsersReq
+	12,  // 81: proto.EndNodeAccess.ImportUsers:input_type -> proto.ImportUsersReq
+	15,  // 82: proto.EndNodeAccess.ReconcileUsers:input_type -> proto.ReconcileUsersReq
+	70,  // 83: proto.EndNodeAccess.SetPlans:input_type -> proto.PlanOpReq
+	70,  // 84: proto.EndNodeAccess.DeletePlans:input_type -> proto.PlanOpReq
+	72,  // 85: proto.EndNodeAccess.GetPlans:input_type -> proto.GetPlansReq
+	18,  // 86: proto.EndNodeAccess.GetSub:input_type -> proto.GetSubReq
+	33,  // 87: proto.EndNodeAccess.GetBandWidthStats:input_type -> proto.GetBandwidthStatsReq
+	38,  // 88: proto.EndNodeAccess.GetTrafficReport:input_type -> proto.GetTrafficReportReq
+	41,  // 89: proto.EndNodeAccess.GetAuditLog:input_type -> proto.GetAuditLogReq
+	20,  // 90: proto.EndNodeAccess.HeartBeat:input_type -> proto.HeartBeatReq
+	26,  // 91: proto.EndNodeAccess.RegisterNode:input_type -> proto.RegisterNodeReq
+	28,  // 92: proto.EndNodeAccess.IssueNodeCert:input_type -> proto.IssueNodeCertReq
+	31,  // 93: proto.EndNodeAccess.GossipPing:input_type -> proto.GossipPingReq
+	57,  // 94: proto.EndNodeAccess.SetGatewayModel:input_type -> proto.SetGatewayModelReq
+	43,  // 95: proto.EndNodeAccess.AddInbound:input_type -> proto.InboundOpReq
+	43,  // 96: proto.EndNodeAccess.DeleteInbound:input_type -> proto.InboundOpReq
+	45,  // 97: proto.EndNodeAccess.TransferInbound:input_type -> proto.TransferInboundReq
+	46,  // 98: proto.EndNodeAccess.CopyInbound:input_type -> proto.CopyInboundReq
+	47,  // 99: proto.EndNodeAccess.CopyUser:input_type -> proto.CopyUserReq
+	48,  // 100: proto.EndNodeAccess.GetInbound:input_type -> proto.GetInboundReq
+	50,  // 101: proto.EndNodeAccess.GetTag:input_type -> proto.GetTagReq
+	52,  // 102: proto.EndNodeAccess.UpdateProxy:input_type -> proto.UpdateProxyReq
+	54,  // 103: proto.EndNodeAccess.AddAdaptiveConfig:input_type -> proto.AdaptiveOpReq
+	54,  // 104: proto.EndNodeAccess.DeleteAdaptiveConfig:input_type -> proto.AdaptiveOpReq
+	55,  // 105: proto.EndNodeAccess.Adaptive:input_type -> proto.AdaptiveReq
+	61,  // 106: proto.EndNodeAccess.FastAddInbound:input_type -> proto.FastAddInboundReq
+	59,  // 107: proto.EndNodeAccess.ObtainNewCert:input_type -> proto.ObtainNewCertReq
+	63,  // 108: proto.EndNodeAccess.TransferCert:input_type -> proto.TransferCertReq
+	66,  // 109: proto.EndNodeAccess.GetCerts:input_type -> proto.GetCertsReq
+	76,  // 110: proto.EndNodeAccess.GetPingMetric:input_type -> proto.GetPingMetricReq
+	78,  // 111: proto.CenterNodeAdmin.GetCluters:input_type -> proto.GetClutersReq
+	80,  // 112: proto.CenterNodeAdmin.GetNodes:input_type -> proto.GetNodesReq
+	20,  // 113: proto.CenterNodeAccess.HeartBeat:input_type -> proto.HeartBeatReq
+	26,  // 114: proto.CenterNodeAccess.RegisterNode:input_type -> proto.RegisterNodeReq
+	83,  // 115: proto.CenterNodeAccess.SyncMembership:input_type -> proto.SyncMembershipReq
+	6,   // 116: proto.EndNodeAccess.GetUsers:output_type -> proto.GetUsersRsp
+	9,   // 117: proto.EndNodeAccess.AddUsers:output_type -> proto.UserOpRsp
+	9,   // 118: proto.EndNodeAccess.DeleteUsers:output_type -> proto.UserOpRsp
+	69,  // 119: proto.EndNodeAccess.ClearUsers:output_type -> proto.ClearUsersRsp
+	9,   // 120: proto.EndNodeAccess.UpdateUsers:output_type -> proto.UserOpRsp
+	9,   // 121: proto.EndNodeAccess.ResetUser:output_type -> proto.UserOpRsp
+	9,   // 122: proto.EndNodeAccess.SuspendUsers:output_type -> proto.UserOpRsp
+	9,   // 123: proto.EndNodeAccess.ResumeUsers:output_type -> proto.UserOpRsp
+	11,  // 124: proto.EndNodeAccess.ExportUsers:output_type -> proto.ExportUsersRsp
+	14,  // 125: proto.EndNodeAccess.ImportUsers:output_type -> proto.ImportUsersRsp
+	17,  // 126: proto.EndNodeAccess.ReconcileUsers:output_type -> proto.ReconcileUsersRsp
+	71,  // 127: proto.EndNodeAccess.SetPlans:output_type -> proto.PlanOpRsp
+	71,  // 128: proto.EndNodeAccess.DeletePlans:output_type -> proto.PlanOpRsp
+	73,  // 129: proto.EndNodeAccess.GetPlans:output_type -> proto.GetPlansRsp
+	19,  // 130: proto.EndNodeAccess.GetSub:output_type -> proto.GetSubRsp
+	35,  // 131: proto.EndNodeAccess.GetBandWidthStats:output_type -> proto.GetBandwidthStatsRsp
+	39,  // 132: proto.EndNodeAccess.GetTrafficReport:output_type -> proto.GetTrafficReportRsp
+	42,  // 133: proto.EndNodeAccess.GetAuditLog:output_type -> proto.GetAuditLogRsp
+	24,  // 134: proto.EndNodeAccess.HeartBeat:output_type -> proto.HeartBeatRsp
+	27,  // 135: proto.EndNodeAccess.RegisterNode:output_type -> proto.RegisterNodeRsp
+	29,  // 136: proto.EndNodeAccess.IssueNodeCert:output_type -> proto.IssueNodeCertRsp
+	32,  // 137: proto.EndNodeAccess.GossipPing:output_type -> proto.GossipPingRsp
+	58,  // 138: proto.EndNodeAccess.SetGatewayModel:output_type -> proto.SetGatewayModelRsp
+	44,  // 139: proto.EndNodeAccess.AddInbound:output_type -> proto.InboundOpRsp
+	44,  // 140: proto.EndNodeAccess.DeleteInbound:output_type -> proto.InboundOpRsp
+	44,  // 141: proto.EndNodeAccess.TransferInbound:output_type -> proto.InboundOpRsp
+	44,  // 142: proto.EndNodeAccess.CopyInbound:output_type -> proto.InboundOpRsp
+	44,  // 143: proto.EndNodeAccess.CopyUser:output_type -> proto.InboundOpRsp
+	49,  // 144: proto.EndNodeAccess.GetInbound:output_type -> proto.GetInboundRsp
+	51,  // 145: proto.EndNodeAccess.GetTag:output_type -> proto.GetTagRsp
+	53,  // 146: proto.EndNodeAccess.UpdateProxy:output_type -> proto.UpdateProxyRsp
+	56,  // 147: proto.EndNodeAccess.AddAdaptiveConfig:output_type -> proto.AdaptiveRsp
+	56,  // 148: proto.EndNodeAccess.DeleteAdaptiveConfig:output_type -> proto.AdaptiveRsp
+	56,  // 149: proto.EndNodeAccess.Adaptive:output_type -> proto.AdaptiveRsp
+	62,  // 150: proto.EndNodeAccess.FastAddInbound:output_type -> proto.FastAddInboundRsp
+	60,  // 151: proto.EndNodeAccess.ObtainNewCert:output_type -> proto.ObtainNewCertRsp
+	64,  // 152: proto.EndNodeAccess.TransferCert:output_type -> proto.TransferCertRsp
+	67,  // 153: proto.EndNodeAccess.GetCerts:output_type -> proto.GetCertsRsp
+	77,  // 154: proto.EndNodeAccess.GetPingMetric:output_type -> proto.GetPingMetricRsp
+	79,  // 155: proto.CenterNodeAdmin.GetCluters:output_type -> proto.GetClutersRsp
+	81,  // 156: proto.CenterNodeAdmin.GetNodes:output_type -> proto.GetNodesRsp
+	24,  // 157: proto.CenterNodeAccess.HeartBeat:output_type -> proto.HeartBeatRsp
+	27,  // 158: proto.CenterNodeAccess.RegisterNode:output_type -> proto.RegisterNodeRsp
+	84,  // 159: proto.CenterNodeAccess.SyncMembership:output_type -> proto.SyncMembershipRsp
+	116, // [116:160] is the sub-list for method output_type
+	72,  // [72:116] is the sub-list for method input_type
+	72,  // [72:72] is the sub-list for extension type_name
+	72,  // [72:72] is the sub-list for extension extendee
+	0,   // [0:72] is the sub-list for field type_name
 }
 
 func init() { file_rpc_server_proto_init() }
@@ -3885,8 +6996,212 @@ func file_rpc_server_proto_init() {
 				return nil
 			}
 		}
-		file_rpc_server_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*NodeAuthInfo); i {
+		file_rpc_server_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UserUsage); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpc_server_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Plan); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpc_server_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*NodeAuthInfo); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpc_server_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetUsersReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpc_server_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetUsersRsp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpc_server_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UserUpdateMask); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpc_server_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UserOpReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpc_server_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UserOpRsp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpc_server_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ExportUsersReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpc_server_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ExportUsersRsp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpc_server_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ImportUsersReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpc_server_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ImportUserResult); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpc_server_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ImportUsersRsp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpc_server_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReconcileUsersReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpc_server_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UserDrift); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpc_server_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReconcileUsersRsp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpc_server_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetSubReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpc_server_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetSubRsp); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3897,8 +7212,8 @@ func file_rpc_server_proto_init() {
 				return nil
 			}
 		}
-		file_rpc_server_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetUsersReq); i {
+		file_rpc_server_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*HeartBeatReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3909,8 +7224,8 @@ func file_rpc_server_proto_init() {
 				return nil
 			}
 		}
-		file_rpc_server_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetUsersRsp); i {
+		file_rpc_server_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ProcessHealth); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3921,8 +7236,8 @@ func file_rpc_server_proto_init() {
 				return nil
 			}
 		}
-		file_rpc_server_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UserOpReq); i {
+		file_rpc_server_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*NodeHealth); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3933,8 +7248,8 @@ func file_rpc_server_proto_init() {
 				return nil
 			}
 		}
-		file_rpc_server_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UserOpRsp); i {
+		file_rpc_server_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Node); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3945,8 +7260,8 @@ func file_rpc_server_proto_init() {
 				return nil
 			}
 		}
-		file_rpc_server_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetSubReq); i {
+		file_rpc_server_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*HeartBeatRsp); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3957,8 +7272,8 @@ func file_rpc_server_proto_init() {
 				return nil
 			}
 		}
-		file_rpc_server_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetSubRsp); i {
+		file_rpc_server_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Nodes); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3969,8 +7284,8 @@ func file_rpc_server_proto_init() {
 				return nil
 			}
 		}
-		file_rpc_server_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*HeartBeatReq); i {
+		file_rpc_server_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RegisterNodeReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3981,8 +7296,8 @@ func file_rpc_server_proto_init() {
 				return nil
 			}
 		}
-		file_rpc_server_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Node); i {
+		file_rpc_server_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RegisterNodeRsp); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3993,8 +7308,8 @@ func file_rpc_server_proto_init() {
 				return nil
 			}
 		}
-		file_rpc_server_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*HeartBeatRsp); i {
+		file_rpc_server_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*IssueNodeCertReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4005,8 +7320,8 @@ func file_rpc_server_proto_init() {
 				return nil
 			}
 		}
-		file_rpc_server_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Nodes); i {
+		file_rpc_server_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*IssueNodeCertRsp); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4017,8 +7332,8 @@ func file_rpc_server_proto_init() {
 				return nil
 			}
 		}
-		file_rpc_server_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RegisterNodeReq); i {
+		file_rpc_server_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GossipMember); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4029,8 +7344,8 @@ func file_rpc_server_proto_init() {
 				return nil
 			}
 		}
-		file_rpc_server_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RegisterNodeRsp); i {
+		file_rpc_server_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GossipPingReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4041,7 +7356,19 @@ func file_rpc_server_proto_init() {
 				return nil
 			}
 		}
-		file_rpc_server_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+		file_rpc_server_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GossipPingRsp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpc_server_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*GetBandwidthStatsReq); i {
 			case 0:
 				return &v.state
@@ -4053,7 +7380,7 @@ func file_rpc_server_proto_init() {
 				return nil
 			}
 		}
-		file_rpc_server_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+		file_rpc_server_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*Stats); i {
 			case 0:
 				return &v.state
@@ -4065,7 +7392,7 @@ func file_rpc_server_proto_init() {
 				return nil
 			}
 		}
-		file_rpc_server_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+		file_rpc_server_proto_msgTypes[34].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*GetBandwidthStatsRsp); i {
 			case 0:
 				return &v.state
@@ -4077,7 +7404,91 @@ func file_rpc_server_proto_init() {
 				return nil
 			}
 		}
-		file_rpc_server_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+		file_rpc_server_proto_msgTypes[35].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TrafficPoint); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpc_server_proto_msgTypes[36].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TrafficSeries); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpc_server_proto_msgTypes[37].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetTrafficReportReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpc_server_proto_msgTypes[38].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetTrafficReportRsp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpc_server_proto_msgTypes[39].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AuditEntry); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpc_server_proto_msgTypes[40].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetAuditLogReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpc_server_proto_msgTypes[41].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetAuditLogRsp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpc_server_proto_msgTypes[42].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*InboundOpReq); i {
 			case 0:
 				return &v.state
@@ -4089,7 +7500,7 @@ func file_rpc_server_proto_init() {
 				return nil
 			}
 		}
-		file_rpc_server_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+		file_rpc_server_proto_msgTypes[43].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*InboundOpRsp); i {
 			case 0:
 				return &v.state
@@ -4101,7 +7512,7 @@ func file_rpc_server_proto_init() {
 				return nil
 			}
 		}
-		file_rpc_server_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+		file_rpc_server_proto_msgTypes[44].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*TransferInboundReq); i {
 			case 0:
 				return &v.state
@@ -4113,7 +7524,7 @@ func file_rpc_server_proto_init() {
 				return nil
 			}
 		}
-		file_rpc_server_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+		file_rpc_server_proto_msgTypes[45].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*CopyInboundReq); i {
 			case 0:
 				return &v.state
@@ -4125,7 +7536,7 @@ func file_rpc_server_proto_init() {
 				return nil
 			}
 		}
-		file_rpc_server_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+		file_rpc_server_proto_msgTypes[46].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*CopyUserReq); i {
 			case 0:
 				return &v.state
@@ -4137,7 +7548,7 @@ func file_rpc_server_proto_init() {
 				return nil
 			}
 		}
-		file_rpc_server_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
+		file_rpc_server_proto_msgTypes[47].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*GetInboundReq); i {
 			case 0:
 				return &v.state
@@ -4149,7 +7560,7 @@ func file_rpc_server_proto_init() {
 				return nil
 			}
 		}
-		file_rpc_server_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
+		file_rpc_server_proto_msgTypes[48].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*GetInboundRsp); i {
 			case 0:
 				return &v.state
@@ -4161,7 +7572,7 @@ func file_rpc_server_proto_init() {
 				return nil
 			}
 		}
-		file_rpc_server_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
+		file_rpc_server_proto_msgTypes[49].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*GetTagReq); i {
 			case 0:
 				return &v.state
@@ -4173,7 +7584,7 @@ func file_rpc_server_proto_init() {
 				return nil
 			}
 		}
-		file_rpc_server_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
+		file_rpc_server_proto_msgTypes[50].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*GetTagRsp); i {
 			case 0:
 				return &v.state
@@ -4185,7 +7596,7 @@ func file_rpc_server_proto_init() {
 				return nil
 			}
 		}
-		file_rpc_server_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
+		file_rpc_server_proto_msgTypes[51].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*UpdateProxyReq); i {
 			case 0:
 				return &v.state
@@ -4197,7 +7608,7 @@ func file_rpc_server_proto_init() {
 				return nil
 			}
 		}
-		file_rpc_server_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
+		file_rpc_server_proto_msgTypes[52].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*UpdateProxyRsp); i {
 			case 0:
 				return &v.state
@@ -4209,7 +7620,7 @@ func file_rpc_server_proto_init() {
 				return nil
 			}
 		}
-		file_rpc_server_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
+		file_rpc_server_proto_msgTypes[53].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*AdaptiveOpReq); i {
 			case 0:
 				return &v.state
@@ -4221,7 +7632,7 @@ func file_rpc_server_proto_init() {
 				return nil
 			}
 		}
-		file_rpc_server_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
+		file_rpc_server_proto_msgTypes[54].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*AdaptiveReq); i {
 			case 0:
 				return &v.state
@@ -4233,7 +7644,7 @@ func file_rpc_server_proto_init() {
 				return nil
 			}
 		}
-		file_rpc_server_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
+		file_rpc_server_proto_msgTypes[55].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*AdaptiveRsp); i {
 			case 0:
 				return &v.state
@@ -4245,7 +7656,7 @@ func file_rpc_server_proto_init() {
 				return nil
 			}
 		}
-		file_rpc_server_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
+		file_rpc_server_proto_msgTypes[56].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*SetGatewayModelReq); i {
 			case 0:
 				return &v.state
@@ -4257,7 +7668,7 @@ func file_rpc_server_proto_init() {
 				return nil
 			}
 		}
-		file_rpc_server_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
+		file_rpc_server_proto_msgTypes[57].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*SetGatewayModelRsp); i {
 			case 0:
 				return &v.state
@@ -4269,7 +7680,7 @@ func file_rpc_server_proto_init() {
 				return nil
 			}
 		}
-		file_rpc_server_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
+		file_rpc_server_proto_msgTypes[58].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*ObtainNewCertReq); i {
 			case 0:
 				return &v.state
@@ -4281,7 +7692,7 @@ func file_rpc_server_proto_init() {
 				return nil
 			}
 		}
-		file_rpc_server_proto_msgTypes[34].Exporter = func(v interface{}, i int) interface{} {
+		file_rpc_server_proto_msgTypes[59].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*ObtainNewCertRsp); i {
 			case 0:
 				return &v.state
@@ -4293,7 +7704,7 @@ func file_rpc_server_proto_init() {
 				return nil
 			}
 		}
-		file_rpc_server_proto_msgTypes[35].Exporter = func(v interface{}, i int) interface{} {
+		file_rpc_server_proto_msgTypes[60].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*FastAddInboundReq); i {
 			case 0:
 				return &v.state
@@ -4305,7 +7716,7 @@ func file_rpc_server_proto_init() {
 				return nil
 			}
 		}
-		file_rpc_server_proto_msgTypes[36].Exporter = func(v interface{}, i int) interface{} {
+		file_rpc_server_proto_msgTypes[61].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*FastAddInboundRsp); i {
 			case 0:
 				return &v.state
@@ -4317,7 +7728,7 @@ func file_rpc_server_proto_init() {
 				return nil
 			}
 		}
-		file_rpc_server_proto_msgTypes[37].Exporter = func(v interface{}, i int) interface{} {
+		file_rpc_server_proto_msgTypes[62].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*TransferCertReq); i {
 			case 0:
 				return &v.state
@@ -4329,7 +7740,7 @@ func file_rpc_server_proto_init() {
 				return nil
 			}
 		}
-		file_rpc_server_proto_msgTypes[38].Exporter = func(v interface{}, i int) interface{} {
+		file_rpc_server_proto_msgTypes[63].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*TransferCertRsp); i {
 			case 0:
 				return &v.state
@@ -4341,7 +7752,7 @@ func file_rpc_server_proto_init() {
 				return nil
 			}
 		}
-		file_rpc_server_proto_msgTypes[39].Exporter = func(v interface{}, i int) interface{} {
+		file_rpc_server_proto_msgTypes[64].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*Cert); i {
 			case 0:
 				return &v.state
@@ -4353,7 +7764,7 @@ func file_rpc_server_proto_init() {
 				return nil
 			}
 		}
-		file_rpc_server_proto_msgTypes[40].Exporter = func(v interface{}, i int) interface{} {
+		file_rpc_server_proto_msgTypes[65].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*GetCertsReq); i {
 			case 0:
 				return &v.state
@@ -4365,7 +7776,7 @@ func file_rpc_server_proto_init() {
 				return nil
 			}
 		}
-		file_rpc_server_proto_msgTypes[41].Exporter = func(v interface{}, i int) interface{} {
+		file_rpc_server_proto_msgTypes[66].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*GetCertsRsp); i {
 			case 0:
 				return &v.state
@@ -4377,7 +7788,7 @@ func file_rpc_server_proto_init() {
 				return nil
 			}
 		}
-		file_rpc_server_proto_msgTypes[42].Exporter = func(v interface{}, i int) interface{} {
+		file_rpc_server_proto_msgTypes[67].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*ClearUsersReq); i {
 			case 0:
 				return &v.state
@@ -4389,7 +7800,7 @@ func file_rpc_server_proto_init() {
 				return nil
 			}
 		}
-		file_rpc_server_proto_msgTypes[43].Exporter = func(v interface{}, i int) interface{} {
+		file_rpc_server_proto_msgTypes[68].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*ClearUsersRsp); i {
 			case 0:
 				return &v.state
@@ -4401,7 +7812,55 @@ func file_rpc_server_proto_init() {
 				return nil
 			}
 		}
-		file_rpc_server_proto_msgTypes[44].Exporter = func(v interface{}, i int) interface{} {
+		file_rpc_server_proto_msgTypes[69].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PlanOpReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpc_server_proto_msgTypes[70].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PlanOpRsp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpc_server_proto_msgTypes[71].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetPlansReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpc_server_proto_msgTypes[72].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetPlansRsp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpc_server_proto_msgTypes[73].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*PingMetric); i {
 			case 0:
 				return &v.state
@@ -4413,7 +7872,7 @@ func file_rpc_server_proto_init() {
 				return nil
 			}
 		}
-		file_rpc_server_proto_msgTypes[45].Exporter = func(v interface{}, i int) interface{} {
+		file_rpc_server_proto_msgTypes[74].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*PingResult); i {
 			case 0:
 				return &v.state
@@ -4425,7 +7884,7 @@ func file_rpc_server_proto_init() {
 				return nil
 			}
 		}
-		file_rpc_server_proto_msgTypes[46].Exporter = func(v interface{}, i int) interface{} {
+		file_rpc_server_proto_msgTypes[75].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*GetPingMetricReq); i {
 			case 0:
 				return &v.state
@@ -4437,7 +7896,7 @@ func file_rpc_server_proto_init() {
 				return nil
 			}
 		}
-		file_rpc_server_proto_msgTypes[47].Exporter = func(v interface{}, i int) interface{} {
+		file_rpc_server_proto_msgTypes[76].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*GetPingMetricRsp); i {
 			case 0:
 				return &v.state
@@ -4449,7 +7908,7 @@ func file_rpc_server_proto_init() {
 				return nil
 			}
 		}
-		file_rpc_server_proto_msgTypes[48].Exporter = func(v interface{}, i int) interface{} {
+		file_rpc_server_proto_msgTypes[77].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*GetClutersReq); i {
 			case 0:
 				return &v.state
@@ -4461,7 +7920,7 @@ func file_rpc_server_proto_init() {
 				return nil
 			}
 		}
-		file_rpc_server_proto_msgTypes[49].Exporter = func(v interface{}, i int) interface{} {
+		file_rpc_server_proto_msgTypes[78].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*GetClutersRsp); i {
 			case 0:
 				return &v.state
@@ -4473,7 +7932,7 @@ func file_rpc_server_proto_init() {
 				return nil
 			}
 		}
-		file_rpc_server_proto_msgTypes[50].Exporter = func(v interface{}, i int) interface{} {
+		file_rpc_server_proto_msgTypes[79].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*GetNodesReq); i {
 			case 0:
 				return &v.state
@@ -4485,7 +7944,7 @@ func file_rpc_server_proto_init() {
 				return nil
 			}
 		}
-		file_rpc_server_proto_msgTypes[51].Exporter = func(v interface{}, i int) interface{} {
+		file_rpc_server_proto_msgTypes[80].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*GetNodesRsp); i {
 			case 0:
 				return &v.state
@@ -4497,6 +7956,42 @@ func file_rpc_server_proto_init() {
 				return nil
 			}
 		}
+		file_rpc_server_proto_msgTypes[81].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MemberNode); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpc_server_proto_msgTypes[82].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SyncMembershipReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpc_server_proto_msgTypes[83].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SyncMembershipRsp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
@@ -4504,7 +7999,7 @@ func file_rpc_server_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_rpc_server_proto_rawDesc,
 			NumEnums:      1,
-			NumMessages:   55,
+			NumMessages:   89,
 			NumExtensions: 0,
 			NumServices:   3,
 		},
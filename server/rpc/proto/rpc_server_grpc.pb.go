@@ -25,10 +25,22 @@ const (
 	EndNodeAccess_ClearUsers_FullMethodName           = "/proto.EndNodeAccess/ClearUsers"
 	EndNodeAccess_UpdateUsers_FullMethodName          = "/proto.EndNodeAccess/UpdateUsers"
 	EndNodeAccess_ResetUser_FullMethodName            = "/proto.EndNodeAccess/ResetUser"
+	EndNodeAccess_SuspendUsers_FullMethodName         = "/proto.EndNodeAccess/SuspendUsers"
+	EndNodeAccess_ResumeUsers_FullMethodName          = "/proto.EndNodeAccess/ResumeUsers"
+	EndNodeAccess_ExportUsers_FullMethodName          = "/proto.EndNodeAccess/ExportUsers"
+	EndNodeAccess_ImportUsers_FullMethodName          = "/proto.EndNodeAccess/ImportUsers"
+	EndNodeAccess_ReconcileUsers_FullMethodName       = "/proto.EndNodeAccess/ReconcileUsers"
+	EndNodeAccess_SetPlans_FullMethodName             = "/proto.EndNodeAccess/SetPlans"
+	EndNodeAccess_DeletePlans_FullMethodName          = "/proto.EndNodeAccess/DeletePlans"
+	EndNodeAccess_GetPlans_FullMethodName             = "/proto.EndNodeAccess/GetPlans"
 	EndNodeAccess_GetSub_FullMethodName               = "/proto.EndNodeAccess/GetSub"
 	EndNodeAccess_GetBandWidthStats_FullMethodName    = "/proto.EndNodeAccess/GetBandWidthStats"
+	EndNodeAccess_GetTrafficReport_FullMethodName     = "/proto.EndNodeAccess/GetTrafficReport"
+	EndNodeAccess_GetAuditLog_FullMethodName          = "/proto.EndNodeAccess/GetAuditLog"
 	EndNodeAccess_HeartBeat_FullMethodName            = "/proto.EndNodeAccess/HeartBeat"
 	EndNodeAccess_RegisterNode_FullMethodName         = "/proto.EndNodeAccess/RegisterNode"
+	EndNodeAccess_IssueNodeCert_FullMethodName        = "/proto.EndNodeAccess/IssueNodeCert"
+	EndNodeAccess_GossipPing_FullMethodName           = "/proto.EndNodeAccess/GossipPing"
 	EndNodeAccess_SetGatewayModel_FullMethodName      = "/proto.EndNodeAccess/SetGatewayModel"
 	EndNodeAccess_AddInbound_FullMethodName           = "/proto.EndNodeAccess/AddInbound"
 	EndNodeAccess_DeleteInbound_FullMethodName        = "/proto.EndNodeAccess/DeleteInbound"
@@ -59,11 +71,23 @@ type EndNodeAccessClient interface {
 	ClearUsers(ctx context.Context, in *ClearUsersReq, opts ...grpc.CallOption) (*ClearUsersRsp, error)
 	UpdateUsers(ctx context.Context, in *UserOpReq, opts ...grpc.CallOption) (*UserOpRsp, error)
 	ResetUser(ctx context.Context, in *UserOpReq, opts ...grpc.CallOption) (*UserOpRsp, error)
+	SuspendUsers(ctx context.Context, in *UserOpReq, opts ...grpc.CallOption) (*UserOpRsp, error)
+	ResumeUsers(ctx context.Context, in *UserOpReq, opts ...grpc.CallOption) (*UserOpRsp, error)
+	ExportUsers(ctx context.Context, in *ExportUsersReq, opts ...grpc.CallOption) (*ExportUsersRsp, error)
+	ImportUsers(ctx context.Context, in *ImportUsersReq, opts ...grpc.CallOption) (*ImportUsersRsp, error)
+	ReconcileUsers(ctx context.Context, in *ReconcileUsersReq, opts ...grpc.CallOption) (*ReconcileUsersRsp, error)
+	SetPlans(ctx context.Context, in *PlanOpReq, opts ...grpc.CallOption) (*PlanOpRsp, error)
+	DeletePlans(ctx context.Context, in *PlanOpReq, opts ...grpc.CallOption) (*PlanOpRsp, error)
+	GetPlans(ctx context.Context, in *GetPlansReq, opts ...grpc.CallOption) (*GetPlansRsp, error)
 	GetSub(ctx context.Context, in *GetSubReq, opts ...grpc.CallOption) (*GetSubRsp, error)
 	GetBandWidthStats(ctx context.Context, in *GetBandwidthStatsReq, opts ...grpc.CallOption) (*GetBandwidthStatsRsp, error)
+	GetTrafficReport(ctx context.Context, in *GetTrafficReportReq, opts ...grpc.CallOption) (*GetTrafficReportRsp, error)
+	GetAuditLog(ctx context.Context, in *GetAuditLogReq, opts ...grpc.CallOption) (*GetAuditLogRsp, error)
 	// system
 	HeartBeat(ctx context.Context, in *HeartBeatReq, opts ...grpc.CallOption) (*HeartBeatRsp, error)
 	RegisterNode(ctx context.Context, in *RegisterNodeReq, opts ...grpc.CallOption) (*RegisterNodeRsp, error)
+	IssueNodeCert(ctx context.Context, in *IssueNodeCertReq, opts ...grpc.CallOption) (*IssueNodeCertRsp, error)
+	GossipPing(ctx context.Context, in *GossipPingReq, opts ...grpc.CallOption) (*GossipPingRsp, error)
 	SetGatewayModel(ctx context.Context, in *SetGatewayModelReq, opts ...grpc.CallOption) (*SetGatewayModelRsp, error)
 	// inbound
 	AddInbound(ctx context.Context, in *InboundOpReq, opts ...grpc.CallOption) (*InboundOpRsp, error)
@@ -149,6 +173,78 @@ func (c *endNodeAccessClient) ResetUser(ctx context.Context, in *UserOpReq, opts
 	return out, nil
 }
 
+func (c *endNodeAccessClient) SuspendUsers(ctx context.Context, in *UserOpReq, opts ...grpc.CallOption) (*UserOpRsp, error) {
+	out := new(UserOpRsp)
+	err := c.cc.Invoke(ctx, EndNodeAccess_SuspendUsers_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *endNodeAccessClient) ResumeUsers(ctx context.Context, in *UserOpReq, opts ...grpc.CallOption) (*UserOpRsp, error) {
+	out := new(UserOpRsp)
+	err := c.cc.Invoke(ctx, EndNodeAccess_ResumeUsers_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *endNodeAccessClient) ExportUsers(ctx context.Context, in *ExportUsersReq, opts ...grpc.CallOption) (*ExportUsersRsp, error) {
+	out := new(ExportUsersRsp)
+	err := c.cc.Invoke(ctx, EndNodeAccess_ExportUsers_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *endNodeAccessClient) ImportUsers(ctx context.Context, in *ImportUsersReq, opts ...grpc.CallOption) (*ImportUsersRsp, error) {
+	out := new(ImportUsersRsp)
+	err := c.cc.Invoke(ctx, EndNodeAccess_ImportUsers_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *endNodeAccessClient) ReconcileUsers(ctx context.Context, in *ReconcileUsersReq, opts ...grpc.CallOption) (*ReconcileUsersRsp, error) {
+	out := new(ReconcileUsersRsp)
+	err := c.cc.Invoke(ctx, EndNodeAccess_ReconcileUsers_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *endNodeAccessClient) SetPlans(ctx context.Context, in *PlanOpReq, opts ...grpc.CallOption) (*PlanOpRsp, error) {
+	out := new(PlanOpRsp)
+	err := c.cc.Invoke(ctx, EndNodeAccess_SetPlans_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *endNodeAccessClient) DeletePlans(ctx context.Context, in *PlanOpReq, opts ...grpc.CallOption) (*PlanOpRsp, error) {
+	out := new(PlanOpRsp)
+	err := c.cc.Invoke(ctx, EndNodeAccess_DeletePlans_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *endNodeAccessClient) GetPlans(ctx context.Context, in *GetPlansReq, opts ...grpc.CallOption) (*GetPlansRsp, error) {
+	out := new(GetPlansRsp)
+	err := c.cc.Invoke(ctx, EndNodeAccess_GetPlans_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *endNodeAccessClient) GetSub(ctx context.Context, in *GetSubReq, opts ...grpc.CallOption) (*GetSubRsp, error) {
 	out := new(GetSubRsp)
 	err := c.cc.Invoke(ctx, EndNodeAccess_GetSub_FullMethodName, in, out, opts...)
@@ -167,6 +263,24 @@ func (c *endNodeAccessClient) GetBandWidthStats(ctx context.Context, in *GetBand
 	return out, nil
 }
 
+func (c *endNodeAccessClient) GetTrafficReport(ctx context.Context, in *GetTrafficReportReq, opts ...grpc.CallOption) (*GetTrafficReportRsp, error) {
+	out := new(GetTrafficReportRsp)
+	err := c.cc.Invoke(ctx, EndNodeAccess_GetTrafficReport_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *endNodeAccessClient) GetAuditLog(ctx context.Context, in *GetAuditLogReq, opts ...grpc.CallOption) (*GetAuditLogRsp, error) {
+	out := new(GetAuditLogRsp)
+	err := c.cc.Invoke(ctx, EndNodeAccess_GetAuditLog_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *endNodeAccessClient) HeartBeat(ctx context.Context, in *HeartBeatReq, opts ...grpc.CallOption) (*HeartBeatRsp, error) {
 	out := new(HeartBeatRsp)
 	err := c.cc.Invoke(ctx, EndNodeAccess_HeartBeat_FullMethodName, in, out, opts...)
@@ -185,6 +299,24 @@ func (c *endNodeAccessClient) RegisterNode(ctx context.Context, in *RegisterNode
 	return out, nil
 }
 
+func (c *endNodeAccessClient) IssueNodeCert(ctx context.Context, in *IssueNodeCertReq, opts ...grpc.CallOption) (*IssueNodeCertRsp, error) {
+	out := new(IssueNodeCertRsp)
+	err := c.cc.Invoke(ctx, EndNodeAccess_IssueNodeCert_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *endNodeAccessClient) GossipPing(ctx context.Context, in *GossipPingReq, opts ...grpc.CallOption) (*GossipPingRsp, error) {
+	out := new(GossipPingRsp)
+	err := c.cc.Invoke(ctx, EndNodeAccess_GossipPing_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *endNodeAccessClient) SetGatewayModel(ctx context.Context, in *SetGatewayModelReq, opts ...grpc.CallOption) (*SetGatewayModelRsp, error) {
 	out := new(SetGatewayModelRsp)
 	err := c.cc.Invoke(ctx, EndNodeAccess_SetGatewayModel_FullMethodName, in, out, opts...)
@@ -349,11 +481,23 @@ type EndNodeAccessServer interface {
 	ClearUsers(context.Context, *ClearUsersReq) (*ClearUsersRsp, error)
 	UpdateUsers(context.Context, *UserOpReq) (*UserOpRsp, error)
 	ResetUser(context.Context, *UserOpReq) (*UserOpRsp, error)
+	SuspendUsers(context.Context, *UserOpReq) (*UserOpRsp, error)
+	ResumeUsers(context.Context, *UserOpReq) (*UserOpRsp, error)
+	ExportUsers(context.Context, *ExportUsersReq) (*ExportUsersRsp, error)
+	ImportUsers(context.Context, *ImportUsersReq) (*ImportUsersRsp, error)
+	ReconcileUsers(context.Context, *ReconcileUsersReq) (*ReconcileUsersRsp, error)
+	SetPlans(context.Context, *PlanOpReq) (*PlanOpRsp, error)
+	DeletePlans(context.Context, *PlanOpReq) (*PlanOpRsp, error)
+	GetPlans(context.Context, *GetPlansReq) (*GetPlansRsp, error)
 	GetSub(context.Context, *GetSubReq) (*GetSubRsp, error)
 	GetBandWidthStats(context.Context, *GetBandwidthStatsReq) (*GetBandwidthStatsRsp, error)
+	GetTrafficReport(context.Context, *GetTrafficReportReq) (*GetTrafficReportRsp, error)
+	GetAuditLog(context.Context, *GetAuditLogReq) (*GetAuditLogRsp, error)
 	// system
 	HeartBeat(context.Context, *HeartBeatReq) (*HeartBeatRsp, error)
 	RegisterNode(context.Context, *RegisterNodeReq) (*RegisterNodeRsp, error)
+	IssueNodeCert(context.Context, *IssueNodeCertReq) (*IssueNodeCertRsp, error)
+	GossipPing(context.Context, *GossipPingReq) (*GossipPingRsp, error)
 	SetGatewayModel(context.Context, *SetGatewayModelReq) (*SetGatewayModelRsp, error)
 	// inbound
 	AddInbound(context.Context, *InboundOpReq) (*InboundOpRsp, error)
@@ -400,18 +544,54 @@ func (UnimplementedEndNodeAccessServer) UpdateUsers(context.Context, *UserOpReq)
 func (UnimplementedEndNodeAccessServer) ResetUser(context.Context, *UserOpReq) (*UserOpRsp, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ResetUser not implemented")
 }
+func (UnimplementedEndNodeAccessServer) SuspendUsers(context.Context, *UserOpReq) (*UserOpRsp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SuspendUsers not implemented")
+}
+func (UnimplementedEndNodeAccessServer) ResumeUsers(context.Context, *UserOpReq) (*UserOpRsp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ResumeUsers not implemented")
+}
+func (UnimplementedEndNodeAccessServer) ExportUsers(context.Context, *ExportUsersReq) (*ExportUsersRsp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExportUsers not implemented")
+}
+func (UnimplementedEndNodeAccessServer) ImportUsers(context.Context, *ImportUsersReq) (*ImportUsersRsp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ImportUsers not implemented")
+}
+func (UnimplementedEndNodeAccessServer) ReconcileUsers(context.Context, *ReconcileUsersReq) (*ReconcileUsersRsp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReconcileUsers not implemented")
+}
+func (UnimplementedEndNodeAccessServer) SetPlans(context.Context, *PlanOpReq) (*PlanOpRsp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetPlans not implemented")
+}
+func (UnimplementedEndNodeAccessServer) DeletePlans(context.Context, *PlanOpReq) (*PlanOpRsp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeletePlans not implemented")
+}
+func (UnimplementedEndNodeAccessServer) GetPlans(context.Context, *GetPlansReq) (*GetPlansRsp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPlans not implemented")
+}
 func (UnimplementedEndNodeAccessServer) GetSub(context.Context, *GetSubReq) (*GetSubRsp, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetSub not implemented")
 }
 func (UnimplementedEndNodeAccessServer) GetBandWidthStats(context.Context, *GetBandwidthStatsReq) (*GetBandwidthStatsRsp, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetBandWidthStats not implemented")
 }
+func (UnimplementedEndNodeAccessServer) GetTrafficReport(context.Context, *GetTrafficReportReq) (*GetTrafficReportRsp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTrafficReport not implemented")
+}
+func (UnimplementedEndNodeAccessServer) GetAuditLog(context.Context, *GetAuditLogReq) (*GetAuditLogRsp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAuditLog not implemented")
+}
 func (UnimplementedEndNodeAccessServer) HeartBeat(context.Context, *HeartBeatReq) (*HeartBeatRsp, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method HeartBeat not implemented")
 }
 func (UnimplementedEndNodeAccessServer) RegisterNode(context.Context, *RegisterNodeReq) (*RegisterNodeRsp, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method RegisterNode not implemented")
 }
+func (UnimplementedEndNodeAccessServer) IssueNodeCert(context.Context, *IssueNodeCertReq) (*IssueNodeCertRsp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method IssueNodeCert not implemented")
+}
+func (UnimplementedEndNodeAccessServer) GossipPing(context.Context, *GossipPingReq) (*GossipPingRsp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GossipPing not implemented")
+}
 func (UnimplementedEndNodeAccessServer) SetGatewayModel(context.Context, *SetGatewayModelReq) (*SetGatewayModelRsp, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SetGatewayModel not implemented")
 }
@@ -584,6 +764,150 @@ func _EndNodeAccess_ResetUser_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _EndNodeAccess_SuspendUsers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UserOpReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EndNodeAccessServer).SuspendUsers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EndNodeAccess_SuspendUsers_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EndNodeAccessServer).SuspendUsers(ctx, req.(*UserOpReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EndNodeAccess_ResumeUsers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UserOpReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EndNodeAccessServer).ResumeUsers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EndNodeAccess_ResumeUsers_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EndNodeAccessServer).ResumeUsers(ctx, req.(*UserOpReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EndNodeAccess_ExportUsers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExportUsersReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EndNodeAccessServer).ExportUsers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EndNodeAccess_ExportUsers_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EndNodeAccessServer).ExportUsers(ctx, req.(*ExportUsersReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EndNodeAccess_ImportUsers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ImportUsersReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EndNodeAccessServer).ImportUsers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EndNodeAccess_ImportUsers_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EndNodeAccessServer).ImportUsers(ctx, req.(*ImportUsersReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EndNodeAccess_ReconcileUsers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReconcileUsersReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EndNodeAccessServer).ReconcileUsers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EndNodeAccess_ReconcileUsers_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EndNodeAccessServer).ReconcileUsers(ctx, req.(*ReconcileUsersReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EndNodeAccess_SetPlans_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PlanOpReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EndNodeAccessServer).SetPlans(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EndNodeAccess_SetPlans_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EndNodeAccessServer).SetPlans(ctx, req.(*PlanOpReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EndNodeAccess_DeletePlans_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PlanOpReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EndNodeAccessServer).DeletePlans(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EndNodeAccess_DeletePlans_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EndNodeAccessServer).DeletePlans(ctx, req.(*PlanOpReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EndNodeAccess_GetPlans_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPlansReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EndNodeAccessServer).GetPlans(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EndNodeAccess_GetPlans_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EndNodeAccessServer).GetPlans(ctx, req.(*GetPlansReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _EndNodeAccess_GetSub_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(GetSubReq)
 	if err := dec(in); err != nil {
@@ -620,6 +944,42 @@ func _EndNodeAccess_GetBandWidthStats_Handler(srv interface{}, ctx context.Conte
 	return interceptor(ctx, in, info, handler)
 }
 
+func _EndNodeAccess_GetTrafficReport_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTrafficReportReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EndNodeAccessServer).GetTrafficReport(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EndNodeAccess_GetTrafficReport_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EndNodeAccessServer).GetTrafficReport(ctx, req.(*GetTrafficReportReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EndNodeAccess_GetAuditLog_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAuditLogReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EndNodeAccessServer).GetAuditLog(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EndNodeAccess_GetAuditLog_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EndNodeAccessServer).GetAuditLog(ctx, req.(*GetAuditLogReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _EndNodeAccess_HeartBeat_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(HeartBeatReq)
 	if err := dec(in); err != nil {
@@ -656,6 +1016,42 @@ func _EndNodeAccess_RegisterNode_Handler(srv interface{}, ctx context.Context, d
 	return interceptor(ctx, in, info, handler)
 }
 
+func _EndNodeAccess_IssueNodeCert_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(IssueNodeCertReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EndNodeAccessServer).IssueNodeCert(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EndNodeAccess_IssueNodeCert_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EndNodeAccessServer).IssueNodeCert(ctx, req.(*IssueNodeCertReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EndNodeAccess_GossipPing_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GossipPingReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EndNodeAccessServer).GossipPing(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EndNodeAccess_GossipPing_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EndNodeAccessServer).GossipPing(ctx, req.(*GossipPingReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _EndNodeAccess_SetGatewayModel_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(SetGatewayModelReq)
 	if err := dec(in); err != nil {
@@ -993,6 +1389,38 @@ var EndNodeAccess_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ResetUser",
 			Handler:    _EndNodeAccess_ResetUser_Handler,
 		},
+		{
+			MethodName: "SuspendUsers",
+			Handler:    _EndNodeAccess_SuspendUsers_Handler,
+		},
+		{
+			MethodName: "ResumeUsers",
+			Handler:    _EndNodeAccess_ResumeUsers_Handler,
+		},
+		{
+			MethodName: "ExportUsers",
+			Handler:    _EndNodeAccess_ExportUsers_Handler,
+		},
+		{
+			MethodName: "ImportUsers",
+			Handler:    _EndNodeAccess_ImportUsers_Handler,
+		},
+		{
+			MethodName: "ReconcileUsers",
+			Handler:    _EndNodeAccess_ReconcileUsers_Handler,
+		},
+		{
+			MethodName: "SetPlans",
+			Handler:    _EndNodeAccess_SetPlans_Handler,
+		},
+		{
+			MethodName: "DeletePlans",
+			Handler:    _EndNodeAccess_DeletePlans_Handler,
+		},
+		{
+			MethodName: "GetPlans",
+			Handler:    _EndNodeAccess_GetPlans_Handler,
+		},
 		{
 			MethodName: "GetSub",
 			Handler:    _EndNodeAccess_GetSub_Handler,
@@ -1001,6 +1429,14 @@ var EndNodeAccess_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetBandWidthStats",
 			Handler:    _EndNodeAccess_GetBandWidthStats_Handler,
 		},
+		{
+			MethodName: "GetTrafficReport",
+			Handler:    _EndNodeAccess_GetTrafficReport_Handler,
+		},
+		{
+			MethodName: "GetAuditLog",
+			Handler:    _EndNodeAccess_GetAuditLog_Handler,
+		},
 		{
 			MethodName: "HeartBeat",
 			Handler:    _EndNodeAccess_HeartBeat_Handler,
@@ -1009,6 +1445,14 @@ var EndNodeAccess_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "RegisterNode",
 			Handler:    _EndNodeAccess_RegisterNode_Handler,
 		},
+		{
+			MethodName: "IssueNodeCert",
+			Handler:    _EndNodeAccess_IssueNodeCert_Handler,
+		},
+		{
+			MethodName: "GossipPing",
+			Handler:    _EndNodeAccess_GossipPing_Handler,
+		},
 		{
 			MethodName: "SetGatewayModel",
 			Handler:    _EndNodeAccess_SetGatewayModel_Handler,
@@ -1210,8 +1654,9 @@ var CenterNodeAdmin_ServiceDesc = grpc.ServiceDesc{
 }
 
 const (
-	CenterNodeAccess_HeartBeat_FullMethodName    = "/proto.CenterNodeAccess/HeartBeat"
-	CenterNodeAccess_RegisterNode_FullMethodName = "/proto.CenterNodeAccess/RegisterNode"
+	CenterNodeAccess_HeartBeat_FullMethodName      = "/proto.CenterNodeAccess/HeartBeat"
+	CenterNodeAccess_RegisterNode_FullMethodName   = "/proto.CenterNodeAccess/RegisterNode"
+	CenterNodeAccess_SyncMembership_FullMethodName = "/proto.CenterNodeAccess/SyncMembership"
 )
 
 // CenterNodeAccessClient is the client API for CenterNodeAccess service.
@@ -1220,6 +1665,7 @@ const (
 type CenterNodeAccessClient interface {
 	HeartBeat(ctx context.Context, in *HeartBeatReq, opts ...grpc.CallOption) (*HeartBeatRsp, error)
 	RegisterNode(ctx context.Context, in *RegisterNodeReq, opts ...grpc.CallOption) (*RegisterNodeRsp, error)
+	SyncMembership(ctx context.Context, in *SyncMembershipReq, opts ...grpc.CallOption) (*SyncMembershipRsp, error)
 }
 
 type centerNodeAccessClient struct {
@@ -1248,12 +1694,22 @@ func (c *centerNodeAccessClient) RegisterNode(ctx context.Context, in *RegisterN
 	return out, nil
 }
 
+func (c *centerNodeAccessClient) SyncMembership(ctx context.Context, in *SyncMembershipReq, opts ...grpc.CallOption) (*SyncMembershipRsp, error) {
+	out := new(SyncMembershipRsp)
+	err := c.cc.Invoke(ctx, CenterNodeAccess_SyncMembership_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // CenterNodeAccessServer is the server API for CenterNodeAccess service.
 // All implementations must embed UnimplementedCenterNodeAccessServer
 // for forward compatibility
 type CenterNodeAccessServer interface {
 	HeartBeat(context.Context, *HeartBeatReq) (*HeartBeatRsp, error)
 	RegisterNode(context.Context, *RegisterNodeReq) (*RegisterNodeRsp, error)
+	SyncMembership(context.Context, *SyncMembershipReq) (*SyncMembershipRsp, error)
 	mustEmbedUnimplementedCenterNodeAccessServer()
 }
 
@@ -1267,6 +1723,9 @@ func (UnimplementedCenterNodeAccessServer) HeartBeat(context.Context, *HeartBeat
 func (UnimplementedCenterNodeAccessServer) RegisterNode(context.Context, *RegisterNodeReq) (*RegisterNodeRsp, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method RegisterNode not implemented")
 }
+func (UnimplementedCenterNodeAccessServer) SyncMembership(context.Context, *SyncMembershipReq) (*SyncMembershipRsp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SyncMembership not implemented")
+}
 func (UnimplementedCenterNodeAccessServer) mustEmbedUnimplementedCenterNodeAccessServer() {}
 
 // UnsafeCenterNodeAccessServer may be embedded to opt out of forward compatibility for this service.
@@ -1316,6 +1775,24 @@ func _CenterNodeAccess_RegisterNode_Handler(srv interface{}, ctx context.Context
 	return interceptor(ctx, in, info, handler)
 }
 
+func _CenterNodeAccess_SyncMembership_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SyncMembershipReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CenterNodeAccessServer).SyncMembership(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CenterNodeAccess_SyncMembership_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CenterNodeAccessServer).SyncMembership(ctx, req.(*SyncMembershipReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // CenterNodeAccess_ServiceDesc is the grpc.ServiceDesc for CenterNodeAccess service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -1331,6 +1808,10 @@ var CenterNodeAccess_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "RegisterNode",
 			Handler:    _CenterNodeAccess_RegisterNode_Handler,
 		},
+		{
+			MethodName: "SyncMembership",
+			Handler:    _CenterNodeAccess_SyncMembership_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "rpc_server.proto",